@@ -0,0 +1,204 @@
+package svg
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A Duration is a clock value attribute value. Negative Durations are offsets
+// before the reference time, for example in begin attributes.
+type Duration time.Duration
+
+// IndefiniteDuration is the indefinite Duration.
+const IndefiniteDuration Duration = math.MinInt64
+
+// A KeySplines is a list of cubic Bézier control points attribute value. Each
+// element contains the control points x1, y1, x2, and y2.
+type KeySplines [][4]float64
+
+// A KeyTimes is a list of times attribute value, each between zero and one.
+type KeyTimes []float64
+
+// A RepeatCount is a repeat count attribute value. Negative RepeatCounts are
+// indefinite.
+type RepeatCount float64
+
+// IndefiniteRepeatCount is the indefinite RepeatCount.
+const IndefiniteRepeatCount RepeatCount = -1
+
+// durationUnits are the units of clock values. "ms" precedes "s" so that
+// suffixes are matched correctly.
+var durationUnits = []struct {
+	suffix   string
+	duration time.Duration
+}{
+	{"h", time.Hour},
+	{"min", time.Minute},
+	{"ms", time.Millisecond},
+	{"s", time.Second},
+}
+
+// String returns d as the shortest timecount value, or "indefinite" if d is
+// IndefiniteDuration.
+func (d Duration) String() string {
+	if d == IndefiniteDuration {
+		return "indefinite"
+	}
+	shortest := strconv.FormatFloat(time.Duration(d).Seconds(), 'f', -1, 64) + "s"
+	for _, unit := range durationUnits {
+		if time.Duration(d)%unit.duration != 0 {
+			continue
+		}
+		if s := strconv.FormatInt(int64(time.Duration(d)/unit.duration), 10) + unit.suffix; len(s) < len(shortest) {
+			shortest = s
+		}
+	}
+	return shortest
+}
+
+// parseDuration parses a clock value, for example "02:30:03", "50:00.10",
+// "10min", "2.5s", or "indefinite".
+func parseDuration(s string) (Duration, error) {
+	s = strings.TrimSpace(s)
+	if s == "indefinite" {
+		return IndefiniteDuration, nil
+	}
+	if strings.Contains(s, ":") {
+		components := strings.Split(s, ":")
+		if len(components) > 3 {
+			return 0, fmt.Errorf("%q: invalid clock value", s)
+		}
+		var duration time.Duration
+		for i, component := range components {
+			var value float64
+			var err error
+			if i == len(components)-1 {
+				value, err = strconv.ParseFloat(component, 64)
+			} else {
+				var intValue int
+				intValue, err = strconv.Atoi(component)
+				value = float64(intValue)
+			}
+			if err != nil || value < 0 || i != 0 && value >= 60 {
+				return 0, fmt.Errorf("%q: invalid clock value", s)
+			}
+			duration = 60*duration + time.Duration(math.Round(value*float64(time.Second)))
+		}
+		return Duration(duration), nil
+	}
+	unitDuration := time.Second
+	for _, unit := range durationUnits {
+		if value, ok := strings.CutSuffix(s, unit.suffix); ok {
+			s = value
+			unitDuration = unit.duration
+			break
+		}
+	}
+	value, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, err
+	}
+	if value < 0 {
+		return 0, fmt.Errorf("%q: negative clock value", s)
+	}
+	return Duration(math.Round(value * float64(unitDuration))), nil
+}
+
+// FormatNumbers implements NumberFormatter.FormatNumbers.
+func (ks KeySplines) FormatNumbers(formatFloat func(float64) string) string {
+	splineStrs := make([]string, 0, len(ks))
+	for _, spline := range ks {
+		splineStrs = append(splineStrs, Numbers(spline[:]).FormatNumbers(formatFloat))
+	}
+	return strings.Join(splineStrs, ";")
+}
+
+func (ks KeySplines) String() string {
+	return ks.FormatNumbers(formatFloat)
+}
+
+// parseKeySplines parses a list of key splines, for example
+// "0.5 0 0.5 1;0 0 1 1".
+func parseKeySplines(s string) (KeySplines, error) {
+	var keySplines KeySplines
+	for _, splineStr := range splitSemicolons(s) {
+		numbers, err := parseNumbers(splineStr)
+		if err != nil {
+			return nil, err
+		}
+		if len(numbers) != 4 {
+			return nil, fmt.Errorf("%q: expected four numbers", splineStr)
+		}
+		keySplines = append(keySplines, [4]float64(numbers))
+	}
+	return keySplines, nil
+}
+
+// FormatNumbers implements NumberFormatter.FormatNumbers.
+func (kt KeyTimes) FormatNumbers(formatFloat func(float64) string) string {
+	timeStrs := make([]string, 0, len(kt))
+	for _, keyTime := range kt {
+		timeStrs = append(timeStrs, formatFloat(keyTime))
+	}
+	return strings.Join(timeStrs, ";")
+}
+
+func (kt KeyTimes) String() string {
+	return kt.FormatNumbers(formatFloat)
+}
+
+// parseKeyTimes parses a list of key times, for example "0;0.25;1".
+func parseKeyTimes(s string) (KeyTimes, error) {
+	var keyTimes KeyTimes
+	for _, timeStr := range splitSemicolons(s) {
+		keyTime, err := strconv.ParseFloat(timeStr, 64)
+		if err != nil {
+			return nil, err
+		}
+		keyTimes = append(keyTimes, keyTime)
+	}
+	return keyTimes, nil
+}
+
+// FormatNumbers implements NumberFormatter.FormatNumbers.
+func (rc RepeatCount) FormatNumbers(formatFloat func(float64) string) string {
+	if rc < 0 {
+		return "indefinite"
+	}
+	return formatFloat(float64(rc))
+}
+
+func (rc RepeatCount) String() string {
+	return rc.FormatNumbers(formatFloat)
+}
+
+// parseRepeatCount parses a repeat count, for example "2.5" or "indefinite".
+func parseRepeatCount(s string) (RepeatCount, error) {
+	s = strings.TrimSpace(s)
+	if s == "indefinite" {
+		return IndefiniteRepeatCount, nil
+	}
+	value, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, err
+	}
+	if value < 0 {
+		return 0, fmt.Errorf("%q: negative repeat count", s)
+	}
+	return RepeatCount(value), nil
+}
+
+// splitSemicolons splits s into a list of trimmed, non-empty strings separated
+// by semicolons.
+func splitSemicolons(s string) []string {
+	var strs []string
+	for str := range strings.SplitSeq(s, ";") {
+		if str = strings.TrimSpace(str); str != "" {
+			strs = append(strs, str)
+		}
+	}
+	return strs
+}
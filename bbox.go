@@ -0,0 +1,180 @@
+package svg
+
+import (
+	"fmt"
+
+	"github.com/twpayne/go-svg/svgpath"
+)
+
+// BBox returns the bounding box of e's geometry, excluding any stroke.
+func (e *CircleElement) BBox() (svgpath.BBox, error) {
+	cx, cy, r, err := userUnits3(e.Attrs, "cx", "cy", "r")
+	if err != nil {
+		return svgpath.BBox{}, err
+	}
+	return svgpath.BBox{
+		MinX: cx - r,
+		MinY: cy - r,
+		MaxX: cx + r,
+		MaxY: cy + r,
+	}, nil
+}
+
+// BBox returns the bounding box of e's geometry, excluding any stroke. If only
+// one of rx or ry is set then it is used for both.
+func (e *EllipseElement) BBox() (svgpath.BBox, error) {
+	cx, cy, err := userUnits2(e.Attrs, "cx", "cy")
+	if err != nil {
+		return svgpath.BBox{}, err
+	}
+	rx, ry, err := userUnits2(e.Attrs, "rx", "ry")
+	if err != nil {
+		return svgpath.BBox{}, err
+	}
+	_, rxOK := e.Attrs["rx"]
+	_, ryOK := e.Attrs["ry"]
+	switch {
+	case rxOK && !ryOK:
+		ry = rx
+	case !rxOK && ryOK:
+		rx = ry
+	}
+	return svgpath.BBox{
+		MinX: cx - rx,
+		MinY: cy - ry,
+		MaxX: cx + rx,
+		MaxY: cy + ry,
+	}, nil
+}
+
+// BBox returns the bounding box of e's geometry, excluding any stroke.
+func (e *LineElement) BBox() (svgpath.BBox, error) {
+	x1, y1, err := userUnits2(e.Attrs, "x1", "y1")
+	if err != nil {
+		return svgpath.BBox{}, err
+	}
+	x2, y2, err := userUnits2(e.Attrs, "x2", "y2")
+	if err != nil {
+		return svgpath.BBox{}, err
+	}
+	return svgpath.EmptyBBox().Extend(x1, y1).Extend(x2, y2), nil
+}
+
+// BBox returns the bounding box of e's geometry, excluding any stroke.
+func (e *PathElement) BBox() (svgpath.BBox, error) {
+	var path *svgpath.Path
+	switch d := e.Attrs["d"].(type) {
+	case nil:
+	case *svgpath.Path:
+		path = d
+	default:
+		var err error
+		if path, err = svgpath.Parse(d.String()); err != nil {
+			return svgpath.BBox{}, fmt.Errorf("d: %w", err)
+		}
+	}
+	return path.BBox(), nil
+}
+
+// BBox returns the bounding box of e's geometry, excluding any stroke.
+func (e *PolygonElement) BBox() (svgpath.BBox, error) {
+	return pointsBBox(e.Attrs)
+}
+
+// BBox returns the bounding box of e's geometry, excluding any stroke.
+func (e *PolylineElement) BBox() (svgpath.BBox, error) {
+	return pointsBBox(e.Attrs)
+}
+
+// BBox returns the bounding box of e's geometry, excluding any stroke.
+func (e *RectElement) BBox() (svgpath.BBox, error) {
+	x, y, err := userUnits2(e.Attrs, "x", "y")
+	if err != nil {
+		return svgpath.BBox{}, err
+	}
+	width, height, err := userUnits2(e.Attrs, "width", "height")
+	if err != nil {
+		return svgpath.BBox{}, err
+	}
+	return svgpath.BBox{
+		MinX: x,
+		MinY: y,
+		MaxX: x + width,
+		MaxY: y + height,
+	}, nil
+}
+
+// pointsBBox returns the bounding box of the points attribute in attrs.
+func pointsBBox(attrs map[string]AttrValue) (svgpath.BBox, error) {
+	var points Points
+	switch value := attrs["points"].(type) {
+	case nil:
+	case Points:
+		points = value
+	default:
+		var err error
+		if points, err = parsePoints(value.String()); err != nil {
+			return svgpath.BBox{}, fmt.Errorf("points: %w", err)
+		}
+	}
+	bbox := svgpath.EmptyBBox()
+	for _, point := range points {
+		bbox = bbox.Extend(point[0], point[1])
+	}
+	return bbox, nil
+}
+
+// userUnits returns the value of the attribute name in attrs in user units.
+// Missing attributes have the value zero.
+func userUnits(attrs map[string]AttrValue, name string) (float64, error) {
+	var length Length
+	switch value := attrs[name].(type) {
+	case nil:
+		return 0, nil
+	case Float64:
+		return float64(value), nil
+	case Int:
+		return float64(value), nil
+	case Length:
+		length = value
+	default:
+		var err error
+		if length, err = parseLength(value.String()); err != nil {
+			return 0, fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	switch length.Unit {
+	case LengthUnitNumber, LengthUnitPx:
+		return length.Value, nil
+	default:
+		return 0, fmt.Errorf("%s: %s: cannot convert to user units", name, length)
+	}
+}
+
+// userUnits2 returns the values of the attributes name1 and name2 in attrs in
+// user units.
+func userUnits2(attrs map[string]AttrValue, name1, name2 string) (float64, float64, error) {
+	value1, err := userUnits(attrs, name1)
+	if err != nil {
+		return 0, 0, err
+	}
+	value2, err := userUnits(attrs, name2)
+	if err != nil {
+		return 0, 0, err
+	}
+	return value1, value2, nil
+}
+
+// userUnits3 returns the values of the attributes name1, name2, and name3 in
+// attrs in user units.
+func userUnits3(attrs map[string]AttrValue, name1, name2, name3 string) (float64, float64, float64, error) {
+	value1, value2, err := userUnits2(attrs, name1, name2)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	value3, err := userUnits(attrs, name3)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return value1, value2, value3, nil
+}
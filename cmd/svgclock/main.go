@@ -5,7 +5,6 @@ import (
 	"flag"
 	"fmt"
 	"html/template"
-	"math"
 	"net/http"
 	"os"
 	"time"
@@ -17,52 +16,61 @@ import (
 //go:embed index.html.tmpl
 var indexHTML string
 
-func rad(deg int) float64 {
-	return math.Pi * float64(deg) / 180
-}
-
+// svgClock returns a self-running clock showing the time t. Each hand is drawn
+// at its initial angle and rotated by an animation with the hand's period.
 func svgClock(t time.Time) *svg.SVGElement {
 	width := 128.0
 	height := 128.0
 	buffer := 4.0
 	diameter := min(width-2*buffer, height-2*buffer)
 	radius := diameter / 2
-	hour, minute, second := t.Hour(), t.Minute(), t.Second()
+	cx, cy := width/2, height/2
+	seconds := time.Duration(t.Hour()%12)*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
 	handElements := make([]svg.Element, 0, 3)
 	for _, hand := range []struct {
-		angle       float64
+		period      time.Duration
 		length      float64
-		stroke      svg.String
+		stroke      svg.Color
 		strokeWidth svg.Length
 	}{
 		{
+			period:      time.Minute,
 			length:      0.8,
-			angle:       rad(360*second/60 - 90),
-			stroke:      svg.String("red"),
+			stroke:      svg.RGB(0xff, 0, 0),
 			strokeWidth: svg.Number(1),
 		},
 		{
+			period:      time.Hour,
 			length:      0.9,
-			angle:       rad(360*(60*minute+second)/(60*60) - 90),
-			stroke:      svg.String("black"),
+			stroke:      svg.RGB(0, 0, 0),
 			strokeWidth: svg.Number(3),
 		},
 		{
+			period:      12 * time.Hour,
 			length:      0.6,
-			angle:       rad(360*((hour%12)*60*60+minute*60+second)/(12*60*60) - 90),
-			stroke:      svg.String("black"),
+			stroke:      svg.RGB(0, 0, 0),
 			strokeWidth: svg.Number(5),
 		},
 	} {
-		handElement := svg.Path().D(svgpath.New().
-			MoveToAbs([]float64{width / 2, height / 2}).
-			LineToRel([]float64{hand.length * radius * math.Cos(hand.angle), hand.length * radius * math.Sin(hand.angle)}).
-			ClosePath(),
-		).Stroke(hand.stroke).StrokeWidth(hand.strokeWidth)
+		angle := 360 * float64(seconds%hand.period) / float64(hand.period)
+		handElement := svg.G(
+			svg.Path().D(svgpath.New().
+				MoveToAbs([]float64{cx, cy}).
+				VLineToRel(-hand.length*radius).
+				ClosePath(),
+			).StrokeColor(hand.stroke).StrokeWidth(hand.strokeWidth),
+			svg.AnimateTransform().
+				AttributeName("transform").
+				Type("rotate").
+				From(svg.String(fmt.Sprintf("%g %g %g", angle, cx, cy))).
+				To(svg.String(fmt.Sprintf("%g %g %g", angle+360, cx, cy))).
+				Dur(svg.Duration(hand.period)).
+				RepeatCount(svg.IndefiniteRepeatCount),
+		).Transform(svg.Rotate(angle, cx, cy))
 		handElements = append(handElements, handElement)
 	}
 	return svg.New().WidthHeight(width, height, svg.Number).ViewBox(0, 0, width, height).AppendChildren(
-		svg.Circle().CXCYR(width/2, height/2, radius, svg.Number).Fill("none").Stroke("black"),
+		svg.Circle().CXCYR(cx, cy, radius, svg.Number).Fill("none").Stroke("black"),
 	).AppendChildren(handElements...)
 }
 
@@ -70,11 +78,9 @@ func run() error {
 	addr := flag.String("addr", ":8080", "address")
 	flag.Parse()
 
-	indexTemplate, err := template.New("").Funcs(template.FuncMap{
-		"now": time.Now,
-		"svgClock": func(t time.Time) template.HTML {
-			return template.HTML(svgClock(t).String())
-		},
+	indexTemplate, err := template.New("").Funcs(svg.FuncMap()).Funcs(template.FuncMap{
+		"now":      time.Now,
+		"svgClock": svgClock,
 	}).Parse(indexHTML)
 	if err != nil {
 		return err
@@ -0,0 +1,459 @@
+package svg
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// A Color is a color attribute value. Its components are not alpha
+// premultiplied.
+type Color struct {
+	R       uint8
+	G       uint8
+	B       uint8
+	A       uint8
+	current bool
+}
+
+// CurrentColor is the currentColor keyword, which refers to the value of the
+// color property.
+var CurrentColor = Color{current: true}
+
+// namedColors are the CSS named colors, see
+// https://www.w3.org/TR/css-color-4/#named-colors.
+var namedColors = map[string][3]uint8{
+	"aliceblue":            {0xf0, 0xf8, 0xff},
+	"antiquewhite":         {0xfa, 0xeb, 0xd7},
+	"aqua":                 {0x00, 0xff, 0xff},
+	"aquamarine":           {0x7f, 0xff, 0xd4},
+	"azure":                {0xf0, 0xff, 0xff},
+	"beige":                {0xf5, 0xf5, 0xdc},
+	"bisque":               {0xff, 0xe4, 0xc4},
+	"black":                {0x00, 0x00, 0x00},
+	"blanchedalmond":       {0xff, 0xeb, 0xcd},
+	"blue":                 {0x00, 0x00, 0xff},
+	"blueviolet":           {0x8a, 0x2b, 0xe2},
+	"brown":                {0xa5, 0x2a, 0x2a},
+	"burlywood":            {0xde, 0xb8, 0x87},
+	"cadetblue":            {0x5f, 0x9e, 0xa0},
+	"chartreuse":           {0x7f, 0xff, 0x00},
+	"chocolate":            {0xd2, 0x69, 0x1e},
+	"coral":                {0xff, 0x7f, 0x50},
+	"cornflowerblue":       {0x64, 0x95, 0xed},
+	"cornsilk":             {0xff, 0xf8, 0xdc},
+	"crimson":              {0xdc, 0x14, 0x3c},
+	"cyan":                 {0x00, 0xff, 0xff},
+	"darkblue":             {0x00, 0x00, 0x8b},
+	"darkcyan":             {0x00, 0x8b, 0x8b},
+	"darkgoldenrod":        {0xb8, 0x86, 0x0b},
+	"darkgray":             {0xa9, 0xa9, 0xa9},
+	"darkgreen":            {0x00, 0x64, 0x00},
+	"darkgrey":             {0xa9, 0xa9, 0xa9},
+	"darkkhaki":            {0xbd, 0xb7, 0x6b},
+	"darkmagenta":          {0x8b, 0x00, 0x8b},
+	"darkolivegreen":       {0x55, 0x6b, 0x2f},
+	"darkorange":           {0xff, 0x8c, 0x00},
+	"darkorchid":           {0x99, 0x32, 0xcc},
+	"darkred":              {0x8b, 0x00, 0x00},
+	"darksalmon":           {0xe9, 0x96, 0x7a},
+	"darkseagreen":         {0x8f, 0xbc, 0x8f},
+	"darkslateblue":        {0x48, 0x3d, 0x8b},
+	"darkslategray":        {0x2f, 0x4f, 0x4f},
+	"darkslategrey":        {0x2f, 0x4f, 0x4f},
+	"darkturquoise":        {0x00, 0xce, 0xd1},
+	"darkviolet":           {0x94, 0x00, 0xd3},
+	"deeppink":             {0xff, 0x14, 0x93},
+	"deepskyblue":          {0x00, 0xbf, 0xff},
+	"dimgray":              {0x69, 0x69, 0x69},
+	"dimgrey":              {0x69, 0x69, 0x69},
+	"dodgerblue":           {0x1e, 0x90, 0xff},
+	"firebrick":            {0xb2, 0x22, 0x22},
+	"floralwhite":          {0xff, 0xfa, 0xf0},
+	"forestgreen":          {0x22, 0x8b, 0x22},
+	"fuchsia":              {0xff, 0x00, 0xff},
+	"gainsboro":            {0xdc, 0xdc, 0xdc},
+	"ghostwhite":           {0xf8, 0xf8, 0xff},
+	"gold":                 {0xff, 0xd7, 0x00},
+	"goldenrod":            {0xda, 0xa5, 0x20},
+	"gray":                 {0x80, 0x80, 0x80},
+	"green":                {0x00, 0x80, 0x00},
+	"greenyellow":          {0xad, 0xff, 0x2f},
+	"grey":                 {0x80, 0x80, 0x80},
+	"honeydew":             {0xf0, 0xff, 0xf0},
+	"hotpink":              {0xff, 0x69, 0xb4},
+	"indianred":            {0xcd, 0x5c, 0x5c},
+	"indigo":               {0x4b, 0x00, 0x82},
+	"ivory":                {0xff, 0xff, 0xf0},
+	"khaki":                {0xf0, 0xe6, 0x8c},
+	"lavender":             {0xe6, 0xe6, 0xfa},
+	"lavenderblush":        {0xff, 0xf0, 0xf5},
+	"lawngreen":            {0x7c, 0xfc, 0x00},
+	"lemonchiffon":         {0xff, 0xfa, 0xcd},
+	"lightblue":            {0xad, 0xd8, 0xe6},
+	"lightcoral":           {0xf0, 0x80, 0x80},
+	"lightcyan":            {0xe0, 0xff, 0xff},
+	"lightgoldenrodyellow": {0xfa, 0xfa, 0xd2},
+	"lightgray":            {0xd3, 0xd3, 0xd3},
+	"lightgreen":           {0x90, 0xee, 0x90},
+	"lightgrey":            {0xd3, 0xd3, 0xd3},
+	"lightpink":            {0xff, 0xb6, 0xc1},
+	"lightsalmon":          {0xff, 0xa0, 0x7a},
+	"lightseagreen":        {0x20, 0xb2, 0xaa},
+	"lightskyblue":         {0x87, 0xce, 0xfa},
+	"lightslategray":       {0x77, 0x88, 0x99},
+	"lightslategrey":       {0x77, 0x88, 0x99},
+	"lightsteelblue":       {0xb0, 0xc4, 0xde},
+	"lightyellow":          {0xff, 0xff, 0xe0},
+	"lime":                 {0x00, 0xff, 0x00},
+	"limegreen":            {0x32, 0xcd, 0x32},
+	"linen":                {0xfa, 0xf0, 0xe6},
+	"magenta":              {0xff, 0x00, 0xff},
+	"maroon":               {0x80, 0x00, 0x00},
+	"mediumaquamarine":     {0x66, 0xcd, 0xaa},
+	"mediumblue":           {0x00, 0x00, 0xcd},
+	"mediumorchid":         {0xba, 0x55, 0xd3},
+	"mediumpurple":         {0x93, 0x70, 0xdb},
+	"mediumseagreen":       {0x3c, 0xb3, 0x71},
+	"mediumslateblue":      {0x7b, 0x68, 0xee},
+	"mediumspringgreen":    {0x00, 0xfa, 0x9a},
+	"mediumturquoise":      {0x48, 0xd1, 0xcc},
+	"mediumvioletred":      {0xc7, 0x15, 0x85},
+	"midnightblue":         {0x19, 0x19, 0x70},
+	"mintcream":            {0xf5, 0xff, 0xfa},
+	"mistyrose":            {0xff, 0xe4, 0xe1},
+	"moccasin":             {0xff, 0xe4, 0xb5},
+	"navajowhite":          {0xff, 0xde, 0xad},
+	"navy":                 {0x00, 0x00, 0x80},
+	"oldlace":              {0xfd, 0xf5, 0xe6},
+	"olive":                {0x80, 0x80, 0x00},
+	"olivedrab":            {0x6b, 0x8e, 0x23},
+	"orange":               {0xff, 0xa5, 0x00},
+	"orangered":            {0xff, 0x45, 0x00},
+	"orchid":               {0xda, 0x70, 0xd6},
+	"palegoldenrod":        {0xee, 0xe8, 0xaa},
+	"palegreen":            {0x98, 0xfb, 0x98},
+	"paleturquoise":        {0xaf, 0xee, 0xee},
+	"palevioletred":        {0xdb, 0x70, 0x93},
+	"papayawhip":           {0xff, 0xef, 0xd5},
+	"peachpuff":            {0xff, 0xda, 0xb9},
+	"peru":                 {0xcd, 0x85, 0x3f},
+	"pink":                 {0xff, 0xc0, 0xcb},
+	"plum":                 {0xdd, 0xa0, 0xdd},
+	"powderblue":           {0xb0, 0xe0, 0xe6},
+	"purple":               {0x80, 0x00, 0x80},
+	"rebeccapurple":        {0x66, 0x33, 0x99},
+	"red":                  {0xff, 0x00, 0x00},
+	"rosybrown":            {0xbc, 0x8f, 0x8f},
+	"royalblue":            {0x41, 0x69, 0xe1},
+	"saddlebrown":          {0x8b, 0x45, 0x13},
+	"salmon":               {0xfa, 0x80, 0x72},
+	"sandybrown":           {0xf4, 0xa4, 0x60},
+	"seagreen":             {0x2e, 0x8b, 0x57},
+	"seashell":             {0xff, 0xf5, 0xee},
+	"sienna":               {0xa0, 0x52, 0x2d},
+	"silver":               {0xc0, 0xc0, 0xc0},
+	"skyblue":              {0x87, 0xce, 0xeb},
+	"slateblue":            {0x6a, 0x5a, 0xcd},
+	"slategray":            {0x70, 0x80, 0x90},
+	"slategrey":            {0x70, 0x80, 0x90},
+	"snow":                 {0xff, 0xfa, 0xfa},
+	"springgreen":          {0x00, 0xff, 0x7f},
+	"steelblue":            {0x46, 0x82, 0xb4},
+	"tan":                  {0xd2, 0xb4, 0x8c},
+	"teal":                 {0x00, 0x80, 0x80},
+	"thistle":              {0xd8, 0xbf, 0xd8},
+	"tomato":               {0xff, 0x63, 0x47},
+	"turquoise":            {0x40, 0xe0, 0xd0},
+	"violet":               {0xee, 0x82, 0xee},
+	"wheat":                {0xf5, 0xde, 0xb3},
+	"white":                {0xff, 0xff, 0xff},
+	"whitesmoke":           {0xf5, 0xf5, 0xf5},
+	"yellow":               {0xff, 0xff, 0x00},
+	"yellowgreen":          {0x9a, 0xcd, 0x32},
+}
+
+// colorNames maps colors to their shortest name.
+var colorNames = func() map[[3]uint8]string {
+	colorNames := make(map[[3]uint8]string, len(namedColors))
+	for name, rgb := range namedColors {
+		if existingName, ok := colorNames[rgb]; !ok || len(name) < len(existingName) || len(name) == len(existingName) && name < existingName {
+			colorNames[rgb] = name
+		}
+	}
+	return colorNames
+}()
+
+// NewColor returns the Color equivalent to c.
+func NewColor(c color.Color) Color {
+	if c, ok := c.(Color); ok {
+		return c
+	}
+	nrgba, _ := color.NRGBAModel.Convert(c).(color.NRGBA)
+	return Color{
+		R: nrgba.R,
+		G: nrgba.G,
+		B: nrgba.B,
+		A: nrgba.A,
+	}
+}
+
+// RGB returns an opaque Color with red, green, and blue components r, g, and b.
+func RGB(r, g, b uint8) Color {
+	return Color{
+		R: r,
+		G: g,
+		B: b,
+		A: 0xff,
+	}
+}
+
+// RGBA returns a Color with red, green, blue, and alpha components r, g, b,
+// and a.
+func RGBA(r, g, b, a uint8) Color {
+	return Color{
+		R: r,
+		G: g,
+		B: b,
+		A: a,
+	}
+}
+
+// ParseColor parses a CSS color, for example "red", "#f00", "rgb(255 0 0 /
+// 50%)", "hsl(0, 100%, 50%)", or "currentColor".
+func ParseColor(s string) (Color, error) {
+	lower := strings.ToLower(strings.TrimSpace(s))
+	switch {
+	case lower == "currentcolor":
+		return CurrentColor, nil
+	case lower == "transparent":
+		return Color{}, nil
+	case strings.HasPrefix(lower, "#"):
+		if c, ok := parseHexColor(lower[1:]); ok {
+			return c, nil
+		}
+	case strings.HasSuffix(lower, ")"):
+		name, args, _ := strings.Cut(strings.TrimSuffix(lower, ")"), "(")
+		if c, ok := parseColorFunction(strings.TrimSpace(name), args); ok {
+			return c, nil
+		}
+	default:
+		if rgb, ok := namedColors[lower]; ok {
+			return RGB(rgb[0], rgb[1], rgb[2]), nil
+		}
+	}
+	return Color{}, fmt.Errorf("%q: invalid color", s)
+}
+
+// IsCurrentColor returns whether c is the currentColor keyword.
+func (c Color) IsCurrentColor() bool {
+	return c.current
+}
+
+// RGBA implements image/color.Color.RGBA. The currentColor keyword is
+// transparent black.
+func (c Color) RGBA() (uint32, uint32, uint32, uint32) {
+	return color.NRGBA{R: c.R, G: c.G, B: c.B, A: c.A}.RGBA()
+}
+
+// String returns the shortest representation of c, either as a name or in
+// hexadecimal notation.
+func (c Color) String() string {
+	if c.current {
+		return "currentColor"
+	}
+	var hex string
+	switch {
+	case c.A == 0xff && isShortHex(c.R, c.G, c.B):
+		hex = fmt.Sprintf("#%x%x%x", c.R>>4, c.G>>4, c.B>>4)
+	case c.A == 0xff:
+		hex = fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+	case isShortHex(c.R, c.G, c.B, c.A):
+		hex = fmt.Sprintf("#%x%x%x%x", c.R>>4, c.G>>4, c.B>>4, c.A>>4)
+	default:
+		hex = fmt.Sprintf("#%02x%02x%02x%02x", c.R, c.G, c.B, c.A)
+	}
+	if c.A == 0xff {
+		if name, ok := colorNames[[3]uint8{c.R, c.G, c.B}]; ok && len(name) < len(hex) {
+			return name
+		}
+	}
+	return hex
+}
+
+// isShortHex returns whether all of components can be written as a single
+// hexadecimal digit.
+func isShortHex(components ...uint8) bool {
+	for _, component := range components {
+		if component>>4 != component&0xf {
+			return false
+		}
+	}
+	return true
+}
+
+// parseHexColor parses the digits of a color in hexadecimal notation.
+func parseHexColor(digits string) (Color, bool) {
+	var components []uint8
+	switch len(digits) {
+	case 3, 4:
+		for i := range len(digits) {
+			component, err := strconv.ParseUint(digits[i:i+1], 16, 8)
+			if err != nil {
+				return Color{}, false
+			}
+			components = append(components, uint8(component*0x11))
+		}
+	case 6, 8:
+		for i := 0; i < len(digits); i += 2 {
+			component, err := strconv.ParseUint(digits[i:i+2], 16, 8)
+			if err != nil {
+				return Color{}, false
+			}
+			components = append(components, uint8(component))
+		}
+	default:
+		return Color{}, false
+	}
+	if len(components) == 3 {
+		components = append(components, 0xff)
+	}
+	return RGBA(components[0], components[1], components[2], components[3]), true
+}
+
+// parseColorFunction parses the rgb(), rgba(), hsl(), and hsla() color
+// functions, in both the legacy comma-separated and modern space-separated
+// syntaxes.
+func parseColorFunction(name, args string) (Color, bool) {
+	var components []string
+	alpha := "1"
+	if strings.Contains(args, ",") {
+		components = strings.Split(args, ",")
+		for i := range components {
+			components[i] = strings.TrimSpace(components[i])
+		}
+		if len(components) == 4 {
+			alpha = components[3]
+			components = components[:3]
+		}
+	} else {
+		var alphaStr string
+		var ok bool
+		args, alphaStr, ok = strings.Cut(args, "/")
+		components = strings.Fields(args)
+		if ok {
+			alpha = strings.TrimSpace(alphaStr)
+		}
+	}
+	if len(components) != 3 {
+		return Color{}, false
+	}
+	a, ok := parseAlphaValue(alpha)
+	if !ok {
+		return Color{}, false
+	}
+	switch name {
+	case "rgb", "rgba":
+		var rgb [3]uint8
+		for i, component := range components {
+			value, ok := parseNumberOrPercentage(component, 255)
+			if !ok {
+				return Color{}, false
+			}
+			rgb[i] = clampUint8(value)
+		}
+		return RGBA(rgb[0], rgb[1], rgb[2], a), true
+	case "hsl", "hsla":
+		hue, ok := parseHue(components[0])
+		if !ok {
+			return Color{}, false
+		}
+		saturation, ok := parseNumberOrPercentage(strings.TrimSuffix(components[1], "%"), 1)
+		if !ok {
+			return Color{}, false
+		}
+		lightness, ok := parseNumberOrPercentage(strings.TrimSuffix(components[2], "%"), 1)
+		if !ok {
+			return Color{}, false
+		}
+		r, g, b := hslToRGB(hue, min(max(saturation/100, 0), 1), min(max(lightness/100, 0), 1))
+		return RGBA(clampUint8(255*r), clampUint8(255*g), clampUint8(255*b), a), true
+	default:
+		return Color{}, false
+	}
+}
+
+// parseAlphaValue parses an alpha value, either a number between zero and one
+// or a percentage.
+func parseAlphaValue(s string) (uint8, bool) {
+	value, ok := parseNumberOrPercentage(s, 1)
+	if !ok {
+		return 0, false
+	}
+	return clampUint8(255 * value), true
+}
+
+// parseHue parses a hue, returning it in degrees.
+func parseHue(s string) (float64, bool) {
+	unitDegrees := 1.0
+	for _, unit := range []struct {
+		suffix  string
+		degrees float64
+	}{
+		{"deg", 1},
+		{"grad", 360.0 / 400},
+		{"rad", 180 / math.Pi},
+		{"turn", 360},
+	} {
+		if strings.HasSuffix(s, unit.suffix) {
+			s = strings.TrimSuffix(s, unit.suffix)
+			unitDegrees = unit.degrees
+			break
+		}
+	}
+	value, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, false
+	}
+	return value * unitDegrees, true
+}
+
+// parseNumberOrPercentage parses a number or a percentage, where 100% is
+// equivalent to hundredPercent.
+func parseNumberOrPercentage(s string, hundredPercent float64) (float64, bool) {
+	if percentage, ok := strings.CutSuffix(s, "%"); ok {
+		value, err := strconv.ParseFloat(percentage, 64)
+		if err != nil {
+			return 0, false
+		}
+		return value * hundredPercent / 100, true
+	}
+	value, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, false
+	}
+	return value, true
+}
+
+// clampUint8 returns value rounded and clamped to the range of a uint8.
+func clampUint8(value float64) uint8 {
+	return uint8(min(max(math.Round(value), 0), 255))
+}
+
+// hslToRGB converts hue in degrees, saturation, and lightness to red, green,
+// and blue components in the range [0, 1], see
+// https://www.w3.org/TR/css-color-4/#hsl-to-rgb.
+func hslToRGB(hue, saturation, lightness float64) (float64, float64, float64) {
+	hue = math.Mod(hue, 360)
+	if hue < 0 {
+		hue += 360
+	}
+	f := func(n float64) float64 {
+		k := math.Mod(n+hue/30, 12)
+		a := saturation * min(lightness, 1-lightness)
+		return lightness - a*max(-1, min(k-3, 9-k, 1))
+	}
+	return f(0), f(8), f(4)
+}
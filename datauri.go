@@ -0,0 +1,116 @@
+package svg
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"strings"
+)
+
+// A DataURIEncoding is an encoding of a data URI. In all encodings, attribute
+// values are delimited with single quotes, which do not need to be encoded.
+type DataURIEncoding int
+
+// DataURIEncodings.
+const (
+	// DataURIEncodingPercent percent-encodes only the characters that must be
+	// encoded. The data URI does not contain any double quotes, so it can be
+	// used in double-quoted HTML attributes and CSS url() values.
+	DataURIEncodingPercent DataURIEncoding = iota
+	// DataURIEncodingBase64 encodes the document with base64.
+	DataURIEncodingBase64
+)
+
+const (
+	dataURIPrefix       = "data:image/svg+xml,"
+	dataURIBase64Prefix = "data:image/svg+xml;base64,"
+)
+
+// A percentEncoder is an io.Writer that percent-encodes the bytes written to
+// it.
+type percentEncoder struct {
+	w      io.Writer
+	buffer []byte
+}
+
+// DataURI returns e as a data URI, using whichever of DataURIEncodingPercent
+// and DataURIEncodingBase64 is shorter.
+func (e *SVGElement) DataURI() (string, error) {
+	var buffer bytes.Buffer
+	if _, err := Write(&buffer, e, WriteOptions{singleQuotes: true}); err != nil {
+		return "", err
+	}
+	percentLen := len(dataURIPrefix)
+	for _, c := range buffer.Bytes() {
+		if mustPercentEncode(c) {
+			percentLen += 3
+		} else {
+			percentLen++
+		}
+	}
+	if base64Len := len(dataURIBase64Prefix) + base64.StdEncoding.EncodedLen(buffer.Len()); base64Len < percentLen {
+		return dataURIBase64Prefix + base64.StdEncoding.EncodeToString(buffer.Bytes()), nil
+	}
+	var builder strings.Builder
+	builder.Grow(percentLen)
+	builder.WriteString(dataURIPrefix)
+	_, _ = (&percentEncoder{w: &builder}).Write(buffer.Bytes())
+	return builder.String(), nil
+}
+
+// WriteDataURI writes e to w as a data URI with encoding. It returns the
+// number of bytes written to w.
+func (e *SVGElement) WriteDataURI(w io.Writer, encoding DataURIEncoding) (int64, error) {
+	wc := &writeCounter{w: w}
+	switch encoding {
+	case DataURIEncodingBase64:
+		if _, err := io.WriteString(wc, dataURIBase64Prefix); err != nil {
+			return int64(wc.bytesWritten), err
+		}
+		base64Encoder := base64.NewEncoder(base64.StdEncoding, wc)
+		if _, err := Write(base64Encoder, e, WriteOptions{singleQuotes: true}); err != nil {
+			return int64(wc.bytesWritten), err
+		}
+		if err := base64Encoder.Close(); err != nil {
+			return int64(wc.bytesWritten), err
+		}
+	default:
+		if _, err := io.WriteString(wc, dataURIPrefix); err != nil {
+			return int64(wc.bytesWritten), err
+		}
+		if _, err := Write(&percentEncoder{w: wc}, e, WriteOptions{singleQuotes: true}); err != nil {
+			return int64(wc.bytesWritten), err
+		}
+	}
+	return int64(wc.bytesWritten), nil
+}
+
+func (p *percentEncoder) Write(data []byte) (int, error) {
+	const hexDigits = "0123456789ABCDEF"
+	p.buffer = p.buffer[:0]
+	for _, c := range data {
+		if mustPercentEncode(c) {
+			p.buffer = append(p.buffer, '%', hexDigits[c>>4], hexDigits[c&0xf])
+		} else {
+			p.buffer = append(p.buffer, c)
+		}
+	}
+	if _, err := p.w.Write(p.buffer); err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+// mustPercentEncode returns whether c must be percent-encoded in a data URI:
+// control characters, non-ASCII bytes, double quotes, and the characters that
+// have special meanings in URIs.
+func mustPercentEncode(c byte) bool {
+	switch {
+	case c < 0x20 || c >= 0x7f:
+		return true
+	case c == '"' || c == '#' || c == '%':
+		return true
+	default:
+		return false
+	}
+}
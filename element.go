@@ -5,3 +5,24 @@ import "encoding/xml"
 type Element interface {
 	xml.Marshaler
 }
+
+// A Node is an Element with a tag name, attributes, and children. All the
+// elements defined by this package are Nodes, except CharData and Comment.
+type Node interface {
+	Element
+	// Attributes returns the node's attributes. Changes to the returned map
+	// change the node's attributes.
+	Attributes() map[string]AttrValue
+	// ChildElements returns the node's children, or nil if the node cannot
+	// have children.
+	ChildElements() []Element
+	// TagName returns the node's tag name, for example "rect".
+	TagName() string
+}
+
+// A Container is a Node that can have children.
+type Container interface {
+	Node
+	// SetChildElements replaces the node's children.
+	SetChildElements(children []Element)
+}
@@ -27,6 +27,14 @@ func (e *SVGElement) AppendChildren(children ...Element) *SVGElement {
 	return e
 }
 
+// RemoveAttrs removes the attributes with the given names.
+func (e *SVGElement) RemoveAttrs(names ...string) *SVGElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
 // Version sets the version attribute.
 func (e *SVGElement) Version(version String) *SVGElement {
 	e.Attrs["version"] = version
@@ -58,7 +66,7 @@ func (e *SVGElement) ZoomAndPan(zoomAndPan String) *SVGElement {
 }
 
 // Transform sets the transform attribute.
-func (e *SVGElement) Transform(transform String) *SVGElement {
+func (e *SVGElement) Transform(transform Transform) *SVGElement {
 	e.Attrs["transform"] = transform
 	return e
 }
@@ -112,7 +120,62 @@ func (e *SVGElement) XYWidthHeight(x, y, width, height float64, lengthFunc Lengt
 
 // MarshallXML implements encoding/xml.Marshaller.MarshalXML.
 func (e *SVGElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
-	return encodeElement(encoder, "svg", e.Attrs, e.Children)
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *SVGElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *SVGElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *SVGElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *SVGElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *SVGElement) TagName() string {
+	return "svg"
+}
+
+// decode decodes e from d.
+func (e *SVGElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *SVGElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "viewBox":
+		return parseViewBox(value)
+	case "transform":
+		return parseTransform(value)
+	case "x":
+		return parseLength(value)
+	case "y":
+		return parseLength(value)
+	case "width":
+		return parseLength(value)
+	case "height":
+		return parseLength(value)
+	default:
+		return String(value), nil
+	}
 }
 
 // An AElement is an a element.
@@ -135,6 +198,14 @@ func (e *AElement) AppendChildren(children ...Element) *AElement {
 	return e
 }
 
+// RemoveAttrs removes the attributes with the given names.
+func (e *AElement) RemoveAttrs(names ...string) *AElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
 // Href sets the href attribute.
 func (e *AElement) Href(href String) *AElement {
 	e.Attrs["href"] = href
@@ -185,6297 +256,22583 @@ func (e *AElement) ReferrerPolicy(referrerPolicy String) *AElement {
 
 // MarshallXML implements encoding/xml.Marshaller.MarshalXML.
 func (e *AElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
-	return encodeElement(encoder, "a", e.Attrs, e.Children)
+	return encodeElement(encoder, e)
 }
 
-// A CircleElement is a circle element.
-type CircleElement struct {
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *AElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *AElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *AElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *AElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *AElement) TagName() string {
+	return "a"
+}
+
+// decode decodes e from d.
+func (e *AElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *AElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	default:
+		return String(value), nil
+	}
+}
+
+// An AnimateElement is an animate element.
+type AnimateElement struct {
 	Attrs    map[string]AttrValue
 	Children []Element
 }
 
-// Circle returns a new CircleElement.
-func Circle(children ...Element) *CircleElement {
-	return &CircleElement{
+// Animate returns a new AnimateElement.
+func Animate(children ...Element) *AnimateElement {
+	return &AnimateElement{
 		Attrs:    map[string]AttrValue{},
 		Children: children,
 	}
 }
 
 // AppendChildren appends the given children.
-func (e *CircleElement) AppendChildren(children ...Element) *CircleElement {
+func (e *AnimateElement) AppendChildren(children ...Element) *AnimateElement {
 	e.Children = append(e.Children, children...)
 	return e
 }
 
+// RemoveAttrs removes the attributes with the given names.
+func (e *AnimateElement) RemoveAttrs(names ...string) *AnimateElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
 // ID sets the id attribute.
-func (e *CircleElement) ID(id String) *CircleElement {
+func (e *AnimateElement) ID(id String) *AnimateElement {
 	e.Attrs["id"] = id
 	return e
 }
 
 // TabIndex sets the tabindex attribute.
-func (e *CircleElement) TabIndex(tabIndex Int) *CircleElement {
+func (e *AnimateElement) TabIndex(tabIndex Int) *AnimateElement {
 	e.Attrs["tabindex"] = tabIndex
 	return e
 }
 
 // Lang sets the lang attribute.
-func (e *CircleElement) Lang(lang String) *CircleElement {
+func (e *AnimateElement) Lang(lang String) *AnimateElement {
 	e.Attrs["lang"] = lang
 	return e
 }
 
 // Class sets the class attribute.
-func (e *CircleElement) Class(class String) *CircleElement {
+func (e *AnimateElement) Class(class String) *AnimateElement {
 	e.Attrs["class"] = class
 	return e
 }
 
 // Style sets the style attribute.
-func (e *CircleElement) Style(style String) *CircleElement {
+func (e *AnimateElement) Style(style String) *AnimateElement {
 	e.Attrs["style"] = style
 	return e
 }
 
-// AlignmentBaseline sets the alignment-baseline attribute.
-func (e *CircleElement) AlignmentBaseline(alignmentBaseline String) *CircleElement {
-	e.Attrs["alignment-baseline"] = alignmentBaseline
+// Begin sets the begin attribute.
+func (e *AnimateElement) Begin(begin String) *AnimateElement {
+	e.Attrs["begin"] = begin
 	return e
 }
 
-// BaselineShift sets the baseline-shift attribute.
-func (e *CircleElement) BaselineShift(baselineShift String) *CircleElement {
-	e.Attrs["baseline-shift"] = baselineShift
+// BeginDuration sets the begin attribute to a Duration.
+func (e *AnimateElement) BeginDuration(begin Duration) *AnimateElement {
+	e.Attrs["begin"] = begin
 	return e
 }
 
-// ClipPath sets the clip-path attribute.
-func (e *CircleElement) ClipPath(clipPath String) *CircleElement {
-	e.Attrs["clip-path"] = clipPath
+// Dur sets the dur attribute.
+func (e *AnimateElement) Dur(dur Duration) *AnimateElement {
+	e.Attrs["dur"] = dur
 	return e
 }
 
-// ClipRule sets the clip-rule attribute.
-func (e *CircleElement) ClipRule(clipRule String) *CircleElement {
-	e.Attrs["clip-rule"] = clipRule
+// End sets the end attribute.
+func (e *AnimateElement) End(end String) *AnimateElement {
+	e.Attrs["end"] = end
 	return e
 }
 
-// Color sets the color attribute.
-func (e *CircleElement) Color(color String) *CircleElement {
-	e.Attrs["color"] = color
+// EndDuration sets the end attribute to a Duration.
+func (e *AnimateElement) EndDuration(end Duration) *AnimateElement {
+	e.Attrs["end"] = end
 	return e
 }
 
-// ColorInterpolation sets the color-interpolation attribute.
-func (e *CircleElement) ColorInterpolation(colorInterpolation String) *CircleElement {
-	e.Attrs["color-interpolation"] = colorInterpolation
+// Min sets the min attribute.
+func (e *AnimateElement) Min(_min Duration) *AnimateElement {
+	e.Attrs["min"] = _min
 	return e
 }
 
-// ColorInterpolationFilters sets the color-interpolation-filters attribute.
-func (e *CircleElement) ColorInterpolationFilters(colorInterpolationFilters String) *CircleElement {
-	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
+// Max sets the max attribute.
+func (e *AnimateElement) Max(_max Duration) *AnimateElement {
+	e.Attrs["max"] = _max
 	return e
 }
 
-// ColorRendering sets the color-rendering attribute.
-func (e *CircleElement) ColorRendering(colorRendering String) *CircleElement {
-	e.Attrs["color-rendering"] = colorRendering
+// Restart sets the restart attribute.
+func (e *AnimateElement) Restart(restart String) *AnimateElement {
+	e.Attrs["restart"] = restart
 	return e
 }
 
-// Cursor sets the cursor attribute.
-func (e *CircleElement) Cursor(cursor String) *CircleElement {
-	e.Attrs["cursor"] = cursor
+// RepeatCount sets the repeatCount attribute.
+func (e *AnimateElement) RepeatCount(repeatCount RepeatCount) *AnimateElement {
+	e.Attrs["repeatCount"] = repeatCount
 	return e
 }
 
-// Direction sets the direction attribute.
-func (e *CircleElement) Direction(direction String) *CircleElement {
-	e.Attrs["direction"] = direction
+// RepeatDur sets the repeatDur attribute.
+func (e *AnimateElement) RepeatDur(repeatDur Duration) *AnimateElement {
+	e.Attrs["repeatDur"] = repeatDur
 	return e
 }
 
-// Display sets the display attribute.
-func (e *CircleElement) Display(display String) *CircleElement {
-	e.Attrs["display"] = display
+// Fill sets the fill attribute.
+func (e *AnimateElement) Fill(fill String) *AnimateElement {
+	e.Attrs["fill"] = fill
 	return e
 }
 
-// DominantBaseline sets the dominant-baseline attribute.
-func (e *CircleElement) DominantBaseline(dominantBaseline String) *CircleElement {
-	e.Attrs["dominant-baseline"] = dominantBaseline
+// CalcMode sets the calcMode attribute.
+func (e *AnimateElement) CalcMode(calcMode String) *AnimateElement {
+	e.Attrs["calcMode"] = calcMode
 	return e
 }
 
-// Fill sets the fill attribute.
-func (e *CircleElement) Fill(fill String) *CircleElement {
-	e.Attrs["fill"] = fill
+// Values sets the values attribute.
+func (e *AnimateElement) Values(values String) *AnimateElement {
+	e.Attrs["values"] = values
 	return e
 }
 
-// FillOpacity sets the fill-opacity attribute.
-func (e *CircleElement) FillOpacity(fillOpacity Float64) *CircleElement {
-	e.Attrs["fill-opacity"] = fillOpacity
+// KeyTimes sets the keyTimes attribute.
+func (e *AnimateElement) KeyTimes(keyTimes KeyTimes) *AnimateElement {
+	e.Attrs["keyTimes"] = keyTimes
 	return e
 }
 
-// FillRule sets the fill-rule attribute.
-func (e *CircleElement) FillRule(fillRule String) *CircleElement {
-	e.Attrs["fill-rule"] = fillRule
+// KeySplines sets the keySplines attribute.
+func (e *AnimateElement) KeySplines(keySplines KeySplines) *AnimateElement {
+	e.Attrs["keySplines"] = keySplines
 	return e
 }
 
-// Filter sets the filter attribute.
-func (e *CircleElement) Filter(filter String) *CircleElement {
-	e.Attrs["filter"] = filter
+// From sets the from attribute.
+func (e *AnimateElement) From(from String) *AnimateElement {
+	e.Attrs["from"] = from
 	return e
 }
 
-// FloodColor sets the flood-color attribute.
-func (e *CircleElement) FloodColor(floodColor String) *CircleElement {
-	e.Attrs["flood-color"] = floodColor
+// To sets the to attribute.
+func (e *AnimateElement) To(to String) *AnimateElement {
+	e.Attrs["to"] = to
 	return e
 }
 
-// FloodOpacity sets the flood-opacity attribute.
-func (e *CircleElement) FloodOpacity(floodOpacity Float64) *CircleElement {
-	e.Attrs["flood-opacity"] = floodOpacity
+// By sets the by attribute.
+func (e *AnimateElement) By(by String) *AnimateElement {
+	e.Attrs["by"] = by
 	return e
 }
 
-// FontFamily sets the font-family attribute.
-func (e *CircleElement) FontFamily(fontFamily String) *CircleElement {
-	e.Attrs["font-family"] = fontFamily
+// Additive sets the additive attribute.
+func (e *AnimateElement) Additive(additive String) *AnimateElement {
+	e.Attrs["additive"] = additive
 	return e
 }
 
-// FontSize sets the font-size attribute.
-func (e *CircleElement) FontSize(fontSize String) *CircleElement {
-	e.Attrs["font-size"] = fontSize
+// Accumulate sets the accumulate attribute.
+func (e *AnimateElement) Accumulate(accumulate String) *AnimateElement {
+	e.Attrs["accumulate"] = accumulate
 	return e
 }
 
-// FontSizeAdjust sets the font-size-adjust attribute.
-func (e *CircleElement) FontSizeAdjust(fontSizeAdjust String) *CircleElement {
-	e.Attrs["font-size-adjust"] = fontSizeAdjust
+// AttributeName sets the attributeName attribute.
+func (e *AnimateElement) AttributeName(attributeName String) *AnimateElement {
+	e.Attrs["attributeName"] = attributeName
 	return e
 }
 
-// FontStretch sets the font-stretch attribute.
-func (e *CircleElement) FontStretch(fontStretch String) *CircleElement {
-	e.Attrs["font-stretch"] = fontStretch
+// Href sets the href attribute.
+func (e *AnimateElement) Href(href String) *AnimateElement {
+	e.Attrs["href"] = href
 	return e
 }
 
-// FontStyle sets the font-style attribute.
-func (e *CircleElement) FontStyle(fontStyle String) *CircleElement {
-	e.Attrs["font-style"] = fontStyle
-	return e
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *AnimateElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
 }
 
-// FontVariant sets the font-variant attribute.
-func (e *CircleElement) FontVariant(fontVariant String) *CircleElement {
-	e.Attrs["font-variant"] = fontVariant
-	return e
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *AnimateElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
 }
 
-// FontWeight sets the font-weight attribute.
-func (e *CircleElement) FontWeight(fontWeight String) *CircleElement {
-	e.Attrs["font-weight"] = fontWeight
-	return e
+// Attributes implements Node.Attributes.
+func (e *AnimateElement) Attributes() map[string]AttrValue {
+	return e.Attrs
 }
 
-// GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
-func (e *CircleElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *CircleElement {
-	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
-	return e
+// ChildElements implements Node.ChildElements.
+func (e *AnimateElement) ChildElements() []Element {
+	return e.Children
 }
 
-// GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
-func (e *CircleElement) GlyphOrientationVertical(glyphOrientationVertical String) *CircleElement {
-	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
-	return e
+// SetChildElements implements Container.SetChildElements.
+func (e *AnimateElement) SetChildElements(children []Element) {
+	e.Children = children
 }
 
-// ImageRendering sets the image-rendering attribute.
-func (e *CircleElement) ImageRendering(imageRendering String) *CircleElement {
-	e.Attrs["image-rendering"] = imageRendering
-	return e
+// TagName implements Node.TagName.
+func (e *AnimateElement) TagName() string {
+	return "animate"
 }
 
-// LetterSpacing sets the letter-spacing attribute.
-func (e *CircleElement) LetterSpacing(letterSpacing String) *CircleElement {
-	e.Attrs["letter-spacing"] = letterSpacing
-	return e
+// decode decodes e from d.
+func (e *AnimateElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *AnimateElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "dur":
+		return parseDuration(value)
+	case "min":
+		return parseDuration(value)
+	case "max":
+		return parseDuration(value)
+	case "repeatCount":
+		return parseRepeatCount(value)
+	case "repeatDur":
+		return parseDuration(value)
+	case "keyTimes":
+		return parseKeyTimes(value)
+	case "keySplines":
+		return parseKeySplines(value)
+	default:
+		return String(value), nil
+	}
 }
 
-// LightingColor sets the lighting-color attribute.
-func (e *CircleElement) LightingColor(lightingColor String) *CircleElement {
-	e.Attrs["lighting-color"] = lightingColor
-	return e
+// An AnimateMotionElement is an animateMotion element.
+type AnimateMotionElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
 }
 
-// MarkerEnd sets the marker-end attribute.
-func (e *CircleElement) MarkerEnd(markerEnd String) *CircleElement {
-	e.Attrs["marker-end"] = markerEnd
-	return e
+// AnimateMotion returns a new AnimateMotionElement.
+func AnimateMotion(children ...Element) *AnimateMotionElement {
+	return &AnimateMotionElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
 }
 
-// MarkerMid sets the marker-mid attribute.
-func (e *CircleElement) MarkerMid(markerMid String) *CircleElement {
-	e.Attrs["marker-mid"] = markerMid
+// AppendChildren appends the given children.
+func (e *AnimateMotionElement) AppendChildren(children ...Element) *AnimateMotionElement {
+	e.Children = append(e.Children, children...)
 	return e
 }
 
-// MarkerStart sets the marker-start attribute.
-func (e *CircleElement) MarkerStart(markerStart String) *CircleElement {
-	e.Attrs["marker-start"] = markerStart
+// RemoveAttrs removes the attributes with the given names.
+func (e *AnimateMotionElement) RemoveAttrs(names ...string) *AnimateMotionElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
 	return e
 }
 
-// Mask sets the mask attribute.
-func (e *CircleElement) Mask(mask String) *CircleElement {
-	e.Attrs["mask"] = mask
+// ID sets the id attribute.
+func (e *AnimateMotionElement) ID(id String) *AnimateMotionElement {
+	e.Attrs["id"] = id
 	return e
 }
 
-// Opacity sets the opacity attribute.
-func (e *CircleElement) Opacity(opacity Float64) *CircleElement {
-	e.Attrs["opacity"] = opacity
+// TabIndex sets the tabindex attribute.
+func (e *AnimateMotionElement) TabIndex(tabIndex Int) *AnimateMotionElement {
+	e.Attrs["tabindex"] = tabIndex
 	return e
 }
 
-// Overflow sets the overflow attribute.
-func (e *CircleElement) Overflow(overflow String) *CircleElement {
-	e.Attrs["overflow"] = overflow
+// Lang sets the lang attribute.
+func (e *AnimateMotionElement) Lang(lang String) *AnimateMotionElement {
+	e.Attrs["lang"] = lang
 	return e
 }
 
-// PaintOrder sets the paint-order attribute.
-func (e *CircleElement) PaintOrder(paintOrder String) *CircleElement {
-	e.Attrs["paint-order"] = paintOrder
+// Class sets the class attribute.
+func (e *AnimateMotionElement) Class(class String) *AnimateMotionElement {
+	e.Attrs["class"] = class
 	return e
 }
 
-// PointerEvents sets the pointer-events attribute.
-func (e *CircleElement) PointerEvents(pointerEvents String) *CircleElement {
-	e.Attrs["pointer-events"] = pointerEvents
+// Style sets the style attribute.
+func (e *AnimateMotionElement) Style(style String) *AnimateMotionElement {
+	e.Attrs["style"] = style
 	return e
 }
 
-// ShapeRendering sets the shape-rendering attribute.
-func (e *CircleElement) ShapeRendering(shapeRendering String) *CircleElement {
-	e.Attrs["shape-rendering"] = shapeRendering
+// Begin sets the begin attribute.
+func (e *AnimateMotionElement) Begin(begin String) *AnimateMotionElement {
+	e.Attrs["begin"] = begin
 	return e
 }
 
-// StopColor sets the stop-color attribute.
-func (e *CircleElement) StopColor(stopColor String) *CircleElement {
-	e.Attrs["stop-color"] = stopColor
+// BeginDuration sets the begin attribute to a Duration.
+func (e *AnimateMotionElement) BeginDuration(begin Duration) *AnimateMotionElement {
+	e.Attrs["begin"] = begin
 	return e
 }
 
-// StopOpacity sets the stop-opacity attribute.
-func (e *CircleElement) StopOpacity(stopOpacity Float64) *CircleElement {
-	e.Attrs["stop-opacity"] = stopOpacity
+// Dur sets the dur attribute.
+func (e *AnimateMotionElement) Dur(dur Duration) *AnimateMotionElement {
+	e.Attrs["dur"] = dur
 	return e
 }
 
-// Stroke sets the stroke attribute.
-func (e *CircleElement) Stroke(stroke String) *CircleElement {
-	e.Attrs["stroke"] = stroke
+// End sets the end attribute.
+func (e *AnimateMotionElement) End(end String) *AnimateMotionElement {
+	e.Attrs["end"] = end
 	return e
 }
 
-// StrokeDashArray sets the stroke-dasharray attribute.
-func (e *CircleElement) StrokeDashArray(strokeDashArray String) *CircleElement {
-	e.Attrs["stroke-dasharray"] = strokeDashArray
+// EndDuration sets the end attribute to a Duration.
+func (e *AnimateMotionElement) EndDuration(end Duration) *AnimateMotionElement {
+	e.Attrs["end"] = end
 	return e
 }
 
-// StrokeDashOffset sets the stroke-dashoffset attribute.
-func (e *CircleElement) StrokeDashOffset(strokeDashOffset Float64) *CircleElement {
-	e.Attrs["stroke-dashoffset"] = strokeDashOffset
+// Min sets the min attribute.
+func (e *AnimateMotionElement) Min(_min Duration) *AnimateMotionElement {
+	e.Attrs["min"] = _min
 	return e
 }
 
-// StrokeLineCap sets the stroke-linecap attribute.
-func (e *CircleElement) StrokeLineCap(strokeLineCap String) *CircleElement {
-	e.Attrs["stroke-linecap"] = strokeLineCap
+// Max sets the max attribute.
+func (e *AnimateMotionElement) Max(_max Duration) *AnimateMotionElement {
+	e.Attrs["max"] = _max
 	return e
 }
 
-// StrokeLineJoin sets the stroke-linejoin attribute.
-func (e *CircleElement) StrokeLineJoin(strokeLineJoin String) *CircleElement {
-	e.Attrs["stroke-linejoin"] = strokeLineJoin
+// Restart sets the restart attribute.
+func (e *AnimateMotionElement) Restart(restart String) *AnimateMotionElement {
+	e.Attrs["restart"] = restart
 	return e
 }
 
-// StrokeMiterLimit sets the stroke-miterlimit attribute.
-func (e *CircleElement) StrokeMiterLimit(strokeMiterLimit Float64) *CircleElement {
-	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
+// RepeatCount sets the repeatCount attribute.
+func (e *AnimateMotionElement) RepeatCount(repeatCount RepeatCount) *AnimateMotionElement {
+	e.Attrs["repeatCount"] = repeatCount
 	return e
 }
 
-// StrokeOpacity sets the stroke-opacity attribute.
-func (e *CircleElement) StrokeOpacity(strokeOpacity Float64) *CircleElement {
-	e.Attrs["stroke-opacity"] = strokeOpacity
+// RepeatDur sets the repeatDur attribute.
+func (e *AnimateMotionElement) RepeatDur(repeatDur Duration) *AnimateMotionElement {
+	e.Attrs["repeatDur"] = repeatDur
 	return e
 }
 
-// StrokeWidth sets the stroke-width attribute.
-func (e *CircleElement) StrokeWidth(strokeWidth Length) *CircleElement {
-	e.Attrs["stroke-width"] = strokeWidth
+// Fill sets the fill attribute.
+func (e *AnimateMotionElement) Fill(fill String) *AnimateMotionElement {
+	e.Attrs["fill"] = fill
 	return e
 }
 
-// TextAnchor sets the text-anchor attribute.
-func (e *CircleElement) TextAnchor(textAnchor String) *CircleElement {
-	e.Attrs["text-anchor"] = textAnchor
+// CalcMode sets the calcMode attribute.
+func (e *AnimateMotionElement) CalcMode(calcMode String) *AnimateMotionElement {
+	e.Attrs["calcMode"] = calcMode
 	return e
 }
 
-// TextDecoration sets the text-decoration attribute.
-func (e *CircleElement) TextDecoration(textDecoration String) *CircleElement {
-	e.Attrs["text-decoration"] = textDecoration
+// Values sets the values attribute.
+func (e *AnimateMotionElement) Values(values String) *AnimateMotionElement {
+	e.Attrs["values"] = values
 	return e
 }
 
-// TextOverflow sets the text-overflow attribute.
-func (e *CircleElement) TextOverflow(textOverflow String) *CircleElement {
-	e.Attrs["text-overflow"] = textOverflow
+// KeyTimes sets the keyTimes attribute.
+func (e *AnimateMotionElement) KeyTimes(keyTimes KeyTimes) *AnimateMotionElement {
+	e.Attrs["keyTimes"] = keyTimes
 	return e
 }
 
-// TextRendering sets the text-rendering attribute.
-func (e *CircleElement) TextRendering(textRendering String) *CircleElement {
-	e.Attrs["text-rendering"] = textRendering
+// KeySplines sets the keySplines attribute.
+func (e *AnimateMotionElement) KeySplines(keySplines KeySplines) *AnimateMotionElement {
+	e.Attrs["keySplines"] = keySplines
 	return e
 }
 
-// UnicodeBiDi sets the unicode-bidi attribute.
-func (e *CircleElement) UnicodeBiDi(unicodeBiDi String) *CircleElement {
-	e.Attrs["unicode-bidi"] = unicodeBiDi
+// From sets the from attribute.
+func (e *AnimateMotionElement) From(from String) *AnimateMotionElement {
+	e.Attrs["from"] = from
 	return e
 }
 
-// VectorEffect sets the vector-effect attribute.
-func (e *CircleElement) VectorEffect(vectorEffect String) *CircleElement {
-	e.Attrs["vector-effect"] = vectorEffect
+// To sets the to attribute.
+func (e *AnimateMotionElement) To(to String) *AnimateMotionElement {
+	e.Attrs["to"] = to
 	return e
 }
 
-// Visibility sets the visibility attribute.
-func (e *CircleElement) Visibility(visibility String) *CircleElement {
-	e.Attrs["visibility"] = visibility
+// By sets the by attribute.
+func (e *AnimateMotionElement) By(by String) *AnimateMotionElement {
+	e.Attrs["by"] = by
 	return e
 }
 
-// WhiteSpace sets the white-space attribute.
-func (e *CircleElement) WhiteSpace(whiteSpace String) *CircleElement {
-	e.Attrs["white-space"] = whiteSpace
+// Additive sets the additive attribute.
+func (e *AnimateMotionElement) Additive(additive String) *AnimateMotionElement {
+	e.Attrs["additive"] = additive
 	return e
 }
 
-// WordSpacing sets the word-spacing attribute.
-func (e *CircleElement) WordSpacing(wordSpacing String) *CircleElement {
-	e.Attrs["word-spacing"] = wordSpacing
+// Accumulate sets the accumulate attribute.
+func (e *AnimateMotionElement) Accumulate(accumulate String) *AnimateMotionElement {
+	e.Attrs["accumulate"] = accumulate
 	return e
 }
 
-// WritingMode sets the writing-mode attribute.
-func (e *CircleElement) WritingMode(writingMode String) *CircleElement {
-	e.Attrs["writing-mode"] = writingMode
+// Href sets the href attribute.
+func (e *AnimateMotionElement) Href(href String) *AnimateMotionElement {
+	e.Attrs["href"] = href
 	return e
 }
 
-// PathLength sets the pathLength attribute.
-func (e *CircleElement) PathLength(pathLength String) *CircleElement {
-	e.Attrs["pathLength"] = pathLength
+// Path sets the path attribute.
+func (e *AnimateMotionElement) Path(path AttrValue) *AnimateMotionElement {
+	e.Attrs["path"] = path
 	return e
 }
 
-// CX sets the cx attribute.
-func (e *CircleElement) CX(cx Length) *CircleElement {
-	e.Attrs["cx"] = cx
+// KeyPoints sets the keyPoints attribute.
+func (e *AnimateMotionElement) KeyPoints(keyPoints String) *AnimateMotionElement {
+	e.Attrs["keyPoints"] = keyPoints
 	return e
 }
 
-// CY sets the cy attribute.
-func (e *CircleElement) CY(cy Length) *CircleElement {
-	e.Attrs["cy"] = cy
+// Rotate sets the rotate attribute.
+func (e *AnimateMotionElement) Rotate(rotate String) *AnimateMotionElement {
+	e.Attrs["rotate"] = rotate
 	return e
 }
 
-// R sets the r attribute.
-func (e *CircleElement) R(r Length) *CircleElement {
-	e.Attrs["r"] = r
+// Origin sets the origin attribute.
+func (e *AnimateMotionElement) Origin(origin String) *AnimateMotionElement {
+	e.Attrs["origin"] = origin
 	return e
 }
 
-// CXCY sets the cx and cy attributes.
-func (e *CircleElement) CXCY(cx, cy float64, lengthFunc LengthFunc) *CircleElement {
-	e.Attrs["cx"] = lengthFunc(cx)
-	e.Attrs["cy"] = lengthFunc(cy)
-	return e
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *AnimateMotionElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
 }
 
-// CXCYR sets the cx, cy, and r attributes.
-func (e *CircleElement) CXCYR(cx, cy, r float64, lengthFunc LengthFunc) *CircleElement {
-	e.Attrs["cx"] = lengthFunc(cx)
-	e.Attrs["cy"] = lengthFunc(cy)
-	e.Attrs["r"] = lengthFunc(r)
-	return e
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *AnimateMotionElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
 }
 
-// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
-func (e *CircleElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
-	return encodeElement(encoder, "circle", e.Attrs, e.Children)
+// Attributes implements Node.Attributes.
+func (e *AnimateMotionElement) Attributes() map[string]AttrValue {
+	return e.Attrs
 }
 
-// A ClipPathElement is a clipPath element.
-type ClipPathElement struct {
+// ChildElements implements Node.ChildElements.
+func (e *AnimateMotionElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *AnimateMotionElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *AnimateMotionElement) TagName() string {
+	return "animateMotion"
+}
+
+// decode decodes e from d.
+func (e *AnimateMotionElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *AnimateMotionElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "dur":
+		return parseDuration(value)
+	case "min":
+		return parseDuration(value)
+	case "max":
+		return parseDuration(value)
+	case "repeatCount":
+		return parseRepeatCount(value)
+	case "repeatDur":
+		return parseDuration(value)
+	case "keyTimes":
+		return parseKeyTimes(value)
+	case "keySplines":
+		return parseKeySplines(value)
+	case "path":
+		return parsePath(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// An AnimateTransformElement is an animateTransform element.
+type AnimateTransformElement struct {
 	Attrs    map[string]AttrValue
 	Children []Element
 }
 
-// ClipPath returns a new ClipPathElement.
-func ClipPath(children ...Element) *ClipPathElement {
-	return &ClipPathElement{
+// AnimateTransform returns a new AnimateTransformElement.
+func AnimateTransform(children ...Element) *AnimateTransformElement {
+	return &AnimateTransformElement{
 		Attrs:    map[string]AttrValue{},
 		Children: children,
 	}
 }
 
 // AppendChildren appends the given children.
-func (e *ClipPathElement) AppendChildren(children ...Element) *ClipPathElement {
+func (e *AnimateTransformElement) AppendChildren(children ...Element) *AnimateTransformElement {
 	e.Children = append(e.Children, children...)
 	return e
 }
 
+// RemoveAttrs removes the attributes with the given names.
+func (e *AnimateTransformElement) RemoveAttrs(names ...string) *AnimateTransformElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
 // ID sets the id attribute.
-func (e *ClipPathElement) ID(id String) *ClipPathElement {
+func (e *AnimateTransformElement) ID(id String) *AnimateTransformElement {
 	e.Attrs["id"] = id
 	return e
 }
 
 // TabIndex sets the tabindex attribute.
-func (e *ClipPathElement) TabIndex(tabIndex Int) *ClipPathElement {
+func (e *AnimateTransformElement) TabIndex(tabIndex Int) *AnimateTransformElement {
 	e.Attrs["tabindex"] = tabIndex
 	return e
 }
 
 // Lang sets the lang attribute.
-func (e *ClipPathElement) Lang(lang String) *ClipPathElement {
+func (e *AnimateTransformElement) Lang(lang String) *AnimateTransformElement {
 	e.Attrs["lang"] = lang
 	return e
 }
 
 // Class sets the class attribute.
-func (e *ClipPathElement) Class(class String) *ClipPathElement {
+func (e *AnimateTransformElement) Class(class String) *AnimateTransformElement {
 	e.Attrs["class"] = class
 	return e
 }
 
 // Style sets the style attribute.
-func (e *ClipPathElement) Style(style String) *ClipPathElement {
+func (e *AnimateTransformElement) Style(style String) *AnimateTransformElement {
 	e.Attrs["style"] = style
 	return e
 }
 
-// AlignmentBaseline sets the alignment-baseline attribute.
-func (e *ClipPathElement) AlignmentBaseline(alignmentBaseline String) *ClipPathElement {
-	e.Attrs["alignment-baseline"] = alignmentBaseline
+// Begin sets the begin attribute.
+func (e *AnimateTransformElement) Begin(begin String) *AnimateTransformElement {
+	e.Attrs["begin"] = begin
 	return e
 }
 
-// BaselineShift sets the baseline-shift attribute.
-func (e *ClipPathElement) BaselineShift(baselineShift String) *ClipPathElement {
-	e.Attrs["baseline-shift"] = baselineShift
+// BeginDuration sets the begin attribute to a Duration.
+func (e *AnimateTransformElement) BeginDuration(begin Duration) *AnimateTransformElement {
+	e.Attrs["begin"] = begin
 	return e
 }
 
-// ClipPath sets the clip-path attribute.
-func (e *ClipPathElement) ClipPath(clipPath String) *ClipPathElement {
-	e.Attrs["clip-path"] = clipPath
+// Dur sets the dur attribute.
+func (e *AnimateTransformElement) Dur(dur Duration) *AnimateTransformElement {
+	e.Attrs["dur"] = dur
 	return e
 }
 
-// ClipRule sets the clip-rule attribute.
-func (e *ClipPathElement) ClipRule(clipRule String) *ClipPathElement {
-	e.Attrs["clip-rule"] = clipRule
+// End sets the end attribute.
+func (e *AnimateTransformElement) End(end String) *AnimateTransformElement {
+	e.Attrs["end"] = end
 	return e
 }
 
-// Color sets the color attribute.
-func (e *ClipPathElement) Color(color String) *ClipPathElement {
-	e.Attrs["color"] = color
+// EndDuration sets the end attribute to a Duration.
+func (e *AnimateTransformElement) EndDuration(end Duration) *AnimateTransformElement {
+	e.Attrs["end"] = end
 	return e
 }
 
-// ColorInterpolation sets the color-interpolation attribute.
-func (e *ClipPathElement) ColorInterpolation(colorInterpolation String) *ClipPathElement {
-	e.Attrs["color-interpolation"] = colorInterpolation
+// Min sets the min attribute.
+func (e *AnimateTransformElement) Min(_min Duration) *AnimateTransformElement {
+	e.Attrs["min"] = _min
 	return e
 }
 
-// ColorInterpolationFilters sets the color-interpolation-filters attribute.
-func (e *ClipPathElement) ColorInterpolationFilters(colorInterpolationFilters String) *ClipPathElement {
-	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
+// Max sets the max attribute.
+func (e *AnimateTransformElement) Max(_max Duration) *AnimateTransformElement {
+	e.Attrs["max"] = _max
 	return e
 }
 
-// ColorRendering sets the color-rendering attribute.
-func (e *ClipPathElement) ColorRendering(colorRendering String) *ClipPathElement {
-	e.Attrs["color-rendering"] = colorRendering
+// Restart sets the restart attribute.
+func (e *AnimateTransformElement) Restart(restart String) *AnimateTransformElement {
+	e.Attrs["restart"] = restart
 	return e
 }
 
-// Cursor sets the cursor attribute.
-func (e *ClipPathElement) Cursor(cursor String) *ClipPathElement {
-	e.Attrs["cursor"] = cursor
+// RepeatCount sets the repeatCount attribute.
+func (e *AnimateTransformElement) RepeatCount(repeatCount RepeatCount) *AnimateTransformElement {
+	e.Attrs["repeatCount"] = repeatCount
 	return e
 }
 
-// Direction sets the direction attribute.
-func (e *ClipPathElement) Direction(direction String) *ClipPathElement {
-	e.Attrs["direction"] = direction
+// RepeatDur sets the repeatDur attribute.
+func (e *AnimateTransformElement) RepeatDur(repeatDur Duration) *AnimateTransformElement {
+	e.Attrs["repeatDur"] = repeatDur
 	return e
 }
 
-// Display sets the display attribute.
-func (e *ClipPathElement) Display(display String) *ClipPathElement {
-	e.Attrs["display"] = display
+// Fill sets the fill attribute.
+func (e *AnimateTransformElement) Fill(fill String) *AnimateTransformElement {
+	e.Attrs["fill"] = fill
 	return e
 }
 
-// DominantBaseline sets the dominant-baseline attribute.
-func (e *ClipPathElement) DominantBaseline(dominantBaseline String) *ClipPathElement {
-	e.Attrs["dominant-baseline"] = dominantBaseline
+// CalcMode sets the calcMode attribute.
+func (e *AnimateTransformElement) CalcMode(calcMode String) *AnimateTransformElement {
+	e.Attrs["calcMode"] = calcMode
 	return e
 }
 
-// Fill sets the fill attribute.
-func (e *ClipPathElement) Fill(fill String) *ClipPathElement {
-	e.Attrs["fill"] = fill
+// Values sets the values attribute.
+func (e *AnimateTransformElement) Values(values String) *AnimateTransformElement {
+	e.Attrs["values"] = values
 	return e
 }
 
-// FillOpacity sets the fill-opacity attribute.
-func (e *ClipPathElement) FillOpacity(fillOpacity Float64) *ClipPathElement {
-	e.Attrs["fill-opacity"] = fillOpacity
+// KeyTimes sets the keyTimes attribute.
+func (e *AnimateTransformElement) KeyTimes(keyTimes KeyTimes) *AnimateTransformElement {
+	e.Attrs["keyTimes"] = keyTimes
 	return e
 }
 
-// FillRule sets the fill-rule attribute.
-func (e *ClipPathElement) FillRule(fillRule String) *ClipPathElement {
-	e.Attrs["fill-rule"] = fillRule
+// KeySplines sets the keySplines attribute.
+func (e *AnimateTransformElement) KeySplines(keySplines KeySplines) *AnimateTransformElement {
+	e.Attrs["keySplines"] = keySplines
 	return e
 }
 
-// Filter sets the filter attribute.
-func (e *ClipPathElement) Filter(filter String) *ClipPathElement {
-	e.Attrs["filter"] = filter
+// From sets the from attribute.
+func (e *AnimateTransformElement) From(from String) *AnimateTransformElement {
+	e.Attrs["from"] = from
 	return e
 }
 
-// FloodColor sets the flood-color attribute.
-func (e *ClipPathElement) FloodColor(floodColor String) *ClipPathElement {
-	e.Attrs["flood-color"] = floodColor
+// To sets the to attribute.
+func (e *AnimateTransformElement) To(to String) *AnimateTransformElement {
+	e.Attrs["to"] = to
 	return e
 }
 
-// FloodOpacity sets the flood-opacity attribute.
-func (e *ClipPathElement) FloodOpacity(floodOpacity Float64) *ClipPathElement {
-	e.Attrs["flood-opacity"] = floodOpacity
+// By sets the by attribute.
+func (e *AnimateTransformElement) By(by String) *AnimateTransformElement {
+	e.Attrs["by"] = by
 	return e
 }
 
-// FontFamily sets the font-family attribute.
-func (e *ClipPathElement) FontFamily(fontFamily String) *ClipPathElement {
-	e.Attrs["font-family"] = fontFamily
+// Additive sets the additive attribute.
+func (e *AnimateTransformElement) Additive(additive String) *AnimateTransformElement {
+	e.Attrs["additive"] = additive
 	return e
 }
 
-// FontSize sets the font-size attribute.
-func (e *ClipPathElement) FontSize(fontSize String) *ClipPathElement {
-	e.Attrs["font-size"] = fontSize
+// Accumulate sets the accumulate attribute.
+func (e *AnimateTransformElement) Accumulate(accumulate String) *AnimateTransformElement {
+	e.Attrs["accumulate"] = accumulate
 	return e
 }
 
-// FontSizeAdjust sets the font-size-adjust attribute.
-func (e *ClipPathElement) FontSizeAdjust(fontSizeAdjust String) *ClipPathElement {
-	e.Attrs["font-size-adjust"] = fontSizeAdjust
+// AttributeName sets the attributeName attribute.
+func (e *AnimateTransformElement) AttributeName(attributeName String) *AnimateTransformElement {
+	e.Attrs["attributeName"] = attributeName
 	return e
 }
 
-// FontStretch sets the font-stretch attribute.
-func (e *ClipPathElement) FontStretch(fontStretch String) *ClipPathElement {
-	e.Attrs["font-stretch"] = fontStretch
+// Href sets the href attribute.
+func (e *AnimateTransformElement) Href(href String) *AnimateTransformElement {
+	e.Attrs["href"] = href
 	return e
 }
 
-// FontStyle sets the font-style attribute.
-func (e *ClipPathElement) FontStyle(fontStyle String) *ClipPathElement {
-	e.Attrs["font-style"] = fontStyle
+// Type sets the type attribute.
+func (e *AnimateTransformElement) Type(_type String) *AnimateTransformElement {
+	e.Attrs["type"] = _type
 	return e
 }
 
-// FontVariant sets the font-variant attribute.
-func (e *ClipPathElement) FontVariant(fontVariant String) *ClipPathElement {
-	e.Attrs["font-variant"] = fontVariant
-	return e
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *AnimateTransformElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
 }
 
-// FontWeight sets the font-weight attribute.
-func (e *ClipPathElement) FontWeight(fontWeight String) *ClipPathElement {
-	e.Attrs["font-weight"] = fontWeight
-	return e
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *AnimateTransformElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
 }
 
-// GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
-func (e *ClipPathElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *ClipPathElement {
-	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
-	return e
+// Attributes implements Node.Attributes.
+func (e *AnimateTransformElement) Attributes() map[string]AttrValue {
+	return e.Attrs
 }
 
-// GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
-func (e *ClipPathElement) GlyphOrientationVertical(glyphOrientationVertical String) *ClipPathElement {
-	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
-	return e
+// ChildElements implements Node.ChildElements.
+func (e *AnimateTransformElement) ChildElements() []Element {
+	return e.Children
 }
 
-// ImageRendering sets the image-rendering attribute.
-func (e *ClipPathElement) ImageRendering(imageRendering String) *ClipPathElement {
-	e.Attrs["image-rendering"] = imageRendering
-	return e
+// SetChildElements implements Container.SetChildElements.
+func (e *AnimateTransformElement) SetChildElements(children []Element) {
+	e.Children = children
 }
 
-// LetterSpacing sets the letter-spacing attribute.
-func (e *ClipPathElement) LetterSpacing(letterSpacing String) *ClipPathElement {
-	e.Attrs["letter-spacing"] = letterSpacing
-	return e
+// TagName implements Node.TagName.
+func (e *AnimateTransformElement) TagName() string {
+	return "animateTransform"
 }
 
-// LightingColor sets the lighting-color attribute.
-func (e *ClipPathElement) LightingColor(lightingColor String) *ClipPathElement {
-	e.Attrs["lighting-color"] = lightingColor
-	return e
+// decode decodes e from d.
+func (e *AnimateTransformElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *AnimateTransformElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "dur":
+		return parseDuration(value)
+	case "min":
+		return parseDuration(value)
+	case "max":
+		return parseDuration(value)
+	case "repeatCount":
+		return parseRepeatCount(value)
+	case "repeatDur":
+		return parseDuration(value)
+	case "keyTimes":
+		return parseKeyTimes(value)
+	case "keySplines":
+		return parseKeySplines(value)
+	default:
+		return String(value), nil
+	}
 }
 
-// MarkerEnd sets the marker-end attribute.
-func (e *ClipPathElement) MarkerEnd(markerEnd String) *ClipPathElement {
-	e.Attrs["marker-end"] = markerEnd
-	return e
+// A CircleElement is a circle element.
+type CircleElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
 }
 
-// MarkerMid sets the marker-mid attribute.
-func (e *ClipPathElement) MarkerMid(markerMid String) *ClipPathElement {
-	e.Attrs["marker-mid"] = markerMid
+// Circle returns a new CircleElement.
+func Circle(children ...Element) *CircleElement {
+	return &CircleElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *CircleElement) AppendChildren(children ...Element) *CircleElement {
+	e.Children = append(e.Children, children...)
 	return e
 }
 
-// MarkerStart sets the marker-start attribute.
-func (e *ClipPathElement) MarkerStart(markerStart String) *ClipPathElement {
-	e.Attrs["marker-start"] = markerStart
+// RemoveAttrs removes the attributes with the given names.
+func (e *CircleElement) RemoveAttrs(names ...string) *CircleElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
 	return e
 }
 
-// Mask sets the mask attribute.
-func (e *ClipPathElement) Mask(mask String) *ClipPathElement {
-	e.Attrs["mask"] = mask
+// ID sets the id attribute.
+func (e *CircleElement) ID(id String) *CircleElement {
+	e.Attrs["id"] = id
 	return e
 }
 
-// Opacity sets the opacity attribute.
-func (e *ClipPathElement) Opacity(opacity Float64) *ClipPathElement {
-	e.Attrs["opacity"] = opacity
+// TabIndex sets the tabindex attribute.
+func (e *CircleElement) TabIndex(tabIndex Int) *CircleElement {
+	e.Attrs["tabindex"] = tabIndex
 	return e
 }
 
-// Overflow sets the overflow attribute.
-func (e *ClipPathElement) Overflow(overflow String) *ClipPathElement {
-	e.Attrs["overflow"] = overflow
+// Lang sets the lang attribute.
+func (e *CircleElement) Lang(lang String) *CircleElement {
+	e.Attrs["lang"] = lang
 	return e
 }
 
-// PaintOrder sets the paint-order attribute.
-func (e *ClipPathElement) PaintOrder(paintOrder String) *ClipPathElement {
-	e.Attrs["paint-order"] = paintOrder
+// Class sets the class attribute.
+func (e *CircleElement) Class(class String) *CircleElement {
+	e.Attrs["class"] = class
 	return e
 }
 
-// PointerEvents sets the pointer-events attribute.
-func (e *ClipPathElement) PointerEvents(pointerEvents String) *ClipPathElement {
-	e.Attrs["pointer-events"] = pointerEvents
+// Style sets the style attribute.
+func (e *CircleElement) Style(style String) *CircleElement {
+	e.Attrs["style"] = style
 	return e
 }
 
-// ShapeRendering sets the shape-rendering attribute.
-func (e *ClipPathElement) ShapeRendering(shapeRendering String) *ClipPathElement {
-	e.Attrs["shape-rendering"] = shapeRendering
+// AlignmentBaseline sets the alignment-baseline attribute.
+func (e *CircleElement) AlignmentBaseline(alignmentBaseline String) *CircleElement {
+	e.Attrs["alignment-baseline"] = alignmentBaseline
 	return e
 }
 
-// StopColor sets the stop-color attribute.
-func (e *ClipPathElement) StopColor(stopColor String) *ClipPathElement {
-	e.Attrs["stop-color"] = stopColor
+// BaselineShift sets the baseline-shift attribute.
+func (e *CircleElement) BaselineShift(baselineShift String) *CircleElement {
+	e.Attrs["baseline-shift"] = baselineShift
 	return e
 }
 
-// StopOpacity sets the stop-opacity attribute.
-func (e *ClipPathElement) StopOpacity(stopOpacity Float64) *ClipPathElement {
-	e.Attrs["stop-opacity"] = stopOpacity
+// ClipPath sets the clip-path attribute.
+func (e *CircleElement) ClipPath(clipPath String) *CircleElement {
+	e.Attrs["clip-path"] = clipPath
 	return e
 }
 
-// Stroke sets the stroke attribute.
-func (e *ClipPathElement) Stroke(stroke String) *ClipPathElement {
-	e.Attrs["stroke"] = stroke
+// ClipRule sets the clip-rule attribute.
+func (e *CircleElement) ClipRule(clipRule String) *CircleElement {
+	e.Attrs["clip-rule"] = clipRule
 	return e
 }
 
-// StrokeDashArray sets the stroke-dasharray attribute.
-func (e *ClipPathElement) StrokeDashArray(strokeDashArray String) *ClipPathElement {
-	e.Attrs["stroke-dasharray"] = strokeDashArray
+// Color sets the color attribute.
+func (e *CircleElement) Color(color Color) *CircleElement {
+	e.Attrs["color"] = color
 	return e
 }
 
-// StrokeDashOffset sets the stroke-dashoffset attribute.
-func (e *ClipPathElement) StrokeDashOffset(strokeDashOffset Float64) *ClipPathElement {
-	e.Attrs["stroke-dashoffset"] = strokeDashOffset
+// ColorInterpolation sets the color-interpolation attribute.
+func (e *CircleElement) ColorInterpolation(colorInterpolation String) *CircleElement {
+	e.Attrs["color-interpolation"] = colorInterpolation
+	return e
+}
+
+// ColorInterpolationFilters sets the color-interpolation-filters attribute.
+func (e *CircleElement) ColorInterpolationFilters(colorInterpolationFilters String) *CircleElement {
+	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
+	return e
+}
+
+// ColorRendering sets the color-rendering attribute.
+func (e *CircleElement) ColorRendering(colorRendering String) *CircleElement {
+	e.Attrs["color-rendering"] = colorRendering
+	return e
+}
+
+// Cursor sets the cursor attribute.
+func (e *CircleElement) Cursor(cursor String) *CircleElement {
+	e.Attrs["cursor"] = cursor
+	return e
+}
+
+// Direction sets the direction attribute.
+func (e *CircleElement) Direction(direction String) *CircleElement {
+	e.Attrs["direction"] = direction
+	return e
+}
+
+// Display sets the display attribute.
+func (e *CircleElement) Display(display String) *CircleElement {
+	e.Attrs["display"] = display
+	return e
+}
+
+// DominantBaseline sets the dominant-baseline attribute.
+func (e *CircleElement) DominantBaseline(dominantBaseline String) *CircleElement {
+	e.Attrs["dominant-baseline"] = dominantBaseline
+	return e
+}
+
+// Fill sets the fill attribute.
+func (e *CircleElement) Fill(fill String) *CircleElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *CircleElement) FillColor(fill Color) *CircleElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *CircleElement) FillPaint(fill Paint) *CircleElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillOpacity sets the fill-opacity attribute.
+func (e *CircleElement) FillOpacity(fillOpacity Float64) *CircleElement {
+	e.Attrs["fill-opacity"] = fillOpacity
+	return e
+}
+
+// FillRule sets the fill-rule attribute.
+func (e *CircleElement) FillRule(fillRule String) *CircleElement {
+	e.Attrs["fill-rule"] = fillRule
+	return e
+}
+
+// Filter sets the filter attribute.
+func (e *CircleElement) Filter(filter String) *CircleElement {
+	e.Attrs["filter"] = filter
+	return e
+}
+
+// FloodColor sets the flood-color attribute.
+func (e *CircleElement) FloodColor(floodColor Color) *CircleElement {
+	e.Attrs["flood-color"] = floodColor
+	return e
+}
+
+// FloodOpacity sets the flood-opacity attribute.
+func (e *CircleElement) FloodOpacity(floodOpacity Float64) *CircleElement {
+	e.Attrs["flood-opacity"] = floodOpacity
+	return e
+}
+
+// FontFamily sets the font-family attribute.
+func (e *CircleElement) FontFamily(fontFamily String) *CircleElement {
+	e.Attrs["font-family"] = fontFamily
+	return e
+}
+
+// FontSize sets the font-size attribute.
+func (e *CircleElement) FontSize(fontSize String) *CircleElement {
+	e.Attrs["font-size"] = fontSize
+	return e
+}
+
+// FontSizeAdjust sets the font-size-adjust attribute.
+func (e *CircleElement) FontSizeAdjust(fontSizeAdjust String) *CircleElement {
+	e.Attrs["font-size-adjust"] = fontSizeAdjust
+	return e
+}
+
+// FontStretch sets the font-stretch attribute.
+func (e *CircleElement) FontStretch(fontStretch String) *CircleElement {
+	e.Attrs["font-stretch"] = fontStretch
+	return e
+}
+
+// FontStyle sets the font-style attribute.
+func (e *CircleElement) FontStyle(fontStyle String) *CircleElement {
+	e.Attrs["font-style"] = fontStyle
+	return e
+}
+
+// FontVariant sets the font-variant attribute.
+func (e *CircleElement) FontVariant(fontVariant String) *CircleElement {
+	e.Attrs["font-variant"] = fontVariant
+	return e
+}
+
+// FontWeight sets the font-weight attribute.
+func (e *CircleElement) FontWeight(fontWeight String) *CircleElement {
+	e.Attrs["font-weight"] = fontWeight
+	return e
+}
+
+// GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
+func (e *CircleElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *CircleElement {
+	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
+	return e
+}
+
+// GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
+func (e *CircleElement) GlyphOrientationVertical(glyphOrientationVertical String) *CircleElement {
+	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
+	return e
+}
+
+// ImageRendering sets the image-rendering attribute.
+func (e *CircleElement) ImageRendering(imageRendering String) *CircleElement {
+	e.Attrs["image-rendering"] = imageRendering
+	return e
+}
+
+// LetterSpacing sets the letter-spacing attribute.
+func (e *CircleElement) LetterSpacing(letterSpacing String) *CircleElement {
+	e.Attrs["letter-spacing"] = letterSpacing
+	return e
+}
+
+// LightingColor sets the lighting-color attribute.
+func (e *CircleElement) LightingColor(lightingColor Color) *CircleElement {
+	e.Attrs["lighting-color"] = lightingColor
+	return e
+}
+
+// MarkerEnd sets the marker-end attribute.
+func (e *CircleElement) MarkerEnd(markerEnd String) *CircleElement {
+	e.Attrs["marker-end"] = markerEnd
+	return e
+}
+
+// MarkerMid sets the marker-mid attribute.
+func (e *CircleElement) MarkerMid(markerMid String) *CircleElement {
+	e.Attrs["marker-mid"] = markerMid
+	return e
+}
+
+// MarkerStart sets the marker-start attribute.
+func (e *CircleElement) MarkerStart(markerStart String) *CircleElement {
+	e.Attrs["marker-start"] = markerStart
+	return e
+}
+
+// Mask sets the mask attribute.
+func (e *CircleElement) Mask(mask String) *CircleElement {
+	e.Attrs["mask"] = mask
+	return e
+}
+
+// Opacity sets the opacity attribute.
+func (e *CircleElement) Opacity(opacity Float64) *CircleElement {
+	e.Attrs["opacity"] = opacity
+	return e
+}
+
+// Overflow sets the overflow attribute.
+func (e *CircleElement) Overflow(overflow String) *CircleElement {
+	e.Attrs["overflow"] = overflow
+	return e
+}
+
+// PaintOrder sets the paint-order attribute.
+func (e *CircleElement) PaintOrder(paintOrder String) *CircleElement {
+	e.Attrs["paint-order"] = paintOrder
+	return e
+}
+
+// PointerEvents sets the pointer-events attribute.
+func (e *CircleElement) PointerEvents(pointerEvents String) *CircleElement {
+	e.Attrs["pointer-events"] = pointerEvents
+	return e
+}
+
+// ShapeRendering sets the shape-rendering attribute.
+func (e *CircleElement) ShapeRendering(shapeRendering String) *CircleElement {
+	e.Attrs["shape-rendering"] = shapeRendering
+	return e
+}
+
+// StopColor sets the stop-color attribute.
+func (e *CircleElement) StopColor(stopColor Color) *CircleElement {
+	e.Attrs["stop-color"] = stopColor
+	return e
+}
+
+// StopOpacity sets the stop-opacity attribute.
+func (e *CircleElement) StopOpacity(stopOpacity Float64) *CircleElement {
+	e.Attrs["stop-opacity"] = stopOpacity
+	return e
+}
+
+// Stroke sets the stroke attribute.
+func (e *CircleElement) Stroke(stroke String) *CircleElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *CircleElement) StrokeColor(stroke Color) *CircleElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *CircleElement) StrokePaint(stroke Paint) *CircleElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeDashArray sets the stroke-dasharray attribute.
+func (e *CircleElement) StrokeDashArray(strokeDashArray String) *CircleElement {
+	e.Attrs["stroke-dasharray"] = strokeDashArray
+	return e
+}
+
+// StrokeDashOffset sets the stroke-dashoffset attribute.
+func (e *CircleElement) StrokeDashOffset(strokeDashOffset Float64) *CircleElement {
+	e.Attrs["stroke-dashoffset"] = strokeDashOffset
+	return e
+}
+
+// StrokeLineCap sets the stroke-linecap attribute.
+func (e *CircleElement) StrokeLineCap(strokeLineCap String) *CircleElement {
+	e.Attrs["stroke-linecap"] = strokeLineCap
+	return e
+}
+
+// StrokeLineJoin sets the stroke-linejoin attribute.
+func (e *CircleElement) StrokeLineJoin(strokeLineJoin String) *CircleElement {
+	e.Attrs["stroke-linejoin"] = strokeLineJoin
+	return e
+}
+
+// StrokeMiterLimit sets the stroke-miterlimit attribute.
+func (e *CircleElement) StrokeMiterLimit(strokeMiterLimit Float64) *CircleElement {
+	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
+	return e
+}
+
+// StrokeOpacity sets the stroke-opacity attribute.
+func (e *CircleElement) StrokeOpacity(strokeOpacity Float64) *CircleElement {
+	e.Attrs["stroke-opacity"] = strokeOpacity
+	return e
+}
+
+// StrokeWidth sets the stroke-width attribute.
+func (e *CircleElement) StrokeWidth(strokeWidth Length) *CircleElement {
+	e.Attrs["stroke-width"] = strokeWidth
+	return e
+}
+
+// TextAnchor sets the text-anchor attribute.
+func (e *CircleElement) TextAnchor(textAnchor String) *CircleElement {
+	e.Attrs["text-anchor"] = textAnchor
+	return e
+}
+
+// TextDecoration sets the text-decoration attribute.
+func (e *CircleElement) TextDecoration(textDecoration String) *CircleElement {
+	e.Attrs["text-decoration"] = textDecoration
+	return e
+}
+
+// TextOverflow sets the text-overflow attribute.
+func (e *CircleElement) TextOverflow(textOverflow String) *CircleElement {
+	e.Attrs["text-overflow"] = textOverflow
+	return e
+}
+
+// TextRendering sets the text-rendering attribute.
+func (e *CircleElement) TextRendering(textRendering String) *CircleElement {
+	e.Attrs["text-rendering"] = textRendering
+	return e
+}
+
+// Transform sets the transform attribute.
+func (e *CircleElement) Transform(transform Transform) *CircleElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
+// UnicodeBiDi sets the unicode-bidi attribute.
+func (e *CircleElement) UnicodeBiDi(unicodeBiDi String) *CircleElement {
+	e.Attrs["unicode-bidi"] = unicodeBiDi
+	return e
+}
+
+// VectorEffect sets the vector-effect attribute.
+func (e *CircleElement) VectorEffect(vectorEffect String) *CircleElement {
+	e.Attrs["vector-effect"] = vectorEffect
+	return e
+}
+
+// Visibility sets the visibility attribute.
+func (e *CircleElement) Visibility(visibility String) *CircleElement {
+	e.Attrs["visibility"] = visibility
+	return e
+}
+
+// WhiteSpace sets the white-space attribute.
+func (e *CircleElement) WhiteSpace(whiteSpace String) *CircleElement {
+	e.Attrs["white-space"] = whiteSpace
+	return e
+}
+
+// WordSpacing sets the word-spacing attribute.
+func (e *CircleElement) WordSpacing(wordSpacing String) *CircleElement {
+	e.Attrs["word-spacing"] = wordSpacing
+	return e
+}
+
+// WritingMode sets the writing-mode attribute.
+func (e *CircleElement) WritingMode(writingMode String) *CircleElement {
+	e.Attrs["writing-mode"] = writingMode
+	return e
+}
+
+// PathLength sets the pathLength attribute.
+func (e *CircleElement) PathLength(pathLength String) *CircleElement {
+	e.Attrs["pathLength"] = pathLength
+	return e
+}
+
+// CX sets the cx attribute.
+func (e *CircleElement) CX(cx Length) *CircleElement {
+	e.Attrs["cx"] = cx
+	return e
+}
+
+// CY sets the cy attribute.
+func (e *CircleElement) CY(cy Length) *CircleElement {
+	e.Attrs["cy"] = cy
+	return e
+}
+
+// R sets the r attribute.
+func (e *CircleElement) R(r Length) *CircleElement {
+	e.Attrs["r"] = r
+	return e
+}
+
+// CXCY sets the cx and cy attributes.
+func (e *CircleElement) CXCY(cx, cy float64, lengthFunc LengthFunc) *CircleElement {
+	e.Attrs["cx"] = lengthFunc(cx)
+	e.Attrs["cy"] = lengthFunc(cy)
+	return e
+}
+
+// CXCYR sets the cx, cy, and r attributes.
+func (e *CircleElement) CXCYR(cx, cy, r float64, lengthFunc LengthFunc) *CircleElement {
+	e.Attrs["cx"] = lengthFunc(cx)
+	e.Attrs["cy"] = lengthFunc(cy)
+	e.Attrs["r"] = lengthFunc(r)
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *CircleElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *CircleElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *CircleElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *CircleElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *CircleElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *CircleElement) TagName() string {
+	return "circle"
+}
+
+// decode decodes e from d.
+func (e *CircleElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *CircleElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "cx":
+		return parseLength(value)
+	case "cy":
+		return parseLength(value)
+	case "r":
+		return parseLength(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A ClipPathElement is a clipPath element.
+type ClipPathElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// ClipPath returns a new ClipPathElement.
+func ClipPath(children ...Element) *ClipPathElement {
+	return &ClipPathElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *ClipPathElement) AppendChildren(children ...Element) *ClipPathElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *ClipPathElement) RemoveAttrs(names ...string) *ClipPathElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *ClipPathElement) ID(id String) *ClipPathElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *ClipPathElement) TabIndex(tabIndex Int) *ClipPathElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *ClipPathElement) Lang(lang String) *ClipPathElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *ClipPathElement) Class(class String) *ClipPathElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *ClipPathElement) Style(style String) *ClipPathElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// AlignmentBaseline sets the alignment-baseline attribute.
+func (e *ClipPathElement) AlignmentBaseline(alignmentBaseline String) *ClipPathElement {
+	e.Attrs["alignment-baseline"] = alignmentBaseline
+	return e
+}
+
+// BaselineShift sets the baseline-shift attribute.
+func (e *ClipPathElement) BaselineShift(baselineShift String) *ClipPathElement {
+	e.Attrs["baseline-shift"] = baselineShift
+	return e
+}
+
+// ClipPath sets the clip-path attribute.
+func (e *ClipPathElement) ClipPath(clipPath String) *ClipPathElement {
+	e.Attrs["clip-path"] = clipPath
+	return e
+}
+
+// ClipRule sets the clip-rule attribute.
+func (e *ClipPathElement) ClipRule(clipRule String) *ClipPathElement {
+	e.Attrs["clip-rule"] = clipRule
+	return e
+}
+
+// Color sets the color attribute.
+func (e *ClipPathElement) Color(color Color) *ClipPathElement {
+	e.Attrs["color"] = color
+	return e
+}
+
+// ColorInterpolation sets the color-interpolation attribute.
+func (e *ClipPathElement) ColorInterpolation(colorInterpolation String) *ClipPathElement {
+	e.Attrs["color-interpolation"] = colorInterpolation
+	return e
+}
+
+// ColorInterpolationFilters sets the color-interpolation-filters attribute.
+func (e *ClipPathElement) ColorInterpolationFilters(colorInterpolationFilters String) *ClipPathElement {
+	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
+	return e
+}
+
+// ColorRendering sets the color-rendering attribute.
+func (e *ClipPathElement) ColorRendering(colorRendering String) *ClipPathElement {
+	e.Attrs["color-rendering"] = colorRendering
+	return e
+}
+
+// Cursor sets the cursor attribute.
+func (e *ClipPathElement) Cursor(cursor String) *ClipPathElement {
+	e.Attrs["cursor"] = cursor
+	return e
+}
+
+// Direction sets the direction attribute.
+func (e *ClipPathElement) Direction(direction String) *ClipPathElement {
+	e.Attrs["direction"] = direction
+	return e
+}
+
+// Display sets the display attribute.
+func (e *ClipPathElement) Display(display String) *ClipPathElement {
+	e.Attrs["display"] = display
+	return e
+}
+
+// DominantBaseline sets the dominant-baseline attribute.
+func (e *ClipPathElement) DominantBaseline(dominantBaseline String) *ClipPathElement {
+	e.Attrs["dominant-baseline"] = dominantBaseline
+	return e
+}
+
+// Fill sets the fill attribute.
+func (e *ClipPathElement) Fill(fill String) *ClipPathElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *ClipPathElement) FillColor(fill Color) *ClipPathElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *ClipPathElement) FillPaint(fill Paint) *ClipPathElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillOpacity sets the fill-opacity attribute.
+func (e *ClipPathElement) FillOpacity(fillOpacity Float64) *ClipPathElement {
+	e.Attrs["fill-opacity"] = fillOpacity
+	return e
+}
+
+// FillRule sets the fill-rule attribute.
+func (e *ClipPathElement) FillRule(fillRule String) *ClipPathElement {
+	e.Attrs["fill-rule"] = fillRule
+	return e
+}
+
+// Filter sets the filter attribute.
+func (e *ClipPathElement) Filter(filter String) *ClipPathElement {
+	e.Attrs["filter"] = filter
+	return e
+}
+
+// FloodColor sets the flood-color attribute.
+func (e *ClipPathElement) FloodColor(floodColor Color) *ClipPathElement {
+	e.Attrs["flood-color"] = floodColor
+	return e
+}
+
+// FloodOpacity sets the flood-opacity attribute.
+func (e *ClipPathElement) FloodOpacity(floodOpacity Float64) *ClipPathElement {
+	e.Attrs["flood-opacity"] = floodOpacity
+	return e
+}
+
+// FontFamily sets the font-family attribute.
+func (e *ClipPathElement) FontFamily(fontFamily String) *ClipPathElement {
+	e.Attrs["font-family"] = fontFamily
+	return e
+}
+
+// FontSize sets the font-size attribute.
+func (e *ClipPathElement) FontSize(fontSize String) *ClipPathElement {
+	e.Attrs["font-size"] = fontSize
+	return e
+}
+
+// FontSizeAdjust sets the font-size-adjust attribute.
+func (e *ClipPathElement) FontSizeAdjust(fontSizeAdjust String) *ClipPathElement {
+	e.Attrs["font-size-adjust"] = fontSizeAdjust
+	return e
+}
+
+// FontStretch sets the font-stretch attribute.
+func (e *ClipPathElement) FontStretch(fontStretch String) *ClipPathElement {
+	e.Attrs["font-stretch"] = fontStretch
+	return e
+}
+
+// FontStyle sets the font-style attribute.
+func (e *ClipPathElement) FontStyle(fontStyle String) *ClipPathElement {
+	e.Attrs["font-style"] = fontStyle
+	return e
+}
+
+// FontVariant sets the font-variant attribute.
+func (e *ClipPathElement) FontVariant(fontVariant String) *ClipPathElement {
+	e.Attrs["font-variant"] = fontVariant
+	return e
+}
+
+// FontWeight sets the font-weight attribute.
+func (e *ClipPathElement) FontWeight(fontWeight String) *ClipPathElement {
+	e.Attrs["font-weight"] = fontWeight
+	return e
+}
+
+// GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
+func (e *ClipPathElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *ClipPathElement {
+	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
+	return e
+}
+
+// GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
+func (e *ClipPathElement) GlyphOrientationVertical(glyphOrientationVertical String) *ClipPathElement {
+	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
+	return e
+}
+
+// ImageRendering sets the image-rendering attribute.
+func (e *ClipPathElement) ImageRendering(imageRendering String) *ClipPathElement {
+	e.Attrs["image-rendering"] = imageRendering
+	return e
+}
+
+// LetterSpacing sets the letter-spacing attribute.
+func (e *ClipPathElement) LetterSpacing(letterSpacing String) *ClipPathElement {
+	e.Attrs["letter-spacing"] = letterSpacing
+	return e
+}
+
+// LightingColor sets the lighting-color attribute.
+func (e *ClipPathElement) LightingColor(lightingColor Color) *ClipPathElement {
+	e.Attrs["lighting-color"] = lightingColor
+	return e
+}
+
+// MarkerEnd sets the marker-end attribute.
+func (e *ClipPathElement) MarkerEnd(markerEnd String) *ClipPathElement {
+	e.Attrs["marker-end"] = markerEnd
+	return e
+}
+
+// MarkerMid sets the marker-mid attribute.
+func (e *ClipPathElement) MarkerMid(markerMid String) *ClipPathElement {
+	e.Attrs["marker-mid"] = markerMid
+	return e
+}
+
+// MarkerStart sets the marker-start attribute.
+func (e *ClipPathElement) MarkerStart(markerStart String) *ClipPathElement {
+	e.Attrs["marker-start"] = markerStart
+	return e
+}
+
+// Mask sets the mask attribute.
+func (e *ClipPathElement) Mask(mask String) *ClipPathElement {
+	e.Attrs["mask"] = mask
+	return e
+}
+
+// Opacity sets the opacity attribute.
+func (e *ClipPathElement) Opacity(opacity Float64) *ClipPathElement {
+	e.Attrs["opacity"] = opacity
+	return e
+}
+
+// Overflow sets the overflow attribute.
+func (e *ClipPathElement) Overflow(overflow String) *ClipPathElement {
+	e.Attrs["overflow"] = overflow
+	return e
+}
+
+// PaintOrder sets the paint-order attribute.
+func (e *ClipPathElement) PaintOrder(paintOrder String) *ClipPathElement {
+	e.Attrs["paint-order"] = paintOrder
+	return e
+}
+
+// PointerEvents sets the pointer-events attribute.
+func (e *ClipPathElement) PointerEvents(pointerEvents String) *ClipPathElement {
+	e.Attrs["pointer-events"] = pointerEvents
+	return e
+}
+
+// ShapeRendering sets the shape-rendering attribute.
+func (e *ClipPathElement) ShapeRendering(shapeRendering String) *ClipPathElement {
+	e.Attrs["shape-rendering"] = shapeRendering
+	return e
+}
+
+// StopColor sets the stop-color attribute.
+func (e *ClipPathElement) StopColor(stopColor Color) *ClipPathElement {
+	e.Attrs["stop-color"] = stopColor
+	return e
+}
+
+// StopOpacity sets the stop-opacity attribute.
+func (e *ClipPathElement) StopOpacity(stopOpacity Float64) *ClipPathElement {
+	e.Attrs["stop-opacity"] = stopOpacity
+	return e
+}
+
+// Stroke sets the stroke attribute.
+func (e *ClipPathElement) Stroke(stroke String) *ClipPathElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *ClipPathElement) StrokeColor(stroke Color) *ClipPathElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *ClipPathElement) StrokePaint(stroke Paint) *ClipPathElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeDashArray sets the stroke-dasharray attribute.
+func (e *ClipPathElement) StrokeDashArray(strokeDashArray String) *ClipPathElement {
+	e.Attrs["stroke-dasharray"] = strokeDashArray
+	return e
+}
+
+// StrokeDashOffset sets the stroke-dashoffset attribute.
+func (e *ClipPathElement) StrokeDashOffset(strokeDashOffset Float64) *ClipPathElement {
+	e.Attrs["stroke-dashoffset"] = strokeDashOffset
+	return e
+}
+
+// StrokeLineCap sets the stroke-linecap attribute.
+func (e *ClipPathElement) StrokeLineCap(strokeLineCap String) *ClipPathElement {
+	e.Attrs["stroke-linecap"] = strokeLineCap
+	return e
+}
+
+// StrokeLineJoin sets the stroke-linejoin attribute.
+func (e *ClipPathElement) StrokeLineJoin(strokeLineJoin String) *ClipPathElement {
+	e.Attrs["stroke-linejoin"] = strokeLineJoin
+	return e
+}
+
+// StrokeMiterLimit sets the stroke-miterlimit attribute.
+func (e *ClipPathElement) StrokeMiterLimit(strokeMiterLimit Float64) *ClipPathElement {
+	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
+	return e
+}
+
+// StrokeOpacity sets the stroke-opacity attribute.
+func (e *ClipPathElement) StrokeOpacity(strokeOpacity Float64) *ClipPathElement {
+	e.Attrs["stroke-opacity"] = strokeOpacity
+	return e
+}
+
+// StrokeWidth sets the stroke-width attribute.
+func (e *ClipPathElement) StrokeWidth(strokeWidth Length) *ClipPathElement {
+	e.Attrs["stroke-width"] = strokeWidth
+	return e
+}
+
+// TextAnchor sets the text-anchor attribute.
+func (e *ClipPathElement) TextAnchor(textAnchor String) *ClipPathElement {
+	e.Attrs["text-anchor"] = textAnchor
+	return e
+}
+
+// TextDecoration sets the text-decoration attribute.
+func (e *ClipPathElement) TextDecoration(textDecoration String) *ClipPathElement {
+	e.Attrs["text-decoration"] = textDecoration
+	return e
+}
+
+// TextOverflow sets the text-overflow attribute.
+func (e *ClipPathElement) TextOverflow(textOverflow String) *ClipPathElement {
+	e.Attrs["text-overflow"] = textOverflow
+	return e
+}
+
+// TextRendering sets the text-rendering attribute.
+func (e *ClipPathElement) TextRendering(textRendering String) *ClipPathElement {
+	e.Attrs["text-rendering"] = textRendering
+	return e
+}
+
+// Transform sets the transform attribute.
+func (e *ClipPathElement) Transform(transform Transform) *ClipPathElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
+// UnicodeBiDi sets the unicode-bidi attribute.
+func (e *ClipPathElement) UnicodeBiDi(unicodeBiDi String) *ClipPathElement {
+	e.Attrs["unicode-bidi"] = unicodeBiDi
+	return e
+}
+
+// VectorEffect sets the vector-effect attribute.
+func (e *ClipPathElement) VectorEffect(vectorEffect String) *ClipPathElement {
+	e.Attrs["vector-effect"] = vectorEffect
+	return e
+}
+
+// Visibility sets the visibility attribute.
+func (e *ClipPathElement) Visibility(visibility String) *ClipPathElement {
+	e.Attrs["visibility"] = visibility
+	return e
+}
+
+// WhiteSpace sets the white-space attribute.
+func (e *ClipPathElement) WhiteSpace(whiteSpace String) *ClipPathElement {
+	e.Attrs["white-space"] = whiteSpace
+	return e
+}
+
+// WordSpacing sets the word-spacing attribute.
+func (e *ClipPathElement) WordSpacing(wordSpacing String) *ClipPathElement {
+	e.Attrs["word-spacing"] = wordSpacing
+	return e
+}
+
+// WritingMode sets the writing-mode attribute.
+func (e *ClipPathElement) WritingMode(writingMode String) *ClipPathElement {
+	e.Attrs["writing-mode"] = writingMode
+	return e
+}
+
+// ExternalResourcesRequired sets the externalResourcesRequired attribute.
+func (e *ClipPathElement) ExternalResourcesRequired(externalResourcesRequired String) *ClipPathElement {
+	e.Attrs["externalResourcesRequired"] = externalResourcesRequired
+	return e
+}
+
+// ClipPathUnits sets the clipPathUnits attribute.
+func (e *ClipPathElement) ClipPathUnits(clipPathUnits String) *ClipPathElement {
+	e.Attrs["clipPathUnits"] = clipPathUnits
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *ClipPathElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *ClipPathElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *ClipPathElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *ClipPathElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *ClipPathElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *ClipPathElement) TagName() string {
+	return "clipPath"
+}
+
+// decode decodes e from d.
+func (e *ClipPathElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *ClipPathElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A DefsElement is a defs element.
+type DefsElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// Defs returns a new DefsElement.
+func Defs(children ...Element) *DefsElement {
+	return &DefsElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *DefsElement) AppendChildren(children ...Element) *DefsElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *DefsElement) RemoveAttrs(names ...string) *DefsElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *DefsElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *DefsElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *DefsElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *DefsElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *DefsElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *DefsElement) TagName() string {
+	return "defs"
+}
+
+// decode decodes e from d.
+func (e *DefsElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *DefsElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	default:
+		return String(value), nil
+	}
+}
+
+// A DescElement is a desc element.
+type DescElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// Desc returns a new DescElement.
+func Desc(children ...Element) *DescElement {
+	return &DescElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *DescElement) AppendChildren(children ...Element) *DescElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *DescElement) RemoveAttrs(names ...string) *DescElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *DescElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *DescElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *DescElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *DescElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *DescElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *DescElement) TagName() string {
+	return "desc"
+}
+
+// decode decodes e from d.
+func (e *DescElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *DescElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	default:
+		return String(value), nil
+	}
+}
+
+// An EllipseElement is an ellipse element.
+type EllipseElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// Ellipse returns a new EllipseElement.
+func Ellipse(children ...Element) *EllipseElement {
+	return &EllipseElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *EllipseElement) AppendChildren(children ...Element) *EllipseElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *EllipseElement) RemoveAttrs(names ...string) *EllipseElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *EllipseElement) ID(id String) *EllipseElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *EllipseElement) TabIndex(tabIndex Int) *EllipseElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *EllipseElement) Lang(lang String) *EllipseElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *EllipseElement) Class(class String) *EllipseElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *EllipseElement) Style(style String) *EllipseElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// AlignmentBaseline sets the alignment-baseline attribute.
+func (e *EllipseElement) AlignmentBaseline(alignmentBaseline String) *EllipseElement {
+	e.Attrs["alignment-baseline"] = alignmentBaseline
+	return e
+}
+
+// BaselineShift sets the baseline-shift attribute.
+func (e *EllipseElement) BaselineShift(baselineShift String) *EllipseElement {
+	e.Attrs["baseline-shift"] = baselineShift
+	return e
+}
+
+// ClipPath sets the clip-path attribute.
+func (e *EllipseElement) ClipPath(clipPath String) *EllipseElement {
+	e.Attrs["clip-path"] = clipPath
+	return e
+}
+
+// ClipRule sets the clip-rule attribute.
+func (e *EllipseElement) ClipRule(clipRule String) *EllipseElement {
+	e.Attrs["clip-rule"] = clipRule
+	return e
+}
+
+// Color sets the color attribute.
+func (e *EllipseElement) Color(color Color) *EllipseElement {
+	e.Attrs["color"] = color
+	return e
+}
+
+// ColorInterpolation sets the color-interpolation attribute.
+func (e *EllipseElement) ColorInterpolation(colorInterpolation String) *EllipseElement {
+	e.Attrs["color-interpolation"] = colorInterpolation
+	return e
+}
+
+// ColorInterpolationFilters sets the color-interpolation-filters attribute.
+func (e *EllipseElement) ColorInterpolationFilters(colorInterpolationFilters String) *EllipseElement {
+	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
+	return e
+}
+
+// ColorRendering sets the color-rendering attribute.
+func (e *EllipseElement) ColorRendering(colorRendering String) *EllipseElement {
+	e.Attrs["color-rendering"] = colorRendering
+	return e
+}
+
+// Cursor sets the cursor attribute.
+func (e *EllipseElement) Cursor(cursor String) *EllipseElement {
+	e.Attrs["cursor"] = cursor
+	return e
+}
+
+// Direction sets the direction attribute.
+func (e *EllipseElement) Direction(direction String) *EllipseElement {
+	e.Attrs["direction"] = direction
+	return e
+}
+
+// Display sets the display attribute.
+func (e *EllipseElement) Display(display String) *EllipseElement {
+	e.Attrs["display"] = display
+	return e
+}
+
+// DominantBaseline sets the dominant-baseline attribute.
+func (e *EllipseElement) DominantBaseline(dominantBaseline String) *EllipseElement {
+	e.Attrs["dominant-baseline"] = dominantBaseline
+	return e
+}
+
+// Fill sets the fill attribute.
+func (e *EllipseElement) Fill(fill String) *EllipseElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *EllipseElement) FillColor(fill Color) *EllipseElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *EllipseElement) FillPaint(fill Paint) *EllipseElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillOpacity sets the fill-opacity attribute.
+func (e *EllipseElement) FillOpacity(fillOpacity Float64) *EllipseElement {
+	e.Attrs["fill-opacity"] = fillOpacity
+	return e
+}
+
+// FillRule sets the fill-rule attribute.
+func (e *EllipseElement) FillRule(fillRule String) *EllipseElement {
+	e.Attrs["fill-rule"] = fillRule
+	return e
+}
+
+// Filter sets the filter attribute.
+func (e *EllipseElement) Filter(filter String) *EllipseElement {
+	e.Attrs["filter"] = filter
+	return e
+}
+
+// FloodColor sets the flood-color attribute.
+func (e *EllipseElement) FloodColor(floodColor Color) *EllipseElement {
+	e.Attrs["flood-color"] = floodColor
+	return e
+}
+
+// FloodOpacity sets the flood-opacity attribute.
+func (e *EllipseElement) FloodOpacity(floodOpacity Float64) *EllipseElement {
+	e.Attrs["flood-opacity"] = floodOpacity
+	return e
+}
+
+// FontFamily sets the font-family attribute.
+func (e *EllipseElement) FontFamily(fontFamily String) *EllipseElement {
+	e.Attrs["font-family"] = fontFamily
+	return e
+}
+
+// FontSize sets the font-size attribute.
+func (e *EllipseElement) FontSize(fontSize String) *EllipseElement {
+	e.Attrs["font-size"] = fontSize
+	return e
+}
+
+// FontSizeAdjust sets the font-size-adjust attribute.
+func (e *EllipseElement) FontSizeAdjust(fontSizeAdjust String) *EllipseElement {
+	e.Attrs["font-size-adjust"] = fontSizeAdjust
+	return e
+}
+
+// FontStretch sets the font-stretch attribute.
+func (e *EllipseElement) FontStretch(fontStretch String) *EllipseElement {
+	e.Attrs["font-stretch"] = fontStretch
+	return e
+}
+
+// FontStyle sets the font-style attribute.
+func (e *EllipseElement) FontStyle(fontStyle String) *EllipseElement {
+	e.Attrs["font-style"] = fontStyle
+	return e
+}
+
+// FontVariant sets the font-variant attribute.
+func (e *EllipseElement) FontVariant(fontVariant String) *EllipseElement {
+	e.Attrs["font-variant"] = fontVariant
+	return e
+}
+
+// FontWeight sets the font-weight attribute.
+func (e *EllipseElement) FontWeight(fontWeight String) *EllipseElement {
+	e.Attrs["font-weight"] = fontWeight
+	return e
+}
+
+// GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
+func (e *EllipseElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *EllipseElement {
+	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
+	return e
+}
+
+// GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
+func (e *EllipseElement) GlyphOrientationVertical(glyphOrientationVertical String) *EllipseElement {
+	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
+	return e
+}
+
+// ImageRendering sets the image-rendering attribute.
+func (e *EllipseElement) ImageRendering(imageRendering String) *EllipseElement {
+	e.Attrs["image-rendering"] = imageRendering
+	return e
+}
+
+// LetterSpacing sets the letter-spacing attribute.
+func (e *EllipseElement) LetterSpacing(letterSpacing String) *EllipseElement {
+	e.Attrs["letter-spacing"] = letterSpacing
+	return e
+}
+
+// LightingColor sets the lighting-color attribute.
+func (e *EllipseElement) LightingColor(lightingColor Color) *EllipseElement {
+	e.Attrs["lighting-color"] = lightingColor
+	return e
+}
+
+// MarkerEnd sets the marker-end attribute.
+func (e *EllipseElement) MarkerEnd(markerEnd String) *EllipseElement {
+	e.Attrs["marker-end"] = markerEnd
+	return e
+}
+
+// MarkerMid sets the marker-mid attribute.
+func (e *EllipseElement) MarkerMid(markerMid String) *EllipseElement {
+	e.Attrs["marker-mid"] = markerMid
+	return e
+}
+
+// MarkerStart sets the marker-start attribute.
+func (e *EllipseElement) MarkerStart(markerStart String) *EllipseElement {
+	e.Attrs["marker-start"] = markerStart
+	return e
+}
+
+// Mask sets the mask attribute.
+func (e *EllipseElement) Mask(mask String) *EllipseElement {
+	e.Attrs["mask"] = mask
+	return e
+}
+
+// Opacity sets the opacity attribute.
+func (e *EllipseElement) Opacity(opacity Float64) *EllipseElement {
+	e.Attrs["opacity"] = opacity
+	return e
+}
+
+// Overflow sets the overflow attribute.
+func (e *EllipseElement) Overflow(overflow String) *EllipseElement {
+	e.Attrs["overflow"] = overflow
+	return e
+}
+
+// PaintOrder sets the paint-order attribute.
+func (e *EllipseElement) PaintOrder(paintOrder String) *EllipseElement {
+	e.Attrs["paint-order"] = paintOrder
+	return e
+}
+
+// PointerEvents sets the pointer-events attribute.
+func (e *EllipseElement) PointerEvents(pointerEvents String) *EllipseElement {
+	e.Attrs["pointer-events"] = pointerEvents
+	return e
+}
+
+// ShapeRendering sets the shape-rendering attribute.
+func (e *EllipseElement) ShapeRendering(shapeRendering String) *EllipseElement {
+	e.Attrs["shape-rendering"] = shapeRendering
+	return e
+}
+
+// StopColor sets the stop-color attribute.
+func (e *EllipseElement) StopColor(stopColor Color) *EllipseElement {
+	e.Attrs["stop-color"] = stopColor
+	return e
+}
+
+// StopOpacity sets the stop-opacity attribute.
+func (e *EllipseElement) StopOpacity(stopOpacity Float64) *EllipseElement {
+	e.Attrs["stop-opacity"] = stopOpacity
+	return e
+}
+
+// Stroke sets the stroke attribute.
+func (e *EllipseElement) Stroke(stroke String) *EllipseElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *EllipseElement) StrokeColor(stroke Color) *EllipseElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *EllipseElement) StrokePaint(stroke Paint) *EllipseElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeDashArray sets the stroke-dasharray attribute.
+func (e *EllipseElement) StrokeDashArray(strokeDashArray String) *EllipseElement {
+	e.Attrs["stroke-dasharray"] = strokeDashArray
+	return e
+}
+
+// StrokeDashOffset sets the stroke-dashoffset attribute.
+func (e *EllipseElement) StrokeDashOffset(strokeDashOffset Float64) *EllipseElement {
+	e.Attrs["stroke-dashoffset"] = strokeDashOffset
+	return e
+}
+
+// StrokeLineCap sets the stroke-linecap attribute.
+func (e *EllipseElement) StrokeLineCap(strokeLineCap String) *EllipseElement {
+	e.Attrs["stroke-linecap"] = strokeLineCap
+	return e
+}
+
+// StrokeLineJoin sets the stroke-linejoin attribute.
+func (e *EllipseElement) StrokeLineJoin(strokeLineJoin String) *EllipseElement {
+	e.Attrs["stroke-linejoin"] = strokeLineJoin
+	return e
+}
+
+// StrokeMiterLimit sets the stroke-miterlimit attribute.
+func (e *EllipseElement) StrokeMiterLimit(strokeMiterLimit Float64) *EllipseElement {
+	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
+	return e
+}
+
+// StrokeOpacity sets the stroke-opacity attribute.
+func (e *EllipseElement) StrokeOpacity(strokeOpacity Float64) *EllipseElement {
+	e.Attrs["stroke-opacity"] = strokeOpacity
+	return e
+}
+
+// StrokeWidth sets the stroke-width attribute.
+func (e *EllipseElement) StrokeWidth(strokeWidth Length) *EllipseElement {
+	e.Attrs["stroke-width"] = strokeWidth
+	return e
+}
+
+// TextAnchor sets the text-anchor attribute.
+func (e *EllipseElement) TextAnchor(textAnchor String) *EllipseElement {
+	e.Attrs["text-anchor"] = textAnchor
+	return e
+}
+
+// TextDecoration sets the text-decoration attribute.
+func (e *EllipseElement) TextDecoration(textDecoration String) *EllipseElement {
+	e.Attrs["text-decoration"] = textDecoration
+	return e
+}
+
+// TextOverflow sets the text-overflow attribute.
+func (e *EllipseElement) TextOverflow(textOverflow String) *EllipseElement {
+	e.Attrs["text-overflow"] = textOverflow
+	return e
+}
+
+// TextRendering sets the text-rendering attribute.
+func (e *EllipseElement) TextRendering(textRendering String) *EllipseElement {
+	e.Attrs["text-rendering"] = textRendering
+	return e
+}
+
+// Transform sets the transform attribute.
+func (e *EllipseElement) Transform(transform Transform) *EllipseElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
+// UnicodeBiDi sets the unicode-bidi attribute.
+func (e *EllipseElement) UnicodeBiDi(unicodeBiDi String) *EllipseElement {
+	e.Attrs["unicode-bidi"] = unicodeBiDi
+	return e
+}
+
+// VectorEffect sets the vector-effect attribute.
+func (e *EllipseElement) VectorEffect(vectorEffect String) *EllipseElement {
+	e.Attrs["vector-effect"] = vectorEffect
+	return e
+}
+
+// Visibility sets the visibility attribute.
+func (e *EllipseElement) Visibility(visibility String) *EllipseElement {
+	e.Attrs["visibility"] = visibility
+	return e
+}
+
+// WhiteSpace sets the white-space attribute.
+func (e *EllipseElement) WhiteSpace(whiteSpace String) *EllipseElement {
+	e.Attrs["white-space"] = whiteSpace
+	return e
+}
+
+// WordSpacing sets the word-spacing attribute.
+func (e *EllipseElement) WordSpacing(wordSpacing String) *EllipseElement {
+	e.Attrs["word-spacing"] = wordSpacing
+	return e
+}
+
+// WritingMode sets the writing-mode attribute.
+func (e *EllipseElement) WritingMode(writingMode String) *EllipseElement {
+	e.Attrs["writing-mode"] = writingMode
+	return e
+}
+
+// PathLength sets the pathLength attribute.
+func (e *EllipseElement) PathLength(pathLength String) *EllipseElement {
+	e.Attrs["pathLength"] = pathLength
+	return e
+}
+
+// CX sets the cx attribute.
+func (e *EllipseElement) CX(cx Length) *EllipseElement {
+	e.Attrs["cx"] = cx
+	return e
+}
+
+// CY sets the cy attribute.
+func (e *EllipseElement) CY(cy Length) *EllipseElement {
+	e.Attrs["cy"] = cy
+	return e
+}
+
+// RX sets the rx attribute.
+func (e *EllipseElement) RX(rx Length) *EllipseElement {
+	e.Attrs["rx"] = rx
+	return e
+}
+
+// RY sets the ry attribute.
+func (e *EllipseElement) RY(ry Length) *EllipseElement {
+	e.Attrs["ry"] = ry
+	return e
+}
+
+// CXCY sets the cx and cy attributes.
+func (e *EllipseElement) CXCY(cx, cy float64, lengthFunc LengthFunc) *EllipseElement {
+	e.Attrs["cx"] = lengthFunc(cx)
+	e.Attrs["cy"] = lengthFunc(cy)
+	return e
+}
+
+// RXRY sets the rx and ry attributes.
+func (e *EllipseElement) RXRY(rx, ry float64, lengthFunc LengthFunc) *EllipseElement {
+	e.Attrs["rx"] = lengthFunc(rx)
+	e.Attrs["ry"] = lengthFunc(ry)
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *EllipseElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *EllipseElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *EllipseElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *EllipseElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *EllipseElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *EllipseElement) TagName() string {
+	return "ellipse"
+}
+
+// decode decodes e from d.
+func (e *EllipseElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *EllipseElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "cx":
+		return parseLength(value)
+	case "cy":
+		return parseLength(value)
+	case "rx":
+		return parseLength(value)
+	case "ry":
+		return parseLength(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A FeBlendElement is a feBlend element.
+type FeBlendElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// FeBlend returns a new FeBlendElement.
+func FeBlend(children ...Element) *FeBlendElement {
+	return &FeBlendElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *FeBlendElement) AppendChildren(children ...Element) *FeBlendElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *FeBlendElement) RemoveAttrs(names ...string) *FeBlendElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *FeBlendElement) ID(id String) *FeBlendElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *FeBlendElement) TabIndex(tabIndex Int) *FeBlendElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *FeBlendElement) Lang(lang String) *FeBlendElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *FeBlendElement) Class(class String) *FeBlendElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *FeBlendElement) Style(style String) *FeBlendElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// AlignmentBaseline sets the alignment-baseline attribute.
+func (e *FeBlendElement) AlignmentBaseline(alignmentBaseline String) *FeBlendElement {
+	e.Attrs["alignment-baseline"] = alignmentBaseline
+	return e
+}
+
+// BaselineShift sets the baseline-shift attribute.
+func (e *FeBlendElement) BaselineShift(baselineShift String) *FeBlendElement {
+	e.Attrs["baseline-shift"] = baselineShift
+	return e
+}
+
+// ClipPath sets the clip-path attribute.
+func (e *FeBlendElement) ClipPath(clipPath String) *FeBlendElement {
+	e.Attrs["clip-path"] = clipPath
+	return e
+}
+
+// ClipRule sets the clip-rule attribute.
+func (e *FeBlendElement) ClipRule(clipRule String) *FeBlendElement {
+	e.Attrs["clip-rule"] = clipRule
+	return e
+}
+
+// Color sets the color attribute.
+func (e *FeBlendElement) Color(color Color) *FeBlendElement {
+	e.Attrs["color"] = color
+	return e
+}
+
+// ColorInterpolation sets the color-interpolation attribute.
+func (e *FeBlendElement) ColorInterpolation(colorInterpolation String) *FeBlendElement {
+	e.Attrs["color-interpolation"] = colorInterpolation
+	return e
+}
+
+// ColorInterpolationFilters sets the color-interpolation-filters attribute.
+func (e *FeBlendElement) ColorInterpolationFilters(colorInterpolationFilters String) *FeBlendElement {
+	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
+	return e
+}
+
+// ColorRendering sets the color-rendering attribute.
+func (e *FeBlendElement) ColorRendering(colorRendering String) *FeBlendElement {
+	e.Attrs["color-rendering"] = colorRendering
+	return e
+}
+
+// Cursor sets the cursor attribute.
+func (e *FeBlendElement) Cursor(cursor String) *FeBlendElement {
+	e.Attrs["cursor"] = cursor
+	return e
+}
+
+// Direction sets the direction attribute.
+func (e *FeBlendElement) Direction(direction String) *FeBlendElement {
+	e.Attrs["direction"] = direction
+	return e
+}
+
+// Display sets the display attribute.
+func (e *FeBlendElement) Display(display String) *FeBlendElement {
+	e.Attrs["display"] = display
+	return e
+}
+
+// DominantBaseline sets the dominant-baseline attribute.
+func (e *FeBlendElement) DominantBaseline(dominantBaseline String) *FeBlendElement {
+	e.Attrs["dominant-baseline"] = dominantBaseline
+	return e
+}
+
+// Fill sets the fill attribute.
+func (e *FeBlendElement) Fill(fill String) *FeBlendElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *FeBlendElement) FillColor(fill Color) *FeBlendElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *FeBlendElement) FillPaint(fill Paint) *FeBlendElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillOpacity sets the fill-opacity attribute.
+func (e *FeBlendElement) FillOpacity(fillOpacity Float64) *FeBlendElement {
+	e.Attrs["fill-opacity"] = fillOpacity
+	return e
+}
+
+// FillRule sets the fill-rule attribute.
+func (e *FeBlendElement) FillRule(fillRule String) *FeBlendElement {
+	e.Attrs["fill-rule"] = fillRule
+	return e
+}
+
+// Filter sets the filter attribute.
+func (e *FeBlendElement) Filter(filter String) *FeBlendElement {
+	e.Attrs["filter"] = filter
+	return e
+}
+
+// FloodColor sets the flood-color attribute.
+func (e *FeBlendElement) FloodColor(floodColor Color) *FeBlendElement {
+	e.Attrs["flood-color"] = floodColor
+	return e
+}
+
+// FloodOpacity sets the flood-opacity attribute.
+func (e *FeBlendElement) FloodOpacity(floodOpacity Float64) *FeBlendElement {
+	e.Attrs["flood-opacity"] = floodOpacity
+	return e
+}
+
+// FontFamily sets the font-family attribute.
+func (e *FeBlendElement) FontFamily(fontFamily String) *FeBlendElement {
+	e.Attrs["font-family"] = fontFamily
+	return e
+}
+
+// FontSize sets the font-size attribute.
+func (e *FeBlendElement) FontSize(fontSize String) *FeBlendElement {
+	e.Attrs["font-size"] = fontSize
+	return e
+}
+
+// FontSizeAdjust sets the font-size-adjust attribute.
+func (e *FeBlendElement) FontSizeAdjust(fontSizeAdjust String) *FeBlendElement {
+	e.Attrs["font-size-adjust"] = fontSizeAdjust
+	return e
+}
+
+// FontStretch sets the font-stretch attribute.
+func (e *FeBlendElement) FontStretch(fontStretch String) *FeBlendElement {
+	e.Attrs["font-stretch"] = fontStretch
+	return e
+}
+
+// FontStyle sets the font-style attribute.
+func (e *FeBlendElement) FontStyle(fontStyle String) *FeBlendElement {
+	e.Attrs["font-style"] = fontStyle
+	return e
+}
+
+// FontVariant sets the font-variant attribute.
+func (e *FeBlendElement) FontVariant(fontVariant String) *FeBlendElement {
+	e.Attrs["font-variant"] = fontVariant
+	return e
+}
+
+// FontWeight sets the font-weight attribute.
+func (e *FeBlendElement) FontWeight(fontWeight String) *FeBlendElement {
+	e.Attrs["font-weight"] = fontWeight
+	return e
+}
+
+// GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
+func (e *FeBlendElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *FeBlendElement {
+	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
+	return e
+}
+
+// GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
+func (e *FeBlendElement) GlyphOrientationVertical(glyphOrientationVertical String) *FeBlendElement {
+	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
+	return e
+}
+
+// ImageRendering sets the image-rendering attribute.
+func (e *FeBlendElement) ImageRendering(imageRendering String) *FeBlendElement {
+	e.Attrs["image-rendering"] = imageRendering
+	return e
+}
+
+// LetterSpacing sets the letter-spacing attribute.
+func (e *FeBlendElement) LetterSpacing(letterSpacing String) *FeBlendElement {
+	e.Attrs["letter-spacing"] = letterSpacing
+	return e
+}
+
+// LightingColor sets the lighting-color attribute.
+func (e *FeBlendElement) LightingColor(lightingColor Color) *FeBlendElement {
+	e.Attrs["lighting-color"] = lightingColor
+	return e
+}
+
+// MarkerEnd sets the marker-end attribute.
+func (e *FeBlendElement) MarkerEnd(markerEnd String) *FeBlendElement {
+	e.Attrs["marker-end"] = markerEnd
+	return e
+}
+
+// MarkerMid sets the marker-mid attribute.
+func (e *FeBlendElement) MarkerMid(markerMid String) *FeBlendElement {
+	e.Attrs["marker-mid"] = markerMid
+	return e
+}
+
+// MarkerStart sets the marker-start attribute.
+func (e *FeBlendElement) MarkerStart(markerStart String) *FeBlendElement {
+	e.Attrs["marker-start"] = markerStart
+	return e
+}
+
+// Mask sets the mask attribute.
+func (e *FeBlendElement) Mask(mask String) *FeBlendElement {
+	e.Attrs["mask"] = mask
+	return e
+}
+
+// Opacity sets the opacity attribute.
+func (e *FeBlendElement) Opacity(opacity Float64) *FeBlendElement {
+	e.Attrs["opacity"] = opacity
+	return e
+}
+
+// Overflow sets the overflow attribute.
+func (e *FeBlendElement) Overflow(overflow String) *FeBlendElement {
+	e.Attrs["overflow"] = overflow
+	return e
+}
+
+// PaintOrder sets the paint-order attribute.
+func (e *FeBlendElement) PaintOrder(paintOrder String) *FeBlendElement {
+	e.Attrs["paint-order"] = paintOrder
+	return e
+}
+
+// PointerEvents sets the pointer-events attribute.
+func (e *FeBlendElement) PointerEvents(pointerEvents String) *FeBlendElement {
+	e.Attrs["pointer-events"] = pointerEvents
+	return e
+}
+
+// ShapeRendering sets the shape-rendering attribute.
+func (e *FeBlendElement) ShapeRendering(shapeRendering String) *FeBlendElement {
+	e.Attrs["shape-rendering"] = shapeRendering
+	return e
+}
+
+// StopColor sets the stop-color attribute.
+func (e *FeBlendElement) StopColor(stopColor Color) *FeBlendElement {
+	e.Attrs["stop-color"] = stopColor
+	return e
+}
+
+// StopOpacity sets the stop-opacity attribute.
+func (e *FeBlendElement) StopOpacity(stopOpacity Float64) *FeBlendElement {
+	e.Attrs["stop-opacity"] = stopOpacity
+	return e
+}
+
+// Stroke sets the stroke attribute.
+func (e *FeBlendElement) Stroke(stroke String) *FeBlendElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *FeBlendElement) StrokeColor(stroke Color) *FeBlendElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *FeBlendElement) StrokePaint(stroke Paint) *FeBlendElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeDashArray sets the stroke-dasharray attribute.
+func (e *FeBlendElement) StrokeDashArray(strokeDashArray String) *FeBlendElement {
+	e.Attrs["stroke-dasharray"] = strokeDashArray
+	return e
+}
+
+// StrokeDashOffset sets the stroke-dashoffset attribute.
+func (e *FeBlendElement) StrokeDashOffset(strokeDashOffset Float64) *FeBlendElement {
+	e.Attrs["stroke-dashoffset"] = strokeDashOffset
+	return e
+}
+
+// StrokeLineCap sets the stroke-linecap attribute.
+func (e *FeBlendElement) StrokeLineCap(strokeLineCap String) *FeBlendElement {
+	e.Attrs["stroke-linecap"] = strokeLineCap
+	return e
+}
+
+// StrokeLineJoin sets the stroke-linejoin attribute.
+func (e *FeBlendElement) StrokeLineJoin(strokeLineJoin String) *FeBlendElement {
+	e.Attrs["stroke-linejoin"] = strokeLineJoin
+	return e
+}
+
+// StrokeMiterLimit sets the stroke-miterlimit attribute.
+func (e *FeBlendElement) StrokeMiterLimit(strokeMiterLimit Float64) *FeBlendElement {
+	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
+	return e
+}
+
+// StrokeOpacity sets the stroke-opacity attribute.
+func (e *FeBlendElement) StrokeOpacity(strokeOpacity Float64) *FeBlendElement {
+	e.Attrs["stroke-opacity"] = strokeOpacity
+	return e
+}
+
+// StrokeWidth sets the stroke-width attribute.
+func (e *FeBlendElement) StrokeWidth(strokeWidth Length) *FeBlendElement {
+	e.Attrs["stroke-width"] = strokeWidth
+	return e
+}
+
+// TextAnchor sets the text-anchor attribute.
+func (e *FeBlendElement) TextAnchor(textAnchor String) *FeBlendElement {
+	e.Attrs["text-anchor"] = textAnchor
+	return e
+}
+
+// TextDecoration sets the text-decoration attribute.
+func (e *FeBlendElement) TextDecoration(textDecoration String) *FeBlendElement {
+	e.Attrs["text-decoration"] = textDecoration
+	return e
+}
+
+// TextOverflow sets the text-overflow attribute.
+func (e *FeBlendElement) TextOverflow(textOverflow String) *FeBlendElement {
+	e.Attrs["text-overflow"] = textOverflow
+	return e
+}
+
+// TextRendering sets the text-rendering attribute.
+func (e *FeBlendElement) TextRendering(textRendering String) *FeBlendElement {
+	e.Attrs["text-rendering"] = textRendering
+	return e
+}
+
+// Transform sets the transform attribute.
+func (e *FeBlendElement) Transform(transform Transform) *FeBlendElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
+// UnicodeBiDi sets the unicode-bidi attribute.
+func (e *FeBlendElement) UnicodeBiDi(unicodeBiDi String) *FeBlendElement {
+	e.Attrs["unicode-bidi"] = unicodeBiDi
+	return e
+}
+
+// VectorEffect sets the vector-effect attribute.
+func (e *FeBlendElement) VectorEffect(vectorEffect String) *FeBlendElement {
+	e.Attrs["vector-effect"] = vectorEffect
+	return e
+}
+
+// Visibility sets the visibility attribute.
+func (e *FeBlendElement) Visibility(visibility String) *FeBlendElement {
+	e.Attrs["visibility"] = visibility
+	return e
+}
+
+// WhiteSpace sets the white-space attribute.
+func (e *FeBlendElement) WhiteSpace(whiteSpace String) *FeBlendElement {
+	e.Attrs["white-space"] = whiteSpace
+	return e
+}
+
+// WordSpacing sets the word-spacing attribute.
+func (e *FeBlendElement) WordSpacing(wordSpacing String) *FeBlendElement {
+	e.Attrs["word-spacing"] = wordSpacing
+	return e
+}
+
+// WritingMode sets the writing-mode attribute.
+func (e *FeBlendElement) WritingMode(writingMode String) *FeBlendElement {
+	e.Attrs["writing-mode"] = writingMode
+	return e
+}
+
+// X sets the x attribute.
+func (e *FeBlendElement) X(x Length) *FeBlendElement {
+	e.Attrs["x"] = x
+	return e
+}
+
+// Y sets the y attribute.
+func (e *FeBlendElement) Y(y Length) *FeBlendElement {
+	e.Attrs["y"] = y
+	return e
+}
+
+// Width sets the width attribute.
+func (e *FeBlendElement) Width(width Length) *FeBlendElement {
+	e.Attrs["width"] = width
+	return e
+}
+
+// Height sets the height attribute.
+func (e *FeBlendElement) Height(height Length) *FeBlendElement {
+	e.Attrs["height"] = height
+	return e
+}
+
+// Result sets the result attribute.
+func (e *FeBlendElement) Result(result String) *FeBlendElement {
+	e.Attrs["result"] = result
+	return e
+}
+
+// In sets the in attribute.
+func (e *FeBlendElement) In(_in String) *FeBlendElement {
+	e.Attrs["in"] = _in
+	return e
+}
+
+// In2 sets the in2 attribute.
+func (e *FeBlendElement) In2(in2 String) *FeBlendElement {
+	e.Attrs["in2"] = in2
+	return e
+}
+
+// Mode sets the mode attribute.
+func (e *FeBlendElement) Mode(mode String) *FeBlendElement {
+	e.Attrs["mode"] = mode
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *FeBlendElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *FeBlendElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *FeBlendElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *FeBlendElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *FeBlendElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *FeBlendElement) TagName() string {
+	return "feBlend"
+}
+
+// decode decodes e from d.
+func (e *FeBlendElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *FeBlendElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "x":
+		return parseLength(value)
+	case "y":
+		return parseLength(value)
+	case "width":
+		return parseLength(value)
+	case "height":
+		return parseLength(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A FeColorMatrixElement is a feColorMatrix element.
+type FeColorMatrixElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// FeColorMatrix returns a new FeColorMatrixElement.
+func FeColorMatrix(children ...Element) *FeColorMatrixElement {
+	return &FeColorMatrixElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *FeColorMatrixElement) AppendChildren(children ...Element) *FeColorMatrixElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *FeColorMatrixElement) RemoveAttrs(names ...string) *FeColorMatrixElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *FeColorMatrixElement) ID(id String) *FeColorMatrixElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *FeColorMatrixElement) TabIndex(tabIndex Int) *FeColorMatrixElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *FeColorMatrixElement) Lang(lang String) *FeColorMatrixElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *FeColorMatrixElement) Class(class String) *FeColorMatrixElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *FeColorMatrixElement) Style(style String) *FeColorMatrixElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// AlignmentBaseline sets the alignment-baseline attribute.
+func (e *FeColorMatrixElement) AlignmentBaseline(alignmentBaseline String) *FeColorMatrixElement {
+	e.Attrs["alignment-baseline"] = alignmentBaseline
+	return e
+}
+
+// BaselineShift sets the baseline-shift attribute.
+func (e *FeColorMatrixElement) BaselineShift(baselineShift String) *FeColorMatrixElement {
+	e.Attrs["baseline-shift"] = baselineShift
+	return e
+}
+
+// ClipPath sets the clip-path attribute.
+func (e *FeColorMatrixElement) ClipPath(clipPath String) *FeColorMatrixElement {
+	e.Attrs["clip-path"] = clipPath
+	return e
+}
+
+// ClipRule sets the clip-rule attribute.
+func (e *FeColorMatrixElement) ClipRule(clipRule String) *FeColorMatrixElement {
+	e.Attrs["clip-rule"] = clipRule
+	return e
+}
+
+// Color sets the color attribute.
+func (e *FeColorMatrixElement) Color(color Color) *FeColorMatrixElement {
+	e.Attrs["color"] = color
+	return e
+}
+
+// ColorInterpolation sets the color-interpolation attribute.
+func (e *FeColorMatrixElement) ColorInterpolation(colorInterpolation String) *FeColorMatrixElement {
+	e.Attrs["color-interpolation"] = colorInterpolation
+	return e
+}
+
+// ColorInterpolationFilters sets the color-interpolation-filters attribute.
+func (e *FeColorMatrixElement) ColorInterpolationFilters(colorInterpolationFilters String) *FeColorMatrixElement {
+	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
+	return e
+}
+
+// ColorRendering sets the color-rendering attribute.
+func (e *FeColorMatrixElement) ColorRendering(colorRendering String) *FeColorMatrixElement {
+	e.Attrs["color-rendering"] = colorRendering
+	return e
+}
+
+// Cursor sets the cursor attribute.
+func (e *FeColorMatrixElement) Cursor(cursor String) *FeColorMatrixElement {
+	e.Attrs["cursor"] = cursor
+	return e
+}
+
+// Direction sets the direction attribute.
+func (e *FeColorMatrixElement) Direction(direction String) *FeColorMatrixElement {
+	e.Attrs["direction"] = direction
+	return e
+}
+
+// Display sets the display attribute.
+func (e *FeColorMatrixElement) Display(display String) *FeColorMatrixElement {
+	e.Attrs["display"] = display
+	return e
+}
+
+// DominantBaseline sets the dominant-baseline attribute.
+func (e *FeColorMatrixElement) DominantBaseline(dominantBaseline String) *FeColorMatrixElement {
+	e.Attrs["dominant-baseline"] = dominantBaseline
+	return e
+}
+
+// Fill sets the fill attribute.
+func (e *FeColorMatrixElement) Fill(fill String) *FeColorMatrixElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *FeColorMatrixElement) FillColor(fill Color) *FeColorMatrixElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *FeColorMatrixElement) FillPaint(fill Paint) *FeColorMatrixElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillOpacity sets the fill-opacity attribute.
+func (e *FeColorMatrixElement) FillOpacity(fillOpacity Float64) *FeColorMatrixElement {
+	e.Attrs["fill-opacity"] = fillOpacity
+	return e
+}
+
+// FillRule sets the fill-rule attribute.
+func (e *FeColorMatrixElement) FillRule(fillRule String) *FeColorMatrixElement {
+	e.Attrs["fill-rule"] = fillRule
+	return e
+}
+
+// Filter sets the filter attribute.
+func (e *FeColorMatrixElement) Filter(filter String) *FeColorMatrixElement {
+	e.Attrs["filter"] = filter
+	return e
+}
+
+// FloodColor sets the flood-color attribute.
+func (e *FeColorMatrixElement) FloodColor(floodColor Color) *FeColorMatrixElement {
+	e.Attrs["flood-color"] = floodColor
+	return e
+}
+
+// FloodOpacity sets the flood-opacity attribute.
+func (e *FeColorMatrixElement) FloodOpacity(floodOpacity Float64) *FeColorMatrixElement {
+	e.Attrs["flood-opacity"] = floodOpacity
+	return e
+}
+
+// FontFamily sets the font-family attribute.
+func (e *FeColorMatrixElement) FontFamily(fontFamily String) *FeColorMatrixElement {
+	e.Attrs["font-family"] = fontFamily
+	return e
+}
+
+// FontSize sets the font-size attribute.
+func (e *FeColorMatrixElement) FontSize(fontSize String) *FeColorMatrixElement {
+	e.Attrs["font-size"] = fontSize
+	return e
+}
+
+// FontSizeAdjust sets the font-size-adjust attribute.
+func (e *FeColorMatrixElement) FontSizeAdjust(fontSizeAdjust String) *FeColorMatrixElement {
+	e.Attrs["font-size-adjust"] = fontSizeAdjust
+	return e
+}
+
+// FontStretch sets the font-stretch attribute.
+func (e *FeColorMatrixElement) FontStretch(fontStretch String) *FeColorMatrixElement {
+	e.Attrs["font-stretch"] = fontStretch
+	return e
+}
+
+// FontStyle sets the font-style attribute.
+func (e *FeColorMatrixElement) FontStyle(fontStyle String) *FeColorMatrixElement {
+	e.Attrs["font-style"] = fontStyle
+	return e
+}
+
+// FontVariant sets the font-variant attribute.
+func (e *FeColorMatrixElement) FontVariant(fontVariant String) *FeColorMatrixElement {
+	e.Attrs["font-variant"] = fontVariant
+	return e
+}
+
+// FontWeight sets the font-weight attribute.
+func (e *FeColorMatrixElement) FontWeight(fontWeight String) *FeColorMatrixElement {
+	e.Attrs["font-weight"] = fontWeight
+	return e
+}
+
+// GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
+func (e *FeColorMatrixElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *FeColorMatrixElement {
+	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
+	return e
+}
+
+// GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
+func (e *FeColorMatrixElement) GlyphOrientationVertical(glyphOrientationVertical String) *FeColorMatrixElement {
+	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
+	return e
+}
+
+// ImageRendering sets the image-rendering attribute.
+func (e *FeColorMatrixElement) ImageRendering(imageRendering String) *FeColorMatrixElement {
+	e.Attrs["image-rendering"] = imageRendering
+	return e
+}
+
+// LetterSpacing sets the letter-spacing attribute.
+func (e *FeColorMatrixElement) LetterSpacing(letterSpacing String) *FeColorMatrixElement {
+	e.Attrs["letter-spacing"] = letterSpacing
+	return e
+}
+
+// LightingColor sets the lighting-color attribute.
+func (e *FeColorMatrixElement) LightingColor(lightingColor Color) *FeColorMatrixElement {
+	e.Attrs["lighting-color"] = lightingColor
+	return e
+}
+
+// MarkerEnd sets the marker-end attribute.
+func (e *FeColorMatrixElement) MarkerEnd(markerEnd String) *FeColorMatrixElement {
+	e.Attrs["marker-end"] = markerEnd
+	return e
+}
+
+// MarkerMid sets the marker-mid attribute.
+func (e *FeColorMatrixElement) MarkerMid(markerMid String) *FeColorMatrixElement {
+	e.Attrs["marker-mid"] = markerMid
+	return e
+}
+
+// MarkerStart sets the marker-start attribute.
+func (e *FeColorMatrixElement) MarkerStart(markerStart String) *FeColorMatrixElement {
+	e.Attrs["marker-start"] = markerStart
+	return e
+}
+
+// Mask sets the mask attribute.
+func (e *FeColorMatrixElement) Mask(mask String) *FeColorMatrixElement {
+	e.Attrs["mask"] = mask
+	return e
+}
+
+// Opacity sets the opacity attribute.
+func (e *FeColorMatrixElement) Opacity(opacity Float64) *FeColorMatrixElement {
+	e.Attrs["opacity"] = opacity
+	return e
+}
+
+// Overflow sets the overflow attribute.
+func (e *FeColorMatrixElement) Overflow(overflow String) *FeColorMatrixElement {
+	e.Attrs["overflow"] = overflow
+	return e
+}
+
+// PaintOrder sets the paint-order attribute.
+func (e *FeColorMatrixElement) PaintOrder(paintOrder String) *FeColorMatrixElement {
+	e.Attrs["paint-order"] = paintOrder
+	return e
+}
+
+// PointerEvents sets the pointer-events attribute.
+func (e *FeColorMatrixElement) PointerEvents(pointerEvents String) *FeColorMatrixElement {
+	e.Attrs["pointer-events"] = pointerEvents
+	return e
+}
+
+// ShapeRendering sets the shape-rendering attribute.
+func (e *FeColorMatrixElement) ShapeRendering(shapeRendering String) *FeColorMatrixElement {
+	e.Attrs["shape-rendering"] = shapeRendering
+	return e
+}
+
+// StopColor sets the stop-color attribute.
+func (e *FeColorMatrixElement) StopColor(stopColor Color) *FeColorMatrixElement {
+	e.Attrs["stop-color"] = stopColor
+	return e
+}
+
+// StopOpacity sets the stop-opacity attribute.
+func (e *FeColorMatrixElement) StopOpacity(stopOpacity Float64) *FeColorMatrixElement {
+	e.Attrs["stop-opacity"] = stopOpacity
+	return e
+}
+
+// Stroke sets the stroke attribute.
+func (e *FeColorMatrixElement) Stroke(stroke String) *FeColorMatrixElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *FeColorMatrixElement) StrokeColor(stroke Color) *FeColorMatrixElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *FeColorMatrixElement) StrokePaint(stroke Paint) *FeColorMatrixElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeDashArray sets the stroke-dasharray attribute.
+func (e *FeColorMatrixElement) StrokeDashArray(strokeDashArray String) *FeColorMatrixElement {
+	e.Attrs["stroke-dasharray"] = strokeDashArray
+	return e
+}
+
+// StrokeDashOffset sets the stroke-dashoffset attribute.
+func (e *FeColorMatrixElement) StrokeDashOffset(strokeDashOffset Float64) *FeColorMatrixElement {
+	e.Attrs["stroke-dashoffset"] = strokeDashOffset
+	return e
+}
+
+// StrokeLineCap sets the stroke-linecap attribute.
+func (e *FeColorMatrixElement) StrokeLineCap(strokeLineCap String) *FeColorMatrixElement {
+	e.Attrs["stroke-linecap"] = strokeLineCap
+	return e
+}
+
+// StrokeLineJoin sets the stroke-linejoin attribute.
+func (e *FeColorMatrixElement) StrokeLineJoin(strokeLineJoin String) *FeColorMatrixElement {
+	e.Attrs["stroke-linejoin"] = strokeLineJoin
+	return e
+}
+
+// StrokeMiterLimit sets the stroke-miterlimit attribute.
+func (e *FeColorMatrixElement) StrokeMiterLimit(strokeMiterLimit Float64) *FeColorMatrixElement {
+	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
+	return e
+}
+
+// StrokeOpacity sets the stroke-opacity attribute.
+func (e *FeColorMatrixElement) StrokeOpacity(strokeOpacity Float64) *FeColorMatrixElement {
+	e.Attrs["stroke-opacity"] = strokeOpacity
+	return e
+}
+
+// StrokeWidth sets the stroke-width attribute.
+func (e *FeColorMatrixElement) StrokeWidth(strokeWidth Length) *FeColorMatrixElement {
+	e.Attrs["stroke-width"] = strokeWidth
+	return e
+}
+
+// TextAnchor sets the text-anchor attribute.
+func (e *FeColorMatrixElement) TextAnchor(textAnchor String) *FeColorMatrixElement {
+	e.Attrs["text-anchor"] = textAnchor
+	return e
+}
+
+// TextDecoration sets the text-decoration attribute.
+func (e *FeColorMatrixElement) TextDecoration(textDecoration String) *FeColorMatrixElement {
+	e.Attrs["text-decoration"] = textDecoration
+	return e
+}
+
+// TextOverflow sets the text-overflow attribute.
+func (e *FeColorMatrixElement) TextOverflow(textOverflow String) *FeColorMatrixElement {
+	e.Attrs["text-overflow"] = textOverflow
+	return e
+}
+
+// TextRendering sets the text-rendering attribute.
+func (e *FeColorMatrixElement) TextRendering(textRendering String) *FeColorMatrixElement {
+	e.Attrs["text-rendering"] = textRendering
+	return e
+}
+
+// Transform sets the transform attribute.
+func (e *FeColorMatrixElement) Transform(transform Transform) *FeColorMatrixElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
+// UnicodeBiDi sets the unicode-bidi attribute.
+func (e *FeColorMatrixElement) UnicodeBiDi(unicodeBiDi String) *FeColorMatrixElement {
+	e.Attrs["unicode-bidi"] = unicodeBiDi
+	return e
+}
+
+// VectorEffect sets the vector-effect attribute.
+func (e *FeColorMatrixElement) VectorEffect(vectorEffect String) *FeColorMatrixElement {
+	e.Attrs["vector-effect"] = vectorEffect
+	return e
+}
+
+// Visibility sets the visibility attribute.
+func (e *FeColorMatrixElement) Visibility(visibility String) *FeColorMatrixElement {
+	e.Attrs["visibility"] = visibility
+	return e
+}
+
+// WhiteSpace sets the white-space attribute.
+func (e *FeColorMatrixElement) WhiteSpace(whiteSpace String) *FeColorMatrixElement {
+	e.Attrs["white-space"] = whiteSpace
+	return e
+}
+
+// WordSpacing sets the word-spacing attribute.
+func (e *FeColorMatrixElement) WordSpacing(wordSpacing String) *FeColorMatrixElement {
+	e.Attrs["word-spacing"] = wordSpacing
+	return e
+}
+
+// WritingMode sets the writing-mode attribute.
+func (e *FeColorMatrixElement) WritingMode(writingMode String) *FeColorMatrixElement {
+	e.Attrs["writing-mode"] = writingMode
+	return e
+}
+
+// X sets the x attribute.
+func (e *FeColorMatrixElement) X(x Length) *FeColorMatrixElement {
+	e.Attrs["x"] = x
+	return e
+}
+
+// Y sets the y attribute.
+func (e *FeColorMatrixElement) Y(y Length) *FeColorMatrixElement {
+	e.Attrs["y"] = y
+	return e
+}
+
+// Width sets the width attribute.
+func (e *FeColorMatrixElement) Width(width Length) *FeColorMatrixElement {
+	e.Attrs["width"] = width
+	return e
+}
+
+// Height sets the height attribute.
+func (e *FeColorMatrixElement) Height(height Length) *FeColorMatrixElement {
+	e.Attrs["height"] = height
+	return e
+}
+
+// Result sets the result attribute.
+func (e *FeColorMatrixElement) Result(result String) *FeColorMatrixElement {
+	e.Attrs["result"] = result
+	return e
+}
+
+// In sets the in attribute.
+func (e *FeColorMatrixElement) In(_in String) *FeColorMatrixElement {
+	e.Attrs["in"] = _in
+	return e
+}
+
+// Type sets the type attribute.
+func (e *FeColorMatrixElement) Type(_type String) *FeColorMatrixElement {
+	e.Attrs["type"] = _type
+	return e
+}
+
+// Values sets the values attribute.
+func (e *FeColorMatrixElement) Values(values Numbers) *FeColorMatrixElement {
+	e.Attrs["values"] = values
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *FeColorMatrixElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *FeColorMatrixElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *FeColorMatrixElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *FeColorMatrixElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *FeColorMatrixElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *FeColorMatrixElement) TagName() string {
+	return "feColorMatrix"
+}
+
+// decode decodes e from d.
+func (e *FeColorMatrixElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *FeColorMatrixElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "x":
+		return parseLength(value)
+	case "y":
+		return parseLength(value)
+	case "width":
+		return parseLength(value)
+	case "height":
+		return parseLength(value)
+	case "values":
+		return parseNumbers(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A FeComponentTransferElement is a feComponentTransfer element.
+type FeComponentTransferElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// FeComponentTransfer returns a new FeComponentTransferElement.
+func FeComponentTransfer(children ...Element) *FeComponentTransferElement {
+	return &FeComponentTransferElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *FeComponentTransferElement) AppendChildren(children ...Element) *FeComponentTransferElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *FeComponentTransferElement) RemoveAttrs(names ...string) *FeComponentTransferElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *FeComponentTransferElement) ID(id String) *FeComponentTransferElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *FeComponentTransferElement) TabIndex(tabIndex Int) *FeComponentTransferElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *FeComponentTransferElement) Lang(lang String) *FeComponentTransferElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *FeComponentTransferElement) Class(class String) *FeComponentTransferElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *FeComponentTransferElement) Style(style String) *FeComponentTransferElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// AlignmentBaseline sets the alignment-baseline attribute.
+func (e *FeComponentTransferElement) AlignmentBaseline(alignmentBaseline String) *FeComponentTransferElement {
+	e.Attrs["alignment-baseline"] = alignmentBaseline
+	return e
+}
+
+// BaselineShift sets the baseline-shift attribute.
+func (e *FeComponentTransferElement) BaselineShift(baselineShift String) *FeComponentTransferElement {
+	e.Attrs["baseline-shift"] = baselineShift
+	return e
+}
+
+// ClipPath sets the clip-path attribute.
+func (e *FeComponentTransferElement) ClipPath(clipPath String) *FeComponentTransferElement {
+	e.Attrs["clip-path"] = clipPath
+	return e
+}
+
+// ClipRule sets the clip-rule attribute.
+func (e *FeComponentTransferElement) ClipRule(clipRule String) *FeComponentTransferElement {
+	e.Attrs["clip-rule"] = clipRule
+	return e
+}
+
+// Color sets the color attribute.
+func (e *FeComponentTransferElement) Color(color Color) *FeComponentTransferElement {
+	e.Attrs["color"] = color
+	return e
+}
+
+// ColorInterpolation sets the color-interpolation attribute.
+func (e *FeComponentTransferElement) ColorInterpolation(colorInterpolation String) *FeComponentTransferElement {
+	e.Attrs["color-interpolation"] = colorInterpolation
+	return e
+}
+
+// ColorInterpolationFilters sets the color-interpolation-filters attribute.
+func (e *FeComponentTransferElement) ColorInterpolationFilters(colorInterpolationFilters String) *FeComponentTransferElement {
+	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
+	return e
+}
+
+// ColorRendering sets the color-rendering attribute.
+func (e *FeComponentTransferElement) ColorRendering(colorRendering String) *FeComponentTransferElement {
+	e.Attrs["color-rendering"] = colorRendering
+	return e
+}
+
+// Cursor sets the cursor attribute.
+func (e *FeComponentTransferElement) Cursor(cursor String) *FeComponentTransferElement {
+	e.Attrs["cursor"] = cursor
+	return e
+}
+
+// Direction sets the direction attribute.
+func (e *FeComponentTransferElement) Direction(direction String) *FeComponentTransferElement {
+	e.Attrs["direction"] = direction
+	return e
+}
+
+// Display sets the display attribute.
+func (e *FeComponentTransferElement) Display(display String) *FeComponentTransferElement {
+	e.Attrs["display"] = display
+	return e
+}
+
+// DominantBaseline sets the dominant-baseline attribute.
+func (e *FeComponentTransferElement) DominantBaseline(dominantBaseline String) *FeComponentTransferElement {
+	e.Attrs["dominant-baseline"] = dominantBaseline
+	return e
+}
+
+// Fill sets the fill attribute.
+func (e *FeComponentTransferElement) Fill(fill String) *FeComponentTransferElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *FeComponentTransferElement) FillColor(fill Color) *FeComponentTransferElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *FeComponentTransferElement) FillPaint(fill Paint) *FeComponentTransferElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillOpacity sets the fill-opacity attribute.
+func (e *FeComponentTransferElement) FillOpacity(fillOpacity Float64) *FeComponentTransferElement {
+	e.Attrs["fill-opacity"] = fillOpacity
+	return e
+}
+
+// FillRule sets the fill-rule attribute.
+func (e *FeComponentTransferElement) FillRule(fillRule String) *FeComponentTransferElement {
+	e.Attrs["fill-rule"] = fillRule
+	return e
+}
+
+// Filter sets the filter attribute.
+func (e *FeComponentTransferElement) Filter(filter String) *FeComponentTransferElement {
+	e.Attrs["filter"] = filter
+	return e
+}
+
+// FloodColor sets the flood-color attribute.
+func (e *FeComponentTransferElement) FloodColor(floodColor Color) *FeComponentTransferElement {
+	e.Attrs["flood-color"] = floodColor
+	return e
+}
+
+// FloodOpacity sets the flood-opacity attribute.
+func (e *FeComponentTransferElement) FloodOpacity(floodOpacity Float64) *FeComponentTransferElement {
+	e.Attrs["flood-opacity"] = floodOpacity
+	return e
+}
+
+// FontFamily sets the font-family attribute.
+func (e *FeComponentTransferElement) FontFamily(fontFamily String) *FeComponentTransferElement {
+	e.Attrs["font-family"] = fontFamily
+	return e
+}
+
+// FontSize sets the font-size attribute.
+func (e *FeComponentTransferElement) FontSize(fontSize String) *FeComponentTransferElement {
+	e.Attrs["font-size"] = fontSize
+	return e
+}
+
+// FontSizeAdjust sets the font-size-adjust attribute.
+func (e *FeComponentTransferElement) FontSizeAdjust(fontSizeAdjust String) *FeComponentTransferElement {
+	e.Attrs["font-size-adjust"] = fontSizeAdjust
+	return e
+}
+
+// FontStretch sets the font-stretch attribute.
+func (e *FeComponentTransferElement) FontStretch(fontStretch String) *FeComponentTransferElement {
+	e.Attrs["font-stretch"] = fontStretch
+	return e
+}
+
+// FontStyle sets the font-style attribute.
+func (e *FeComponentTransferElement) FontStyle(fontStyle String) *FeComponentTransferElement {
+	e.Attrs["font-style"] = fontStyle
+	return e
+}
+
+// FontVariant sets the font-variant attribute.
+func (e *FeComponentTransferElement) FontVariant(fontVariant String) *FeComponentTransferElement {
+	e.Attrs["font-variant"] = fontVariant
+	return e
+}
+
+// FontWeight sets the font-weight attribute.
+func (e *FeComponentTransferElement) FontWeight(fontWeight String) *FeComponentTransferElement {
+	e.Attrs["font-weight"] = fontWeight
+	return e
+}
+
+// GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
+func (e *FeComponentTransferElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *FeComponentTransferElement {
+	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
+	return e
+}
+
+// GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
+func (e *FeComponentTransferElement) GlyphOrientationVertical(glyphOrientationVertical String) *FeComponentTransferElement {
+	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
+	return e
+}
+
+// ImageRendering sets the image-rendering attribute.
+func (e *FeComponentTransferElement) ImageRendering(imageRendering String) *FeComponentTransferElement {
+	e.Attrs["image-rendering"] = imageRendering
+	return e
+}
+
+// LetterSpacing sets the letter-spacing attribute.
+func (e *FeComponentTransferElement) LetterSpacing(letterSpacing String) *FeComponentTransferElement {
+	e.Attrs["letter-spacing"] = letterSpacing
+	return e
+}
+
+// LightingColor sets the lighting-color attribute.
+func (e *FeComponentTransferElement) LightingColor(lightingColor Color) *FeComponentTransferElement {
+	e.Attrs["lighting-color"] = lightingColor
+	return e
+}
+
+// MarkerEnd sets the marker-end attribute.
+func (e *FeComponentTransferElement) MarkerEnd(markerEnd String) *FeComponentTransferElement {
+	e.Attrs["marker-end"] = markerEnd
+	return e
+}
+
+// MarkerMid sets the marker-mid attribute.
+func (e *FeComponentTransferElement) MarkerMid(markerMid String) *FeComponentTransferElement {
+	e.Attrs["marker-mid"] = markerMid
+	return e
+}
+
+// MarkerStart sets the marker-start attribute.
+func (e *FeComponentTransferElement) MarkerStart(markerStart String) *FeComponentTransferElement {
+	e.Attrs["marker-start"] = markerStart
+	return e
+}
+
+// Mask sets the mask attribute.
+func (e *FeComponentTransferElement) Mask(mask String) *FeComponentTransferElement {
+	e.Attrs["mask"] = mask
+	return e
+}
+
+// Opacity sets the opacity attribute.
+func (e *FeComponentTransferElement) Opacity(opacity Float64) *FeComponentTransferElement {
+	e.Attrs["opacity"] = opacity
+	return e
+}
+
+// Overflow sets the overflow attribute.
+func (e *FeComponentTransferElement) Overflow(overflow String) *FeComponentTransferElement {
+	e.Attrs["overflow"] = overflow
+	return e
+}
+
+// PaintOrder sets the paint-order attribute.
+func (e *FeComponentTransferElement) PaintOrder(paintOrder String) *FeComponentTransferElement {
+	e.Attrs["paint-order"] = paintOrder
+	return e
+}
+
+// PointerEvents sets the pointer-events attribute.
+func (e *FeComponentTransferElement) PointerEvents(pointerEvents String) *FeComponentTransferElement {
+	e.Attrs["pointer-events"] = pointerEvents
+	return e
+}
+
+// ShapeRendering sets the shape-rendering attribute.
+func (e *FeComponentTransferElement) ShapeRendering(shapeRendering String) *FeComponentTransferElement {
+	e.Attrs["shape-rendering"] = shapeRendering
+	return e
+}
+
+// StopColor sets the stop-color attribute.
+func (e *FeComponentTransferElement) StopColor(stopColor Color) *FeComponentTransferElement {
+	e.Attrs["stop-color"] = stopColor
+	return e
+}
+
+// StopOpacity sets the stop-opacity attribute.
+func (e *FeComponentTransferElement) StopOpacity(stopOpacity Float64) *FeComponentTransferElement {
+	e.Attrs["stop-opacity"] = stopOpacity
+	return e
+}
+
+// Stroke sets the stroke attribute.
+func (e *FeComponentTransferElement) Stroke(stroke String) *FeComponentTransferElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *FeComponentTransferElement) StrokeColor(stroke Color) *FeComponentTransferElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *FeComponentTransferElement) StrokePaint(stroke Paint) *FeComponentTransferElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeDashArray sets the stroke-dasharray attribute.
+func (e *FeComponentTransferElement) StrokeDashArray(strokeDashArray String) *FeComponentTransferElement {
+	e.Attrs["stroke-dasharray"] = strokeDashArray
+	return e
+}
+
+// StrokeDashOffset sets the stroke-dashoffset attribute.
+func (e *FeComponentTransferElement) StrokeDashOffset(strokeDashOffset Float64) *FeComponentTransferElement {
+	e.Attrs["stroke-dashoffset"] = strokeDashOffset
+	return e
+}
+
+// StrokeLineCap sets the stroke-linecap attribute.
+func (e *FeComponentTransferElement) StrokeLineCap(strokeLineCap String) *FeComponentTransferElement {
+	e.Attrs["stroke-linecap"] = strokeLineCap
+	return e
+}
+
+// StrokeLineJoin sets the stroke-linejoin attribute.
+func (e *FeComponentTransferElement) StrokeLineJoin(strokeLineJoin String) *FeComponentTransferElement {
+	e.Attrs["stroke-linejoin"] = strokeLineJoin
+	return e
+}
+
+// StrokeMiterLimit sets the stroke-miterlimit attribute.
+func (e *FeComponentTransferElement) StrokeMiterLimit(strokeMiterLimit Float64) *FeComponentTransferElement {
+	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
+	return e
+}
+
+// StrokeOpacity sets the stroke-opacity attribute.
+func (e *FeComponentTransferElement) StrokeOpacity(strokeOpacity Float64) *FeComponentTransferElement {
+	e.Attrs["stroke-opacity"] = strokeOpacity
+	return e
+}
+
+// StrokeWidth sets the stroke-width attribute.
+func (e *FeComponentTransferElement) StrokeWidth(strokeWidth Length) *FeComponentTransferElement {
+	e.Attrs["stroke-width"] = strokeWidth
+	return e
+}
+
+// TextAnchor sets the text-anchor attribute.
+func (e *FeComponentTransferElement) TextAnchor(textAnchor String) *FeComponentTransferElement {
+	e.Attrs["text-anchor"] = textAnchor
+	return e
+}
+
+// TextDecoration sets the text-decoration attribute.
+func (e *FeComponentTransferElement) TextDecoration(textDecoration String) *FeComponentTransferElement {
+	e.Attrs["text-decoration"] = textDecoration
+	return e
+}
+
+// TextOverflow sets the text-overflow attribute.
+func (e *FeComponentTransferElement) TextOverflow(textOverflow String) *FeComponentTransferElement {
+	e.Attrs["text-overflow"] = textOverflow
+	return e
+}
+
+// TextRendering sets the text-rendering attribute.
+func (e *FeComponentTransferElement) TextRendering(textRendering String) *FeComponentTransferElement {
+	e.Attrs["text-rendering"] = textRendering
+	return e
+}
+
+// Transform sets the transform attribute.
+func (e *FeComponentTransferElement) Transform(transform Transform) *FeComponentTransferElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
+// UnicodeBiDi sets the unicode-bidi attribute.
+func (e *FeComponentTransferElement) UnicodeBiDi(unicodeBiDi String) *FeComponentTransferElement {
+	e.Attrs["unicode-bidi"] = unicodeBiDi
+	return e
+}
+
+// VectorEffect sets the vector-effect attribute.
+func (e *FeComponentTransferElement) VectorEffect(vectorEffect String) *FeComponentTransferElement {
+	e.Attrs["vector-effect"] = vectorEffect
+	return e
+}
+
+// Visibility sets the visibility attribute.
+func (e *FeComponentTransferElement) Visibility(visibility String) *FeComponentTransferElement {
+	e.Attrs["visibility"] = visibility
+	return e
+}
+
+// WhiteSpace sets the white-space attribute.
+func (e *FeComponentTransferElement) WhiteSpace(whiteSpace String) *FeComponentTransferElement {
+	e.Attrs["white-space"] = whiteSpace
+	return e
+}
+
+// WordSpacing sets the word-spacing attribute.
+func (e *FeComponentTransferElement) WordSpacing(wordSpacing String) *FeComponentTransferElement {
+	e.Attrs["word-spacing"] = wordSpacing
+	return e
+}
+
+// WritingMode sets the writing-mode attribute.
+func (e *FeComponentTransferElement) WritingMode(writingMode String) *FeComponentTransferElement {
+	e.Attrs["writing-mode"] = writingMode
+	return e
+}
+
+// X sets the x attribute.
+func (e *FeComponentTransferElement) X(x Length) *FeComponentTransferElement {
+	e.Attrs["x"] = x
+	return e
+}
+
+// Y sets the y attribute.
+func (e *FeComponentTransferElement) Y(y Length) *FeComponentTransferElement {
+	e.Attrs["y"] = y
+	return e
+}
+
+// Width sets the width attribute.
+func (e *FeComponentTransferElement) Width(width Length) *FeComponentTransferElement {
+	e.Attrs["width"] = width
+	return e
+}
+
+// Height sets the height attribute.
+func (e *FeComponentTransferElement) Height(height Length) *FeComponentTransferElement {
+	e.Attrs["height"] = height
+	return e
+}
+
+// Result sets the result attribute.
+func (e *FeComponentTransferElement) Result(result String) *FeComponentTransferElement {
+	e.Attrs["result"] = result
+	return e
+}
+
+// In sets the in attribute.
+func (e *FeComponentTransferElement) In(_in String) *FeComponentTransferElement {
+	e.Attrs["in"] = _in
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *FeComponentTransferElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *FeComponentTransferElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *FeComponentTransferElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *FeComponentTransferElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *FeComponentTransferElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *FeComponentTransferElement) TagName() string {
+	return "feComponentTransfer"
+}
+
+// decode decodes e from d.
+func (e *FeComponentTransferElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *FeComponentTransferElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "x":
+		return parseLength(value)
+	case "y":
+		return parseLength(value)
+	case "width":
+		return parseLength(value)
+	case "height":
+		return parseLength(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A FeCompositeElement is a feComposite element.
+type FeCompositeElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// FeComposite returns a new FeCompositeElement.
+func FeComposite(children ...Element) *FeCompositeElement {
+	return &FeCompositeElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *FeCompositeElement) AppendChildren(children ...Element) *FeCompositeElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *FeCompositeElement) RemoveAttrs(names ...string) *FeCompositeElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *FeCompositeElement) ID(id String) *FeCompositeElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *FeCompositeElement) TabIndex(tabIndex Int) *FeCompositeElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *FeCompositeElement) Lang(lang String) *FeCompositeElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *FeCompositeElement) Class(class String) *FeCompositeElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *FeCompositeElement) Style(style String) *FeCompositeElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// AlignmentBaseline sets the alignment-baseline attribute.
+func (e *FeCompositeElement) AlignmentBaseline(alignmentBaseline String) *FeCompositeElement {
+	e.Attrs["alignment-baseline"] = alignmentBaseline
+	return e
+}
+
+// BaselineShift sets the baseline-shift attribute.
+func (e *FeCompositeElement) BaselineShift(baselineShift String) *FeCompositeElement {
+	e.Attrs["baseline-shift"] = baselineShift
+	return e
+}
+
+// ClipPath sets the clip-path attribute.
+func (e *FeCompositeElement) ClipPath(clipPath String) *FeCompositeElement {
+	e.Attrs["clip-path"] = clipPath
+	return e
+}
+
+// ClipRule sets the clip-rule attribute.
+func (e *FeCompositeElement) ClipRule(clipRule String) *FeCompositeElement {
+	e.Attrs["clip-rule"] = clipRule
+	return e
+}
+
+// Color sets the color attribute.
+func (e *FeCompositeElement) Color(color Color) *FeCompositeElement {
+	e.Attrs["color"] = color
+	return e
+}
+
+// ColorInterpolation sets the color-interpolation attribute.
+func (e *FeCompositeElement) ColorInterpolation(colorInterpolation String) *FeCompositeElement {
+	e.Attrs["color-interpolation"] = colorInterpolation
+	return e
+}
+
+// ColorInterpolationFilters sets the color-interpolation-filters attribute.
+func (e *FeCompositeElement) ColorInterpolationFilters(colorInterpolationFilters String) *FeCompositeElement {
+	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
+	return e
+}
+
+// ColorRendering sets the color-rendering attribute.
+func (e *FeCompositeElement) ColorRendering(colorRendering String) *FeCompositeElement {
+	e.Attrs["color-rendering"] = colorRendering
+	return e
+}
+
+// Cursor sets the cursor attribute.
+func (e *FeCompositeElement) Cursor(cursor String) *FeCompositeElement {
+	e.Attrs["cursor"] = cursor
+	return e
+}
+
+// Direction sets the direction attribute.
+func (e *FeCompositeElement) Direction(direction String) *FeCompositeElement {
+	e.Attrs["direction"] = direction
+	return e
+}
+
+// Display sets the display attribute.
+func (e *FeCompositeElement) Display(display String) *FeCompositeElement {
+	e.Attrs["display"] = display
+	return e
+}
+
+// DominantBaseline sets the dominant-baseline attribute.
+func (e *FeCompositeElement) DominantBaseline(dominantBaseline String) *FeCompositeElement {
+	e.Attrs["dominant-baseline"] = dominantBaseline
+	return e
+}
+
+// Fill sets the fill attribute.
+func (e *FeCompositeElement) Fill(fill String) *FeCompositeElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *FeCompositeElement) FillColor(fill Color) *FeCompositeElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *FeCompositeElement) FillPaint(fill Paint) *FeCompositeElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillOpacity sets the fill-opacity attribute.
+func (e *FeCompositeElement) FillOpacity(fillOpacity Float64) *FeCompositeElement {
+	e.Attrs["fill-opacity"] = fillOpacity
+	return e
+}
+
+// FillRule sets the fill-rule attribute.
+func (e *FeCompositeElement) FillRule(fillRule String) *FeCompositeElement {
+	e.Attrs["fill-rule"] = fillRule
+	return e
+}
+
+// Filter sets the filter attribute.
+func (e *FeCompositeElement) Filter(filter String) *FeCompositeElement {
+	e.Attrs["filter"] = filter
+	return e
+}
+
+// FloodColor sets the flood-color attribute.
+func (e *FeCompositeElement) FloodColor(floodColor Color) *FeCompositeElement {
+	e.Attrs["flood-color"] = floodColor
+	return e
+}
+
+// FloodOpacity sets the flood-opacity attribute.
+func (e *FeCompositeElement) FloodOpacity(floodOpacity Float64) *FeCompositeElement {
+	e.Attrs["flood-opacity"] = floodOpacity
+	return e
+}
+
+// FontFamily sets the font-family attribute.
+func (e *FeCompositeElement) FontFamily(fontFamily String) *FeCompositeElement {
+	e.Attrs["font-family"] = fontFamily
+	return e
+}
+
+// FontSize sets the font-size attribute.
+func (e *FeCompositeElement) FontSize(fontSize String) *FeCompositeElement {
+	e.Attrs["font-size"] = fontSize
+	return e
+}
+
+// FontSizeAdjust sets the font-size-adjust attribute.
+func (e *FeCompositeElement) FontSizeAdjust(fontSizeAdjust String) *FeCompositeElement {
+	e.Attrs["font-size-adjust"] = fontSizeAdjust
+	return e
+}
+
+// FontStretch sets the font-stretch attribute.
+func (e *FeCompositeElement) FontStretch(fontStretch String) *FeCompositeElement {
+	e.Attrs["font-stretch"] = fontStretch
+	return e
+}
+
+// FontStyle sets the font-style attribute.
+func (e *FeCompositeElement) FontStyle(fontStyle String) *FeCompositeElement {
+	e.Attrs["font-style"] = fontStyle
+	return e
+}
+
+// FontVariant sets the font-variant attribute.
+func (e *FeCompositeElement) FontVariant(fontVariant String) *FeCompositeElement {
+	e.Attrs["font-variant"] = fontVariant
+	return e
+}
+
+// FontWeight sets the font-weight attribute.
+func (e *FeCompositeElement) FontWeight(fontWeight String) *FeCompositeElement {
+	e.Attrs["font-weight"] = fontWeight
+	return e
+}
+
+// GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
+func (e *FeCompositeElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *FeCompositeElement {
+	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
+	return e
+}
+
+// GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
+func (e *FeCompositeElement) GlyphOrientationVertical(glyphOrientationVertical String) *FeCompositeElement {
+	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
+	return e
+}
+
+// ImageRendering sets the image-rendering attribute.
+func (e *FeCompositeElement) ImageRendering(imageRendering String) *FeCompositeElement {
+	e.Attrs["image-rendering"] = imageRendering
+	return e
+}
+
+// LetterSpacing sets the letter-spacing attribute.
+func (e *FeCompositeElement) LetterSpacing(letterSpacing String) *FeCompositeElement {
+	e.Attrs["letter-spacing"] = letterSpacing
+	return e
+}
+
+// LightingColor sets the lighting-color attribute.
+func (e *FeCompositeElement) LightingColor(lightingColor Color) *FeCompositeElement {
+	e.Attrs["lighting-color"] = lightingColor
+	return e
+}
+
+// MarkerEnd sets the marker-end attribute.
+func (e *FeCompositeElement) MarkerEnd(markerEnd String) *FeCompositeElement {
+	e.Attrs["marker-end"] = markerEnd
+	return e
+}
+
+// MarkerMid sets the marker-mid attribute.
+func (e *FeCompositeElement) MarkerMid(markerMid String) *FeCompositeElement {
+	e.Attrs["marker-mid"] = markerMid
+	return e
+}
+
+// MarkerStart sets the marker-start attribute.
+func (e *FeCompositeElement) MarkerStart(markerStart String) *FeCompositeElement {
+	e.Attrs["marker-start"] = markerStart
+	return e
+}
+
+// Mask sets the mask attribute.
+func (e *FeCompositeElement) Mask(mask String) *FeCompositeElement {
+	e.Attrs["mask"] = mask
+	return e
+}
+
+// Opacity sets the opacity attribute.
+func (e *FeCompositeElement) Opacity(opacity Float64) *FeCompositeElement {
+	e.Attrs["opacity"] = opacity
+	return e
+}
+
+// Overflow sets the overflow attribute.
+func (e *FeCompositeElement) Overflow(overflow String) *FeCompositeElement {
+	e.Attrs["overflow"] = overflow
+	return e
+}
+
+// PaintOrder sets the paint-order attribute.
+func (e *FeCompositeElement) PaintOrder(paintOrder String) *FeCompositeElement {
+	e.Attrs["paint-order"] = paintOrder
+	return e
+}
+
+// PointerEvents sets the pointer-events attribute.
+func (e *FeCompositeElement) PointerEvents(pointerEvents String) *FeCompositeElement {
+	e.Attrs["pointer-events"] = pointerEvents
+	return e
+}
+
+// ShapeRendering sets the shape-rendering attribute.
+func (e *FeCompositeElement) ShapeRendering(shapeRendering String) *FeCompositeElement {
+	e.Attrs["shape-rendering"] = shapeRendering
+	return e
+}
+
+// StopColor sets the stop-color attribute.
+func (e *FeCompositeElement) StopColor(stopColor Color) *FeCompositeElement {
+	e.Attrs["stop-color"] = stopColor
+	return e
+}
+
+// StopOpacity sets the stop-opacity attribute.
+func (e *FeCompositeElement) StopOpacity(stopOpacity Float64) *FeCompositeElement {
+	e.Attrs["stop-opacity"] = stopOpacity
+	return e
+}
+
+// Stroke sets the stroke attribute.
+func (e *FeCompositeElement) Stroke(stroke String) *FeCompositeElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *FeCompositeElement) StrokeColor(stroke Color) *FeCompositeElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *FeCompositeElement) StrokePaint(stroke Paint) *FeCompositeElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeDashArray sets the stroke-dasharray attribute.
+func (e *FeCompositeElement) StrokeDashArray(strokeDashArray String) *FeCompositeElement {
+	e.Attrs["stroke-dasharray"] = strokeDashArray
+	return e
+}
+
+// StrokeDashOffset sets the stroke-dashoffset attribute.
+func (e *FeCompositeElement) StrokeDashOffset(strokeDashOffset Float64) *FeCompositeElement {
+	e.Attrs["stroke-dashoffset"] = strokeDashOffset
+	return e
+}
+
+// StrokeLineCap sets the stroke-linecap attribute.
+func (e *FeCompositeElement) StrokeLineCap(strokeLineCap String) *FeCompositeElement {
+	e.Attrs["stroke-linecap"] = strokeLineCap
+	return e
+}
+
+// StrokeLineJoin sets the stroke-linejoin attribute.
+func (e *FeCompositeElement) StrokeLineJoin(strokeLineJoin String) *FeCompositeElement {
+	e.Attrs["stroke-linejoin"] = strokeLineJoin
+	return e
+}
+
+// StrokeMiterLimit sets the stroke-miterlimit attribute.
+func (e *FeCompositeElement) StrokeMiterLimit(strokeMiterLimit Float64) *FeCompositeElement {
+	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
+	return e
+}
+
+// StrokeOpacity sets the stroke-opacity attribute.
+func (e *FeCompositeElement) StrokeOpacity(strokeOpacity Float64) *FeCompositeElement {
+	e.Attrs["stroke-opacity"] = strokeOpacity
+	return e
+}
+
+// StrokeWidth sets the stroke-width attribute.
+func (e *FeCompositeElement) StrokeWidth(strokeWidth Length) *FeCompositeElement {
+	e.Attrs["stroke-width"] = strokeWidth
+	return e
+}
+
+// TextAnchor sets the text-anchor attribute.
+func (e *FeCompositeElement) TextAnchor(textAnchor String) *FeCompositeElement {
+	e.Attrs["text-anchor"] = textAnchor
+	return e
+}
+
+// TextDecoration sets the text-decoration attribute.
+func (e *FeCompositeElement) TextDecoration(textDecoration String) *FeCompositeElement {
+	e.Attrs["text-decoration"] = textDecoration
+	return e
+}
+
+// TextOverflow sets the text-overflow attribute.
+func (e *FeCompositeElement) TextOverflow(textOverflow String) *FeCompositeElement {
+	e.Attrs["text-overflow"] = textOverflow
+	return e
+}
+
+// TextRendering sets the text-rendering attribute.
+func (e *FeCompositeElement) TextRendering(textRendering String) *FeCompositeElement {
+	e.Attrs["text-rendering"] = textRendering
+	return e
+}
+
+// Transform sets the transform attribute.
+func (e *FeCompositeElement) Transform(transform Transform) *FeCompositeElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
+// UnicodeBiDi sets the unicode-bidi attribute.
+func (e *FeCompositeElement) UnicodeBiDi(unicodeBiDi String) *FeCompositeElement {
+	e.Attrs["unicode-bidi"] = unicodeBiDi
+	return e
+}
+
+// VectorEffect sets the vector-effect attribute.
+func (e *FeCompositeElement) VectorEffect(vectorEffect String) *FeCompositeElement {
+	e.Attrs["vector-effect"] = vectorEffect
+	return e
+}
+
+// Visibility sets the visibility attribute.
+func (e *FeCompositeElement) Visibility(visibility String) *FeCompositeElement {
+	e.Attrs["visibility"] = visibility
+	return e
+}
+
+// WhiteSpace sets the white-space attribute.
+func (e *FeCompositeElement) WhiteSpace(whiteSpace String) *FeCompositeElement {
+	e.Attrs["white-space"] = whiteSpace
+	return e
+}
+
+// WordSpacing sets the word-spacing attribute.
+func (e *FeCompositeElement) WordSpacing(wordSpacing String) *FeCompositeElement {
+	e.Attrs["word-spacing"] = wordSpacing
+	return e
+}
+
+// WritingMode sets the writing-mode attribute.
+func (e *FeCompositeElement) WritingMode(writingMode String) *FeCompositeElement {
+	e.Attrs["writing-mode"] = writingMode
+	return e
+}
+
+// X sets the x attribute.
+func (e *FeCompositeElement) X(x Length) *FeCompositeElement {
+	e.Attrs["x"] = x
+	return e
+}
+
+// Y sets the y attribute.
+func (e *FeCompositeElement) Y(y Length) *FeCompositeElement {
+	e.Attrs["y"] = y
+	return e
+}
+
+// Width sets the width attribute.
+func (e *FeCompositeElement) Width(width Length) *FeCompositeElement {
+	e.Attrs["width"] = width
+	return e
+}
+
+// Height sets the height attribute.
+func (e *FeCompositeElement) Height(height Length) *FeCompositeElement {
+	e.Attrs["height"] = height
+	return e
+}
+
+// Result sets the result attribute.
+func (e *FeCompositeElement) Result(result String) *FeCompositeElement {
+	e.Attrs["result"] = result
+	return e
+}
+
+// In sets the in attribute.
+func (e *FeCompositeElement) In(_in String) *FeCompositeElement {
+	e.Attrs["in"] = _in
+	return e
+}
+
+// In2 sets the in2 attribute.
+func (e *FeCompositeElement) In2(in2 String) *FeCompositeElement {
+	e.Attrs["in2"] = in2
+	return e
+}
+
+// Operator sets the operator attribute.
+func (e *FeCompositeElement) Operator(operator String) *FeCompositeElement {
+	e.Attrs["operator"] = operator
+	return e
+}
+
+// K1 sets the k1 attribute.
+func (e *FeCompositeElement) K1(k1 Float64) *FeCompositeElement {
+	e.Attrs["k1"] = k1
+	return e
+}
+
+// K2 sets the k2 attribute.
+func (e *FeCompositeElement) K2(k2 Float64) *FeCompositeElement {
+	e.Attrs["k2"] = k2
+	return e
+}
+
+// K3 sets the k3 attribute.
+func (e *FeCompositeElement) K3(k3 Float64) *FeCompositeElement {
+	e.Attrs["k3"] = k3
+	return e
+}
+
+// K4 sets the k4 attribute.
+func (e *FeCompositeElement) K4(k4 Float64) *FeCompositeElement {
+	e.Attrs["k4"] = k4
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *FeCompositeElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *FeCompositeElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *FeCompositeElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *FeCompositeElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *FeCompositeElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *FeCompositeElement) TagName() string {
+	return "feComposite"
+}
+
+// decode decodes e from d.
+func (e *FeCompositeElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *FeCompositeElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "x":
+		return parseLength(value)
+	case "y":
+		return parseLength(value)
+	case "width":
+		return parseLength(value)
+	case "height":
+		return parseLength(value)
+	case "k1":
+		return parseFloat64(value)
+	case "k2":
+		return parseFloat64(value)
+	case "k3":
+		return parseFloat64(value)
+	case "k4":
+		return parseFloat64(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A FeConvolveMatrixElement is a feConvolveMatrix element.
+type FeConvolveMatrixElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// FeConvolveMatrix returns a new FeConvolveMatrixElement.
+func FeConvolveMatrix(children ...Element) *FeConvolveMatrixElement {
+	return &FeConvolveMatrixElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *FeConvolveMatrixElement) AppendChildren(children ...Element) *FeConvolveMatrixElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *FeConvolveMatrixElement) RemoveAttrs(names ...string) *FeConvolveMatrixElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *FeConvolveMatrixElement) ID(id String) *FeConvolveMatrixElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *FeConvolveMatrixElement) TabIndex(tabIndex Int) *FeConvolveMatrixElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *FeConvolveMatrixElement) Lang(lang String) *FeConvolveMatrixElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *FeConvolveMatrixElement) Class(class String) *FeConvolveMatrixElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *FeConvolveMatrixElement) Style(style String) *FeConvolveMatrixElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// AlignmentBaseline sets the alignment-baseline attribute.
+func (e *FeConvolveMatrixElement) AlignmentBaseline(alignmentBaseline String) *FeConvolveMatrixElement {
+	e.Attrs["alignment-baseline"] = alignmentBaseline
+	return e
+}
+
+// BaselineShift sets the baseline-shift attribute.
+func (e *FeConvolveMatrixElement) BaselineShift(baselineShift String) *FeConvolveMatrixElement {
+	e.Attrs["baseline-shift"] = baselineShift
+	return e
+}
+
+// ClipPath sets the clip-path attribute.
+func (e *FeConvolveMatrixElement) ClipPath(clipPath String) *FeConvolveMatrixElement {
+	e.Attrs["clip-path"] = clipPath
+	return e
+}
+
+// ClipRule sets the clip-rule attribute.
+func (e *FeConvolveMatrixElement) ClipRule(clipRule String) *FeConvolveMatrixElement {
+	e.Attrs["clip-rule"] = clipRule
+	return e
+}
+
+// Color sets the color attribute.
+func (e *FeConvolveMatrixElement) Color(color Color) *FeConvolveMatrixElement {
+	e.Attrs["color"] = color
+	return e
+}
+
+// ColorInterpolation sets the color-interpolation attribute.
+func (e *FeConvolveMatrixElement) ColorInterpolation(colorInterpolation String) *FeConvolveMatrixElement {
+	e.Attrs["color-interpolation"] = colorInterpolation
+	return e
+}
+
+// ColorInterpolationFilters sets the color-interpolation-filters attribute.
+func (e *FeConvolveMatrixElement) ColorInterpolationFilters(colorInterpolationFilters String) *FeConvolveMatrixElement {
+	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
+	return e
+}
+
+// ColorRendering sets the color-rendering attribute.
+func (e *FeConvolveMatrixElement) ColorRendering(colorRendering String) *FeConvolveMatrixElement {
+	e.Attrs["color-rendering"] = colorRendering
+	return e
+}
+
+// Cursor sets the cursor attribute.
+func (e *FeConvolveMatrixElement) Cursor(cursor String) *FeConvolveMatrixElement {
+	e.Attrs["cursor"] = cursor
+	return e
+}
+
+// Direction sets the direction attribute.
+func (e *FeConvolveMatrixElement) Direction(direction String) *FeConvolveMatrixElement {
+	e.Attrs["direction"] = direction
+	return e
+}
+
+// Display sets the display attribute.
+func (e *FeConvolveMatrixElement) Display(display String) *FeConvolveMatrixElement {
+	e.Attrs["display"] = display
+	return e
+}
+
+// DominantBaseline sets the dominant-baseline attribute.
+func (e *FeConvolveMatrixElement) DominantBaseline(dominantBaseline String) *FeConvolveMatrixElement {
+	e.Attrs["dominant-baseline"] = dominantBaseline
+	return e
+}
+
+// Fill sets the fill attribute.
+func (e *FeConvolveMatrixElement) Fill(fill String) *FeConvolveMatrixElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *FeConvolveMatrixElement) FillColor(fill Color) *FeConvolveMatrixElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *FeConvolveMatrixElement) FillPaint(fill Paint) *FeConvolveMatrixElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillOpacity sets the fill-opacity attribute.
+func (e *FeConvolveMatrixElement) FillOpacity(fillOpacity Float64) *FeConvolveMatrixElement {
+	e.Attrs["fill-opacity"] = fillOpacity
+	return e
+}
+
+// FillRule sets the fill-rule attribute.
+func (e *FeConvolveMatrixElement) FillRule(fillRule String) *FeConvolveMatrixElement {
+	e.Attrs["fill-rule"] = fillRule
+	return e
+}
+
+// Filter sets the filter attribute.
+func (e *FeConvolveMatrixElement) Filter(filter String) *FeConvolveMatrixElement {
+	e.Attrs["filter"] = filter
+	return e
+}
+
+// FloodColor sets the flood-color attribute.
+func (e *FeConvolveMatrixElement) FloodColor(floodColor Color) *FeConvolveMatrixElement {
+	e.Attrs["flood-color"] = floodColor
+	return e
+}
+
+// FloodOpacity sets the flood-opacity attribute.
+func (e *FeConvolveMatrixElement) FloodOpacity(floodOpacity Float64) *FeConvolveMatrixElement {
+	e.Attrs["flood-opacity"] = floodOpacity
+	return e
+}
+
+// FontFamily sets the font-family attribute.
+func (e *FeConvolveMatrixElement) FontFamily(fontFamily String) *FeConvolveMatrixElement {
+	e.Attrs["font-family"] = fontFamily
+	return e
+}
+
+// FontSize sets the font-size attribute.
+func (e *FeConvolveMatrixElement) FontSize(fontSize String) *FeConvolveMatrixElement {
+	e.Attrs["font-size"] = fontSize
+	return e
+}
+
+// FontSizeAdjust sets the font-size-adjust attribute.
+func (e *FeConvolveMatrixElement) FontSizeAdjust(fontSizeAdjust String) *FeConvolveMatrixElement {
+	e.Attrs["font-size-adjust"] = fontSizeAdjust
+	return e
+}
+
+// FontStretch sets the font-stretch attribute.
+func (e *FeConvolveMatrixElement) FontStretch(fontStretch String) *FeConvolveMatrixElement {
+	e.Attrs["font-stretch"] = fontStretch
+	return e
+}
+
+// FontStyle sets the font-style attribute.
+func (e *FeConvolveMatrixElement) FontStyle(fontStyle String) *FeConvolveMatrixElement {
+	e.Attrs["font-style"] = fontStyle
+	return e
+}
+
+// FontVariant sets the font-variant attribute.
+func (e *FeConvolveMatrixElement) FontVariant(fontVariant String) *FeConvolveMatrixElement {
+	e.Attrs["font-variant"] = fontVariant
+	return e
+}
+
+// FontWeight sets the font-weight attribute.
+func (e *FeConvolveMatrixElement) FontWeight(fontWeight String) *FeConvolveMatrixElement {
+	e.Attrs["font-weight"] = fontWeight
+	return e
+}
+
+// GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
+func (e *FeConvolveMatrixElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *FeConvolveMatrixElement {
+	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
+	return e
+}
+
+// GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
+func (e *FeConvolveMatrixElement) GlyphOrientationVertical(glyphOrientationVertical String) *FeConvolveMatrixElement {
+	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
+	return e
+}
+
+// ImageRendering sets the image-rendering attribute.
+func (e *FeConvolveMatrixElement) ImageRendering(imageRendering String) *FeConvolveMatrixElement {
+	e.Attrs["image-rendering"] = imageRendering
+	return e
+}
+
+// LetterSpacing sets the letter-spacing attribute.
+func (e *FeConvolveMatrixElement) LetterSpacing(letterSpacing String) *FeConvolveMatrixElement {
+	e.Attrs["letter-spacing"] = letterSpacing
+	return e
+}
+
+// LightingColor sets the lighting-color attribute.
+func (e *FeConvolveMatrixElement) LightingColor(lightingColor Color) *FeConvolveMatrixElement {
+	e.Attrs["lighting-color"] = lightingColor
+	return e
+}
+
+// MarkerEnd sets the marker-end attribute.
+func (e *FeConvolveMatrixElement) MarkerEnd(markerEnd String) *FeConvolveMatrixElement {
+	e.Attrs["marker-end"] = markerEnd
+	return e
+}
+
+// MarkerMid sets the marker-mid attribute.
+func (e *FeConvolveMatrixElement) MarkerMid(markerMid String) *FeConvolveMatrixElement {
+	e.Attrs["marker-mid"] = markerMid
+	return e
+}
+
+// MarkerStart sets the marker-start attribute.
+func (e *FeConvolveMatrixElement) MarkerStart(markerStart String) *FeConvolveMatrixElement {
+	e.Attrs["marker-start"] = markerStart
+	return e
+}
+
+// Mask sets the mask attribute.
+func (e *FeConvolveMatrixElement) Mask(mask String) *FeConvolveMatrixElement {
+	e.Attrs["mask"] = mask
+	return e
+}
+
+// Opacity sets the opacity attribute.
+func (e *FeConvolveMatrixElement) Opacity(opacity Float64) *FeConvolveMatrixElement {
+	e.Attrs["opacity"] = opacity
+	return e
+}
+
+// Overflow sets the overflow attribute.
+func (e *FeConvolveMatrixElement) Overflow(overflow String) *FeConvolveMatrixElement {
+	e.Attrs["overflow"] = overflow
+	return e
+}
+
+// PaintOrder sets the paint-order attribute.
+func (e *FeConvolveMatrixElement) PaintOrder(paintOrder String) *FeConvolveMatrixElement {
+	e.Attrs["paint-order"] = paintOrder
+	return e
+}
+
+// PointerEvents sets the pointer-events attribute.
+func (e *FeConvolveMatrixElement) PointerEvents(pointerEvents String) *FeConvolveMatrixElement {
+	e.Attrs["pointer-events"] = pointerEvents
+	return e
+}
+
+// ShapeRendering sets the shape-rendering attribute.
+func (e *FeConvolveMatrixElement) ShapeRendering(shapeRendering String) *FeConvolveMatrixElement {
+	e.Attrs["shape-rendering"] = shapeRendering
+	return e
+}
+
+// StopColor sets the stop-color attribute.
+func (e *FeConvolveMatrixElement) StopColor(stopColor Color) *FeConvolveMatrixElement {
+	e.Attrs["stop-color"] = stopColor
+	return e
+}
+
+// StopOpacity sets the stop-opacity attribute.
+func (e *FeConvolveMatrixElement) StopOpacity(stopOpacity Float64) *FeConvolveMatrixElement {
+	e.Attrs["stop-opacity"] = stopOpacity
+	return e
+}
+
+// Stroke sets the stroke attribute.
+func (e *FeConvolveMatrixElement) Stroke(stroke String) *FeConvolveMatrixElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *FeConvolveMatrixElement) StrokeColor(stroke Color) *FeConvolveMatrixElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *FeConvolveMatrixElement) StrokePaint(stroke Paint) *FeConvolveMatrixElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeDashArray sets the stroke-dasharray attribute.
+func (e *FeConvolveMatrixElement) StrokeDashArray(strokeDashArray String) *FeConvolveMatrixElement {
+	e.Attrs["stroke-dasharray"] = strokeDashArray
+	return e
+}
+
+// StrokeDashOffset sets the stroke-dashoffset attribute.
+func (e *FeConvolveMatrixElement) StrokeDashOffset(strokeDashOffset Float64) *FeConvolveMatrixElement {
+	e.Attrs["stroke-dashoffset"] = strokeDashOffset
+	return e
+}
+
+// StrokeLineCap sets the stroke-linecap attribute.
+func (e *FeConvolveMatrixElement) StrokeLineCap(strokeLineCap String) *FeConvolveMatrixElement {
+	e.Attrs["stroke-linecap"] = strokeLineCap
+	return e
+}
+
+// StrokeLineJoin sets the stroke-linejoin attribute.
+func (e *FeConvolveMatrixElement) StrokeLineJoin(strokeLineJoin String) *FeConvolveMatrixElement {
+	e.Attrs["stroke-linejoin"] = strokeLineJoin
+	return e
+}
+
+// StrokeMiterLimit sets the stroke-miterlimit attribute.
+func (e *FeConvolveMatrixElement) StrokeMiterLimit(strokeMiterLimit Float64) *FeConvolveMatrixElement {
+	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
+	return e
+}
+
+// StrokeOpacity sets the stroke-opacity attribute.
+func (e *FeConvolveMatrixElement) StrokeOpacity(strokeOpacity Float64) *FeConvolveMatrixElement {
+	e.Attrs["stroke-opacity"] = strokeOpacity
+	return e
+}
+
+// StrokeWidth sets the stroke-width attribute.
+func (e *FeConvolveMatrixElement) StrokeWidth(strokeWidth Length) *FeConvolveMatrixElement {
+	e.Attrs["stroke-width"] = strokeWidth
+	return e
+}
+
+// TextAnchor sets the text-anchor attribute.
+func (e *FeConvolveMatrixElement) TextAnchor(textAnchor String) *FeConvolveMatrixElement {
+	e.Attrs["text-anchor"] = textAnchor
+	return e
+}
+
+// TextDecoration sets the text-decoration attribute.
+func (e *FeConvolveMatrixElement) TextDecoration(textDecoration String) *FeConvolveMatrixElement {
+	e.Attrs["text-decoration"] = textDecoration
+	return e
+}
+
+// TextOverflow sets the text-overflow attribute.
+func (e *FeConvolveMatrixElement) TextOverflow(textOverflow String) *FeConvolveMatrixElement {
+	e.Attrs["text-overflow"] = textOverflow
+	return e
+}
+
+// TextRendering sets the text-rendering attribute.
+func (e *FeConvolveMatrixElement) TextRendering(textRendering String) *FeConvolveMatrixElement {
+	e.Attrs["text-rendering"] = textRendering
+	return e
+}
+
+// Transform sets the transform attribute.
+func (e *FeConvolveMatrixElement) Transform(transform Transform) *FeConvolveMatrixElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
+// UnicodeBiDi sets the unicode-bidi attribute.
+func (e *FeConvolveMatrixElement) UnicodeBiDi(unicodeBiDi String) *FeConvolveMatrixElement {
+	e.Attrs["unicode-bidi"] = unicodeBiDi
+	return e
+}
+
+// VectorEffect sets the vector-effect attribute.
+func (e *FeConvolveMatrixElement) VectorEffect(vectorEffect String) *FeConvolveMatrixElement {
+	e.Attrs["vector-effect"] = vectorEffect
+	return e
+}
+
+// Visibility sets the visibility attribute.
+func (e *FeConvolveMatrixElement) Visibility(visibility String) *FeConvolveMatrixElement {
+	e.Attrs["visibility"] = visibility
+	return e
+}
+
+// WhiteSpace sets the white-space attribute.
+func (e *FeConvolveMatrixElement) WhiteSpace(whiteSpace String) *FeConvolveMatrixElement {
+	e.Attrs["white-space"] = whiteSpace
+	return e
+}
+
+// WordSpacing sets the word-spacing attribute.
+func (e *FeConvolveMatrixElement) WordSpacing(wordSpacing String) *FeConvolveMatrixElement {
+	e.Attrs["word-spacing"] = wordSpacing
+	return e
+}
+
+// WritingMode sets the writing-mode attribute.
+func (e *FeConvolveMatrixElement) WritingMode(writingMode String) *FeConvolveMatrixElement {
+	e.Attrs["writing-mode"] = writingMode
+	return e
+}
+
+// X sets the x attribute.
+func (e *FeConvolveMatrixElement) X(x Length) *FeConvolveMatrixElement {
+	e.Attrs["x"] = x
+	return e
+}
+
+// Y sets the y attribute.
+func (e *FeConvolveMatrixElement) Y(y Length) *FeConvolveMatrixElement {
+	e.Attrs["y"] = y
+	return e
+}
+
+// Width sets the width attribute.
+func (e *FeConvolveMatrixElement) Width(width Length) *FeConvolveMatrixElement {
+	e.Attrs["width"] = width
+	return e
+}
+
+// Height sets the height attribute.
+func (e *FeConvolveMatrixElement) Height(height Length) *FeConvolveMatrixElement {
+	e.Attrs["height"] = height
+	return e
+}
+
+// Result sets the result attribute.
+func (e *FeConvolveMatrixElement) Result(result String) *FeConvolveMatrixElement {
+	e.Attrs["result"] = result
+	return e
+}
+
+// In sets the in attribute.
+func (e *FeConvolveMatrixElement) In(_in String) *FeConvolveMatrixElement {
+	e.Attrs["in"] = _in
+	return e
+}
+
+// Order sets the order attribute.
+func (e *FeConvolveMatrixElement) Order(order Numbers) *FeConvolveMatrixElement {
+	e.Attrs["order"] = order
+	return e
+}
+
+// KernelMatrix sets the kernelMatrix attribute.
+func (e *FeConvolveMatrixElement) KernelMatrix(kernelMatrix Numbers) *FeConvolveMatrixElement {
+	e.Attrs["kernelMatrix"] = kernelMatrix
+	return e
+}
+
+// Divisor sets the divisor attribute.
+func (e *FeConvolveMatrixElement) Divisor(divisor Float64) *FeConvolveMatrixElement {
+	e.Attrs["divisor"] = divisor
+	return e
+}
+
+// Bias sets the bias attribute.
+func (e *FeConvolveMatrixElement) Bias(bias Float64) *FeConvolveMatrixElement {
+	e.Attrs["bias"] = bias
+	return e
+}
+
+// TargetX sets the targetX attribute.
+func (e *FeConvolveMatrixElement) TargetX(targetX Int) *FeConvolveMatrixElement {
+	e.Attrs["targetX"] = targetX
+	return e
+}
+
+// TargetY sets the targetY attribute.
+func (e *FeConvolveMatrixElement) TargetY(targetY Int) *FeConvolveMatrixElement {
+	e.Attrs["targetY"] = targetY
+	return e
+}
+
+// EdgeMode sets the edgeMode attribute.
+func (e *FeConvolveMatrixElement) EdgeMode(edgeMode String) *FeConvolveMatrixElement {
+	e.Attrs["edgeMode"] = edgeMode
+	return e
+}
+
+// KernelUnitLength sets the kernelUnitLength attribute.
+func (e *FeConvolveMatrixElement) KernelUnitLength(kernelUnitLength Numbers) *FeConvolveMatrixElement {
+	e.Attrs["kernelUnitLength"] = kernelUnitLength
+	return e
+}
+
+// PreserveAlpha sets the preserveAlpha attribute.
+func (e *FeConvolveMatrixElement) PreserveAlpha(preserveAlpha String) *FeConvolveMatrixElement {
+	e.Attrs["preserveAlpha"] = preserveAlpha
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *FeConvolveMatrixElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *FeConvolveMatrixElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *FeConvolveMatrixElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *FeConvolveMatrixElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *FeConvolveMatrixElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *FeConvolveMatrixElement) TagName() string {
+	return "feConvolveMatrix"
+}
+
+// decode decodes e from d.
+func (e *FeConvolveMatrixElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *FeConvolveMatrixElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "x":
+		return parseLength(value)
+	case "y":
+		return parseLength(value)
+	case "width":
+		return parseLength(value)
+	case "height":
+		return parseLength(value)
+	case "order":
+		return parseNumbers(value)
+	case "kernelMatrix":
+		return parseNumbers(value)
+	case "divisor":
+		return parseFloat64(value)
+	case "bias":
+		return parseFloat64(value)
+	case "targetX":
+		return parseInt(value)
+	case "targetY":
+		return parseInt(value)
+	case "kernelUnitLength":
+		return parseNumbers(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A FeDiffuseLightingElement is a feDiffuseLighting element.
+type FeDiffuseLightingElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// FeDiffuseLighting returns a new FeDiffuseLightingElement.
+func FeDiffuseLighting(children ...Element) *FeDiffuseLightingElement {
+	return &FeDiffuseLightingElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *FeDiffuseLightingElement) AppendChildren(children ...Element) *FeDiffuseLightingElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *FeDiffuseLightingElement) RemoveAttrs(names ...string) *FeDiffuseLightingElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *FeDiffuseLightingElement) ID(id String) *FeDiffuseLightingElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *FeDiffuseLightingElement) TabIndex(tabIndex Int) *FeDiffuseLightingElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *FeDiffuseLightingElement) Lang(lang String) *FeDiffuseLightingElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *FeDiffuseLightingElement) Class(class String) *FeDiffuseLightingElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *FeDiffuseLightingElement) Style(style String) *FeDiffuseLightingElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// AlignmentBaseline sets the alignment-baseline attribute.
+func (e *FeDiffuseLightingElement) AlignmentBaseline(alignmentBaseline String) *FeDiffuseLightingElement {
+	e.Attrs["alignment-baseline"] = alignmentBaseline
+	return e
+}
+
+// BaselineShift sets the baseline-shift attribute.
+func (e *FeDiffuseLightingElement) BaselineShift(baselineShift String) *FeDiffuseLightingElement {
+	e.Attrs["baseline-shift"] = baselineShift
+	return e
+}
+
+// ClipPath sets the clip-path attribute.
+func (e *FeDiffuseLightingElement) ClipPath(clipPath String) *FeDiffuseLightingElement {
+	e.Attrs["clip-path"] = clipPath
+	return e
+}
+
+// ClipRule sets the clip-rule attribute.
+func (e *FeDiffuseLightingElement) ClipRule(clipRule String) *FeDiffuseLightingElement {
+	e.Attrs["clip-rule"] = clipRule
+	return e
+}
+
+// Color sets the color attribute.
+func (e *FeDiffuseLightingElement) Color(color Color) *FeDiffuseLightingElement {
+	e.Attrs["color"] = color
+	return e
+}
+
+// ColorInterpolation sets the color-interpolation attribute.
+func (e *FeDiffuseLightingElement) ColorInterpolation(colorInterpolation String) *FeDiffuseLightingElement {
+	e.Attrs["color-interpolation"] = colorInterpolation
+	return e
+}
+
+// ColorInterpolationFilters sets the color-interpolation-filters attribute.
+func (e *FeDiffuseLightingElement) ColorInterpolationFilters(colorInterpolationFilters String) *FeDiffuseLightingElement {
+	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
+	return e
+}
+
+// ColorRendering sets the color-rendering attribute.
+func (e *FeDiffuseLightingElement) ColorRendering(colorRendering String) *FeDiffuseLightingElement {
+	e.Attrs["color-rendering"] = colorRendering
+	return e
+}
+
+// Cursor sets the cursor attribute.
+func (e *FeDiffuseLightingElement) Cursor(cursor String) *FeDiffuseLightingElement {
+	e.Attrs["cursor"] = cursor
+	return e
+}
+
+// Direction sets the direction attribute.
+func (e *FeDiffuseLightingElement) Direction(direction String) *FeDiffuseLightingElement {
+	e.Attrs["direction"] = direction
+	return e
+}
+
+// Display sets the display attribute.
+func (e *FeDiffuseLightingElement) Display(display String) *FeDiffuseLightingElement {
+	e.Attrs["display"] = display
+	return e
+}
+
+// DominantBaseline sets the dominant-baseline attribute.
+func (e *FeDiffuseLightingElement) DominantBaseline(dominantBaseline String) *FeDiffuseLightingElement {
+	e.Attrs["dominant-baseline"] = dominantBaseline
+	return e
+}
+
+// Fill sets the fill attribute.
+func (e *FeDiffuseLightingElement) Fill(fill String) *FeDiffuseLightingElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *FeDiffuseLightingElement) FillColor(fill Color) *FeDiffuseLightingElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *FeDiffuseLightingElement) FillPaint(fill Paint) *FeDiffuseLightingElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillOpacity sets the fill-opacity attribute.
+func (e *FeDiffuseLightingElement) FillOpacity(fillOpacity Float64) *FeDiffuseLightingElement {
+	e.Attrs["fill-opacity"] = fillOpacity
+	return e
+}
+
+// FillRule sets the fill-rule attribute.
+func (e *FeDiffuseLightingElement) FillRule(fillRule String) *FeDiffuseLightingElement {
+	e.Attrs["fill-rule"] = fillRule
+	return e
+}
+
+// Filter sets the filter attribute.
+func (e *FeDiffuseLightingElement) Filter(filter String) *FeDiffuseLightingElement {
+	e.Attrs["filter"] = filter
+	return e
+}
+
+// FloodColor sets the flood-color attribute.
+func (e *FeDiffuseLightingElement) FloodColor(floodColor Color) *FeDiffuseLightingElement {
+	e.Attrs["flood-color"] = floodColor
+	return e
+}
+
+// FloodOpacity sets the flood-opacity attribute.
+func (e *FeDiffuseLightingElement) FloodOpacity(floodOpacity Float64) *FeDiffuseLightingElement {
+	e.Attrs["flood-opacity"] = floodOpacity
+	return e
+}
+
+// FontFamily sets the font-family attribute.
+func (e *FeDiffuseLightingElement) FontFamily(fontFamily String) *FeDiffuseLightingElement {
+	e.Attrs["font-family"] = fontFamily
+	return e
+}
+
+// FontSize sets the font-size attribute.
+func (e *FeDiffuseLightingElement) FontSize(fontSize String) *FeDiffuseLightingElement {
+	e.Attrs["font-size"] = fontSize
+	return e
+}
+
+// FontSizeAdjust sets the font-size-adjust attribute.
+func (e *FeDiffuseLightingElement) FontSizeAdjust(fontSizeAdjust String) *FeDiffuseLightingElement {
+	e.Attrs["font-size-adjust"] = fontSizeAdjust
+	return e
+}
+
+// FontStretch sets the font-stretch attribute.
+func (e *FeDiffuseLightingElement) FontStretch(fontStretch String) *FeDiffuseLightingElement {
+	e.Attrs["font-stretch"] = fontStretch
+	return e
+}
+
+// FontStyle sets the font-style attribute.
+func (e *FeDiffuseLightingElement) FontStyle(fontStyle String) *FeDiffuseLightingElement {
+	e.Attrs["font-style"] = fontStyle
+	return e
+}
+
+// FontVariant sets the font-variant attribute.
+func (e *FeDiffuseLightingElement) FontVariant(fontVariant String) *FeDiffuseLightingElement {
+	e.Attrs["font-variant"] = fontVariant
+	return e
+}
+
+// FontWeight sets the font-weight attribute.
+func (e *FeDiffuseLightingElement) FontWeight(fontWeight String) *FeDiffuseLightingElement {
+	e.Attrs["font-weight"] = fontWeight
+	return e
+}
+
+// GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
+func (e *FeDiffuseLightingElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *FeDiffuseLightingElement {
+	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
+	return e
+}
+
+// GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
+func (e *FeDiffuseLightingElement) GlyphOrientationVertical(glyphOrientationVertical String) *FeDiffuseLightingElement {
+	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
+	return e
+}
+
+// ImageRendering sets the image-rendering attribute.
+func (e *FeDiffuseLightingElement) ImageRendering(imageRendering String) *FeDiffuseLightingElement {
+	e.Attrs["image-rendering"] = imageRendering
+	return e
+}
+
+// LetterSpacing sets the letter-spacing attribute.
+func (e *FeDiffuseLightingElement) LetterSpacing(letterSpacing String) *FeDiffuseLightingElement {
+	e.Attrs["letter-spacing"] = letterSpacing
+	return e
+}
+
+// LightingColor sets the lighting-color attribute.
+func (e *FeDiffuseLightingElement) LightingColor(lightingColor Color) *FeDiffuseLightingElement {
+	e.Attrs["lighting-color"] = lightingColor
+	return e
+}
+
+// MarkerEnd sets the marker-end attribute.
+func (e *FeDiffuseLightingElement) MarkerEnd(markerEnd String) *FeDiffuseLightingElement {
+	e.Attrs["marker-end"] = markerEnd
+	return e
+}
+
+// MarkerMid sets the marker-mid attribute.
+func (e *FeDiffuseLightingElement) MarkerMid(markerMid String) *FeDiffuseLightingElement {
+	e.Attrs["marker-mid"] = markerMid
+	return e
+}
+
+// MarkerStart sets the marker-start attribute.
+func (e *FeDiffuseLightingElement) MarkerStart(markerStart String) *FeDiffuseLightingElement {
+	e.Attrs["marker-start"] = markerStart
+	return e
+}
+
+// Mask sets the mask attribute.
+func (e *FeDiffuseLightingElement) Mask(mask String) *FeDiffuseLightingElement {
+	e.Attrs["mask"] = mask
+	return e
+}
+
+// Opacity sets the opacity attribute.
+func (e *FeDiffuseLightingElement) Opacity(opacity Float64) *FeDiffuseLightingElement {
+	e.Attrs["opacity"] = opacity
+	return e
+}
+
+// Overflow sets the overflow attribute.
+func (e *FeDiffuseLightingElement) Overflow(overflow String) *FeDiffuseLightingElement {
+	e.Attrs["overflow"] = overflow
+	return e
+}
+
+// PaintOrder sets the paint-order attribute.
+func (e *FeDiffuseLightingElement) PaintOrder(paintOrder String) *FeDiffuseLightingElement {
+	e.Attrs["paint-order"] = paintOrder
+	return e
+}
+
+// PointerEvents sets the pointer-events attribute.
+func (e *FeDiffuseLightingElement) PointerEvents(pointerEvents String) *FeDiffuseLightingElement {
+	e.Attrs["pointer-events"] = pointerEvents
+	return e
+}
+
+// ShapeRendering sets the shape-rendering attribute.
+func (e *FeDiffuseLightingElement) ShapeRendering(shapeRendering String) *FeDiffuseLightingElement {
+	e.Attrs["shape-rendering"] = shapeRendering
+	return e
+}
+
+// StopColor sets the stop-color attribute.
+func (e *FeDiffuseLightingElement) StopColor(stopColor Color) *FeDiffuseLightingElement {
+	e.Attrs["stop-color"] = stopColor
+	return e
+}
+
+// StopOpacity sets the stop-opacity attribute.
+func (e *FeDiffuseLightingElement) StopOpacity(stopOpacity Float64) *FeDiffuseLightingElement {
+	e.Attrs["stop-opacity"] = stopOpacity
+	return e
+}
+
+// Stroke sets the stroke attribute.
+func (e *FeDiffuseLightingElement) Stroke(stroke String) *FeDiffuseLightingElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *FeDiffuseLightingElement) StrokeColor(stroke Color) *FeDiffuseLightingElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *FeDiffuseLightingElement) StrokePaint(stroke Paint) *FeDiffuseLightingElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeDashArray sets the stroke-dasharray attribute.
+func (e *FeDiffuseLightingElement) StrokeDashArray(strokeDashArray String) *FeDiffuseLightingElement {
+	e.Attrs["stroke-dasharray"] = strokeDashArray
+	return e
+}
+
+// StrokeDashOffset sets the stroke-dashoffset attribute.
+func (e *FeDiffuseLightingElement) StrokeDashOffset(strokeDashOffset Float64) *FeDiffuseLightingElement {
+	e.Attrs["stroke-dashoffset"] = strokeDashOffset
+	return e
+}
+
+// StrokeLineCap sets the stroke-linecap attribute.
+func (e *FeDiffuseLightingElement) StrokeLineCap(strokeLineCap String) *FeDiffuseLightingElement {
+	e.Attrs["stroke-linecap"] = strokeLineCap
+	return e
+}
+
+// StrokeLineJoin sets the stroke-linejoin attribute.
+func (e *FeDiffuseLightingElement) StrokeLineJoin(strokeLineJoin String) *FeDiffuseLightingElement {
+	e.Attrs["stroke-linejoin"] = strokeLineJoin
+	return e
+}
+
+// StrokeMiterLimit sets the stroke-miterlimit attribute.
+func (e *FeDiffuseLightingElement) StrokeMiterLimit(strokeMiterLimit Float64) *FeDiffuseLightingElement {
+	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
+	return e
+}
+
+// StrokeOpacity sets the stroke-opacity attribute.
+func (e *FeDiffuseLightingElement) StrokeOpacity(strokeOpacity Float64) *FeDiffuseLightingElement {
+	e.Attrs["stroke-opacity"] = strokeOpacity
+	return e
+}
+
+// StrokeWidth sets the stroke-width attribute.
+func (e *FeDiffuseLightingElement) StrokeWidth(strokeWidth Length) *FeDiffuseLightingElement {
+	e.Attrs["stroke-width"] = strokeWidth
+	return e
+}
+
+// TextAnchor sets the text-anchor attribute.
+func (e *FeDiffuseLightingElement) TextAnchor(textAnchor String) *FeDiffuseLightingElement {
+	e.Attrs["text-anchor"] = textAnchor
+	return e
+}
+
+// TextDecoration sets the text-decoration attribute.
+func (e *FeDiffuseLightingElement) TextDecoration(textDecoration String) *FeDiffuseLightingElement {
+	e.Attrs["text-decoration"] = textDecoration
+	return e
+}
+
+// TextOverflow sets the text-overflow attribute.
+func (e *FeDiffuseLightingElement) TextOverflow(textOverflow String) *FeDiffuseLightingElement {
+	e.Attrs["text-overflow"] = textOverflow
+	return e
+}
+
+// TextRendering sets the text-rendering attribute.
+func (e *FeDiffuseLightingElement) TextRendering(textRendering String) *FeDiffuseLightingElement {
+	e.Attrs["text-rendering"] = textRendering
+	return e
+}
+
+// Transform sets the transform attribute.
+func (e *FeDiffuseLightingElement) Transform(transform Transform) *FeDiffuseLightingElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
+// UnicodeBiDi sets the unicode-bidi attribute.
+func (e *FeDiffuseLightingElement) UnicodeBiDi(unicodeBiDi String) *FeDiffuseLightingElement {
+	e.Attrs["unicode-bidi"] = unicodeBiDi
+	return e
+}
+
+// VectorEffect sets the vector-effect attribute.
+func (e *FeDiffuseLightingElement) VectorEffect(vectorEffect String) *FeDiffuseLightingElement {
+	e.Attrs["vector-effect"] = vectorEffect
+	return e
+}
+
+// Visibility sets the visibility attribute.
+func (e *FeDiffuseLightingElement) Visibility(visibility String) *FeDiffuseLightingElement {
+	e.Attrs["visibility"] = visibility
+	return e
+}
+
+// WhiteSpace sets the white-space attribute.
+func (e *FeDiffuseLightingElement) WhiteSpace(whiteSpace String) *FeDiffuseLightingElement {
+	e.Attrs["white-space"] = whiteSpace
+	return e
+}
+
+// WordSpacing sets the word-spacing attribute.
+func (e *FeDiffuseLightingElement) WordSpacing(wordSpacing String) *FeDiffuseLightingElement {
+	e.Attrs["word-spacing"] = wordSpacing
+	return e
+}
+
+// WritingMode sets the writing-mode attribute.
+func (e *FeDiffuseLightingElement) WritingMode(writingMode String) *FeDiffuseLightingElement {
+	e.Attrs["writing-mode"] = writingMode
+	return e
+}
+
+// X sets the x attribute.
+func (e *FeDiffuseLightingElement) X(x Length) *FeDiffuseLightingElement {
+	e.Attrs["x"] = x
+	return e
+}
+
+// Y sets the y attribute.
+func (e *FeDiffuseLightingElement) Y(y Length) *FeDiffuseLightingElement {
+	e.Attrs["y"] = y
+	return e
+}
+
+// Width sets the width attribute.
+func (e *FeDiffuseLightingElement) Width(width Length) *FeDiffuseLightingElement {
+	e.Attrs["width"] = width
+	return e
+}
+
+// Height sets the height attribute.
+func (e *FeDiffuseLightingElement) Height(height Length) *FeDiffuseLightingElement {
+	e.Attrs["height"] = height
+	return e
+}
+
+// Result sets the result attribute.
+func (e *FeDiffuseLightingElement) Result(result String) *FeDiffuseLightingElement {
+	e.Attrs["result"] = result
+	return e
+}
+
+// In sets the in attribute.
+func (e *FeDiffuseLightingElement) In(_in String) *FeDiffuseLightingElement {
+	e.Attrs["in"] = _in
+	return e
+}
+
+// SurfaceScale sets the surfaceScale attribute.
+func (e *FeDiffuseLightingElement) SurfaceScale(surfaceScale Float64) *FeDiffuseLightingElement {
+	e.Attrs["surfaceScale"] = surfaceScale
+	return e
+}
+
+// DiffuseConstant sets the diffuseConstant attribute.
+func (e *FeDiffuseLightingElement) DiffuseConstant(diffuseConstant Float64) *FeDiffuseLightingElement {
+	e.Attrs["diffuseConstant"] = diffuseConstant
+	return e
+}
+
+// KernelUnitLength sets the kernelUnitLength attribute.
+func (e *FeDiffuseLightingElement) KernelUnitLength(kernelUnitLength Numbers) *FeDiffuseLightingElement {
+	e.Attrs["kernelUnitLength"] = kernelUnitLength
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *FeDiffuseLightingElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *FeDiffuseLightingElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *FeDiffuseLightingElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *FeDiffuseLightingElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *FeDiffuseLightingElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *FeDiffuseLightingElement) TagName() string {
+	return "feDiffuseLighting"
+}
+
+// decode decodes e from d.
+func (e *FeDiffuseLightingElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *FeDiffuseLightingElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "x":
+		return parseLength(value)
+	case "y":
+		return parseLength(value)
+	case "width":
+		return parseLength(value)
+	case "height":
+		return parseLength(value)
+	case "surfaceScale":
+		return parseFloat64(value)
+	case "diffuseConstant":
+		return parseFloat64(value)
+	case "kernelUnitLength":
+		return parseNumbers(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A FeDisplacementMapElement is a feDisplacementMap element.
+type FeDisplacementMapElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// FeDisplacementMap returns a new FeDisplacementMapElement.
+func FeDisplacementMap(children ...Element) *FeDisplacementMapElement {
+	return &FeDisplacementMapElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *FeDisplacementMapElement) AppendChildren(children ...Element) *FeDisplacementMapElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *FeDisplacementMapElement) RemoveAttrs(names ...string) *FeDisplacementMapElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *FeDisplacementMapElement) ID(id String) *FeDisplacementMapElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *FeDisplacementMapElement) TabIndex(tabIndex Int) *FeDisplacementMapElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *FeDisplacementMapElement) Lang(lang String) *FeDisplacementMapElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *FeDisplacementMapElement) Class(class String) *FeDisplacementMapElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *FeDisplacementMapElement) Style(style String) *FeDisplacementMapElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// AlignmentBaseline sets the alignment-baseline attribute.
+func (e *FeDisplacementMapElement) AlignmentBaseline(alignmentBaseline String) *FeDisplacementMapElement {
+	e.Attrs["alignment-baseline"] = alignmentBaseline
+	return e
+}
+
+// BaselineShift sets the baseline-shift attribute.
+func (e *FeDisplacementMapElement) BaselineShift(baselineShift String) *FeDisplacementMapElement {
+	e.Attrs["baseline-shift"] = baselineShift
+	return e
+}
+
+// ClipPath sets the clip-path attribute.
+func (e *FeDisplacementMapElement) ClipPath(clipPath String) *FeDisplacementMapElement {
+	e.Attrs["clip-path"] = clipPath
+	return e
+}
+
+// ClipRule sets the clip-rule attribute.
+func (e *FeDisplacementMapElement) ClipRule(clipRule String) *FeDisplacementMapElement {
+	e.Attrs["clip-rule"] = clipRule
+	return e
+}
+
+// Color sets the color attribute.
+func (e *FeDisplacementMapElement) Color(color Color) *FeDisplacementMapElement {
+	e.Attrs["color"] = color
+	return e
+}
+
+// ColorInterpolation sets the color-interpolation attribute.
+func (e *FeDisplacementMapElement) ColorInterpolation(colorInterpolation String) *FeDisplacementMapElement {
+	e.Attrs["color-interpolation"] = colorInterpolation
+	return e
+}
+
+// ColorInterpolationFilters sets the color-interpolation-filters attribute.
+func (e *FeDisplacementMapElement) ColorInterpolationFilters(colorInterpolationFilters String) *FeDisplacementMapElement {
+	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
+	return e
+}
+
+// ColorRendering sets the color-rendering attribute.
+func (e *FeDisplacementMapElement) ColorRendering(colorRendering String) *FeDisplacementMapElement {
+	e.Attrs["color-rendering"] = colorRendering
+	return e
+}
+
+// Cursor sets the cursor attribute.
+func (e *FeDisplacementMapElement) Cursor(cursor String) *FeDisplacementMapElement {
+	e.Attrs["cursor"] = cursor
+	return e
+}
+
+// Direction sets the direction attribute.
+func (e *FeDisplacementMapElement) Direction(direction String) *FeDisplacementMapElement {
+	e.Attrs["direction"] = direction
+	return e
+}
+
+// Display sets the display attribute.
+func (e *FeDisplacementMapElement) Display(display String) *FeDisplacementMapElement {
+	e.Attrs["display"] = display
+	return e
+}
+
+// DominantBaseline sets the dominant-baseline attribute.
+func (e *FeDisplacementMapElement) DominantBaseline(dominantBaseline String) *FeDisplacementMapElement {
+	e.Attrs["dominant-baseline"] = dominantBaseline
+	return e
+}
+
+// Fill sets the fill attribute.
+func (e *FeDisplacementMapElement) Fill(fill String) *FeDisplacementMapElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *FeDisplacementMapElement) FillColor(fill Color) *FeDisplacementMapElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *FeDisplacementMapElement) FillPaint(fill Paint) *FeDisplacementMapElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillOpacity sets the fill-opacity attribute.
+func (e *FeDisplacementMapElement) FillOpacity(fillOpacity Float64) *FeDisplacementMapElement {
+	e.Attrs["fill-opacity"] = fillOpacity
+	return e
+}
+
+// FillRule sets the fill-rule attribute.
+func (e *FeDisplacementMapElement) FillRule(fillRule String) *FeDisplacementMapElement {
+	e.Attrs["fill-rule"] = fillRule
+	return e
+}
+
+// Filter sets the filter attribute.
+func (e *FeDisplacementMapElement) Filter(filter String) *FeDisplacementMapElement {
+	e.Attrs["filter"] = filter
+	return e
+}
+
+// FloodColor sets the flood-color attribute.
+func (e *FeDisplacementMapElement) FloodColor(floodColor Color) *FeDisplacementMapElement {
+	e.Attrs["flood-color"] = floodColor
+	return e
+}
+
+// FloodOpacity sets the flood-opacity attribute.
+func (e *FeDisplacementMapElement) FloodOpacity(floodOpacity Float64) *FeDisplacementMapElement {
+	e.Attrs["flood-opacity"] = floodOpacity
+	return e
+}
+
+// FontFamily sets the font-family attribute.
+func (e *FeDisplacementMapElement) FontFamily(fontFamily String) *FeDisplacementMapElement {
+	e.Attrs["font-family"] = fontFamily
+	return e
+}
+
+// FontSize sets the font-size attribute.
+func (e *FeDisplacementMapElement) FontSize(fontSize String) *FeDisplacementMapElement {
+	e.Attrs["font-size"] = fontSize
+	return e
+}
+
+// FontSizeAdjust sets the font-size-adjust attribute.
+func (e *FeDisplacementMapElement) FontSizeAdjust(fontSizeAdjust String) *FeDisplacementMapElement {
+	e.Attrs["font-size-adjust"] = fontSizeAdjust
+	return e
+}
+
+// FontStretch sets the font-stretch attribute.
+func (e *FeDisplacementMapElement) FontStretch(fontStretch String) *FeDisplacementMapElement {
+	e.Attrs["font-stretch"] = fontStretch
+	return e
+}
+
+// FontStyle sets the font-style attribute.
+func (e *FeDisplacementMapElement) FontStyle(fontStyle String) *FeDisplacementMapElement {
+	e.Attrs["font-style"] = fontStyle
+	return e
+}
+
+// FontVariant sets the font-variant attribute.
+func (e *FeDisplacementMapElement) FontVariant(fontVariant String) *FeDisplacementMapElement {
+	e.Attrs["font-variant"] = fontVariant
+	return e
+}
+
+// FontWeight sets the font-weight attribute.
+func (e *FeDisplacementMapElement) FontWeight(fontWeight String) *FeDisplacementMapElement {
+	e.Attrs["font-weight"] = fontWeight
+	return e
+}
+
+// GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
+func (e *FeDisplacementMapElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *FeDisplacementMapElement {
+	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
+	return e
+}
+
+// GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
+func (e *FeDisplacementMapElement) GlyphOrientationVertical(glyphOrientationVertical String) *FeDisplacementMapElement {
+	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
+	return e
+}
+
+// ImageRendering sets the image-rendering attribute.
+func (e *FeDisplacementMapElement) ImageRendering(imageRendering String) *FeDisplacementMapElement {
+	e.Attrs["image-rendering"] = imageRendering
+	return e
+}
+
+// LetterSpacing sets the letter-spacing attribute.
+func (e *FeDisplacementMapElement) LetterSpacing(letterSpacing String) *FeDisplacementMapElement {
+	e.Attrs["letter-spacing"] = letterSpacing
+	return e
+}
+
+// LightingColor sets the lighting-color attribute.
+func (e *FeDisplacementMapElement) LightingColor(lightingColor Color) *FeDisplacementMapElement {
+	e.Attrs["lighting-color"] = lightingColor
+	return e
+}
+
+// MarkerEnd sets the marker-end attribute.
+func (e *FeDisplacementMapElement) MarkerEnd(markerEnd String) *FeDisplacementMapElement {
+	e.Attrs["marker-end"] = markerEnd
+	return e
+}
+
+// MarkerMid sets the marker-mid attribute.
+func (e *FeDisplacementMapElement) MarkerMid(markerMid String) *FeDisplacementMapElement {
+	e.Attrs["marker-mid"] = markerMid
+	return e
+}
+
+// MarkerStart sets the marker-start attribute.
+func (e *FeDisplacementMapElement) MarkerStart(markerStart String) *FeDisplacementMapElement {
+	e.Attrs["marker-start"] = markerStart
+	return e
+}
+
+// Mask sets the mask attribute.
+func (e *FeDisplacementMapElement) Mask(mask String) *FeDisplacementMapElement {
+	e.Attrs["mask"] = mask
+	return e
+}
+
+// Opacity sets the opacity attribute.
+func (e *FeDisplacementMapElement) Opacity(opacity Float64) *FeDisplacementMapElement {
+	e.Attrs["opacity"] = opacity
+	return e
+}
+
+// Overflow sets the overflow attribute.
+func (e *FeDisplacementMapElement) Overflow(overflow String) *FeDisplacementMapElement {
+	e.Attrs["overflow"] = overflow
+	return e
+}
+
+// PaintOrder sets the paint-order attribute.
+func (e *FeDisplacementMapElement) PaintOrder(paintOrder String) *FeDisplacementMapElement {
+	e.Attrs["paint-order"] = paintOrder
+	return e
+}
+
+// PointerEvents sets the pointer-events attribute.
+func (e *FeDisplacementMapElement) PointerEvents(pointerEvents String) *FeDisplacementMapElement {
+	e.Attrs["pointer-events"] = pointerEvents
+	return e
+}
+
+// ShapeRendering sets the shape-rendering attribute.
+func (e *FeDisplacementMapElement) ShapeRendering(shapeRendering String) *FeDisplacementMapElement {
+	e.Attrs["shape-rendering"] = shapeRendering
+	return e
+}
+
+// StopColor sets the stop-color attribute.
+func (e *FeDisplacementMapElement) StopColor(stopColor Color) *FeDisplacementMapElement {
+	e.Attrs["stop-color"] = stopColor
+	return e
+}
+
+// StopOpacity sets the stop-opacity attribute.
+func (e *FeDisplacementMapElement) StopOpacity(stopOpacity Float64) *FeDisplacementMapElement {
+	e.Attrs["stop-opacity"] = stopOpacity
+	return e
+}
+
+// Stroke sets the stroke attribute.
+func (e *FeDisplacementMapElement) Stroke(stroke String) *FeDisplacementMapElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *FeDisplacementMapElement) StrokeColor(stroke Color) *FeDisplacementMapElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *FeDisplacementMapElement) StrokePaint(stroke Paint) *FeDisplacementMapElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeDashArray sets the stroke-dasharray attribute.
+func (e *FeDisplacementMapElement) StrokeDashArray(strokeDashArray String) *FeDisplacementMapElement {
+	e.Attrs["stroke-dasharray"] = strokeDashArray
+	return e
+}
+
+// StrokeDashOffset sets the stroke-dashoffset attribute.
+func (e *FeDisplacementMapElement) StrokeDashOffset(strokeDashOffset Float64) *FeDisplacementMapElement {
+	e.Attrs["stroke-dashoffset"] = strokeDashOffset
+	return e
+}
+
+// StrokeLineCap sets the stroke-linecap attribute.
+func (e *FeDisplacementMapElement) StrokeLineCap(strokeLineCap String) *FeDisplacementMapElement {
+	e.Attrs["stroke-linecap"] = strokeLineCap
+	return e
+}
+
+// StrokeLineJoin sets the stroke-linejoin attribute.
+func (e *FeDisplacementMapElement) StrokeLineJoin(strokeLineJoin String) *FeDisplacementMapElement {
+	e.Attrs["stroke-linejoin"] = strokeLineJoin
+	return e
+}
+
+// StrokeMiterLimit sets the stroke-miterlimit attribute.
+func (e *FeDisplacementMapElement) StrokeMiterLimit(strokeMiterLimit Float64) *FeDisplacementMapElement {
+	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
+	return e
+}
+
+// StrokeOpacity sets the stroke-opacity attribute.
+func (e *FeDisplacementMapElement) StrokeOpacity(strokeOpacity Float64) *FeDisplacementMapElement {
+	e.Attrs["stroke-opacity"] = strokeOpacity
+	return e
+}
+
+// StrokeWidth sets the stroke-width attribute.
+func (e *FeDisplacementMapElement) StrokeWidth(strokeWidth Length) *FeDisplacementMapElement {
+	e.Attrs["stroke-width"] = strokeWidth
+	return e
+}
+
+// TextAnchor sets the text-anchor attribute.
+func (e *FeDisplacementMapElement) TextAnchor(textAnchor String) *FeDisplacementMapElement {
+	e.Attrs["text-anchor"] = textAnchor
+	return e
+}
+
+// TextDecoration sets the text-decoration attribute.
+func (e *FeDisplacementMapElement) TextDecoration(textDecoration String) *FeDisplacementMapElement {
+	e.Attrs["text-decoration"] = textDecoration
+	return e
+}
+
+// TextOverflow sets the text-overflow attribute.
+func (e *FeDisplacementMapElement) TextOverflow(textOverflow String) *FeDisplacementMapElement {
+	e.Attrs["text-overflow"] = textOverflow
+	return e
+}
+
+// TextRendering sets the text-rendering attribute.
+func (e *FeDisplacementMapElement) TextRendering(textRendering String) *FeDisplacementMapElement {
+	e.Attrs["text-rendering"] = textRendering
+	return e
+}
+
+// Transform sets the transform attribute.
+func (e *FeDisplacementMapElement) Transform(transform Transform) *FeDisplacementMapElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
+// UnicodeBiDi sets the unicode-bidi attribute.
+func (e *FeDisplacementMapElement) UnicodeBiDi(unicodeBiDi String) *FeDisplacementMapElement {
+	e.Attrs["unicode-bidi"] = unicodeBiDi
+	return e
+}
+
+// VectorEffect sets the vector-effect attribute.
+func (e *FeDisplacementMapElement) VectorEffect(vectorEffect String) *FeDisplacementMapElement {
+	e.Attrs["vector-effect"] = vectorEffect
+	return e
+}
+
+// Visibility sets the visibility attribute.
+func (e *FeDisplacementMapElement) Visibility(visibility String) *FeDisplacementMapElement {
+	e.Attrs["visibility"] = visibility
+	return e
+}
+
+// WhiteSpace sets the white-space attribute.
+func (e *FeDisplacementMapElement) WhiteSpace(whiteSpace String) *FeDisplacementMapElement {
+	e.Attrs["white-space"] = whiteSpace
+	return e
+}
+
+// WordSpacing sets the word-spacing attribute.
+func (e *FeDisplacementMapElement) WordSpacing(wordSpacing String) *FeDisplacementMapElement {
+	e.Attrs["word-spacing"] = wordSpacing
+	return e
+}
+
+// WritingMode sets the writing-mode attribute.
+func (e *FeDisplacementMapElement) WritingMode(writingMode String) *FeDisplacementMapElement {
+	e.Attrs["writing-mode"] = writingMode
+	return e
+}
+
+// X sets the x attribute.
+func (e *FeDisplacementMapElement) X(x Length) *FeDisplacementMapElement {
+	e.Attrs["x"] = x
+	return e
+}
+
+// Y sets the y attribute.
+func (e *FeDisplacementMapElement) Y(y Length) *FeDisplacementMapElement {
+	e.Attrs["y"] = y
+	return e
+}
+
+// Width sets the width attribute.
+func (e *FeDisplacementMapElement) Width(width Length) *FeDisplacementMapElement {
+	e.Attrs["width"] = width
+	return e
+}
+
+// Height sets the height attribute.
+func (e *FeDisplacementMapElement) Height(height Length) *FeDisplacementMapElement {
+	e.Attrs["height"] = height
+	return e
+}
+
+// Result sets the result attribute.
+func (e *FeDisplacementMapElement) Result(result String) *FeDisplacementMapElement {
+	e.Attrs["result"] = result
+	return e
+}
+
+// In sets the in attribute.
+func (e *FeDisplacementMapElement) In(_in String) *FeDisplacementMapElement {
+	e.Attrs["in"] = _in
+	return e
+}
+
+// In2 sets the in2 attribute.
+func (e *FeDisplacementMapElement) In2(in2 String) *FeDisplacementMapElement {
+	e.Attrs["in2"] = in2
+	return e
+}
+
+// Scale sets the scale attribute.
+func (e *FeDisplacementMapElement) Scale(scale Float64) *FeDisplacementMapElement {
+	e.Attrs["scale"] = scale
+	return e
+}
+
+// XChannelSelector sets the xChannelSelector attribute.
+func (e *FeDisplacementMapElement) XChannelSelector(xChannelSelector String) *FeDisplacementMapElement {
+	e.Attrs["xChannelSelector"] = xChannelSelector
+	return e
+}
+
+// YChannelSelector sets the yChannelSelector attribute.
+func (e *FeDisplacementMapElement) YChannelSelector(yChannelSelector String) *FeDisplacementMapElement {
+	e.Attrs["yChannelSelector"] = yChannelSelector
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *FeDisplacementMapElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *FeDisplacementMapElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *FeDisplacementMapElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *FeDisplacementMapElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *FeDisplacementMapElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *FeDisplacementMapElement) TagName() string {
+	return "feDisplacementMap"
+}
+
+// decode decodes e from d.
+func (e *FeDisplacementMapElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *FeDisplacementMapElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "x":
+		return parseLength(value)
+	case "y":
+		return parseLength(value)
+	case "width":
+		return parseLength(value)
+	case "height":
+		return parseLength(value)
+	case "scale":
+		return parseFloat64(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A FeDistantLightElement is a feDistantLight element.
+type FeDistantLightElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// FeDistantLight returns a new FeDistantLightElement.
+func FeDistantLight(children ...Element) *FeDistantLightElement {
+	return &FeDistantLightElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *FeDistantLightElement) AppendChildren(children ...Element) *FeDistantLightElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *FeDistantLightElement) RemoveAttrs(names ...string) *FeDistantLightElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *FeDistantLightElement) ID(id String) *FeDistantLightElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *FeDistantLightElement) TabIndex(tabIndex Int) *FeDistantLightElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *FeDistantLightElement) Lang(lang String) *FeDistantLightElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *FeDistantLightElement) Class(class String) *FeDistantLightElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *FeDistantLightElement) Style(style String) *FeDistantLightElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// Azimuth sets the azimuth attribute.
+func (e *FeDistantLightElement) Azimuth(azimuth Float64) *FeDistantLightElement {
+	e.Attrs["azimuth"] = azimuth
+	return e
+}
+
+// Elevation sets the elevation attribute.
+func (e *FeDistantLightElement) Elevation(elevation Float64) *FeDistantLightElement {
+	e.Attrs["elevation"] = elevation
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *FeDistantLightElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *FeDistantLightElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *FeDistantLightElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *FeDistantLightElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *FeDistantLightElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *FeDistantLightElement) TagName() string {
+	return "feDistantLight"
+}
+
+// decode decodes e from d.
+func (e *FeDistantLightElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *FeDistantLightElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "azimuth":
+		return parseFloat64(value)
+	case "elevation":
+		return parseFloat64(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A FeDropShadowElement is a feDropShadow element.
+type FeDropShadowElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// FeDropShadow returns a new FeDropShadowElement.
+func FeDropShadow(children ...Element) *FeDropShadowElement {
+	return &FeDropShadowElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *FeDropShadowElement) AppendChildren(children ...Element) *FeDropShadowElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *FeDropShadowElement) RemoveAttrs(names ...string) *FeDropShadowElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *FeDropShadowElement) ID(id String) *FeDropShadowElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *FeDropShadowElement) TabIndex(tabIndex Int) *FeDropShadowElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *FeDropShadowElement) Lang(lang String) *FeDropShadowElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *FeDropShadowElement) Class(class String) *FeDropShadowElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *FeDropShadowElement) Style(style String) *FeDropShadowElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// AlignmentBaseline sets the alignment-baseline attribute.
+func (e *FeDropShadowElement) AlignmentBaseline(alignmentBaseline String) *FeDropShadowElement {
+	e.Attrs["alignment-baseline"] = alignmentBaseline
+	return e
+}
+
+// BaselineShift sets the baseline-shift attribute.
+func (e *FeDropShadowElement) BaselineShift(baselineShift String) *FeDropShadowElement {
+	e.Attrs["baseline-shift"] = baselineShift
+	return e
+}
+
+// ClipPath sets the clip-path attribute.
+func (e *FeDropShadowElement) ClipPath(clipPath String) *FeDropShadowElement {
+	e.Attrs["clip-path"] = clipPath
+	return e
+}
+
+// ClipRule sets the clip-rule attribute.
+func (e *FeDropShadowElement) ClipRule(clipRule String) *FeDropShadowElement {
+	e.Attrs["clip-rule"] = clipRule
+	return e
+}
+
+// Color sets the color attribute.
+func (e *FeDropShadowElement) Color(color Color) *FeDropShadowElement {
+	e.Attrs["color"] = color
+	return e
+}
+
+// ColorInterpolation sets the color-interpolation attribute.
+func (e *FeDropShadowElement) ColorInterpolation(colorInterpolation String) *FeDropShadowElement {
+	e.Attrs["color-interpolation"] = colorInterpolation
+	return e
+}
+
+// ColorInterpolationFilters sets the color-interpolation-filters attribute.
+func (e *FeDropShadowElement) ColorInterpolationFilters(colorInterpolationFilters String) *FeDropShadowElement {
+	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
+	return e
+}
+
+// ColorRendering sets the color-rendering attribute.
+func (e *FeDropShadowElement) ColorRendering(colorRendering String) *FeDropShadowElement {
+	e.Attrs["color-rendering"] = colorRendering
+	return e
+}
+
+// Cursor sets the cursor attribute.
+func (e *FeDropShadowElement) Cursor(cursor String) *FeDropShadowElement {
+	e.Attrs["cursor"] = cursor
+	return e
+}
+
+// Direction sets the direction attribute.
+func (e *FeDropShadowElement) Direction(direction String) *FeDropShadowElement {
+	e.Attrs["direction"] = direction
+	return e
+}
+
+// Display sets the display attribute.
+func (e *FeDropShadowElement) Display(display String) *FeDropShadowElement {
+	e.Attrs["display"] = display
+	return e
+}
+
+// DominantBaseline sets the dominant-baseline attribute.
+func (e *FeDropShadowElement) DominantBaseline(dominantBaseline String) *FeDropShadowElement {
+	e.Attrs["dominant-baseline"] = dominantBaseline
+	return e
+}
+
+// Fill sets the fill attribute.
+func (e *FeDropShadowElement) Fill(fill String) *FeDropShadowElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *FeDropShadowElement) FillColor(fill Color) *FeDropShadowElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *FeDropShadowElement) FillPaint(fill Paint) *FeDropShadowElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillOpacity sets the fill-opacity attribute.
+func (e *FeDropShadowElement) FillOpacity(fillOpacity Float64) *FeDropShadowElement {
+	e.Attrs["fill-opacity"] = fillOpacity
+	return e
+}
+
+// FillRule sets the fill-rule attribute.
+func (e *FeDropShadowElement) FillRule(fillRule String) *FeDropShadowElement {
+	e.Attrs["fill-rule"] = fillRule
+	return e
+}
+
+// Filter sets the filter attribute.
+func (e *FeDropShadowElement) Filter(filter String) *FeDropShadowElement {
+	e.Attrs["filter"] = filter
+	return e
+}
+
+// FloodColor sets the flood-color attribute.
+func (e *FeDropShadowElement) FloodColor(floodColor Color) *FeDropShadowElement {
+	e.Attrs["flood-color"] = floodColor
+	return e
+}
+
+// FloodOpacity sets the flood-opacity attribute.
+func (e *FeDropShadowElement) FloodOpacity(floodOpacity Float64) *FeDropShadowElement {
+	e.Attrs["flood-opacity"] = floodOpacity
+	return e
+}
+
+// FontFamily sets the font-family attribute.
+func (e *FeDropShadowElement) FontFamily(fontFamily String) *FeDropShadowElement {
+	e.Attrs["font-family"] = fontFamily
+	return e
+}
+
+// FontSize sets the font-size attribute.
+func (e *FeDropShadowElement) FontSize(fontSize String) *FeDropShadowElement {
+	e.Attrs["font-size"] = fontSize
+	return e
+}
+
+// FontSizeAdjust sets the font-size-adjust attribute.
+func (e *FeDropShadowElement) FontSizeAdjust(fontSizeAdjust String) *FeDropShadowElement {
+	e.Attrs["font-size-adjust"] = fontSizeAdjust
+	return e
+}
+
+// FontStretch sets the font-stretch attribute.
+func (e *FeDropShadowElement) FontStretch(fontStretch String) *FeDropShadowElement {
+	e.Attrs["font-stretch"] = fontStretch
+	return e
+}
+
+// FontStyle sets the font-style attribute.
+func (e *FeDropShadowElement) FontStyle(fontStyle String) *FeDropShadowElement {
+	e.Attrs["font-style"] = fontStyle
+	return e
+}
+
+// FontVariant sets the font-variant attribute.
+func (e *FeDropShadowElement) FontVariant(fontVariant String) *FeDropShadowElement {
+	e.Attrs["font-variant"] = fontVariant
+	return e
+}
+
+// FontWeight sets the font-weight attribute.
+func (e *FeDropShadowElement) FontWeight(fontWeight String) *FeDropShadowElement {
+	e.Attrs["font-weight"] = fontWeight
+	return e
+}
+
+// GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
+func (e *FeDropShadowElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *FeDropShadowElement {
+	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
+	return e
+}
+
+// GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
+func (e *FeDropShadowElement) GlyphOrientationVertical(glyphOrientationVertical String) *FeDropShadowElement {
+	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
+	return e
+}
+
+// ImageRendering sets the image-rendering attribute.
+func (e *FeDropShadowElement) ImageRendering(imageRendering String) *FeDropShadowElement {
+	e.Attrs["image-rendering"] = imageRendering
+	return e
+}
+
+// LetterSpacing sets the letter-spacing attribute.
+func (e *FeDropShadowElement) LetterSpacing(letterSpacing String) *FeDropShadowElement {
+	e.Attrs["letter-spacing"] = letterSpacing
+	return e
+}
+
+// LightingColor sets the lighting-color attribute.
+func (e *FeDropShadowElement) LightingColor(lightingColor Color) *FeDropShadowElement {
+	e.Attrs["lighting-color"] = lightingColor
+	return e
+}
+
+// MarkerEnd sets the marker-end attribute.
+func (e *FeDropShadowElement) MarkerEnd(markerEnd String) *FeDropShadowElement {
+	e.Attrs["marker-end"] = markerEnd
+	return e
+}
+
+// MarkerMid sets the marker-mid attribute.
+func (e *FeDropShadowElement) MarkerMid(markerMid String) *FeDropShadowElement {
+	e.Attrs["marker-mid"] = markerMid
+	return e
+}
+
+// MarkerStart sets the marker-start attribute.
+func (e *FeDropShadowElement) MarkerStart(markerStart String) *FeDropShadowElement {
+	e.Attrs["marker-start"] = markerStart
+	return e
+}
+
+// Mask sets the mask attribute.
+func (e *FeDropShadowElement) Mask(mask String) *FeDropShadowElement {
+	e.Attrs["mask"] = mask
+	return e
+}
+
+// Opacity sets the opacity attribute.
+func (e *FeDropShadowElement) Opacity(opacity Float64) *FeDropShadowElement {
+	e.Attrs["opacity"] = opacity
+	return e
+}
+
+// Overflow sets the overflow attribute.
+func (e *FeDropShadowElement) Overflow(overflow String) *FeDropShadowElement {
+	e.Attrs["overflow"] = overflow
+	return e
+}
+
+// PaintOrder sets the paint-order attribute.
+func (e *FeDropShadowElement) PaintOrder(paintOrder String) *FeDropShadowElement {
+	e.Attrs["paint-order"] = paintOrder
+	return e
+}
+
+// PointerEvents sets the pointer-events attribute.
+func (e *FeDropShadowElement) PointerEvents(pointerEvents String) *FeDropShadowElement {
+	e.Attrs["pointer-events"] = pointerEvents
+	return e
+}
+
+// ShapeRendering sets the shape-rendering attribute.
+func (e *FeDropShadowElement) ShapeRendering(shapeRendering String) *FeDropShadowElement {
+	e.Attrs["shape-rendering"] = shapeRendering
+	return e
+}
+
+// StopColor sets the stop-color attribute.
+func (e *FeDropShadowElement) StopColor(stopColor Color) *FeDropShadowElement {
+	e.Attrs["stop-color"] = stopColor
+	return e
+}
+
+// StopOpacity sets the stop-opacity attribute.
+func (e *FeDropShadowElement) StopOpacity(stopOpacity Float64) *FeDropShadowElement {
+	e.Attrs["stop-opacity"] = stopOpacity
+	return e
+}
+
+// Stroke sets the stroke attribute.
+func (e *FeDropShadowElement) Stroke(stroke String) *FeDropShadowElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *FeDropShadowElement) StrokeColor(stroke Color) *FeDropShadowElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *FeDropShadowElement) StrokePaint(stroke Paint) *FeDropShadowElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeDashArray sets the stroke-dasharray attribute.
+func (e *FeDropShadowElement) StrokeDashArray(strokeDashArray String) *FeDropShadowElement {
+	e.Attrs["stroke-dasharray"] = strokeDashArray
+	return e
+}
+
+// StrokeDashOffset sets the stroke-dashoffset attribute.
+func (e *FeDropShadowElement) StrokeDashOffset(strokeDashOffset Float64) *FeDropShadowElement {
+	e.Attrs["stroke-dashoffset"] = strokeDashOffset
+	return e
+}
+
+// StrokeLineCap sets the stroke-linecap attribute.
+func (e *FeDropShadowElement) StrokeLineCap(strokeLineCap String) *FeDropShadowElement {
+	e.Attrs["stroke-linecap"] = strokeLineCap
+	return e
+}
+
+// StrokeLineJoin sets the stroke-linejoin attribute.
+func (e *FeDropShadowElement) StrokeLineJoin(strokeLineJoin String) *FeDropShadowElement {
+	e.Attrs["stroke-linejoin"] = strokeLineJoin
+	return e
+}
+
+// StrokeMiterLimit sets the stroke-miterlimit attribute.
+func (e *FeDropShadowElement) StrokeMiterLimit(strokeMiterLimit Float64) *FeDropShadowElement {
+	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
+	return e
+}
+
+// StrokeOpacity sets the stroke-opacity attribute.
+func (e *FeDropShadowElement) StrokeOpacity(strokeOpacity Float64) *FeDropShadowElement {
+	e.Attrs["stroke-opacity"] = strokeOpacity
+	return e
+}
+
+// StrokeWidth sets the stroke-width attribute.
+func (e *FeDropShadowElement) StrokeWidth(strokeWidth Length) *FeDropShadowElement {
+	e.Attrs["stroke-width"] = strokeWidth
+	return e
+}
+
+// TextAnchor sets the text-anchor attribute.
+func (e *FeDropShadowElement) TextAnchor(textAnchor String) *FeDropShadowElement {
+	e.Attrs["text-anchor"] = textAnchor
+	return e
+}
+
+// TextDecoration sets the text-decoration attribute.
+func (e *FeDropShadowElement) TextDecoration(textDecoration String) *FeDropShadowElement {
+	e.Attrs["text-decoration"] = textDecoration
+	return e
+}
+
+// TextOverflow sets the text-overflow attribute.
+func (e *FeDropShadowElement) TextOverflow(textOverflow String) *FeDropShadowElement {
+	e.Attrs["text-overflow"] = textOverflow
+	return e
+}
+
+// TextRendering sets the text-rendering attribute.
+func (e *FeDropShadowElement) TextRendering(textRendering String) *FeDropShadowElement {
+	e.Attrs["text-rendering"] = textRendering
+	return e
+}
+
+// Transform sets the transform attribute.
+func (e *FeDropShadowElement) Transform(transform Transform) *FeDropShadowElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
+// UnicodeBiDi sets the unicode-bidi attribute.
+func (e *FeDropShadowElement) UnicodeBiDi(unicodeBiDi String) *FeDropShadowElement {
+	e.Attrs["unicode-bidi"] = unicodeBiDi
+	return e
+}
+
+// VectorEffect sets the vector-effect attribute.
+func (e *FeDropShadowElement) VectorEffect(vectorEffect String) *FeDropShadowElement {
+	e.Attrs["vector-effect"] = vectorEffect
+	return e
+}
+
+// Visibility sets the visibility attribute.
+func (e *FeDropShadowElement) Visibility(visibility String) *FeDropShadowElement {
+	e.Attrs["visibility"] = visibility
+	return e
+}
+
+// WhiteSpace sets the white-space attribute.
+func (e *FeDropShadowElement) WhiteSpace(whiteSpace String) *FeDropShadowElement {
+	e.Attrs["white-space"] = whiteSpace
+	return e
+}
+
+// WordSpacing sets the word-spacing attribute.
+func (e *FeDropShadowElement) WordSpacing(wordSpacing String) *FeDropShadowElement {
+	e.Attrs["word-spacing"] = wordSpacing
+	return e
+}
+
+// WritingMode sets the writing-mode attribute.
+func (e *FeDropShadowElement) WritingMode(writingMode String) *FeDropShadowElement {
+	e.Attrs["writing-mode"] = writingMode
+	return e
+}
+
+// X sets the x attribute.
+func (e *FeDropShadowElement) X(x Length) *FeDropShadowElement {
+	e.Attrs["x"] = x
+	return e
+}
+
+// Y sets the y attribute.
+func (e *FeDropShadowElement) Y(y Length) *FeDropShadowElement {
+	e.Attrs["y"] = y
+	return e
+}
+
+// Width sets the width attribute.
+func (e *FeDropShadowElement) Width(width Length) *FeDropShadowElement {
+	e.Attrs["width"] = width
+	return e
+}
+
+// Height sets the height attribute.
+func (e *FeDropShadowElement) Height(height Length) *FeDropShadowElement {
+	e.Attrs["height"] = height
+	return e
+}
+
+// Result sets the result attribute.
+func (e *FeDropShadowElement) Result(result String) *FeDropShadowElement {
+	e.Attrs["result"] = result
+	return e
+}
+
+// In sets the in attribute.
+func (e *FeDropShadowElement) In(_in String) *FeDropShadowElement {
+	e.Attrs["in"] = _in
+	return e
+}
+
+// DX sets the dx attribute.
+func (e *FeDropShadowElement) DX(dx Float64) *FeDropShadowElement {
+	e.Attrs["dx"] = dx
+	return e
+}
+
+// DY sets the dy attribute.
+func (e *FeDropShadowElement) DY(dy Float64) *FeDropShadowElement {
+	e.Attrs["dy"] = dy
+	return e
+}
+
+// StdDeviation sets the stdDeviation attribute.
+func (e *FeDropShadowElement) StdDeviation(stdDeviation Numbers) *FeDropShadowElement {
+	e.Attrs["stdDeviation"] = stdDeviation
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *FeDropShadowElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *FeDropShadowElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *FeDropShadowElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *FeDropShadowElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *FeDropShadowElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *FeDropShadowElement) TagName() string {
+	return "feDropShadow"
+}
+
+// decode decodes e from d.
+func (e *FeDropShadowElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *FeDropShadowElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "x":
+		return parseLength(value)
+	case "y":
+		return parseLength(value)
+	case "width":
+		return parseLength(value)
+	case "height":
+		return parseLength(value)
+	case "dx":
+		return parseFloat64(value)
+	case "dy":
+		return parseFloat64(value)
+	case "stdDeviation":
+		return parseNumbers(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A FeFloodElement is a feFlood element.
+type FeFloodElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// FeFlood returns a new FeFloodElement.
+func FeFlood(children ...Element) *FeFloodElement {
+	return &FeFloodElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *FeFloodElement) AppendChildren(children ...Element) *FeFloodElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *FeFloodElement) RemoveAttrs(names ...string) *FeFloodElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *FeFloodElement) ID(id String) *FeFloodElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *FeFloodElement) TabIndex(tabIndex Int) *FeFloodElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *FeFloodElement) Lang(lang String) *FeFloodElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *FeFloodElement) Class(class String) *FeFloodElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *FeFloodElement) Style(style String) *FeFloodElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// AlignmentBaseline sets the alignment-baseline attribute.
+func (e *FeFloodElement) AlignmentBaseline(alignmentBaseline String) *FeFloodElement {
+	e.Attrs["alignment-baseline"] = alignmentBaseline
+	return e
+}
+
+// BaselineShift sets the baseline-shift attribute.
+func (e *FeFloodElement) BaselineShift(baselineShift String) *FeFloodElement {
+	e.Attrs["baseline-shift"] = baselineShift
+	return e
+}
+
+// ClipPath sets the clip-path attribute.
+func (e *FeFloodElement) ClipPath(clipPath String) *FeFloodElement {
+	e.Attrs["clip-path"] = clipPath
+	return e
+}
+
+// ClipRule sets the clip-rule attribute.
+func (e *FeFloodElement) ClipRule(clipRule String) *FeFloodElement {
+	e.Attrs["clip-rule"] = clipRule
+	return e
+}
+
+// Color sets the color attribute.
+func (e *FeFloodElement) Color(color Color) *FeFloodElement {
+	e.Attrs["color"] = color
+	return e
+}
+
+// ColorInterpolation sets the color-interpolation attribute.
+func (e *FeFloodElement) ColorInterpolation(colorInterpolation String) *FeFloodElement {
+	e.Attrs["color-interpolation"] = colorInterpolation
+	return e
+}
+
+// ColorInterpolationFilters sets the color-interpolation-filters attribute.
+func (e *FeFloodElement) ColorInterpolationFilters(colorInterpolationFilters String) *FeFloodElement {
+	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
+	return e
+}
+
+// ColorRendering sets the color-rendering attribute.
+func (e *FeFloodElement) ColorRendering(colorRendering String) *FeFloodElement {
+	e.Attrs["color-rendering"] = colorRendering
+	return e
+}
+
+// Cursor sets the cursor attribute.
+func (e *FeFloodElement) Cursor(cursor String) *FeFloodElement {
+	e.Attrs["cursor"] = cursor
+	return e
+}
+
+// Direction sets the direction attribute.
+func (e *FeFloodElement) Direction(direction String) *FeFloodElement {
+	e.Attrs["direction"] = direction
+	return e
+}
+
+// Display sets the display attribute.
+func (e *FeFloodElement) Display(display String) *FeFloodElement {
+	e.Attrs["display"] = display
+	return e
+}
+
+// DominantBaseline sets the dominant-baseline attribute.
+func (e *FeFloodElement) DominantBaseline(dominantBaseline String) *FeFloodElement {
+	e.Attrs["dominant-baseline"] = dominantBaseline
+	return e
+}
+
+// Fill sets the fill attribute.
+func (e *FeFloodElement) Fill(fill String) *FeFloodElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *FeFloodElement) FillColor(fill Color) *FeFloodElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *FeFloodElement) FillPaint(fill Paint) *FeFloodElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillOpacity sets the fill-opacity attribute.
+func (e *FeFloodElement) FillOpacity(fillOpacity Float64) *FeFloodElement {
+	e.Attrs["fill-opacity"] = fillOpacity
+	return e
+}
+
+// FillRule sets the fill-rule attribute.
+func (e *FeFloodElement) FillRule(fillRule String) *FeFloodElement {
+	e.Attrs["fill-rule"] = fillRule
+	return e
+}
+
+// Filter sets the filter attribute.
+func (e *FeFloodElement) Filter(filter String) *FeFloodElement {
+	e.Attrs["filter"] = filter
+	return e
+}
+
+// FloodColor sets the flood-color attribute.
+func (e *FeFloodElement) FloodColor(floodColor Color) *FeFloodElement {
+	e.Attrs["flood-color"] = floodColor
+	return e
+}
+
+// FloodOpacity sets the flood-opacity attribute.
+func (e *FeFloodElement) FloodOpacity(floodOpacity Float64) *FeFloodElement {
+	e.Attrs["flood-opacity"] = floodOpacity
+	return e
+}
+
+// FontFamily sets the font-family attribute.
+func (e *FeFloodElement) FontFamily(fontFamily String) *FeFloodElement {
+	e.Attrs["font-family"] = fontFamily
+	return e
+}
+
+// FontSize sets the font-size attribute.
+func (e *FeFloodElement) FontSize(fontSize String) *FeFloodElement {
+	e.Attrs["font-size"] = fontSize
+	return e
+}
+
+// FontSizeAdjust sets the font-size-adjust attribute.
+func (e *FeFloodElement) FontSizeAdjust(fontSizeAdjust String) *FeFloodElement {
+	e.Attrs["font-size-adjust"] = fontSizeAdjust
+	return e
+}
+
+// FontStretch sets the font-stretch attribute.
+func (e *FeFloodElement) FontStretch(fontStretch String) *FeFloodElement {
+	e.Attrs["font-stretch"] = fontStretch
+	return e
+}
+
+// FontStyle sets the font-style attribute.
+func (e *FeFloodElement) FontStyle(fontStyle String) *FeFloodElement {
+	e.Attrs["font-style"] = fontStyle
+	return e
+}
+
+// FontVariant sets the font-variant attribute.
+func (e *FeFloodElement) FontVariant(fontVariant String) *FeFloodElement {
+	e.Attrs["font-variant"] = fontVariant
+	return e
+}
+
+// FontWeight sets the font-weight attribute.
+func (e *FeFloodElement) FontWeight(fontWeight String) *FeFloodElement {
+	e.Attrs["font-weight"] = fontWeight
+	return e
+}
+
+// GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
+func (e *FeFloodElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *FeFloodElement {
+	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
+	return e
+}
+
+// GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
+func (e *FeFloodElement) GlyphOrientationVertical(glyphOrientationVertical String) *FeFloodElement {
+	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
+	return e
+}
+
+// ImageRendering sets the image-rendering attribute.
+func (e *FeFloodElement) ImageRendering(imageRendering String) *FeFloodElement {
+	e.Attrs["image-rendering"] = imageRendering
+	return e
+}
+
+// LetterSpacing sets the letter-spacing attribute.
+func (e *FeFloodElement) LetterSpacing(letterSpacing String) *FeFloodElement {
+	e.Attrs["letter-spacing"] = letterSpacing
+	return e
+}
+
+// LightingColor sets the lighting-color attribute.
+func (e *FeFloodElement) LightingColor(lightingColor Color) *FeFloodElement {
+	e.Attrs["lighting-color"] = lightingColor
+	return e
+}
+
+// MarkerEnd sets the marker-end attribute.
+func (e *FeFloodElement) MarkerEnd(markerEnd String) *FeFloodElement {
+	e.Attrs["marker-end"] = markerEnd
+	return e
+}
+
+// MarkerMid sets the marker-mid attribute.
+func (e *FeFloodElement) MarkerMid(markerMid String) *FeFloodElement {
+	e.Attrs["marker-mid"] = markerMid
+	return e
+}
+
+// MarkerStart sets the marker-start attribute.
+func (e *FeFloodElement) MarkerStart(markerStart String) *FeFloodElement {
+	e.Attrs["marker-start"] = markerStart
+	return e
+}
+
+// Mask sets the mask attribute.
+func (e *FeFloodElement) Mask(mask String) *FeFloodElement {
+	e.Attrs["mask"] = mask
+	return e
+}
+
+// Opacity sets the opacity attribute.
+func (e *FeFloodElement) Opacity(opacity Float64) *FeFloodElement {
+	e.Attrs["opacity"] = opacity
+	return e
+}
+
+// Overflow sets the overflow attribute.
+func (e *FeFloodElement) Overflow(overflow String) *FeFloodElement {
+	e.Attrs["overflow"] = overflow
+	return e
+}
+
+// PaintOrder sets the paint-order attribute.
+func (e *FeFloodElement) PaintOrder(paintOrder String) *FeFloodElement {
+	e.Attrs["paint-order"] = paintOrder
+	return e
+}
+
+// PointerEvents sets the pointer-events attribute.
+func (e *FeFloodElement) PointerEvents(pointerEvents String) *FeFloodElement {
+	e.Attrs["pointer-events"] = pointerEvents
+	return e
+}
+
+// ShapeRendering sets the shape-rendering attribute.
+func (e *FeFloodElement) ShapeRendering(shapeRendering String) *FeFloodElement {
+	e.Attrs["shape-rendering"] = shapeRendering
+	return e
+}
+
+// StopColor sets the stop-color attribute.
+func (e *FeFloodElement) StopColor(stopColor Color) *FeFloodElement {
+	e.Attrs["stop-color"] = stopColor
+	return e
+}
+
+// StopOpacity sets the stop-opacity attribute.
+func (e *FeFloodElement) StopOpacity(stopOpacity Float64) *FeFloodElement {
+	e.Attrs["stop-opacity"] = stopOpacity
+	return e
+}
+
+// Stroke sets the stroke attribute.
+func (e *FeFloodElement) Stroke(stroke String) *FeFloodElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *FeFloodElement) StrokeColor(stroke Color) *FeFloodElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *FeFloodElement) StrokePaint(stroke Paint) *FeFloodElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeDashArray sets the stroke-dasharray attribute.
+func (e *FeFloodElement) StrokeDashArray(strokeDashArray String) *FeFloodElement {
+	e.Attrs["stroke-dasharray"] = strokeDashArray
+	return e
+}
+
+// StrokeDashOffset sets the stroke-dashoffset attribute.
+func (e *FeFloodElement) StrokeDashOffset(strokeDashOffset Float64) *FeFloodElement {
+	e.Attrs["stroke-dashoffset"] = strokeDashOffset
+	return e
+}
+
+// StrokeLineCap sets the stroke-linecap attribute.
+func (e *FeFloodElement) StrokeLineCap(strokeLineCap String) *FeFloodElement {
+	e.Attrs["stroke-linecap"] = strokeLineCap
+	return e
+}
+
+// StrokeLineJoin sets the stroke-linejoin attribute.
+func (e *FeFloodElement) StrokeLineJoin(strokeLineJoin String) *FeFloodElement {
+	e.Attrs["stroke-linejoin"] = strokeLineJoin
+	return e
+}
+
+// StrokeMiterLimit sets the stroke-miterlimit attribute.
+func (e *FeFloodElement) StrokeMiterLimit(strokeMiterLimit Float64) *FeFloodElement {
+	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
+	return e
+}
+
+// StrokeOpacity sets the stroke-opacity attribute.
+func (e *FeFloodElement) StrokeOpacity(strokeOpacity Float64) *FeFloodElement {
+	e.Attrs["stroke-opacity"] = strokeOpacity
+	return e
+}
+
+// StrokeWidth sets the stroke-width attribute.
+func (e *FeFloodElement) StrokeWidth(strokeWidth Length) *FeFloodElement {
+	e.Attrs["stroke-width"] = strokeWidth
+	return e
+}
+
+// TextAnchor sets the text-anchor attribute.
+func (e *FeFloodElement) TextAnchor(textAnchor String) *FeFloodElement {
+	e.Attrs["text-anchor"] = textAnchor
+	return e
+}
+
+// TextDecoration sets the text-decoration attribute.
+func (e *FeFloodElement) TextDecoration(textDecoration String) *FeFloodElement {
+	e.Attrs["text-decoration"] = textDecoration
+	return e
+}
+
+// TextOverflow sets the text-overflow attribute.
+func (e *FeFloodElement) TextOverflow(textOverflow String) *FeFloodElement {
+	e.Attrs["text-overflow"] = textOverflow
+	return e
+}
+
+// TextRendering sets the text-rendering attribute.
+func (e *FeFloodElement) TextRendering(textRendering String) *FeFloodElement {
+	e.Attrs["text-rendering"] = textRendering
+	return e
+}
+
+// Transform sets the transform attribute.
+func (e *FeFloodElement) Transform(transform Transform) *FeFloodElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
+// UnicodeBiDi sets the unicode-bidi attribute.
+func (e *FeFloodElement) UnicodeBiDi(unicodeBiDi String) *FeFloodElement {
+	e.Attrs["unicode-bidi"] = unicodeBiDi
+	return e
+}
+
+// VectorEffect sets the vector-effect attribute.
+func (e *FeFloodElement) VectorEffect(vectorEffect String) *FeFloodElement {
+	e.Attrs["vector-effect"] = vectorEffect
+	return e
+}
+
+// Visibility sets the visibility attribute.
+func (e *FeFloodElement) Visibility(visibility String) *FeFloodElement {
+	e.Attrs["visibility"] = visibility
+	return e
+}
+
+// WhiteSpace sets the white-space attribute.
+func (e *FeFloodElement) WhiteSpace(whiteSpace String) *FeFloodElement {
+	e.Attrs["white-space"] = whiteSpace
+	return e
+}
+
+// WordSpacing sets the word-spacing attribute.
+func (e *FeFloodElement) WordSpacing(wordSpacing String) *FeFloodElement {
+	e.Attrs["word-spacing"] = wordSpacing
+	return e
+}
+
+// WritingMode sets the writing-mode attribute.
+func (e *FeFloodElement) WritingMode(writingMode String) *FeFloodElement {
+	e.Attrs["writing-mode"] = writingMode
+	return e
+}
+
+// X sets the x attribute.
+func (e *FeFloodElement) X(x Length) *FeFloodElement {
+	e.Attrs["x"] = x
+	return e
+}
+
+// Y sets the y attribute.
+func (e *FeFloodElement) Y(y Length) *FeFloodElement {
+	e.Attrs["y"] = y
+	return e
+}
+
+// Width sets the width attribute.
+func (e *FeFloodElement) Width(width Length) *FeFloodElement {
+	e.Attrs["width"] = width
+	return e
+}
+
+// Height sets the height attribute.
+func (e *FeFloodElement) Height(height Length) *FeFloodElement {
+	e.Attrs["height"] = height
+	return e
+}
+
+// Result sets the result attribute.
+func (e *FeFloodElement) Result(result String) *FeFloodElement {
+	e.Attrs["result"] = result
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *FeFloodElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *FeFloodElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *FeFloodElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *FeFloodElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *FeFloodElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *FeFloodElement) TagName() string {
+	return "feFlood"
+}
+
+// decode decodes e from d.
+func (e *FeFloodElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *FeFloodElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "x":
+		return parseLength(value)
+	case "y":
+		return parseLength(value)
+	case "width":
+		return parseLength(value)
+	case "height":
+		return parseLength(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A FeFuncAElement is a feFuncA element.
+type FeFuncAElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// FeFuncA returns a new FeFuncAElement.
+func FeFuncA(children ...Element) *FeFuncAElement {
+	return &FeFuncAElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *FeFuncAElement) AppendChildren(children ...Element) *FeFuncAElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *FeFuncAElement) RemoveAttrs(names ...string) *FeFuncAElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *FeFuncAElement) ID(id String) *FeFuncAElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *FeFuncAElement) TabIndex(tabIndex Int) *FeFuncAElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *FeFuncAElement) Lang(lang String) *FeFuncAElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *FeFuncAElement) Class(class String) *FeFuncAElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *FeFuncAElement) Style(style String) *FeFuncAElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// Type sets the type attribute.
+func (e *FeFuncAElement) Type(_type String) *FeFuncAElement {
+	e.Attrs["type"] = _type
+	return e
+}
+
+// TableValues sets the tableValues attribute.
+func (e *FeFuncAElement) TableValues(tableValues Numbers) *FeFuncAElement {
+	e.Attrs["tableValues"] = tableValues
+	return e
+}
+
+// Slope sets the slope attribute.
+func (e *FeFuncAElement) Slope(slope Float64) *FeFuncAElement {
+	e.Attrs["slope"] = slope
+	return e
+}
+
+// Intercept sets the intercept attribute.
+func (e *FeFuncAElement) Intercept(intercept Float64) *FeFuncAElement {
+	e.Attrs["intercept"] = intercept
+	return e
+}
+
+// Amplitude sets the amplitude attribute.
+func (e *FeFuncAElement) Amplitude(amplitude Float64) *FeFuncAElement {
+	e.Attrs["amplitude"] = amplitude
+	return e
+}
+
+// Exponent sets the exponent attribute.
+func (e *FeFuncAElement) Exponent(exponent Float64) *FeFuncAElement {
+	e.Attrs["exponent"] = exponent
+	return e
+}
+
+// Offset sets the offset attribute.
+func (e *FeFuncAElement) Offset(offset Float64) *FeFuncAElement {
+	e.Attrs["offset"] = offset
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *FeFuncAElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *FeFuncAElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *FeFuncAElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *FeFuncAElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *FeFuncAElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *FeFuncAElement) TagName() string {
+	return "feFuncA"
+}
+
+// decode decodes e from d.
+func (e *FeFuncAElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *FeFuncAElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "tableValues":
+		return parseNumbers(value)
+	case "slope":
+		return parseFloat64(value)
+	case "intercept":
+		return parseFloat64(value)
+	case "amplitude":
+		return parseFloat64(value)
+	case "exponent":
+		return parseFloat64(value)
+	case "offset":
+		return parseFloat64(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A FeFuncBElement is a feFuncB element.
+type FeFuncBElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// FeFuncB returns a new FeFuncBElement.
+func FeFuncB(children ...Element) *FeFuncBElement {
+	return &FeFuncBElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *FeFuncBElement) AppendChildren(children ...Element) *FeFuncBElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *FeFuncBElement) RemoveAttrs(names ...string) *FeFuncBElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *FeFuncBElement) ID(id String) *FeFuncBElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *FeFuncBElement) TabIndex(tabIndex Int) *FeFuncBElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *FeFuncBElement) Lang(lang String) *FeFuncBElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *FeFuncBElement) Class(class String) *FeFuncBElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *FeFuncBElement) Style(style String) *FeFuncBElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// Type sets the type attribute.
+func (e *FeFuncBElement) Type(_type String) *FeFuncBElement {
+	e.Attrs["type"] = _type
+	return e
+}
+
+// TableValues sets the tableValues attribute.
+func (e *FeFuncBElement) TableValues(tableValues Numbers) *FeFuncBElement {
+	e.Attrs["tableValues"] = tableValues
+	return e
+}
+
+// Slope sets the slope attribute.
+func (e *FeFuncBElement) Slope(slope Float64) *FeFuncBElement {
+	e.Attrs["slope"] = slope
+	return e
+}
+
+// Intercept sets the intercept attribute.
+func (e *FeFuncBElement) Intercept(intercept Float64) *FeFuncBElement {
+	e.Attrs["intercept"] = intercept
+	return e
+}
+
+// Amplitude sets the amplitude attribute.
+func (e *FeFuncBElement) Amplitude(amplitude Float64) *FeFuncBElement {
+	e.Attrs["amplitude"] = amplitude
+	return e
+}
+
+// Exponent sets the exponent attribute.
+func (e *FeFuncBElement) Exponent(exponent Float64) *FeFuncBElement {
+	e.Attrs["exponent"] = exponent
+	return e
+}
+
+// Offset sets the offset attribute.
+func (e *FeFuncBElement) Offset(offset Float64) *FeFuncBElement {
+	e.Attrs["offset"] = offset
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *FeFuncBElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *FeFuncBElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *FeFuncBElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *FeFuncBElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *FeFuncBElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *FeFuncBElement) TagName() string {
+	return "feFuncB"
+}
+
+// decode decodes e from d.
+func (e *FeFuncBElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *FeFuncBElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "tableValues":
+		return parseNumbers(value)
+	case "slope":
+		return parseFloat64(value)
+	case "intercept":
+		return parseFloat64(value)
+	case "amplitude":
+		return parseFloat64(value)
+	case "exponent":
+		return parseFloat64(value)
+	case "offset":
+		return parseFloat64(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A FeFuncGElement is a feFuncG element.
+type FeFuncGElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// FeFuncG returns a new FeFuncGElement.
+func FeFuncG(children ...Element) *FeFuncGElement {
+	return &FeFuncGElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *FeFuncGElement) AppendChildren(children ...Element) *FeFuncGElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *FeFuncGElement) RemoveAttrs(names ...string) *FeFuncGElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *FeFuncGElement) ID(id String) *FeFuncGElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *FeFuncGElement) TabIndex(tabIndex Int) *FeFuncGElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *FeFuncGElement) Lang(lang String) *FeFuncGElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *FeFuncGElement) Class(class String) *FeFuncGElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *FeFuncGElement) Style(style String) *FeFuncGElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// Type sets the type attribute.
+func (e *FeFuncGElement) Type(_type String) *FeFuncGElement {
+	e.Attrs["type"] = _type
+	return e
+}
+
+// TableValues sets the tableValues attribute.
+func (e *FeFuncGElement) TableValues(tableValues Numbers) *FeFuncGElement {
+	e.Attrs["tableValues"] = tableValues
+	return e
+}
+
+// Slope sets the slope attribute.
+func (e *FeFuncGElement) Slope(slope Float64) *FeFuncGElement {
+	e.Attrs["slope"] = slope
+	return e
+}
+
+// Intercept sets the intercept attribute.
+func (e *FeFuncGElement) Intercept(intercept Float64) *FeFuncGElement {
+	e.Attrs["intercept"] = intercept
+	return e
+}
+
+// Amplitude sets the amplitude attribute.
+func (e *FeFuncGElement) Amplitude(amplitude Float64) *FeFuncGElement {
+	e.Attrs["amplitude"] = amplitude
+	return e
+}
+
+// Exponent sets the exponent attribute.
+func (e *FeFuncGElement) Exponent(exponent Float64) *FeFuncGElement {
+	e.Attrs["exponent"] = exponent
+	return e
+}
+
+// Offset sets the offset attribute.
+func (e *FeFuncGElement) Offset(offset Float64) *FeFuncGElement {
+	e.Attrs["offset"] = offset
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *FeFuncGElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *FeFuncGElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *FeFuncGElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *FeFuncGElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *FeFuncGElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *FeFuncGElement) TagName() string {
+	return "feFuncG"
+}
+
+// decode decodes e from d.
+func (e *FeFuncGElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *FeFuncGElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "tableValues":
+		return parseNumbers(value)
+	case "slope":
+		return parseFloat64(value)
+	case "intercept":
+		return parseFloat64(value)
+	case "amplitude":
+		return parseFloat64(value)
+	case "exponent":
+		return parseFloat64(value)
+	case "offset":
+		return parseFloat64(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A FeFuncRElement is a feFuncR element.
+type FeFuncRElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// FeFuncR returns a new FeFuncRElement.
+func FeFuncR(children ...Element) *FeFuncRElement {
+	return &FeFuncRElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *FeFuncRElement) AppendChildren(children ...Element) *FeFuncRElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *FeFuncRElement) RemoveAttrs(names ...string) *FeFuncRElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *FeFuncRElement) ID(id String) *FeFuncRElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *FeFuncRElement) TabIndex(tabIndex Int) *FeFuncRElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *FeFuncRElement) Lang(lang String) *FeFuncRElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *FeFuncRElement) Class(class String) *FeFuncRElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *FeFuncRElement) Style(style String) *FeFuncRElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// Type sets the type attribute.
+func (e *FeFuncRElement) Type(_type String) *FeFuncRElement {
+	e.Attrs["type"] = _type
+	return e
+}
+
+// TableValues sets the tableValues attribute.
+func (e *FeFuncRElement) TableValues(tableValues Numbers) *FeFuncRElement {
+	e.Attrs["tableValues"] = tableValues
+	return e
+}
+
+// Slope sets the slope attribute.
+func (e *FeFuncRElement) Slope(slope Float64) *FeFuncRElement {
+	e.Attrs["slope"] = slope
+	return e
+}
+
+// Intercept sets the intercept attribute.
+func (e *FeFuncRElement) Intercept(intercept Float64) *FeFuncRElement {
+	e.Attrs["intercept"] = intercept
+	return e
+}
+
+// Amplitude sets the amplitude attribute.
+func (e *FeFuncRElement) Amplitude(amplitude Float64) *FeFuncRElement {
+	e.Attrs["amplitude"] = amplitude
+	return e
+}
+
+// Exponent sets the exponent attribute.
+func (e *FeFuncRElement) Exponent(exponent Float64) *FeFuncRElement {
+	e.Attrs["exponent"] = exponent
+	return e
+}
+
+// Offset sets the offset attribute.
+func (e *FeFuncRElement) Offset(offset Float64) *FeFuncRElement {
+	e.Attrs["offset"] = offset
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *FeFuncRElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *FeFuncRElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *FeFuncRElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *FeFuncRElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *FeFuncRElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *FeFuncRElement) TagName() string {
+	return "feFuncR"
+}
+
+// decode decodes e from d.
+func (e *FeFuncRElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *FeFuncRElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "tableValues":
+		return parseNumbers(value)
+	case "slope":
+		return parseFloat64(value)
+	case "intercept":
+		return parseFloat64(value)
+	case "amplitude":
+		return parseFloat64(value)
+	case "exponent":
+		return parseFloat64(value)
+	case "offset":
+		return parseFloat64(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A FeGaussianBlurElement is a feGaussianBlur element.
+type FeGaussianBlurElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// FeGaussianBlur returns a new FeGaussianBlurElement.
+func FeGaussianBlur(children ...Element) *FeGaussianBlurElement {
+	return &FeGaussianBlurElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *FeGaussianBlurElement) AppendChildren(children ...Element) *FeGaussianBlurElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *FeGaussianBlurElement) RemoveAttrs(names ...string) *FeGaussianBlurElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *FeGaussianBlurElement) ID(id String) *FeGaussianBlurElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *FeGaussianBlurElement) TabIndex(tabIndex Int) *FeGaussianBlurElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *FeGaussianBlurElement) Lang(lang String) *FeGaussianBlurElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *FeGaussianBlurElement) Class(class String) *FeGaussianBlurElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *FeGaussianBlurElement) Style(style String) *FeGaussianBlurElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// AlignmentBaseline sets the alignment-baseline attribute.
+func (e *FeGaussianBlurElement) AlignmentBaseline(alignmentBaseline String) *FeGaussianBlurElement {
+	e.Attrs["alignment-baseline"] = alignmentBaseline
+	return e
+}
+
+// BaselineShift sets the baseline-shift attribute.
+func (e *FeGaussianBlurElement) BaselineShift(baselineShift String) *FeGaussianBlurElement {
+	e.Attrs["baseline-shift"] = baselineShift
+	return e
+}
+
+// ClipPath sets the clip-path attribute.
+func (e *FeGaussianBlurElement) ClipPath(clipPath String) *FeGaussianBlurElement {
+	e.Attrs["clip-path"] = clipPath
+	return e
+}
+
+// ClipRule sets the clip-rule attribute.
+func (e *FeGaussianBlurElement) ClipRule(clipRule String) *FeGaussianBlurElement {
+	e.Attrs["clip-rule"] = clipRule
+	return e
+}
+
+// Color sets the color attribute.
+func (e *FeGaussianBlurElement) Color(color Color) *FeGaussianBlurElement {
+	e.Attrs["color"] = color
+	return e
+}
+
+// ColorInterpolation sets the color-interpolation attribute.
+func (e *FeGaussianBlurElement) ColorInterpolation(colorInterpolation String) *FeGaussianBlurElement {
+	e.Attrs["color-interpolation"] = colorInterpolation
+	return e
+}
+
+// ColorInterpolationFilters sets the color-interpolation-filters attribute.
+func (e *FeGaussianBlurElement) ColorInterpolationFilters(colorInterpolationFilters String) *FeGaussianBlurElement {
+	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
+	return e
+}
+
+// ColorRendering sets the color-rendering attribute.
+func (e *FeGaussianBlurElement) ColorRendering(colorRendering String) *FeGaussianBlurElement {
+	e.Attrs["color-rendering"] = colorRendering
+	return e
+}
+
+// Cursor sets the cursor attribute.
+func (e *FeGaussianBlurElement) Cursor(cursor String) *FeGaussianBlurElement {
+	e.Attrs["cursor"] = cursor
+	return e
+}
+
+// Direction sets the direction attribute.
+func (e *FeGaussianBlurElement) Direction(direction String) *FeGaussianBlurElement {
+	e.Attrs["direction"] = direction
+	return e
+}
+
+// Display sets the display attribute.
+func (e *FeGaussianBlurElement) Display(display String) *FeGaussianBlurElement {
+	e.Attrs["display"] = display
+	return e
+}
+
+// DominantBaseline sets the dominant-baseline attribute.
+func (e *FeGaussianBlurElement) DominantBaseline(dominantBaseline String) *FeGaussianBlurElement {
+	e.Attrs["dominant-baseline"] = dominantBaseline
+	return e
+}
+
+// Fill sets the fill attribute.
+func (e *FeGaussianBlurElement) Fill(fill String) *FeGaussianBlurElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *FeGaussianBlurElement) FillColor(fill Color) *FeGaussianBlurElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *FeGaussianBlurElement) FillPaint(fill Paint) *FeGaussianBlurElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillOpacity sets the fill-opacity attribute.
+func (e *FeGaussianBlurElement) FillOpacity(fillOpacity Float64) *FeGaussianBlurElement {
+	e.Attrs["fill-opacity"] = fillOpacity
+	return e
+}
+
+// FillRule sets the fill-rule attribute.
+func (e *FeGaussianBlurElement) FillRule(fillRule String) *FeGaussianBlurElement {
+	e.Attrs["fill-rule"] = fillRule
+	return e
+}
+
+// Filter sets the filter attribute.
+func (e *FeGaussianBlurElement) Filter(filter String) *FeGaussianBlurElement {
+	e.Attrs["filter"] = filter
+	return e
+}
+
+// FloodColor sets the flood-color attribute.
+func (e *FeGaussianBlurElement) FloodColor(floodColor Color) *FeGaussianBlurElement {
+	e.Attrs["flood-color"] = floodColor
+	return e
+}
+
+// FloodOpacity sets the flood-opacity attribute.
+func (e *FeGaussianBlurElement) FloodOpacity(floodOpacity Float64) *FeGaussianBlurElement {
+	e.Attrs["flood-opacity"] = floodOpacity
+	return e
+}
+
+// FontFamily sets the font-family attribute.
+func (e *FeGaussianBlurElement) FontFamily(fontFamily String) *FeGaussianBlurElement {
+	e.Attrs["font-family"] = fontFamily
+	return e
+}
+
+// FontSize sets the font-size attribute.
+func (e *FeGaussianBlurElement) FontSize(fontSize String) *FeGaussianBlurElement {
+	e.Attrs["font-size"] = fontSize
+	return e
+}
+
+// FontSizeAdjust sets the font-size-adjust attribute.
+func (e *FeGaussianBlurElement) FontSizeAdjust(fontSizeAdjust String) *FeGaussianBlurElement {
+	e.Attrs["font-size-adjust"] = fontSizeAdjust
+	return e
+}
+
+// FontStretch sets the font-stretch attribute.
+func (e *FeGaussianBlurElement) FontStretch(fontStretch String) *FeGaussianBlurElement {
+	e.Attrs["font-stretch"] = fontStretch
+	return e
+}
+
+// FontStyle sets the font-style attribute.
+func (e *FeGaussianBlurElement) FontStyle(fontStyle String) *FeGaussianBlurElement {
+	e.Attrs["font-style"] = fontStyle
+	return e
+}
+
+// FontVariant sets the font-variant attribute.
+func (e *FeGaussianBlurElement) FontVariant(fontVariant String) *FeGaussianBlurElement {
+	e.Attrs["font-variant"] = fontVariant
+	return e
+}
+
+// FontWeight sets the font-weight attribute.
+func (e *FeGaussianBlurElement) FontWeight(fontWeight String) *FeGaussianBlurElement {
+	e.Attrs["font-weight"] = fontWeight
+	return e
+}
+
+// GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
+func (e *FeGaussianBlurElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *FeGaussianBlurElement {
+	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
+	return e
+}
+
+// GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
+func (e *FeGaussianBlurElement) GlyphOrientationVertical(glyphOrientationVertical String) *FeGaussianBlurElement {
+	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
+	return e
+}
+
+// ImageRendering sets the image-rendering attribute.
+func (e *FeGaussianBlurElement) ImageRendering(imageRendering String) *FeGaussianBlurElement {
+	e.Attrs["image-rendering"] = imageRendering
+	return e
+}
+
+// LetterSpacing sets the letter-spacing attribute.
+func (e *FeGaussianBlurElement) LetterSpacing(letterSpacing String) *FeGaussianBlurElement {
+	e.Attrs["letter-spacing"] = letterSpacing
+	return e
+}
+
+// LightingColor sets the lighting-color attribute.
+func (e *FeGaussianBlurElement) LightingColor(lightingColor Color) *FeGaussianBlurElement {
+	e.Attrs["lighting-color"] = lightingColor
+	return e
+}
+
+// MarkerEnd sets the marker-end attribute.
+func (e *FeGaussianBlurElement) MarkerEnd(markerEnd String) *FeGaussianBlurElement {
+	e.Attrs["marker-end"] = markerEnd
+	return e
+}
+
+// MarkerMid sets the marker-mid attribute.
+func (e *FeGaussianBlurElement) MarkerMid(markerMid String) *FeGaussianBlurElement {
+	e.Attrs["marker-mid"] = markerMid
+	return e
+}
+
+// MarkerStart sets the marker-start attribute.
+func (e *FeGaussianBlurElement) MarkerStart(markerStart String) *FeGaussianBlurElement {
+	e.Attrs["marker-start"] = markerStart
+	return e
+}
+
+// Mask sets the mask attribute.
+func (e *FeGaussianBlurElement) Mask(mask String) *FeGaussianBlurElement {
+	e.Attrs["mask"] = mask
+	return e
+}
+
+// Opacity sets the opacity attribute.
+func (e *FeGaussianBlurElement) Opacity(opacity Float64) *FeGaussianBlurElement {
+	e.Attrs["opacity"] = opacity
+	return e
+}
+
+// Overflow sets the overflow attribute.
+func (e *FeGaussianBlurElement) Overflow(overflow String) *FeGaussianBlurElement {
+	e.Attrs["overflow"] = overflow
+	return e
+}
+
+// PaintOrder sets the paint-order attribute.
+func (e *FeGaussianBlurElement) PaintOrder(paintOrder String) *FeGaussianBlurElement {
+	e.Attrs["paint-order"] = paintOrder
+	return e
+}
+
+// PointerEvents sets the pointer-events attribute.
+func (e *FeGaussianBlurElement) PointerEvents(pointerEvents String) *FeGaussianBlurElement {
+	e.Attrs["pointer-events"] = pointerEvents
+	return e
+}
+
+// ShapeRendering sets the shape-rendering attribute.
+func (e *FeGaussianBlurElement) ShapeRendering(shapeRendering String) *FeGaussianBlurElement {
+	e.Attrs["shape-rendering"] = shapeRendering
+	return e
+}
+
+// StopColor sets the stop-color attribute.
+func (e *FeGaussianBlurElement) StopColor(stopColor Color) *FeGaussianBlurElement {
+	e.Attrs["stop-color"] = stopColor
+	return e
+}
+
+// StopOpacity sets the stop-opacity attribute.
+func (e *FeGaussianBlurElement) StopOpacity(stopOpacity Float64) *FeGaussianBlurElement {
+	e.Attrs["stop-opacity"] = stopOpacity
+	return e
+}
+
+// Stroke sets the stroke attribute.
+func (e *FeGaussianBlurElement) Stroke(stroke String) *FeGaussianBlurElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *FeGaussianBlurElement) StrokeColor(stroke Color) *FeGaussianBlurElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *FeGaussianBlurElement) StrokePaint(stroke Paint) *FeGaussianBlurElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeDashArray sets the stroke-dasharray attribute.
+func (e *FeGaussianBlurElement) StrokeDashArray(strokeDashArray String) *FeGaussianBlurElement {
+	e.Attrs["stroke-dasharray"] = strokeDashArray
+	return e
+}
+
+// StrokeDashOffset sets the stroke-dashoffset attribute.
+func (e *FeGaussianBlurElement) StrokeDashOffset(strokeDashOffset Float64) *FeGaussianBlurElement {
+	e.Attrs["stroke-dashoffset"] = strokeDashOffset
+	return e
+}
+
+// StrokeLineCap sets the stroke-linecap attribute.
+func (e *FeGaussianBlurElement) StrokeLineCap(strokeLineCap String) *FeGaussianBlurElement {
+	e.Attrs["stroke-linecap"] = strokeLineCap
+	return e
+}
+
+// StrokeLineJoin sets the stroke-linejoin attribute.
+func (e *FeGaussianBlurElement) StrokeLineJoin(strokeLineJoin String) *FeGaussianBlurElement {
+	e.Attrs["stroke-linejoin"] = strokeLineJoin
+	return e
+}
+
+// StrokeMiterLimit sets the stroke-miterlimit attribute.
+func (e *FeGaussianBlurElement) StrokeMiterLimit(strokeMiterLimit Float64) *FeGaussianBlurElement {
+	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
+	return e
+}
+
+// StrokeOpacity sets the stroke-opacity attribute.
+func (e *FeGaussianBlurElement) StrokeOpacity(strokeOpacity Float64) *FeGaussianBlurElement {
+	e.Attrs["stroke-opacity"] = strokeOpacity
+	return e
+}
+
+// StrokeWidth sets the stroke-width attribute.
+func (e *FeGaussianBlurElement) StrokeWidth(strokeWidth Length) *FeGaussianBlurElement {
+	e.Attrs["stroke-width"] = strokeWidth
+	return e
+}
+
+// TextAnchor sets the text-anchor attribute.
+func (e *FeGaussianBlurElement) TextAnchor(textAnchor String) *FeGaussianBlurElement {
+	e.Attrs["text-anchor"] = textAnchor
+	return e
+}
+
+// TextDecoration sets the text-decoration attribute.
+func (e *FeGaussianBlurElement) TextDecoration(textDecoration String) *FeGaussianBlurElement {
+	e.Attrs["text-decoration"] = textDecoration
+	return e
+}
+
+// TextOverflow sets the text-overflow attribute.
+func (e *FeGaussianBlurElement) TextOverflow(textOverflow String) *FeGaussianBlurElement {
+	e.Attrs["text-overflow"] = textOverflow
+	return e
+}
+
+// TextRendering sets the text-rendering attribute.
+func (e *FeGaussianBlurElement) TextRendering(textRendering String) *FeGaussianBlurElement {
+	e.Attrs["text-rendering"] = textRendering
+	return e
+}
+
+// Transform sets the transform attribute.
+func (e *FeGaussianBlurElement) Transform(transform Transform) *FeGaussianBlurElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
+// UnicodeBiDi sets the unicode-bidi attribute.
+func (e *FeGaussianBlurElement) UnicodeBiDi(unicodeBiDi String) *FeGaussianBlurElement {
+	e.Attrs["unicode-bidi"] = unicodeBiDi
+	return e
+}
+
+// VectorEffect sets the vector-effect attribute.
+func (e *FeGaussianBlurElement) VectorEffect(vectorEffect String) *FeGaussianBlurElement {
+	e.Attrs["vector-effect"] = vectorEffect
+	return e
+}
+
+// Visibility sets the visibility attribute.
+func (e *FeGaussianBlurElement) Visibility(visibility String) *FeGaussianBlurElement {
+	e.Attrs["visibility"] = visibility
+	return e
+}
+
+// WhiteSpace sets the white-space attribute.
+func (e *FeGaussianBlurElement) WhiteSpace(whiteSpace String) *FeGaussianBlurElement {
+	e.Attrs["white-space"] = whiteSpace
+	return e
+}
+
+// WordSpacing sets the word-spacing attribute.
+func (e *FeGaussianBlurElement) WordSpacing(wordSpacing String) *FeGaussianBlurElement {
+	e.Attrs["word-spacing"] = wordSpacing
+	return e
+}
+
+// WritingMode sets the writing-mode attribute.
+func (e *FeGaussianBlurElement) WritingMode(writingMode String) *FeGaussianBlurElement {
+	e.Attrs["writing-mode"] = writingMode
+	return e
+}
+
+// X sets the x attribute.
+func (e *FeGaussianBlurElement) X(x Length) *FeGaussianBlurElement {
+	e.Attrs["x"] = x
+	return e
+}
+
+// Y sets the y attribute.
+func (e *FeGaussianBlurElement) Y(y Length) *FeGaussianBlurElement {
+	e.Attrs["y"] = y
+	return e
+}
+
+// Width sets the width attribute.
+func (e *FeGaussianBlurElement) Width(width Length) *FeGaussianBlurElement {
+	e.Attrs["width"] = width
+	return e
+}
+
+// Height sets the height attribute.
+func (e *FeGaussianBlurElement) Height(height Length) *FeGaussianBlurElement {
+	e.Attrs["height"] = height
+	return e
+}
+
+// Result sets the result attribute.
+func (e *FeGaussianBlurElement) Result(result String) *FeGaussianBlurElement {
+	e.Attrs["result"] = result
+	return e
+}
+
+// In sets the in attribute.
+func (e *FeGaussianBlurElement) In(_in String) *FeGaussianBlurElement {
+	e.Attrs["in"] = _in
+	return e
+}
+
+// StdDeviation sets the stdDeviation attribute.
+func (e *FeGaussianBlurElement) StdDeviation(stdDeviation Numbers) *FeGaussianBlurElement {
+	e.Attrs["stdDeviation"] = stdDeviation
+	return e
+}
+
+// EdgeMode sets the edgeMode attribute.
+func (e *FeGaussianBlurElement) EdgeMode(edgeMode String) *FeGaussianBlurElement {
+	e.Attrs["edgeMode"] = edgeMode
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *FeGaussianBlurElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *FeGaussianBlurElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *FeGaussianBlurElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *FeGaussianBlurElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *FeGaussianBlurElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *FeGaussianBlurElement) TagName() string {
+	return "feGaussianBlur"
+}
+
+// decode decodes e from d.
+func (e *FeGaussianBlurElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *FeGaussianBlurElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "x":
+		return parseLength(value)
+	case "y":
+		return parseLength(value)
+	case "width":
+		return parseLength(value)
+	case "height":
+		return parseLength(value)
+	case "stdDeviation":
+		return parseNumbers(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A FeImageElement is a feImage element.
+type FeImageElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// FeImage returns a new FeImageElement.
+func FeImage(children ...Element) *FeImageElement {
+	return &FeImageElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *FeImageElement) AppendChildren(children ...Element) *FeImageElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *FeImageElement) RemoveAttrs(names ...string) *FeImageElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *FeImageElement) ID(id String) *FeImageElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *FeImageElement) TabIndex(tabIndex Int) *FeImageElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *FeImageElement) Lang(lang String) *FeImageElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *FeImageElement) Class(class String) *FeImageElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *FeImageElement) Style(style String) *FeImageElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// AlignmentBaseline sets the alignment-baseline attribute.
+func (e *FeImageElement) AlignmentBaseline(alignmentBaseline String) *FeImageElement {
+	e.Attrs["alignment-baseline"] = alignmentBaseline
+	return e
+}
+
+// BaselineShift sets the baseline-shift attribute.
+func (e *FeImageElement) BaselineShift(baselineShift String) *FeImageElement {
+	e.Attrs["baseline-shift"] = baselineShift
+	return e
+}
+
+// ClipPath sets the clip-path attribute.
+func (e *FeImageElement) ClipPath(clipPath String) *FeImageElement {
+	e.Attrs["clip-path"] = clipPath
+	return e
+}
+
+// ClipRule sets the clip-rule attribute.
+func (e *FeImageElement) ClipRule(clipRule String) *FeImageElement {
+	e.Attrs["clip-rule"] = clipRule
+	return e
+}
+
+// Color sets the color attribute.
+func (e *FeImageElement) Color(color Color) *FeImageElement {
+	e.Attrs["color"] = color
+	return e
+}
+
+// ColorInterpolation sets the color-interpolation attribute.
+func (e *FeImageElement) ColorInterpolation(colorInterpolation String) *FeImageElement {
+	e.Attrs["color-interpolation"] = colorInterpolation
+	return e
+}
+
+// ColorInterpolationFilters sets the color-interpolation-filters attribute.
+func (e *FeImageElement) ColorInterpolationFilters(colorInterpolationFilters String) *FeImageElement {
+	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
+	return e
+}
+
+// ColorRendering sets the color-rendering attribute.
+func (e *FeImageElement) ColorRendering(colorRendering String) *FeImageElement {
+	e.Attrs["color-rendering"] = colorRendering
+	return e
+}
+
+// Cursor sets the cursor attribute.
+func (e *FeImageElement) Cursor(cursor String) *FeImageElement {
+	e.Attrs["cursor"] = cursor
+	return e
+}
+
+// Direction sets the direction attribute.
+func (e *FeImageElement) Direction(direction String) *FeImageElement {
+	e.Attrs["direction"] = direction
+	return e
+}
+
+// Display sets the display attribute.
+func (e *FeImageElement) Display(display String) *FeImageElement {
+	e.Attrs["display"] = display
+	return e
+}
+
+// DominantBaseline sets the dominant-baseline attribute.
+func (e *FeImageElement) DominantBaseline(dominantBaseline String) *FeImageElement {
+	e.Attrs["dominant-baseline"] = dominantBaseline
+	return e
+}
+
+// Fill sets the fill attribute.
+func (e *FeImageElement) Fill(fill String) *FeImageElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *FeImageElement) FillColor(fill Color) *FeImageElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *FeImageElement) FillPaint(fill Paint) *FeImageElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillOpacity sets the fill-opacity attribute.
+func (e *FeImageElement) FillOpacity(fillOpacity Float64) *FeImageElement {
+	e.Attrs["fill-opacity"] = fillOpacity
+	return e
+}
+
+// FillRule sets the fill-rule attribute.
+func (e *FeImageElement) FillRule(fillRule String) *FeImageElement {
+	e.Attrs["fill-rule"] = fillRule
+	return e
+}
+
+// Filter sets the filter attribute.
+func (e *FeImageElement) Filter(filter String) *FeImageElement {
+	e.Attrs["filter"] = filter
+	return e
+}
+
+// FloodColor sets the flood-color attribute.
+func (e *FeImageElement) FloodColor(floodColor Color) *FeImageElement {
+	e.Attrs["flood-color"] = floodColor
+	return e
+}
+
+// FloodOpacity sets the flood-opacity attribute.
+func (e *FeImageElement) FloodOpacity(floodOpacity Float64) *FeImageElement {
+	e.Attrs["flood-opacity"] = floodOpacity
+	return e
+}
+
+// FontFamily sets the font-family attribute.
+func (e *FeImageElement) FontFamily(fontFamily String) *FeImageElement {
+	e.Attrs["font-family"] = fontFamily
+	return e
+}
+
+// FontSize sets the font-size attribute.
+func (e *FeImageElement) FontSize(fontSize String) *FeImageElement {
+	e.Attrs["font-size"] = fontSize
+	return e
+}
+
+// FontSizeAdjust sets the font-size-adjust attribute.
+func (e *FeImageElement) FontSizeAdjust(fontSizeAdjust String) *FeImageElement {
+	e.Attrs["font-size-adjust"] = fontSizeAdjust
+	return e
+}
+
+// FontStretch sets the font-stretch attribute.
+func (e *FeImageElement) FontStretch(fontStretch String) *FeImageElement {
+	e.Attrs["font-stretch"] = fontStretch
+	return e
+}
+
+// FontStyle sets the font-style attribute.
+func (e *FeImageElement) FontStyle(fontStyle String) *FeImageElement {
+	e.Attrs["font-style"] = fontStyle
+	return e
+}
+
+// FontVariant sets the font-variant attribute.
+func (e *FeImageElement) FontVariant(fontVariant String) *FeImageElement {
+	e.Attrs["font-variant"] = fontVariant
+	return e
+}
+
+// FontWeight sets the font-weight attribute.
+func (e *FeImageElement) FontWeight(fontWeight String) *FeImageElement {
+	e.Attrs["font-weight"] = fontWeight
+	return e
+}
+
+// GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
+func (e *FeImageElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *FeImageElement {
+	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
+	return e
+}
+
+// GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
+func (e *FeImageElement) GlyphOrientationVertical(glyphOrientationVertical String) *FeImageElement {
+	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
+	return e
+}
+
+// ImageRendering sets the image-rendering attribute.
+func (e *FeImageElement) ImageRendering(imageRendering String) *FeImageElement {
+	e.Attrs["image-rendering"] = imageRendering
+	return e
+}
+
+// LetterSpacing sets the letter-spacing attribute.
+func (e *FeImageElement) LetterSpacing(letterSpacing String) *FeImageElement {
+	e.Attrs["letter-spacing"] = letterSpacing
+	return e
+}
+
+// LightingColor sets the lighting-color attribute.
+func (e *FeImageElement) LightingColor(lightingColor Color) *FeImageElement {
+	e.Attrs["lighting-color"] = lightingColor
+	return e
+}
+
+// MarkerEnd sets the marker-end attribute.
+func (e *FeImageElement) MarkerEnd(markerEnd String) *FeImageElement {
+	e.Attrs["marker-end"] = markerEnd
+	return e
+}
+
+// MarkerMid sets the marker-mid attribute.
+func (e *FeImageElement) MarkerMid(markerMid String) *FeImageElement {
+	e.Attrs["marker-mid"] = markerMid
+	return e
+}
+
+// MarkerStart sets the marker-start attribute.
+func (e *FeImageElement) MarkerStart(markerStart String) *FeImageElement {
+	e.Attrs["marker-start"] = markerStart
+	return e
+}
+
+// Mask sets the mask attribute.
+func (e *FeImageElement) Mask(mask String) *FeImageElement {
+	e.Attrs["mask"] = mask
+	return e
+}
+
+// Opacity sets the opacity attribute.
+func (e *FeImageElement) Opacity(opacity Float64) *FeImageElement {
+	e.Attrs["opacity"] = opacity
+	return e
+}
+
+// Overflow sets the overflow attribute.
+func (e *FeImageElement) Overflow(overflow String) *FeImageElement {
+	e.Attrs["overflow"] = overflow
+	return e
+}
+
+// PaintOrder sets the paint-order attribute.
+func (e *FeImageElement) PaintOrder(paintOrder String) *FeImageElement {
+	e.Attrs["paint-order"] = paintOrder
+	return e
+}
+
+// PointerEvents sets the pointer-events attribute.
+func (e *FeImageElement) PointerEvents(pointerEvents String) *FeImageElement {
+	e.Attrs["pointer-events"] = pointerEvents
+	return e
+}
+
+// ShapeRendering sets the shape-rendering attribute.
+func (e *FeImageElement) ShapeRendering(shapeRendering String) *FeImageElement {
+	e.Attrs["shape-rendering"] = shapeRendering
+	return e
+}
+
+// StopColor sets the stop-color attribute.
+func (e *FeImageElement) StopColor(stopColor Color) *FeImageElement {
+	e.Attrs["stop-color"] = stopColor
+	return e
+}
+
+// StopOpacity sets the stop-opacity attribute.
+func (e *FeImageElement) StopOpacity(stopOpacity Float64) *FeImageElement {
+	e.Attrs["stop-opacity"] = stopOpacity
+	return e
+}
+
+// Stroke sets the stroke attribute.
+func (e *FeImageElement) Stroke(stroke String) *FeImageElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *FeImageElement) StrokeColor(stroke Color) *FeImageElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *FeImageElement) StrokePaint(stroke Paint) *FeImageElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeDashArray sets the stroke-dasharray attribute.
+func (e *FeImageElement) StrokeDashArray(strokeDashArray String) *FeImageElement {
+	e.Attrs["stroke-dasharray"] = strokeDashArray
+	return e
+}
+
+// StrokeDashOffset sets the stroke-dashoffset attribute.
+func (e *FeImageElement) StrokeDashOffset(strokeDashOffset Float64) *FeImageElement {
+	e.Attrs["stroke-dashoffset"] = strokeDashOffset
+	return e
+}
+
+// StrokeLineCap sets the stroke-linecap attribute.
+func (e *FeImageElement) StrokeLineCap(strokeLineCap String) *FeImageElement {
+	e.Attrs["stroke-linecap"] = strokeLineCap
+	return e
+}
+
+// StrokeLineJoin sets the stroke-linejoin attribute.
+func (e *FeImageElement) StrokeLineJoin(strokeLineJoin String) *FeImageElement {
+	e.Attrs["stroke-linejoin"] = strokeLineJoin
+	return e
+}
+
+// StrokeMiterLimit sets the stroke-miterlimit attribute.
+func (e *FeImageElement) StrokeMiterLimit(strokeMiterLimit Float64) *FeImageElement {
+	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
+	return e
+}
+
+// StrokeOpacity sets the stroke-opacity attribute.
+func (e *FeImageElement) StrokeOpacity(strokeOpacity Float64) *FeImageElement {
+	e.Attrs["stroke-opacity"] = strokeOpacity
+	return e
+}
+
+// StrokeWidth sets the stroke-width attribute.
+func (e *FeImageElement) StrokeWidth(strokeWidth Length) *FeImageElement {
+	e.Attrs["stroke-width"] = strokeWidth
+	return e
+}
+
+// TextAnchor sets the text-anchor attribute.
+func (e *FeImageElement) TextAnchor(textAnchor String) *FeImageElement {
+	e.Attrs["text-anchor"] = textAnchor
+	return e
+}
+
+// TextDecoration sets the text-decoration attribute.
+func (e *FeImageElement) TextDecoration(textDecoration String) *FeImageElement {
+	e.Attrs["text-decoration"] = textDecoration
+	return e
+}
+
+// TextOverflow sets the text-overflow attribute.
+func (e *FeImageElement) TextOverflow(textOverflow String) *FeImageElement {
+	e.Attrs["text-overflow"] = textOverflow
+	return e
+}
+
+// TextRendering sets the text-rendering attribute.
+func (e *FeImageElement) TextRendering(textRendering String) *FeImageElement {
+	e.Attrs["text-rendering"] = textRendering
+	return e
+}
+
+// Transform sets the transform attribute.
+func (e *FeImageElement) Transform(transform Transform) *FeImageElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
+// UnicodeBiDi sets the unicode-bidi attribute.
+func (e *FeImageElement) UnicodeBiDi(unicodeBiDi String) *FeImageElement {
+	e.Attrs["unicode-bidi"] = unicodeBiDi
+	return e
+}
+
+// VectorEffect sets the vector-effect attribute.
+func (e *FeImageElement) VectorEffect(vectorEffect String) *FeImageElement {
+	e.Attrs["vector-effect"] = vectorEffect
+	return e
+}
+
+// Visibility sets the visibility attribute.
+func (e *FeImageElement) Visibility(visibility String) *FeImageElement {
+	e.Attrs["visibility"] = visibility
+	return e
+}
+
+// WhiteSpace sets the white-space attribute.
+func (e *FeImageElement) WhiteSpace(whiteSpace String) *FeImageElement {
+	e.Attrs["white-space"] = whiteSpace
+	return e
+}
+
+// WordSpacing sets the word-spacing attribute.
+func (e *FeImageElement) WordSpacing(wordSpacing String) *FeImageElement {
+	e.Attrs["word-spacing"] = wordSpacing
+	return e
+}
+
+// WritingMode sets the writing-mode attribute.
+func (e *FeImageElement) WritingMode(writingMode String) *FeImageElement {
+	e.Attrs["writing-mode"] = writingMode
+	return e
+}
+
+// X sets the x attribute.
+func (e *FeImageElement) X(x Length) *FeImageElement {
+	e.Attrs["x"] = x
+	return e
+}
+
+// Y sets the y attribute.
+func (e *FeImageElement) Y(y Length) *FeImageElement {
+	e.Attrs["y"] = y
+	return e
+}
+
+// Width sets the width attribute.
+func (e *FeImageElement) Width(width Length) *FeImageElement {
+	e.Attrs["width"] = width
+	return e
+}
+
+// Height sets the height attribute.
+func (e *FeImageElement) Height(height Length) *FeImageElement {
+	e.Attrs["height"] = height
+	return e
+}
+
+// Result sets the result attribute.
+func (e *FeImageElement) Result(result String) *FeImageElement {
+	e.Attrs["result"] = result
+	return e
+}
+
+// Href sets the href attribute.
+func (e *FeImageElement) Href(href String) *FeImageElement {
+	e.Attrs["href"] = href
+	return e
+}
+
+// PreserveAspectRatio sets the preserveAspectRatio attribute.
+func (e *FeImageElement) PreserveAspectRatio(preserveAspectRatio String) *FeImageElement {
+	e.Attrs["preserveAspectRatio"] = preserveAspectRatio
+	return e
+}
+
+// CrossOrigin sets the crossorigin attribute.
+func (e *FeImageElement) CrossOrigin(crossOrigin String) *FeImageElement {
+	e.Attrs["crossorigin"] = crossOrigin
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *FeImageElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *FeImageElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *FeImageElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *FeImageElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *FeImageElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *FeImageElement) TagName() string {
+	return "feImage"
+}
+
+// decode decodes e from d.
+func (e *FeImageElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *FeImageElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "x":
+		return parseLength(value)
+	case "y":
+		return parseLength(value)
+	case "width":
+		return parseLength(value)
+	case "height":
+		return parseLength(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A FeMergeElement is a feMerge element.
+type FeMergeElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// FeMerge returns a new FeMergeElement.
+func FeMerge(children ...Element) *FeMergeElement {
+	return &FeMergeElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *FeMergeElement) AppendChildren(children ...Element) *FeMergeElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *FeMergeElement) RemoveAttrs(names ...string) *FeMergeElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *FeMergeElement) ID(id String) *FeMergeElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *FeMergeElement) TabIndex(tabIndex Int) *FeMergeElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *FeMergeElement) Lang(lang String) *FeMergeElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *FeMergeElement) Class(class String) *FeMergeElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *FeMergeElement) Style(style String) *FeMergeElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// AlignmentBaseline sets the alignment-baseline attribute.
+func (e *FeMergeElement) AlignmentBaseline(alignmentBaseline String) *FeMergeElement {
+	e.Attrs["alignment-baseline"] = alignmentBaseline
+	return e
+}
+
+// BaselineShift sets the baseline-shift attribute.
+func (e *FeMergeElement) BaselineShift(baselineShift String) *FeMergeElement {
+	e.Attrs["baseline-shift"] = baselineShift
+	return e
+}
+
+// ClipPath sets the clip-path attribute.
+func (e *FeMergeElement) ClipPath(clipPath String) *FeMergeElement {
+	e.Attrs["clip-path"] = clipPath
+	return e
+}
+
+// ClipRule sets the clip-rule attribute.
+func (e *FeMergeElement) ClipRule(clipRule String) *FeMergeElement {
+	e.Attrs["clip-rule"] = clipRule
+	return e
+}
+
+// Color sets the color attribute.
+func (e *FeMergeElement) Color(color Color) *FeMergeElement {
+	e.Attrs["color"] = color
+	return e
+}
+
+// ColorInterpolation sets the color-interpolation attribute.
+func (e *FeMergeElement) ColorInterpolation(colorInterpolation String) *FeMergeElement {
+	e.Attrs["color-interpolation"] = colorInterpolation
+	return e
+}
+
+// ColorInterpolationFilters sets the color-interpolation-filters attribute.
+func (e *FeMergeElement) ColorInterpolationFilters(colorInterpolationFilters String) *FeMergeElement {
+	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
+	return e
+}
+
+// ColorRendering sets the color-rendering attribute.
+func (e *FeMergeElement) ColorRendering(colorRendering String) *FeMergeElement {
+	e.Attrs["color-rendering"] = colorRendering
+	return e
+}
+
+// Cursor sets the cursor attribute.
+func (e *FeMergeElement) Cursor(cursor String) *FeMergeElement {
+	e.Attrs["cursor"] = cursor
+	return e
+}
+
+// Direction sets the direction attribute.
+func (e *FeMergeElement) Direction(direction String) *FeMergeElement {
+	e.Attrs["direction"] = direction
+	return e
+}
+
+// Display sets the display attribute.
+func (e *FeMergeElement) Display(display String) *FeMergeElement {
+	e.Attrs["display"] = display
+	return e
+}
+
+// DominantBaseline sets the dominant-baseline attribute.
+func (e *FeMergeElement) DominantBaseline(dominantBaseline String) *FeMergeElement {
+	e.Attrs["dominant-baseline"] = dominantBaseline
+	return e
+}
+
+// Fill sets the fill attribute.
+func (e *FeMergeElement) Fill(fill String) *FeMergeElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *FeMergeElement) FillColor(fill Color) *FeMergeElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *FeMergeElement) FillPaint(fill Paint) *FeMergeElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillOpacity sets the fill-opacity attribute.
+func (e *FeMergeElement) FillOpacity(fillOpacity Float64) *FeMergeElement {
+	e.Attrs["fill-opacity"] = fillOpacity
+	return e
+}
+
+// FillRule sets the fill-rule attribute.
+func (e *FeMergeElement) FillRule(fillRule String) *FeMergeElement {
+	e.Attrs["fill-rule"] = fillRule
+	return e
+}
+
+// Filter sets the filter attribute.
+func (e *FeMergeElement) Filter(filter String) *FeMergeElement {
+	e.Attrs["filter"] = filter
+	return e
+}
+
+// FloodColor sets the flood-color attribute.
+func (e *FeMergeElement) FloodColor(floodColor Color) *FeMergeElement {
+	e.Attrs["flood-color"] = floodColor
+	return e
+}
+
+// FloodOpacity sets the flood-opacity attribute.
+func (e *FeMergeElement) FloodOpacity(floodOpacity Float64) *FeMergeElement {
+	e.Attrs["flood-opacity"] = floodOpacity
+	return e
+}
+
+// FontFamily sets the font-family attribute.
+func (e *FeMergeElement) FontFamily(fontFamily String) *FeMergeElement {
+	e.Attrs["font-family"] = fontFamily
+	return e
+}
+
+// FontSize sets the font-size attribute.
+func (e *FeMergeElement) FontSize(fontSize String) *FeMergeElement {
+	e.Attrs["font-size"] = fontSize
+	return e
+}
+
+// FontSizeAdjust sets the font-size-adjust attribute.
+func (e *FeMergeElement) FontSizeAdjust(fontSizeAdjust String) *FeMergeElement {
+	e.Attrs["font-size-adjust"] = fontSizeAdjust
+	return e
+}
+
+// FontStretch sets the font-stretch attribute.
+func (e *FeMergeElement) FontStretch(fontStretch String) *FeMergeElement {
+	e.Attrs["font-stretch"] = fontStretch
+	return e
+}
+
+// FontStyle sets the font-style attribute.
+func (e *FeMergeElement) FontStyle(fontStyle String) *FeMergeElement {
+	e.Attrs["font-style"] = fontStyle
+	return e
+}
+
+// FontVariant sets the font-variant attribute.
+func (e *FeMergeElement) FontVariant(fontVariant String) *FeMergeElement {
+	e.Attrs["font-variant"] = fontVariant
+	return e
+}
+
+// FontWeight sets the font-weight attribute.
+func (e *FeMergeElement) FontWeight(fontWeight String) *FeMergeElement {
+	e.Attrs["font-weight"] = fontWeight
+	return e
+}
+
+// GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
+func (e *FeMergeElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *FeMergeElement {
+	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
+	return e
+}
+
+// GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
+func (e *FeMergeElement) GlyphOrientationVertical(glyphOrientationVertical String) *FeMergeElement {
+	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
+	return e
+}
+
+// ImageRendering sets the image-rendering attribute.
+func (e *FeMergeElement) ImageRendering(imageRendering String) *FeMergeElement {
+	e.Attrs["image-rendering"] = imageRendering
+	return e
+}
+
+// LetterSpacing sets the letter-spacing attribute.
+func (e *FeMergeElement) LetterSpacing(letterSpacing String) *FeMergeElement {
+	e.Attrs["letter-spacing"] = letterSpacing
+	return e
+}
+
+// LightingColor sets the lighting-color attribute.
+func (e *FeMergeElement) LightingColor(lightingColor Color) *FeMergeElement {
+	e.Attrs["lighting-color"] = lightingColor
+	return e
+}
+
+// MarkerEnd sets the marker-end attribute.
+func (e *FeMergeElement) MarkerEnd(markerEnd String) *FeMergeElement {
+	e.Attrs["marker-end"] = markerEnd
+	return e
+}
+
+// MarkerMid sets the marker-mid attribute.
+func (e *FeMergeElement) MarkerMid(markerMid String) *FeMergeElement {
+	e.Attrs["marker-mid"] = markerMid
+	return e
+}
+
+// MarkerStart sets the marker-start attribute.
+func (e *FeMergeElement) MarkerStart(markerStart String) *FeMergeElement {
+	e.Attrs["marker-start"] = markerStart
+	return e
+}
+
+// Mask sets the mask attribute.
+func (e *FeMergeElement) Mask(mask String) *FeMergeElement {
+	e.Attrs["mask"] = mask
+	return e
+}
+
+// Opacity sets the opacity attribute.
+func (e *FeMergeElement) Opacity(opacity Float64) *FeMergeElement {
+	e.Attrs["opacity"] = opacity
+	return e
+}
+
+// Overflow sets the overflow attribute.
+func (e *FeMergeElement) Overflow(overflow String) *FeMergeElement {
+	e.Attrs["overflow"] = overflow
+	return e
+}
+
+// PaintOrder sets the paint-order attribute.
+func (e *FeMergeElement) PaintOrder(paintOrder String) *FeMergeElement {
+	e.Attrs["paint-order"] = paintOrder
+	return e
+}
+
+// PointerEvents sets the pointer-events attribute.
+func (e *FeMergeElement) PointerEvents(pointerEvents String) *FeMergeElement {
+	e.Attrs["pointer-events"] = pointerEvents
+	return e
+}
+
+// ShapeRendering sets the shape-rendering attribute.
+func (e *FeMergeElement) ShapeRendering(shapeRendering String) *FeMergeElement {
+	e.Attrs["shape-rendering"] = shapeRendering
+	return e
+}
+
+// StopColor sets the stop-color attribute.
+func (e *FeMergeElement) StopColor(stopColor Color) *FeMergeElement {
+	e.Attrs["stop-color"] = stopColor
+	return e
+}
+
+// StopOpacity sets the stop-opacity attribute.
+func (e *FeMergeElement) StopOpacity(stopOpacity Float64) *FeMergeElement {
+	e.Attrs["stop-opacity"] = stopOpacity
+	return e
+}
+
+// Stroke sets the stroke attribute.
+func (e *FeMergeElement) Stroke(stroke String) *FeMergeElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *FeMergeElement) StrokeColor(stroke Color) *FeMergeElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *FeMergeElement) StrokePaint(stroke Paint) *FeMergeElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeDashArray sets the stroke-dasharray attribute.
+func (e *FeMergeElement) StrokeDashArray(strokeDashArray String) *FeMergeElement {
+	e.Attrs["stroke-dasharray"] = strokeDashArray
+	return e
+}
+
+// StrokeDashOffset sets the stroke-dashoffset attribute.
+func (e *FeMergeElement) StrokeDashOffset(strokeDashOffset Float64) *FeMergeElement {
+	e.Attrs["stroke-dashoffset"] = strokeDashOffset
+	return e
+}
+
+// StrokeLineCap sets the stroke-linecap attribute.
+func (e *FeMergeElement) StrokeLineCap(strokeLineCap String) *FeMergeElement {
+	e.Attrs["stroke-linecap"] = strokeLineCap
+	return e
+}
+
+// StrokeLineJoin sets the stroke-linejoin attribute.
+func (e *FeMergeElement) StrokeLineJoin(strokeLineJoin String) *FeMergeElement {
+	e.Attrs["stroke-linejoin"] = strokeLineJoin
+	return e
+}
+
+// StrokeMiterLimit sets the stroke-miterlimit attribute.
+func (e *FeMergeElement) StrokeMiterLimit(strokeMiterLimit Float64) *FeMergeElement {
+	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
+	return e
+}
+
+// StrokeOpacity sets the stroke-opacity attribute.
+func (e *FeMergeElement) StrokeOpacity(strokeOpacity Float64) *FeMergeElement {
+	e.Attrs["stroke-opacity"] = strokeOpacity
+	return e
+}
+
+// StrokeWidth sets the stroke-width attribute.
+func (e *FeMergeElement) StrokeWidth(strokeWidth Length) *FeMergeElement {
+	e.Attrs["stroke-width"] = strokeWidth
+	return e
+}
+
+// TextAnchor sets the text-anchor attribute.
+func (e *FeMergeElement) TextAnchor(textAnchor String) *FeMergeElement {
+	e.Attrs["text-anchor"] = textAnchor
+	return e
+}
+
+// TextDecoration sets the text-decoration attribute.
+func (e *FeMergeElement) TextDecoration(textDecoration String) *FeMergeElement {
+	e.Attrs["text-decoration"] = textDecoration
+	return e
+}
+
+// TextOverflow sets the text-overflow attribute.
+func (e *FeMergeElement) TextOverflow(textOverflow String) *FeMergeElement {
+	e.Attrs["text-overflow"] = textOverflow
+	return e
+}
+
+// TextRendering sets the text-rendering attribute.
+func (e *FeMergeElement) TextRendering(textRendering String) *FeMergeElement {
+	e.Attrs["text-rendering"] = textRendering
+	return e
+}
+
+// Transform sets the transform attribute.
+func (e *FeMergeElement) Transform(transform Transform) *FeMergeElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
+// UnicodeBiDi sets the unicode-bidi attribute.
+func (e *FeMergeElement) UnicodeBiDi(unicodeBiDi String) *FeMergeElement {
+	e.Attrs["unicode-bidi"] = unicodeBiDi
+	return e
+}
+
+// VectorEffect sets the vector-effect attribute.
+func (e *FeMergeElement) VectorEffect(vectorEffect String) *FeMergeElement {
+	e.Attrs["vector-effect"] = vectorEffect
+	return e
+}
+
+// Visibility sets the visibility attribute.
+func (e *FeMergeElement) Visibility(visibility String) *FeMergeElement {
+	e.Attrs["visibility"] = visibility
+	return e
+}
+
+// WhiteSpace sets the white-space attribute.
+func (e *FeMergeElement) WhiteSpace(whiteSpace String) *FeMergeElement {
+	e.Attrs["white-space"] = whiteSpace
+	return e
+}
+
+// WordSpacing sets the word-spacing attribute.
+func (e *FeMergeElement) WordSpacing(wordSpacing String) *FeMergeElement {
+	e.Attrs["word-spacing"] = wordSpacing
+	return e
+}
+
+// WritingMode sets the writing-mode attribute.
+func (e *FeMergeElement) WritingMode(writingMode String) *FeMergeElement {
+	e.Attrs["writing-mode"] = writingMode
+	return e
+}
+
+// X sets the x attribute.
+func (e *FeMergeElement) X(x Length) *FeMergeElement {
+	e.Attrs["x"] = x
+	return e
+}
+
+// Y sets the y attribute.
+func (e *FeMergeElement) Y(y Length) *FeMergeElement {
+	e.Attrs["y"] = y
+	return e
+}
+
+// Width sets the width attribute.
+func (e *FeMergeElement) Width(width Length) *FeMergeElement {
+	e.Attrs["width"] = width
+	return e
+}
+
+// Height sets the height attribute.
+func (e *FeMergeElement) Height(height Length) *FeMergeElement {
+	e.Attrs["height"] = height
+	return e
+}
+
+// Result sets the result attribute.
+func (e *FeMergeElement) Result(result String) *FeMergeElement {
+	e.Attrs["result"] = result
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *FeMergeElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *FeMergeElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *FeMergeElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *FeMergeElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *FeMergeElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *FeMergeElement) TagName() string {
+	return "feMerge"
+}
+
+// decode decodes e from d.
+func (e *FeMergeElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *FeMergeElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "x":
+		return parseLength(value)
+	case "y":
+		return parseLength(value)
+	case "width":
+		return parseLength(value)
+	case "height":
+		return parseLength(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A FeMergeNodeElement is a feMergeNode element.
+type FeMergeNodeElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// FeMergeNode returns a new FeMergeNodeElement.
+func FeMergeNode(children ...Element) *FeMergeNodeElement {
+	return &FeMergeNodeElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *FeMergeNodeElement) AppendChildren(children ...Element) *FeMergeNodeElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *FeMergeNodeElement) RemoveAttrs(names ...string) *FeMergeNodeElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *FeMergeNodeElement) ID(id String) *FeMergeNodeElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *FeMergeNodeElement) TabIndex(tabIndex Int) *FeMergeNodeElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *FeMergeNodeElement) Lang(lang String) *FeMergeNodeElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *FeMergeNodeElement) Class(class String) *FeMergeNodeElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *FeMergeNodeElement) Style(style String) *FeMergeNodeElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// In sets the in attribute.
+func (e *FeMergeNodeElement) In(_in String) *FeMergeNodeElement {
+	e.Attrs["in"] = _in
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *FeMergeNodeElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *FeMergeNodeElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *FeMergeNodeElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *FeMergeNodeElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *FeMergeNodeElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *FeMergeNodeElement) TagName() string {
+	return "feMergeNode"
+}
+
+// decode decodes e from d.
+func (e *FeMergeNodeElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *FeMergeNodeElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A FeMorphologyElement is a feMorphology element.
+type FeMorphologyElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// FeMorphology returns a new FeMorphologyElement.
+func FeMorphology(children ...Element) *FeMorphologyElement {
+	return &FeMorphologyElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *FeMorphologyElement) AppendChildren(children ...Element) *FeMorphologyElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *FeMorphologyElement) RemoveAttrs(names ...string) *FeMorphologyElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *FeMorphologyElement) ID(id String) *FeMorphologyElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *FeMorphologyElement) TabIndex(tabIndex Int) *FeMorphologyElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *FeMorphologyElement) Lang(lang String) *FeMorphologyElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *FeMorphologyElement) Class(class String) *FeMorphologyElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *FeMorphologyElement) Style(style String) *FeMorphologyElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// AlignmentBaseline sets the alignment-baseline attribute.
+func (e *FeMorphologyElement) AlignmentBaseline(alignmentBaseline String) *FeMorphologyElement {
+	e.Attrs["alignment-baseline"] = alignmentBaseline
+	return e
+}
+
+// BaselineShift sets the baseline-shift attribute.
+func (e *FeMorphologyElement) BaselineShift(baselineShift String) *FeMorphologyElement {
+	e.Attrs["baseline-shift"] = baselineShift
+	return e
+}
+
+// ClipPath sets the clip-path attribute.
+func (e *FeMorphologyElement) ClipPath(clipPath String) *FeMorphologyElement {
+	e.Attrs["clip-path"] = clipPath
+	return e
+}
+
+// ClipRule sets the clip-rule attribute.
+func (e *FeMorphologyElement) ClipRule(clipRule String) *FeMorphologyElement {
+	e.Attrs["clip-rule"] = clipRule
+	return e
+}
+
+// Color sets the color attribute.
+func (e *FeMorphologyElement) Color(color Color) *FeMorphologyElement {
+	e.Attrs["color"] = color
+	return e
+}
+
+// ColorInterpolation sets the color-interpolation attribute.
+func (e *FeMorphologyElement) ColorInterpolation(colorInterpolation String) *FeMorphologyElement {
+	e.Attrs["color-interpolation"] = colorInterpolation
+	return e
+}
+
+// ColorInterpolationFilters sets the color-interpolation-filters attribute.
+func (e *FeMorphologyElement) ColorInterpolationFilters(colorInterpolationFilters String) *FeMorphologyElement {
+	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
+	return e
+}
+
+// ColorRendering sets the color-rendering attribute.
+func (e *FeMorphologyElement) ColorRendering(colorRendering String) *FeMorphologyElement {
+	e.Attrs["color-rendering"] = colorRendering
+	return e
+}
+
+// Cursor sets the cursor attribute.
+func (e *FeMorphologyElement) Cursor(cursor String) *FeMorphologyElement {
+	e.Attrs["cursor"] = cursor
+	return e
+}
+
+// Direction sets the direction attribute.
+func (e *FeMorphologyElement) Direction(direction String) *FeMorphologyElement {
+	e.Attrs["direction"] = direction
+	return e
+}
+
+// Display sets the display attribute.
+func (e *FeMorphologyElement) Display(display String) *FeMorphologyElement {
+	e.Attrs["display"] = display
+	return e
+}
+
+// DominantBaseline sets the dominant-baseline attribute.
+func (e *FeMorphologyElement) DominantBaseline(dominantBaseline String) *FeMorphologyElement {
+	e.Attrs["dominant-baseline"] = dominantBaseline
+	return e
+}
+
+// Fill sets the fill attribute.
+func (e *FeMorphologyElement) Fill(fill String) *FeMorphologyElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *FeMorphologyElement) FillColor(fill Color) *FeMorphologyElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *FeMorphologyElement) FillPaint(fill Paint) *FeMorphologyElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillOpacity sets the fill-opacity attribute.
+func (e *FeMorphologyElement) FillOpacity(fillOpacity Float64) *FeMorphologyElement {
+	e.Attrs["fill-opacity"] = fillOpacity
+	return e
+}
+
+// FillRule sets the fill-rule attribute.
+func (e *FeMorphologyElement) FillRule(fillRule String) *FeMorphologyElement {
+	e.Attrs["fill-rule"] = fillRule
+	return e
+}
+
+// Filter sets the filter attribute.
+func (e *FeMorphologyElement) Filter(filter String) *FeMorphologyElement {
+	e.Attrs["filter"] = filter
+	return e
+}
+
+// FloodColor sets the flood-color attribute.
+func (e *FeMorphologyElement) FloodColor(floodColor Color) *FeMorphologyElement {
+	e.Attrs["flood-color"] = floodColor
+	return e
+}
+
+// FloodOpacity sets the flood-opacity attribute.
+func (e *FeMorphologyElement) FloodOpacity(floodOpacity Float64) *FeMorphologyElement {
+	e.Attrs["flood-opacity"] = floodOpacity
+	return e
+}
+
+// FontFamily sets the font-family attribute.
+func (e *FeMorphologyElement) FontFamily(fontFamily String) *FeMorphologyElement {
+	e.Attrs["font-family"] = fontFamily
+	return e
+}
+
+// FontSize sets the font-size attribute.
+func (e *FeMorphologyElement) FontSize(fontSize String) *FeMorphologyElement {
+	e.Attrs["font-size"] = fontSize
+	return e
+}
+
+// FontSizeAdjust sets the font-size-adjust attribute.
+func (e *FeMorphologyElement) FontSizeAdjust(fontSizeAdjust String) *FeMorphologyElement {
+	e.Attrs["font-size-adjust"] = fontSizeAdjust
+	return e
+}
+
+// FontStretch sets the font-stretch attribute.
+func (e *FeMorphologyElement) FontStretch(fontStretch String) *FeMorphologyElement {
+	e.Attrs["font-stretch"] = fontStretch
+	return e
+}
+
+// FontStyle sets the font-style attribute.
+func (e *FeMorphologyElement) FontStyle(fontStyle String) *FeMorphologyElement {
+	e.Attrs["font-style"] = fontStyle
+	return e
+}
+
+// FontVariant sets the font-variant attribute.
+func (e *FeMorphologyElement) FontVariant(fontVariant String) *FeMorphologyElement {
+	e.Attrs["font-variant"] = fontVariant
+	return e
+}
+
+// FontWeight sets the font-weight attribute.
+func (e *FeMorphologyElement) FontWeight(fontWeight String) *FeMorphologyElement {
+	e.Attrs["font-weight"] = fontWeight
+	return e
+}
+
+// GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
+func (e *FeMorphologyElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *FeMorphologyElement {
+	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
+	return e
+}
+
+// GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
+func (e *FeMorphologyElement) GlyphOrientationVertical(glyphOrientationVertical String) *FeMorphologyElement {
+	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
+	return e
+}
+
+// ImageRendering sets the image-rendering attribute.
+func (e *FeMorphologyElement) ImageRendering(imageRendering String) *FeMorphologyElement {
+	e.Attrs["image-rendering"] = imageRendering
+	return e
+}
+
+// LetterSpacing sets the letter-spacing attribute.
+func (e *FeMorphologyElement) LetterSpacing(letterSpacing String) *FeMorphologyElement {
+	e.Attrs["letter-spacing"] = letterSpacing
+	return e
+}
+
+// LightingColor sets the lighting-color attribute.
+func (e *FeMorphologyElement) LightingColor(lightingColor Color) *FeMorphologyElement {
+	e.Attrs["lighting-color"] = lightingColor
+	return e
+}
+
+// MarkerEnd sets the marker-end attribute.
+func (e *FeMorphologyElement) MarkerEnd(markerEnd String) *FeMorphologyElement {
+	e.Attrs["marker-end"] = markerEnd
+	return e
+}
+
+// MarkerMid sets the marker-mid attribute.
+func (e *FeMorphologyElement) MarkerMid(markerMid String) *FeMorphologyElement {
+	e.Attrs["marker-mid"] = markerMid
+	return e
+}
+
+// MarkerStart sets the marker-start attribute.
+func (e *FeMorphologyElement) MarkerStart(markerStart String) *FeMorphologyElement {
+	e.Attrs["marker-start"] = markerStart
+	return e
+}
+
+// Mask sets the mask attribute.
+func (e *FeMorphologyElement) Mask(mask String) *FeMorphologyElement {
+	e.Attrs["mask"] = mask
+	return e
+}
+
+// Opacity sets the opacity attribute.
+func (e *FeMorphologyElement) Opacity(opacity Float64) *FeMorphologyElement {
+	e.Attrs["opacity"] = opacity
+	return e
+}
+
+// Overflow sets the overflow attribute.
+func (e *FeMorphologyElement) Overflow(overflow String) *FeMorphologyElement {
+	e.Attrs["overflow"] = overflow
+	return e
+}
+
+// PaintOrder sets the paint-order attribute.
+func (e *FeMorphologyElement) PaintOrder(paintOrder String) *FeMorphologyElement {
+	e.Attrs["paint-order"] = paintOrder
+	return e
+}
+
+// PointerEvents sets the pointer-events attribute.
+func (e *FeMorphologyElement) PointerEvents(pointerEvents String) *FeMorphologyElement {
+	e.Attrs["pointer-events"] = pointerEvents
+	return e
+}
+
+// ShapeRendering sets the shape-rendering attribute.
+func (e *FeMorphologyElement) ShapeRendering(shapeRendering String) *FeMorphologyElement {
+	e.Attrs["shape-rendering"] = shapeRendering
+	return e
+}
+
+// StopColor sets the stop-color attribute.
+func (e *FeMorphologyElement) StopColor(stopColor Color) *FeMorphologyElement {
+	e.Attrs["stop-color"] = stopColor
+	return e
+}
+
+// StopOpacity sets the stop-opacity attribute.
+func (e *FeMorphologyElement) StopOpacity(stopOpacity Float64) *FeMorphologyElement {
+	e.Attrs["stop-opacity"] = stopOpacity
+	return e
+}
+
+// Stroke sets the stroke attribute.
+func (e *FeMorphologyElement) Stroke(stroke String) *FeMorphologyElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *FeMorphologyElement) StrokeColor(stroke Color) *FeMorphologyElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *FeMorphologyElement) StrokePaint(stroke Paint) *FeMorphologyElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeDashArray sets the stroke-dasharray attribute.
+func (e *FeMorphologyElement) StrokeDashArray(strokeDashArray String) *FeMorphologyElement {
+	e.Attrs["stroke-dasharray"] = strokeDashArray
+	return e
+}
+
+// StrokeDashOffset sets the stroke-dashoffset attribute.
+func (e *FeMorphologyElement) StrokeDashOffset(strokeDashOffset Float64) *FeMorphologyElement {
+	e.Attrs["stroke-dashoffset"] = strokeDashOffset
+	return e
+}
+
+// StrokeLineCap sets the stroke-linecap attribute.
+func (e *FeMorphologyElement) StrokeLineCap(strokeLineCap String) *FeMorphologyElement {
+	e.Attrs["stroke-linecap"] = strokeLineCap
+	return e
+}
+
+// StrokeLineJoin sets the stroke-linejoin attribute.
+func (e *FeMorphologyElement) StrokeLineJoin(strokeLineJoin String) *FeMorphologyElement {
+	e.Attrs["stroke-linejoin"] = strokeLineJoin
+	return e
+}
+
+// StrokeMiterLimit sets the stroke-miterlimit attribute.
+func (e *FeMorphologyElement) StrokeMiterLimit(strokeMiterLimit Float64) *FeMorphologyElement {
+	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
+	return e
+}
+
+// StrokeOpacity sets the stroke-opacity attribute.
+func (e *FeMorphologyElement) StrokeOpacity(strokeOpacity Float64) *FeMorphologyElement {
+	e.Attrs["stroke-opacity"] = strokeOpacity
+	return e
+}
+
+// StrokeWidth sets the stroke-width attribute.
+func (e *FeMorphologyElement) StrokeWidth(strokeWidth Length) *FeMorphologyElement {
+	e.Attrs["stroke-width"] = strokeWidth
+	return e
+}
+
+// TextAnchor sets the text-anchor attribute.
+func (e *FeMorphologyElement) TextAnchor(textAnchor String) *FeMorphologyElement {
+	e.Attrs["text-anchor"] = textAnchor
+	return e
+}
+
+// TextDecoration sets the text-decoration attribute.
+func (e *FeMorphologyElement) TextDecoration(textDecoration String) *FeMorphologyElement {
+	e.Attrs["text-decoration"] = textDecoration
+	return e
+}
+
+// TextOverflow sets the text-overflow attribute.
+func (e *FeMorphologyElement) TextOverflow(textOverflow String) *FeMorphologyElement {
+	e.Attrs["text-overflow"] = textOverflow
+	return e
+}
+
+// TextRendering sets the text-rendering attribute.
+func (e *FeMorphologyElement) TextRendering(textRendering String) *FeMorphologyElement {
+	e.Attrs["text-rendering"] = textRendering
+	return e
+}
+
+// Transform sets the transform attribute.
+func (e *FeMorphologyElement) Transform(transform Transform) *FeMorphologyElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
+// UnicodeBiDi sets the unicode-bidi attribute.
+func (e *FeMorphologyElement) UnicodeBiDi(unicodeBiDi String) *FeMorphologyElement {
+	e.Attrs["unicode-bidi"] = unicodeBiDi
+	return e
+}
+
+// VectorEffect sets the vector-effect attribute.
+func (e *FeMorphologyElement) VectorEffect(vectorEffect String) *FeMorphologyElement {
+	e.Attrs["vector-effect"] = vectorEffect
+	return e
+}
+
+// Visibility sets the visibility attribute.
+func (e *FeMorphologyElement) Visibility(visibility String) *FeMorphologyElement {
+	e.Attrs["visibility"] = visibility
+	return e
+}
+
+// WhiteSpace sets the white-space attribute.
+func (e *FeMorphologyElement) WhiteSpace(whiteSpace String) *FeMorphologyElement {
+	e.Attrs["white-space"] = whiteSpace
+	return e
+}
+
+// WordSpacing sets the word-spacing attribute.
+func (e *FeMorphologyElement) WordSpacing(wordSpacing String) *FeMorphologyElement {
+	e.Attrs["word-spacing"] = wordSpacing
+	return e
+}
+
+// WritingMode sets the writing-mode attribute.
+func (e *FeMorphologyElement) WritingMode(writingMode String) *FeMorphologyElement {
+	e.Attrs["writing-mode"] = writingMode
+	return e
+}
+
+// X sets the x attribute.
+func (e *FeMorphologyElement) X(x Length) *FeMorphologyElement {
+	e.Attrs["x"] = x
+	return e
+}
+
+// Y sets the y attribute.
+func (e *FeMorphologyElement) Y(y Length) *FeMorphologyElement {
+	e.Attrs["y"] = y
+	return e
+}
+
+// Width sets the width attribute.
+func (e *FeMorphologyElement) Width(width Length) *FeMorphologyElement {
+	e.Attrs["width"] = width
+	return e
+}
+
+// Height sets the height attribute.
+func (e *FeMorphologyElement) Height(height Length) *FeMorphologyElement {
+	e.Attrs["height"] = height
+	return e
+}
+
+// Result sets the result attribute.
+func (e *FeMorphologyElement) Result(result String) *FeMorphologyElement {
+	e.Attrs["result"] = result
+	return e
+}
+
+// In sets the in attribute.
+func (e *FeMorphologyElement) In(_in String) *FeMorphologyElement {
+	e.Attrs["in"] = _in
+	return e
+}
+
+// Operator sets the operator attribute.
+func (e *FeMorphologyElement) Operator(operator String) *FeMorphologyElement {
+	e.Attrs["operator"] = operator
+	return e
+}
+
+// Radius sets the radius attribute.
+func (e *FeMorphologyElement) Radius(radius Numbers) *FeMorphologyElement {
+	e.Attrs["radius"] = radius
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *FeMorphologyElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *FeMorphologyElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *FeMorphologyElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *FeMorphologyElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *FeMorphologyElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *FeMorphologyElement) TagName() string {
+	return "feMorphology"
+}
+
+// decode decodes e from d.
+func (e *FeMorphologyElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *FeMorphologyElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "x":
+		return parseLength(value)
+	case "y":
+		return parseLength(value)
+	case "width":
+		return parseLength(value)
+	case "height":
+		return parseLength(value)
+	case "radius":
+		return parseNumbers(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A FeOffsetElement is a feOffset element.
+type FeOffsetElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// FeOffset returns a new FeOffsetElement.
+func FeOffset(children ...Element) *FeOffsetElement {
+	return &FeOffsetElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *FeOffsetElement) AppendChildren(children ...Element) *FeOffsetElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *FeOffsetElement) RemoveAttrs(names ...string) *FeOffsetElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *FeOffsetElement) ID(id String) *FeOffsetElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *FeOffsetElement) TabIndex(tabIndex Int) *FeOffsetElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *FeOffsetElement) Lang(lang String) *FeOffsetElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *FeOffsetElement) Class(class String) *FeOffsetElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *FeOffsetElement) Style(style String) *FeOffsetElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// AlignmentBaseline sets the alignment-baseline attribute.
+func (e *FeOffsetElement) AlignmentBaseline(alignmentBaseline String) *FeOffsetElement {
+	e.Attrs["alignment-baseline"] = alignmentBaseline
+	return e
+}
+
+// BaselineShift sets the baseline-shift attribute.
+func (e *FeOffsetElement) BaselineShift(baselineShift String) *FeOffsetElement {
+	e.Attrs["baseline-shift"] = baselineShift
+	return e
+}
+
+// ClipPath sets the clip-path attribute.
+func (e *FeOffsetElement) ClipPath(clipPath String) *FeOffsetElement {
+	e.Attrs["clip-path"] = clipPath
+	return e
+}
+
+// ClipRule sets the clip-rule attribute.
+func (e *FeOffsetElement) ClipRule(clipRule String) *FeOffsetElement {
+	e.Attrs["clip-rule"] = clipRule
+	return e
+}
+
+// Color sets the color attribute.
+func (e *FeOffsetElement) Color(color Color) *FeOffsetElement {
+	e.Attrs["color"] = color
+	return e
+}
+
+// ColorInterpolation sets the color-interpolation attribute.
+func (e *FeOffsetElement) ColorInterpolation(colorInterpolation String) *FeOffsetElement {
+	e.Attrs["color-interpolation"] = colorInterpolation
+	return e
+}
+
+// ColorInterpolationFilters sets the color-interpolation-filters attribute.
+func (e *FeOffsetElement) ColorInterpolationFilters(colorInterpolationFilters String) *FeOffsetElement {
+	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
+	return e
+}
+
+// ColorRendering sets the color-rendering attribute.
+func (e *FeOffsetElement) ColorRendering(colorRendering String) *FeOffsetElement {
+	e.Attrs["color-rendering"] = colorRendering
+	return e
+}
+
+// Cursor sets the cursor attribute.
+func (e *FeOffsetElement) Cursor(cursor String) *FeOffsetElement {
+	e.Attrs["cursor"] = cursor
+	return e
+}
+
+// Direction sets the direction attribute.
+func (e *FeOffsetElement) Direction(direction String) *FeOffsetElement {
+	e.Attrs["direction"] = direction
+	return e
+}
+
+// Display sets the display attribute.
+func (e *FeOffsetElement) Display(display String) *FeOffsetElement {
+	e.Attrs["display"] = display
+	return e
+}
+
+// DominantBaseline sets the dominant-baseline attribute.
+func (e *FeOffsetElement) DominantBaseline(dominantBaseline String) *FeOffsetElement {
+	e.Attrs["dominant-baseline"] = dominantBaseline
+	return e
+}
+
+// Fill sets the fill attribute.
+func (e *FeOffsetElement) Fill(fill String) *FeOffsetElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *FeOffsetElement) FillColor(fill Color) *FeOffsetElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *FeOffsetElement) FillPaint(fill Paint) *FeOffsetElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillOpacity sets the fill-opacity attribute.
+func (e *FeOffsetElement) FillOpacity(fillOpacity Float64) *FeOffsetElement {
+	e.Attrs["fill-opacity"] = fillOpacity
+	return e
+}
+
+// FillRule sets the fill-rule attribute.
+func (e *FeOffsetElement) FillRule(fillRule String) *FeOffsetElement {
+	e.Attrs["fill-rule"] = fillRule
+	return e
+}
+
+// Filter sets the filter attribute.
+func (e *FeOffsetElement) Filter(filter String) *FeOffsetElement {
+	e.Attrs["filter"] = filter
+	return e
+}
+
+// FloodColor sets the flood-color attribute.
+func (e *FeOffsetElement) FloodColor(floodColor Color) *FeOffsetElement {
+	e.Attrs["flood-color"] = floodColor
+	return e
+}
+
+// FloodOpacity sets the flood-opacity attribute.
+func (e *FeOffsetElement) FloodOpacity(floodOpacity Float64) *FeOffsetElement {
+	e.Attrs["flood-opacity"] = floodOpacity
+	return e
+}
+
+// FontFamily sets the font-family attribute.
+func (e *FeOffsetElement) FontFamily(fontFamily String) *FeOffsetElement {
+	e.Attrs["font-family"] = fontFamily
+	return e
+}
+
+// FontSize sets the font-size attribute.
+func (e *FeOffsetElement) FontSize(fontSize String) *FeOffsetElement {
+	e.Attrs["font-size"] = fontSize
+	return e
+}
+
+// FontSizeAdjust sets the font-size-adjust attribute.
+func (e *FeOffsetElement) FontSizeAdjust(fontSizeAdjust String) *FeOffsetElement {
+	e.Attrs["font-size-adjust"] = fontSizeAdjust
+	return e
+}
+
+// FontStretch sets the font-stretch attribute.
+func (e *FeOffsetElement) FontStretch(fontStretch String) *FeOffsetElement {
+	e.Attrs["font-stretch"] = fontStretch
+	return e
+}
+
+// FontStyle sets the font-style attribute.
+func (e *FeOffsetElement) FontStyle(fontStyle String) *FeOffsetElement {
+	e.Attrs["font-style"] = fontStyle
+	return e
+}
+
+// FontVariant sets the font-variant attribute.
+func (e *FeOffsetElement) FontVariant(fontVariant String) *FeOffsetElement {
+	e.Attrs["font-variant"] = fontVariant
+	return e
+}
+
+// FontWeight sets the font-weight attribute.
+func (e *FeOffsetElement) FontWeight(fontWeight String) *FeOffsetElement {
+	e.Attrs["font-weight"] = fontWeight
+	return e
+}
+
+// GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
+func (e *FeOffsetElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *FeOffsetElement {
+	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
+	return e
+}
+
+// GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
+func (e *FeOffsetElement) GlyphOrientationVertical(glyphOrientationVertical String) *FeOffsetElement {
+	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
+	return e
+}
+
+// ImageRendering sets the image-rendering attribute.
+func (e *FeOffsetElement) ImageRendering(imageRendering String) *FeOffsetElement {
+	e.Attrs["image-rendering"] = imageRendering
+	return e
+}
+
+// LetterSpacing sets the letter-spacing attribute.
+func (e *FeOffsetElement) LetterSpacing(letterSpacing String) *FeOffsetElement {
+	e.Attrs["letter-spacing"] = letterSpacing
+	return e
+}
+
+// LightingColor sets the lighting-color attribute.
+func (e *FeOffsetElement) LightingColor(lightingColor Color) *FeOffsetElement {
+	e.Attrs["lighting-color"] = lightingColor
+	return e
+}
+
+// MarkerEnd sets the marker-end attribute.
+func (e *FeOffsetElement) MarkerEnd(markerEnd String) *FeOffsetElement {
+	e.Attrs["marker-end"] = markerEnd
+	return e
+}
+
+// MarkerMid sets the marker-mid attribute.
+func (e *FeOffsetElement) MarkerMid(markerMid String) *FeOffsetElement {
+	e.Attrs["marker-mid"] = markerMid
+	return e
+}
+
+// MarkerStart sets the marker-start attribute.
+func (e *FeOffsetElement) MarkerStart(markerStart String) *FeOffsetElement {
+	e.Attrs["marker-start"] = markerStart
+	return e
+}
+
+// Mask sets the mask attribute.
+func (e *FeOffsetElement) Mask(mask String) *FeOffsetElement {
+	e.Attrs["mask"] = mask
+	return e
+}
+
+// Opacity sets the opacity attribute.
+func (e *FeOffsetElement) Opacity(opacity Float64) *FeOffsetElement {
+	e.Attrs["opacity"] = opacity
+	return e
+}
+
+// Overflow sets the overflow attribute.
+func (e *FeOffsetElement) Overflow(overflow String) *FeOffsetElement {
+	e.Attrs["overflow"] = overflow
+	return e
+}
+
+// PaintOrder sets the paint-order attribute.
+func (e *FeOffsetElement) PaintOrder(paintOrder String) *FeOffsetElement {
+	e.Attrs["paint-order"] = paintOrder
+	return e
+}
+
+// PointerEvents sets the pointer-events attribute.
+func (e *FeOffsetElement) PointerEvents(pointerEvents String) *FeOffsetElement {
+	e.Attrs["pointer-events"] = pointerEvents
+	return e
+}
+
+// ShapeRendering sets the shape-rendering attribute.
+func (e *FeOffsetElement) ShapeRendering(shapeRendering String) *FeOffsetElement {
+	e.Attrs["shape-rendering"] = shapeRendering
+	return e
+}
+
+// StopColor sets the stop-color attribute.
+func (e *FeOffsetElement) StopColor(stopColor Color) *FeOffsetElement {
+	e.Attrs["stop-color"] = stopColor
+	return e
+}
+
+// StopOpacity sets the stop-opacity attribute.
+func (e *FeOffsetElement) StopOpacity(stopOpacity Float64) *FeOffsetElement {
+	e.Attrs["stop-opacity"] = stopOpacity
+	return e
+}
+
+// Stroke sets the stroke attribute.
+func (e *FeOffsetElement) Stroke(stroke String) *FeOffsetElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *FeOffsetElement) StrokeColor(stroke Color) *FeOffsetElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *FeOffsetElement) StrokePaint(stroke Paint) *FeOffsetElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeDashArray sets the stroke-dasharray attribute.
+func (e *FeOffsetElement) StrokeDashArray(strokeDashArray String) *FeOffsetElement {
+	e.Attrs["stroke-dasharray"] = strokeDashArray
+	return e
+}
+
+// StrokeDashOffset sets the stroke-dashoffset attribute.
+func (e *FeOffsetElement) StrokeDashOffset(strokeDashOffset Float64) *FeOffsetElement {
+	e.Attrs["stroke-dashoffset"] = strokeDashOffset
+	return e
+}
+
+// StrokeLineCap sets the stroke-linecap attribute.
+func (e *FeOffsetElement) StrokeLineCap(strokeLineCap String) *FeOffsetElement {
+	e.Attrs["stroke-linecap"] = strokeLineCap
+	return e
+}
+
+// StrokeLineJoin sets the stroke-linejoin attribute.
+func (e *FeOffsetElement) StrokeLineJoin(strokeLineJoin String) *FeOffsetElement {
+	e.Attrs["stroke-linejoin"] = strokeLineJoin
+	return e
+}
+
+// StrokeMiterLimit sets the stroke-miterlimit attribute.
+func (e *FeOffsetElement) StrokeMiterLimit(strokeMiterLimit Float64) *FeOffsetElement {
+	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
+	return e
+}
+
+// StrokeOpacity sets the stroke-opacity attribute.
+func (e *FeOffsetElement) StrokeOpacity(strokeOpacity Float64) *FeOffsetElement {
+	e.Attrs["stroke-opacity"] = strokeOpacity
+	return e
+}
+
+// StrokeWidth sets the stroke-width attribute.
+func (e *FeOffsetElement) StrokeWidth(strokeWidth Length) *FeOffsetElement {
+	e.Attrs["stroke-width"] = strokeWidth
+	return e
+}
+
+// TextAnchor sets the text-anchor attribute.
+func (e *FeOffsetElement) TextAnchor(textAnchor String) *FeOffsetElement {
+	e.Attrs["text-anchor"] = textAnchor
+	return e
+}
+
+// TextDecoration sets the text-decoration attribute.
+func (e *FeOffsetElement) TextDecoration(textDecoration String) *FeOffsetElement {
+	e.Attrs["text-decoration"] = textDecoration
+	return e
+}
+
+// TextOverflow sets the text-overflow attribute.
+func (e *FeOffsetElement) TextOverflow(textOverflow String) *FeOffsetElement {
+	e.Attrs["text-overflow"] = textOverflow
+	return e
+}
+
+// TextRendering sets the text-rendering attribute.
+func (e *FeOffsetElement) TextRendering(textRendering String) *FeOffsetElement {
+	e.Attrs["text-rendering"] = textRendering
+	return e
+}
+
+// Transform sets the transform attribute.
+func (e *FeOffsetElement) Transform(transform Transform) *FeOffsetElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
+// UnicodeBiDi sets the unicode-bidi attribute.
+func (e *FeOffsetElement) UnicodeBiDi(unicodeBiDi String) *FeOffsetElement {
+	e.Attrs["unicode-bidi"] = unicodeBiDi
+	return e
+}
+
+// VectorEffect sets the vector-effect attribute.
+func (e *FeOffsetElement) VectorEffect(vectorEffect String) *FeOffsetElement {
+	e.Attrs["vector-effect"] = vectorEffect
+	return e
+}
+
+// Visibility sets the visibility attribute.
+func (e *FeOffsetElement) Visibility(visibility String) *FeOffsetElement {
+	e.Attrs["visibility"] = visibility
+	return e
+}
+
+// WhiteSpace sets the white-space attribute.
+func (e *FeOffsetElement) WhiteSpace(whiteSpace String) *FeOffsetElement {
+	e.Attrs["white-space"] = whiteSpace
+	return e
+}
+
+// WordSpacing sets the word-spacing attribute.
+func (e *FeOffsetElement) WordSpacing(wordSpacing String) *FeOffsetElement {
+	e.Attrs["word-spacing"] = wordSpacing
+	return e
+}
+
+// WritingMode sets the writing-mode attribute.
+func (e *FeOffsetElement) WritingMode(writingMode String) *FeOffsetElement {
+	e.Attrs["writing-mode"] = writingMode
+	return e
+}
+
+// X sets the x attribute.
+func (e *FeOffsetElement) X(x Length) *FeOffsetElement {
+	e.Attrs["x"] = x
+	return e
+}
+
+// Y sets the y attribute.
+func (e *FeOffsetElement) Y(y Length) *FeOffsetElement {
+	e.Attrs["y"] = y
+	return e
+}
+
+// Width sets the width attribute.
+func (e *FeOffsetElement) Width(width Length) *FeOffsetElement {
+	e.Attrs["width"] = width
+	return e
+}
+
+// Height sets the height attribute.
+func (e *FeOffsetElement) Height(height Length) *FeOffsetElement {
+	e.Attrs["height"] = height
+	return e
+}
+
+// Result sets the result attribute.
+func (e *FeOffsetElement) Result(result String) *FeOffsetElement {
+	e.Attrs["result"] = result
+	return e
+}
+
+// In sets the in attribute.
+func (e *FeOffsetElement) In(_in String) *FeOffsetElement {
+	e.Attrs["in"] = _in
+	return e
+}
+
+// DX sets the dx attribute.
+func (e *FeOffsetElement) DX(dx Float64) *FeOffsetElement {
+	e.Attrs["dx"] = dx
+	return e
+}
+
+// DY sets the dy attribute.
+func (e *FeOffsetElement) DY(dy Float64) *FeOffsetElement {
+	e.Attrs["dy"] = dy
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *FeOffsetElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *FeOffsetElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *FeOffsetElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *FeOffsetElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *FeOffsetElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *FeOffsetElement) TagName() string {
+	return "feOffset"
+}
+
+// decode decodes e from d.
+func (e *FeOffsetElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *FeOffsetElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "x":
+		return parseLength(value)
+	case "y":
+		return parseLength(value)
+	case "width":
+		return parseLength(value)
+	case "height":
+		return parseLength(value)
+	case "dx":
+		return parseFloat64(value)
+	case "dy":
+		return parseFloat64(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A FePointLightElement is a fePointLight element.
+type FePointLightElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// FePointLight returns a new FePointLightElement.
+func FePointLight(children ...Element) *FePointLightElement {
+	return &FePointLightElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *FePointLightElement) AppendChildren(children ...Element) *FePointLightElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *FePointLightElement) RemoveAttrs(names ...string) *FePointLightElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *FePointLightElement) ID(id String) *FePointLightElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *FePointLightElement) TabIndex(tabIndex Int) *FePointLightElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *FePointLightElement) Lang(lang String) *FePointLightElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *FePointLightElement) Class(class String) *FePointLightElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *FePointLightElement) Style(style String) *FePointLightElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// X sets the x attribute.
+func (e *FePointLightElement) X(x Float64) *FePointLightElement {
+	e.Attrs["x"] = x
+	return e
+}
+
+// Y sets the y attribute.
+func (e *FePointLightElement) Y(y Float64) *FePointLightElement {
+	e.Attrs["y"] = y
+	return e
+}
+
+// Z sets the z attribute.
+func (e *FePointLightElement) Z(z Float64) *FePointLightElement {
+	e.Attrs["z"] = z
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *FePointLightElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *FePointLightElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *FePointLightElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *FePointLightElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *FePointLightElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *FePointLightElement) TagName() string {
+	return "fePointLight"
+}
+
+// decode decodes e from d.
+func (e *FePointLightElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *FePointLightElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "x":
+		return parseFloat64(value)
+	case "y":
+		return parseFloat64(value)
+	case "z":
+		return parseFloat64(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A FeSpecularLightingElement is a feSpecularLighting element.
+type FeSpecularLightingElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// FeSpecularLighting returns a new FeSpecularLightingElement.
+func FeSpecularLighting(children ...Element) *FeSpecularLightingElement {
+	return &FeSpecularLightingElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *FeSpecularLightingElement) AppendChildren(children ...Element) *FeSpecularLightingElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *FeSpecularLightingElement) RemoveAttrs(names ...string) *FeSpecularLightingElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *FeSpecularLightingElement) ID(id String) *FeSpecularLightingElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *FeSpecularLightingElement) TabIndex(tabIndex Int) *FeSpecularLightingElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *FeSpecularLightingElement) Lang(lang String) *FeSpecularLightingElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *FeSpecularLightingElement) Class(class String) *FeSpecularLightingElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *FeSpecularLightingElement) Style(style String) *FeSpecularLightingElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// AlignmentBaseline sets the alignment-baseline attribute.
+func (e *FeSpecularLightingElement) AlignmentBaseline(alignmentBaseline String) *FeSpecularLightingElement {
+	e.Attrs["alignment-baseline"] = alignmentBaseline
+	return e
+}
+
+// BaselineShift sets the baseline-shift attribute.
+func (e *FeSpecularLightingElement) BaselineShift(baselineShift String) *FeSpecularLightingElement {
+	e.Attrs["baseline-shift"] = baselineShift
+	return e
+}
+
+// ClipPath sets the clip-path attribute.
+func (e *FeSpecularLightingElement) ClipPath(clipPath String) *FeSpecularLightingElement {
+	e.Attrs["clip-path"] = clipPath
+	return e
+}
+
+// ClipRule sets the clip-rule attribute.
+func (e *FeSpecularLightingElement) ClipRule(clipRule String) *FeSpecularLightingElement {
+	e.Attrs["clip-rule"] = clipRule
+	return e
+}
+
+// Color sets the color attribute.
+func (e *FeSpecularLightingElement) Color(color Color) *FeSpecularLightingElement {
+	e.Attrs["color"] = color
+	return e
+}
+
+// ColorInterpolation sets the color-interpolation attribute.
+func (e *FeSpecularLightingElement) ColorInterpolation(colorInterpolation String) *FeSpecularLightingElement {
+	e.Attrs["color-interpolation"] = colorInterpolation
+	return e
+}
+
+// ColorInterpolationFilters sets the color-interpolation-filters attribute.
+func (e *FeSpecularLightingElement) ColorInterpolationFilters(colorInterpolationFilters String) *FeSpecularLightingElement {
+	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
+	return e
+}
+
+// ColorRendering sets the color-rendering attribute.
+func (e *FeSpecularLightingElement) ColorRendering(colorRendering String) *FeSpecularLightingElement {
+	e.Attrs["color-rendering"] = colorRendering
+	return e
+}
+
+// Cursor sets the cursor attribute.
+func (e *FeSpecularLightingElement) Cursor(cursor String) *FeSpecularLightingElement {
+	e.Attrs["cursor"] = cursor
+	return e
+}
+
+// Direction sets the direction attribute.
+func (e *FeSpecularLightingElement) Direction(direction String) *FeSpecularLightingElement {
+	e.Attrs["direction"] = direction
+	return e
+}
+
+// Display sets the display attribute.
+func (e *FeSpecularLightingElement) Display(display String) *FeSpecularLightingElement {
+	e.Attrs["display"] = display
+	return e
+}
+
+// DominantBaseline sets the dominant-baseline attribute.
+func (e *FeSpecularLightingElement) DominantBaseline(dominantBaseline String) *FeSpecularLightingElement {
+	e.Attrs["dominant-baseline"] = dominantBaseline
+	return e
+}
+
+// Fill sets the fill attribute.
+func (e *FeSpecularLightingElement) Fill(fill String) *FeSpecularLightingElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *FeSpecularLightingElement) FillColor(fill Color) *FeSpecularLightingElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *FeSpecularLightingElement) FillPaint(fill Paint) *FeSpecularLightingElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillOpacity sets the fill-opacity attribute.
+func (e *FeSpecularLightingElement) FillOpacity(fillOpacity Float64) *FeSpecularLightingElement {
+	e.Attrs["fill-opacity"] = fillOpacity
+	return e
+}
+
+// FillRule sets the fill-rule attribute.
+func (e *FeSpecularLightingElement) FillRule(fillRule String) *FeSpecularLightingElement {
+	e.Attrs["fill-rule"] = fillRule
+	return e
+}
+
+// Filter sets the filter attribute.
+func (e *FeSpecularLightingElement) Filter(filter String) *FeSpecularLightingElement {
+	e.Attrs["filter"] = filter
+	return e
+}
+
+// FloodColor sets the flood-color attribute.
+func (e *FeSpecularLightingElement) FloodColor(floodColor Color) *FeSpecularLightingElement {
+	e.Attrs["flood-color"] = floodColor
+	return e
+}
+
+// FloodOpacity sets the flood-opacity attribute.
+func (e *FeSpecularLightingElement) FloodOpacity(floodOpacity Float64) *FeSpecularLightingElement {
+	e.Attrs["flood-opacity"] = floodOpacity
+	return e
+}
+
+// FontFamily sets the font-family attribute.
+func (e *FeSpecularLightingElement) FontFamily(fontFamily String) *FeSpecularLightingElement {
+	e.Attrs["font-family"] = fontFamily
+	return e
+}
+
+// FontSize sets the font-size attribute.
+func (e *FeSpecularLightingElement) FontSize(fontSize String) *FeSpecularLightingElement {
+	e.Attrs["font-size"] = fontSize
+	return e
+}
+
+// FontSizeAdjust sets the font-size-adjust attribute.
+func (e *FeSpecularLightingElement) FontSizeAdjust(fontSizeAdjust String) *FeSpecularLightingElement {
+	e.Attrs["font-size-adjust"] = fontSizeAdjust
+	return e
+}
+
+// FontStretch sets the font-stretch attribute.
+func (e *FeSpecularLightingElement) FontStretch(fontStretch String) *FeSpecularLightingElement {
+	e.Attrs["font-stretch"] = fontStretch
+	return e
+}
+
+// FontStyle sets the font-style attribute.
+func (e *FeSpecularLightingElement) FontStyle(fontStyle String) *FeSpecularLightingElement {
+	e.Attrs["font-style"] = fontStyle
+	return e
+}
+
+// FontVariant sets the font-variant attribute.
+func (e *FeSpecularLightingElement) FontVariant(fontVariant String) *FeSpecularLightingElement {
+	e.Attrs["font-variant"] = fontVariant
+	return e
+}
+
+// FontWeight sets the font-weight attribute.
+func (e *FeSpecularLightingElement) FontWeight(fontWeight String) *FeSpecularLightingElement {
+	e.Attrs["font-weight"] = fontWeight
+	return e
+}
+
+// GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
+func (e *FeSpecularLightingElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *FeSpecularLightingElement {
+	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
+	return e
+}
+
+// GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
+func (e *FeSpecularLightingElement) GlyphOrientationVertical(glyphOrientationVertical String) *FeSpecularLightingElement {
+	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
+	return e
+}
+
+// ImageRendering sets the image-rendering attribute.
+func (e *FeSpecularLightingElement) ImageRendering(imageRendering String) *FeSpecularLightingElement {
+	e.Attrs["image-rendering"] = imageRendering
+	return e
+}
+
+// LetterSpacing sets the letter-spacing attribute.
+func (e *FeSpecularLightingElement) LetterSpacing(letterSpacing String) *FeSpecularLightingElement {
+	e.Attrs["letter-spacing"] = letterSpacing
+	return e
+}
+
+// LightingColor sets the lighting-color attribute.
+func (e *FeSpecularLightingElement) LightingColor(lightingColor Color) *FeSpecularLightingElement {
+	e.Attrs["lighting-color"] = lightingColor
+	return e
+}
+
+// MarkerEnd sets the marker-end attribute.
+func (e *FeSpecularLightingElement) MarkerEnd(markerEnd String) *FeSpecularLightingElement {
+	e.Attrs["marker-end"] = markerEnd
+	return e
+}
+
+// MarkerMid sets the marker-mid attribute.
+func (e *FeSpecularLightingElement) MarkerMid(markerMid String) *FeSpecularLightingElement {
+	e.Attrs["marker-mid"] = markerMid
+	return e
+}
+
+// MarkerStart sets the marker-start attribute.
+func (e *FeSpecularLightingElement) MarkerStart(markerStart String) *FeSpecularLightingElement {
+	e.Attrs["marker-start"] = markerStart
+	return e
+}
+
+// Mask sets the mask attribute.
+func (e *FeSpecularLightingElement) Mask(mask String) *FeSpecularLightingElement {
+	e.Attrs["mask"] = mask
+	return e
+}
+
+// Opacity sets the opacity attribute.
+func (e *FeSpecularLightingElement) Opacity(opacity Float64) *FeSpecularLightingElement {
+	e.Attrs["opacity"] = opacity
+	return e
+}
+
+// Overflow sets the overflow attribute.
+func (e *FeSpecularLightingElement) Overflow(overflow String) *FeSpecularLightingElement {
+	e.Attrs["overflow"] = overflow
+	return e
+}
+
+// PaintOrder sets the paint-order attribute.
+func (e *FeSpecularLightingElement) PaintOrder(paintOrder String) *FeSpecularLightingElement {
+	e.Attrs["paint-order"] = paintOrder
+	return e
+}
+
+// PointerEvents sets the pointer-events attribute.
+func (e *FeSpecularLightingElement) PointerEvents(pointerEvents String) *FeSpecularLightingElement {
+	e.Attrs["pointer-events"] = pointerEvents
+	return e
+}
+
+// ShapeRendering sets the shape-rendering attribute.
+func (e *FeSpecularLightingElement) ShapeRendering(shapeRendering String) *FeSpecularLightingElement {
+	e.Attrs["shape-rendering"] = shapeRendering
+	return e
+}
+
+// StopColor sets the stop-color attribute.
+func (e *FeSpecularLightingElement) StopColor(stopColor Color) *FeSpecularLightingElement {
+	e.Attrs["stop-color"] = stopColor
+	return e
+}
+
+// StopOpacity sets the stop-opacity attribute.
+func (e *FeSpecularLightingElement) StopOpacity(stopOpacity Float64) *FeSpecularLightingElement {
+	e.Attrs["stop-opacity"] = stopOpacity
+	return e
+}
+
+// Stroke sets the stroke attribute.
+func (e *FeSpecularLightingElement) Stroke(stroke String) *FeSpecularLightingElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *FeSpecularLightingElement) StrokeColor(stroke Color) *FeSpecularLightingElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *FeSpecularLightingElement) StrokePaint(stroke Paint) *FeSpecularLightingElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeDashArray sets the stroke-dasharray attribute.
+func (e *FeSpecularLightingElement) StrokeDashArray(strokeDashArray String) *FeSpecularLightingElement {
+	e.Attrs["stroke-dasharray"] = strokeDashArray
+	return e
+}
+
+// StrokeDashOffset sets the stroke-dashoffset attribute.
+func (e *FeSpecularLightingElement) StrokeDashOffset(strokeDashOffset Float64) *FeSpecularLightingElement {
+	e.Attrs["stroke-dashoffset"] = strokeDashOffset
+	return e
+}
+
+// StrokeLineCap sets the stroke-linecap attribute.
+func (e *FeSpecularLightingElement) StrokeLineCap(strokeLineCap String) *FeSpecularLightingElement {
+	e.Attrs["stroke-linecap"] = strokeLineCap
+	return e
+}
+
+// StrokeLineJoin sets the stroke-linejoin attribute.
+func (e *FeSpecularLightingElement) StrokeLineJoin(strokeLineJoin String) *FeSpecularLightingElement {
+	e.Attrs["stroke-linejoin"] = strokeLineJoin
+	return e
+}
+
+// StrokeMiterLimit sets the stroke-miterlimit attribute.
+func (e *FeSpecularLightingElement) StrokeMiterLimit(strokeMiterLimit Float64) *FeSpecularLightingElement {
+	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
+	return e
+}
+
+// StrokeOpacity sets the stroke-opacity attribute.
+func (e *FeSpecularLightingElement) StrokeOpacity(strokeOpacity Float64) *FeSpecularLightingElement {
+	e.Attrs["stroke-opacity"] = strokeOpacity
+	return e
+}
+
+// StrokeWidth sets the stroke-width attribute.
+func (e *FeSpecularLightingElement) StrokeWidth(strokeWidth Length) *FeSpecularLightingElement {
+	e.Attrs["stroke-width"] = strokeWidth
+	return e
+}
+
+// TextAnchor sets the text-anchor attribute.
+func (e *FeSpecularLightingElement) TextAnchor(textAnchor String) *FeSpecularLightingElement {
+	e.Attrs["text-anchor"] = textAnchor
+	return e
+}
+
+// TextDecoration sets the text-decoration attribute.
+func (e *FeSpecularLightingElement) TextDecoration(textDecoration String) *FeSpecularLightingElement {
+	e.Attrs["text-decoration"] = textDecoration
+	return e
+}
+
+// TextOverflow sets the text-overflow attribute.
+func (e *FeSpecularLightingElement) TextOverflow(textOverflow String) *FeSpecularLightingElement {
+	e.Attrs["text-overflow"] = textOverflow
+	return e
+}
+
+// TextRendering sets the text-rendering attribute.
+func (e *FeSpecularLightingElement) TextRendering(textRendering String) *FeSpecularLightingElement {
+	e.Attrs["text-rendering"] = textRendering
+	return e
+}
+
+// Transform sets the transform attribute.
+func (e *FeSpecularLightingElement) Transform(transform Transform) *FeSpecularLightingElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
+// UnicodeBiDi sets the unicode-bidi attribute.
+func (e *FeSpecularLightingElement) UnicodeBiDi(unicodeBiDi String) *FeSpecularLightingElement {
+	e.Attrs["unicode-bidi"] = unicodeBiDi
+	return e
+}
+
+// VectorEffect sets the vector-effect attribute.
+func (e *FeSpecularLightingElement) VectorEffect(vectorEffect String) *FeSpecularLightingElement {
+	e.Attrs["vector-effect"] = vectorEffect
+	return e
+}
+
+// Visibility sets the visibility attribute.
+func (e *FeSpecularLightingElement) Visibility(visibility String) *FeSpecularLightingElement {
+	e.Attrs["visibility"] = visibility
+	return e
+}
+
+// WhiteSpace sets the white-space attribute.
+func (e *FeSpecularLightingElement) WhiteSpace(whiteSpace String) *FeSpecularLightingElement {
+	e.Attrs["white-space"] = whiteSpace
+	return e
+}
+
+// WordSpacing sets the word-spacing attribute.
+func (e *FeSpecularLightingElement) WordSpacing(wordSpacing String) *FeSpecularLightingElement {
+	e.Attrs["word-spacing"] = wordSpacing
+	return e
+}
+
+// WritingMode sets the writing-mode attribute.
+func (e *FeSpecularLightingElement) WritingMode(writingMode String) *FeSpecularLightingElement {
+	e.Attrs["writing-mode"] = writingMode
+	return e
+}
+
+// X sets the x attribute.
+func (e *FeSpecularLightingElement) X(x Length) *FeSpecularLightingElement {
+	e.Attrs["x"] = x
+	return e
+}
+
+// Y sets the y attribute.
+func (e *FeSpecularLightingElement) Y(y Length) *FeSpecularLightingElement {
+	e.Attrs["y"] = y
+	return e
+}
+
+// Width sets the width attribute.
+func (e *FeSpecularLightingElement) Width(width Length) *FeSpecularLightingElement {
+	e.Attrs["width"] = width
+	return e
+}
+
+// Height sets the height attribute.
+func (e *FeSpecularLightingElement) Height(height Length) *FeSpecularLightingElement {
+	e.Attrs["height"] = height
+	return e
+}
+
+// Result sets the result attribute.
+func (e *FeSpecularLightingElement) Result(result String) *FeSpecularLightingElement {
+	e.Attrs["result"] = result
+	return e
+}
+
+// In sets the in attribute.
+func (e *FeSpecularLightingElement) In(_in String) *FeSpecularLightingElement {
+	e.Attrs["in"] = _in
+	return e
+}
+
+// SurfaceScale sets the surfaceScale attribute.
+func (e *FeSpecularLightingElement) SurfaceScale(surfaceScale Float64) *FeSpecularLightingElement {
+	e.Attrs["surfaceScale"] = surfaceScale
+	return e
+}
+
+// SpecularConstant sets the specularConstant attribute.
+func (e *FeSpecularLightingElement) SpecularConstant(specularConstant Float64) *FeSpecularLightingElement {
+	e.Attrs["specularConstant"] = specularConstant
+	return e
+}
+
+// SpecularExponent sets the specularExponent attribute.
+func (e *FeSpecularLightingElement) SpecularExponent(specularExponent Float64) *FeSpecularLightingElement {
+	e.Attrs["specularExponent"] = specularExponent
+	return e
+}
+
+// KernelUnitLength sets the kernelUnitLength attribute.
+func (e *FeSpecularLightingElement) KernelUnitLength(kernelUnitLength Numbers) *FeSpecularLightingElement {
+	e.Attrs["kernelUnitLength"] = kernelUnitLength
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *FeSpecularLightingElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *FeSpecularLightingElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *FeSpecularLightingElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *FeSpecularLightingElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *FeSpecularLightingElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *FeSpecularLightingElement) TagName() string {
+	return "feSpecularLighting"
+}
+
+// decode decodes e from d.
+func (e *FeSpecularLightingElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *FeSpecularLightingElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "x":
+		return parseLength(value)
+	case "y":
+		return parseLength(value)
+	case "width":
+		return parseLength(value)
+	case "height":
+		return parseLength(value)
+	case "surfaceScale":
+		return parseFloat64(value)
+	case "specularConstant":
+		return parseFloat64(value)
+	case "specularExponent":
+		return parseFloat64(value)
+	case "kernelUnitLength":
+		return parseNumbers(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A FeSpotLightElement is a feSpotLight element.
+type FeSpotLightElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// FeSpotLight returns a new FeSpotLightElement.
+func FeSpotLight(children ...Element) *FeSpotLightElement {
+	return &FeSpotLightElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *FeSpotLightElement) AppendChildren(children ...Element) *FeSpotLightElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *FeSpotLightElement) RemoveAttrs(names ...string) *FeSpotLightElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *FeSpotLightElement) ID(id String) *FeSpotLightElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *FeSpotLightElement) TabIndex(tabIndex Int) *FeSpotLightElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *FeSpotLightElement) Lang(lang String) *FeSpotLightElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *FeSpotLightElement) Class(class String) *FeSpotLightElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *FeSpotLightElement) Style(style String) *FeSpotLightElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// X sets the x attribute.
+func (e *FeSpotLightElement) X(x Float64) *FeSpotLightElement {
+	e.Attrs["x"] = x
+	return e
+}
+
+// Y sets the y attribute.
+func (e *FeSpotLightElement) Y(y Float64) *FeSpotLightElement {
+	e.Attrs["y"] = y
+	return e
+}
+
+// Z sets the z attribute.
+func (e *FeSpotLightElement) Z(z Float64) *FeSpotLightElement {
+	e.Attrs["z"] = z
+	return e
+}
+
+// PointsAtX sets the pointsAtX attribute.
+func (e *FeSpotLightElement) PointsAtX(pointsAtX Float64) *FeSpotLightElement {
+	e.Attrs["pointsAtX"] = pointsAtX
+	return e
+}
+
+// PointsAtY sets the pointsAtY attribute.
+func (e *FeSpotLightElement) PointsAtY(pointsAtY Float64) *FeSpotLightElement {
+	e.Attrs["pointsAtY"] = pointsAtY
+	return e
+}
+
+// PointsAtZ sets the pointsAtZ attribute.
+func (e *FeSpotLightElement) PointsAtZ(pointsAtZ Float64) *FeSpotLightElement {
+	e.Attrs["pointsAtZ"] = pointsAtZ
+	return e
+}
+
+// SpecularExponent sets the specularExponent attribute.
+func (e *FeSpotLightElement) SpecularExponent(specularExponent Float64) *FeSpotLightElement {
+	e.Attrs["specularExponent"] = specularExponent
+	return e
+}
+
+// LimitingConeAngle sets the limitingConeAngle attribute.
+func (e *FeSpotLightElement) LimitingConeAngle(limitingConeAngle Float64) *FeSpotLightElement {
+	e.Attrs["limitingConeAngle"] = limitingConeAngle
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *FeSpotLightElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *FeSpotLightElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *FeSpotLightElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *FeSpotLightElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *FeSpotLightElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *FeSpotLightElement) TagName() string {
+	return "feSpotLight"
+}
+
+// decode decodes e from d.
+func (e *FeSpotLightElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *FeSpotLightElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "x":
+		return parseFloat64(value)
+	case "y":
+		return parseFloat64(value)
+	case "z":
+		return parseFloat64(value)
+	case "pointsAtX":
+		return parseFloat64(value)
+	case "pointsAtY":
+		return parseFloat64(value)
+	case "pointsAtZ":
+		return parseFloat64(value)
+	case "specularExponent":
+		return parseFloat64(value)
+	case "limitingConeAngle":
+		return parseFloat64(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A FeTileElement is a feTile element.
+type FeTileElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// FeTile returns a new FeTileElement.
+func FeTile(children ...Element) *FeTileElement {
+	return &FeTileElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *FeTileElement) AppendChildren(children ...Element) *FeTileElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *FeTileElement) RemoveAttrs(names ...string) *FeTileElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *FeTileElement) ID(id String) *FeTileElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *FeTileElement) TabIndex(tabIndex Int) *FeTileElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *FeTileElement) Lang(lang String) *FeTileElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *FeTileElement) Class(class String) *FeTileElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *FeTileElement) Style(style String) *FeTileElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// AlignmentBaseline sets the alignment-baseline attribute.
+func (e *FeTileElement) AlignmentBaseline(alignmentBaseline String) *FeTileElement {
+	e.Attrs["alignment-baseline"] = alignmentBaseline
+	return e
+}
+
+// BaselineShift sets the baseline-shift attribute.
+func (e *FeTileElement) BaselineShift(baselineShift String) *FeTileElement {
+	e.Attrs["baseline-shift"] = baselineShift
+	return e
+}
+
+// ClipPath sets the clip-path attribute.
+func (e *FeTileElement) ClipPath(clipPath String) *FeTileElement {
+	e.Attrs["clip-path"] = clipPath
+	return e
+}
+
+// ClipRule sets the clip-rule attribute.
+func (e *FeTileElement) ClipRule(clipRule String) *FeTileElement {
+	e.Attrs["clip-rule"] = clipRule
+	return e
+}
+
+// Color sets the color attribute.
+func (e *FeTileElement) Color(color Color) *FeTileElement {
+	e.Attrs["color"] = color
+	return e
+}
+
+// ColorInterpolation sets the color-interpolation attribute.
+func (e *FeTileElement) ColorInterpolation(colorInterpolation String) *FeTileElement {
+	e.Attrs["color-interpolation"] = colorInterpolation
+	return e
+}
+
+// ColorInterpolationFilters sets the color-interpolation-filters attribute.
+func (e *FeTileElement) ColorInterpolationFilters(colorInterpolationFilters String) *FeTileElement {
+	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
+	return e
+}
+
+// ColorRendering sets the color-rendering attribute.
+func (e *FeTileElement) ColorRendering(colorRendering String) *FeTileElement {
+	e.Attrs["color-rendering"] = colorRendering
+	return e
+}
+
+// Cursor sets the cursor attribute.
+func (e *FeTileElement) Cursor(cursor String) *FeTileElement {
+	e.Attrs["cursor"] = cursor
+	return e
+}
+
+// Direction sets the direction attribute.
+func (e *FeTileElement) Direction(direction String) *FeTileElement {
+	e.Attrs["direction"] = direction
+	return e
+}
+
+// Display sets the display attribute.
+func (e *FeTileElement) Display(display String) *FeTileElement {
+	e.Attrs["display"] = display
+	return e
+}
+
+// DominantBaseline sets the dominant-baseline attribute.
+func (e *FeTileElement) DominantBaseline(dominantBaseline String) *FeTileElement {
+	e.Attrs["dominant-baseline"] = dominantBaseline
+	return e
+}
+
+// Fill sets the fill attribute.
+func (e *FeTileElement) Fill(fill String) *FeTileElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *FeTileElement) FillColor(fill Color) *FeTileElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *FeTileElement) FillPaint(fill Paint) *FeTileElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillOpacity sets the fill-opacity attribute.
+func (e *FeTileElement) FillOpacity(fillOpacity Float64) *FeTileElement {
+	e.Attrs["fill-opacity"] = fillOpacity
+	return e
+}
+
+// FillRule sets the fill-rule attribute.
+func (e *FeTileElement) FillRule(fillRule String) *FeTileElement {
+	e.Attrs["fill-rule"] = fillRule
+	return e
+}
+
+// Filter sets the filter attribute.
+func (e *FeTileElement) Filter(filter String) *FeTileElement {
+	e.Attrs["filter"] = filter
+	return e
+}
+
+// FloodColor sets the flood-color attribute.
+func (e *FeTileElement) FloodColor(floodColor Color) *FeTileElement {
+	e.Attrs["flood-color"] = floodColor
+	return e
+}
+
+// FloodOpacity sets the flood-opacity attribute.
+func (e *FeTileElement) FloodOpacity(floodOpacity Float64) *FeTileElement {
+	e.Attrs["flood-opacity"] = floodOpacity
+	return e
+}
+
+// FontFamily sets the font-family attribute.
+func (e *FeTileElement) FontFamily(fontFamily String) *FeTileElement {
+	e.Attrs["font-family"] = fontFamily
+	return e
+}
+
+// FontSize sets the font-size attribute.
+func (e *FeTileElement) FontSize(fontSize String) *FeTileElement {
+	e.Attrs["font-size"] = fontSize
+	return e
+}
+
+// FontSizeAdjust sets the font-size-adjust attribute.
+func (e *FeTileElement) FontSizeAdjust(fontSizeAdjust String) *FeTileElement {
+	e.Attrs["font-size-adjust"] = fontSizeAdjust
+	return e
+}
+
+// FontStretch sets the font-stretch attribute.
+func (e *FeTileElement) FontStretch(fontStretch String) *FeTileElement {
+	e.Attrs["font-stretch"] = fontStretch
+	return e
+}
+
+// FontStyle sets the font-style attribute.
+func (e *FeTileElement) FontStyle(fontStyle String) *FeTileElement {
+	e.Attrs["font-style"] = fontStyle
+	return e
+}
+
+// FontVariant sets the font-variant attribute.
+func (e *FeTileElement) FontVariant(fontVariant String) *FeTileElement {
+	e.Attrs["font-variant"] = fontVariant
+	return e
+}
+
+// FontWeight sets the font-weight attribute.
+func (e *FeTileElement) FontWeight(fontWeight String) *FeTileElement {
+	e.Attrs["font-weight"] = fontWeight
+	return e
+}
+
+// GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
+func (e *FeTileElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *FeTileElement {
+	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
+	return e
+}
+
+// GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
+func (e *FeTileElement) GlyphOrientationVertical(glyphOrientationVertical String) *FeTileElement {
+	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
+	return e
+}
+
+// ImageRendering sets the image-rendering attribute.
+func (e *FeTileElement) ImageRendering(imageRendering String) *FeTileElement {
+	e.Attrs["image-rendering"] = imageRendering
+	return e
+}
+
+// LetterSpacing sets the letter-spacing attribute.
+func (e *FeTileElement) LetterSpacing(letterSpacing String) *FeTileElement {
+	e.Attrs["letter-spacing"] = letterSpacing
+	return e
+}
+
+// LightingColor sets the lighting-color attribute.
+func (e *FeTileElement) LightingColor(lightingColor Color) *FeTileElement {
+	e.Attrs["lighting-color"] = lightingColor
+	return e
+}
+
+// MarkerEnd sets the marker-end attribute.
+func (e *FeTileElement) MarkerEnd(markerEnd String) *FeTileElement {
+	e.Attrs["marker-end"] = markerEnd
+	return e
+}
+
+// MarkerMid sets the marker-mid attribute.
+func (e *FeTileElement) MarkerMid(markerMid String) *FeTileElement {
+	e.Attrs["marker-mid"] = markerMid
+	return e
+}
+
+// MarkerStart sets the marker-start attribute.
+func (e *FeTileElement) MarkerStart(markerStart String) *FeTileElement {
+	e.Attrs["marker-start"] = markerStart
+	return e
+}
+
+// Mask sets the mask attribute.
+func (e *FeTileElement) Mask(mask String) *FeTileElement {
+	e.Attrs["mask"] = mask
+	return e
+}
+
+// Opacity sets the opacity attribute.
+func (e *FeTileElement) Opacity(opacity Float64) *FeTileElement {
+	e.Attrs["opacity"] = opacity
+	return e
+}
+
+// Overflow sets the overflow attribute.
+func (e *FeTileElement) Overflow(overflow String) *FeTileElement {
+	e.Attrs["overflow"] = overflow
+	return e
+}
+
+// PaintOrder sets the paint-order attribute.
+func (e *FeTileElement) PaintOrder(paintOrder String) *FeTileElement {
+	e.Attrs["paint-order"] = paintOrder
+	return e
+}
+
+// PointerEvents sets the pointer-events attribute.
+func (e *FeTileElement) PointerEvents(pointerEvents String) *FeTileElement {
+	e.Attrs["pointer-events"] = pointerEvents
+	return e
+}
+
+// ShapeRendering sets the shape-rendering attribute.
+func (e *FeTileElement) ShapeRendering(shapeRendering String) *FeTileElement {
+	e.Attrs["shape-rendering"] = shapeRendering
+	return e
+}
+
+// StopColor sets the stop-color attribute.
+func (e *FeTileElement) StopColor(stopColor Color) *FeTileElement {
+	e.Attrs["stop-color"] = stopColor
+	return e
+}
+
+// StopOpacity sets the stop-opacity attribute.
+func (e *FeTileElement) StopOpacity(stopOpacity Float64) *FeTileElement {
+	e.Attrs["stop-opacity"] = stopOpacity
+	return e
+}
+
+// Stroke sets the stroke attribute.
+func (e *FeTileElement) Stroke(stroke String) *FeTileElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *FeTileElement) StrokeColor(stroke Color) *FeTileElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *FeTileElement) StrokePaint(stroke Paint) *FeTileElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeDashArray sets the stroke-dasharray attribute.
+func (e *FeTileElement) StrokeDashArray(strokeDashArray String) *FeTileElement {
+	e.Attrs["stroke-dasharray"] = strokeDashArray
+	return e
+}
+
+// StrokeDashOffset sets the stroke-dashoffset attribute.
+func (e *FeTileElement) StrokeDashOffset(strokeDashOffset Float64) *FeTileElement {
+	e.Attrs["stroke-dashoffset"] = strokeDashOffset
+	return e
+}
+
+// StrokeLineCap sets the stroke-linecap attribute.
+func (e *FeTileElement) StrokeLineCap(strokeLineCap String) *FeTileElement {
+	e.Attrs["stroke-linecap"] = strokeLineCap
+	return e
+}
+
+// StrokeLineJoin sets the stroke-linejoin attribute.
+func (e *FeTileElement) StrokeLineJoin(strokeLineJoin String) *FeTileElement {
+	e.Attrs["stroke-linejoin"] = strokeLineJoin
+	return e
+}
+
+// StrokeMiterLimit sets the stroke-miterlimit attribute.
+func (e *FeTileElement) StrokeMiterLimit(strokeMiterLimit Float64) *FeTileElement {
+	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
+	return e
+}
+
+// StrokeOpacity sets the stroke-opacity attribute.
+func (e *FeTileElement) StrokeOpacity(strokeOpacity Float64) *FeTileElement {
+	e.Attrs["stroke-opacity"] = strokeOpacity
+	return e
+}
+
+// StrokeWidth sets the stroke-width attribute.
+func (e *FeTileElement) StrokeWidth(strokeWidth Length) *FeTileElement {
+	e.Attrs["stroke-width"] = strokeWidth
+	return e
+}
+
+// TextAnchor sets the text-anchor attribute.
+func (e *FeTileElement) TextAnchor(textAnchor String) *FeTileElement {
+	e.Attrs["text-anchor"] = textAnchor
+	return e
+}
+
+// TextDecoration sets the text-decoration attribute.
+func (e *FeTileElement) TextDecoration(textDecoration String) *FeTileElement {
+	e.Attrs["text-decoration"] = textDecoration
+	return e
+}
+
+// TextOverflow sets the text-overflow attribute.
+func (e *FeTileElement) TextOverflow(textOverflow String) *FeTileElement {
+	e.Attrs["text-overflow"] = textOverflow
+	return e
+}
+
+// TextRendering sets the text-rendering attribute.
+func (e *FeTileElement) TextRendering(textRendering String) *FeTileElement {
+	e.Attrs["text-rendering"] = textRendering
+	return e
+}
+
+// Transform sets the transform attribute.
+func (e *FeTileElement) Transform(transform Transform) *FeTileElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
+// UnicodeBiDi sets the unicode-bidi attribute.
+func (e *FeTileElement) UnicodeBiDi(unicodeBiDi String) *FeTileElement {
+	e.Attrs["unicode-bidi"] = unicodeBiDi
+	return e
+}
+
+// VectorEffect sets the vector-effect attribute.
+func (e *FeTileElement) VectorEffect(vectorEffect String) *FeTileElement {
+	e.Attrs["vector-effect"] = vectorEffect
+	return e
+}
+
+// Visibility sets the visibility attribute.
+func (e *FeTileElement) Visibility(visibility String) *FeTileElement {
+	e.Attrs["visibility"] = visibility
+	return e
+}
+
+// WhiteSpace sets the white-space attribute.
+func (e *FeTileElement) WhiteSpace(whiteSpace String) *FeTileElement {
+	e.Attrs["white-space"] = whiteSpace
+	return e
+}
+
+// WordSpacing sets the word-spacing attribute.
+func (e *FeTileElement) WordSpacing(wordSpacing String) *FeTileElement {
+	e.Attrs["word-spacing"] = wordSpacing
+	return e
+}
+
+// WritingMode sets the writing-mode attribute.
+func (e *FeTileElement) WritingMode(writingMode String) *FeTileElement {
+	e.Attrs["writing-mode"] = writingMode
+	return e
+}
+
+// X sets the x attribute.
+func (e *FeTileElement) X(x Length) *FeTileElement {
+	e.Attrs["x"] = x
+	return e
+}
+
+// Y sets the y attribute.
+func (e *FeTileElement) Y(y Length) *FeTileElement {
+	e.Attrs["y"] = y
+	return e
+}
+
+// Width sets the width attribute.
+func (e *FeTileElement) Width(width Length) *FeTileElement {
+	e.Attrs["width"] = width
+	return e
+}
+
+// Height sets the height attribute.
+func (e *FeTileElement) Height(height Length) *FeTileElement {
+	e.Attrs["height"] = height
+	return e
+}
+
+// Result sets the result attribute.
+func (e *FeTileElement) Result(result String) *FeTileElement {
+	e.Attrs["result"] = result
+	return e
+}
+
+// In sets the in attribute.
+func (e *FeTileElement) In(_in String) *FeTileElement {
+	e.Attrs["in"] = _in
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *FeTileElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *FeTileElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *FeTileElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *FeTileElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *FeTileElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *FeTileElement) TagName() string {
+	return "feTile"
+}
+
+// decode decodes e from d.
+func (e *FeTileElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *FeTileElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "x":
+		return parseLength(value)
+	case "y":
+		return parseLength(value)
+	case "width":
+		return parseLength(value)
+	case "height":
+		return parseLength(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A FeTurbulenceElement is a feTurbulence element.
+type FeTurbulenceElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// FeTurbulence returns a new FeTurbulenceElement.
+func FeTurbulence(children ...Element) *FeTurbulenceElement {
+	return &FeTurbulenceElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *FeTurbulenceElement) AppendChildren(children ...Element) *FeTurbulenceElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *FeTurbulenceElement) RemoveAttrs(names ...string) *FeTurbulenceElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *FeTurbulenceElement) ID(id String) *FeTurbulenceElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *FeTurbulenceElement) TabIndex(tabIndex Int) *FeTurbulenceElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *FeTurbulenceElement) Lang(lang String) *FeTurbulenceElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *FeTurbulenceElement) Class(class String) *FeTurbulenceElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *FeTurbulenceElement) Style(style String) *FeTurbulenceElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// AlignmentBaseline sets the alignment-baseline attribute.
+func (e *FeTurbulenceElement) AlignmentBaseline(alignmentBaseline String) *FeTurbulenceElement {
+	e.Attrs["alignment-baseline"] = alignmentBaseline
+	return e
+}
+
+// BaselineShift sets the baseline-shift attribute.
+func (e *FeTurbulenceElement) BaselineShift(baselineShift String) *FeTurbulenceElement {
+	e.Attrs["baseline-shift"] = baselineShift
+	return e
+}
+
+// ClipPath sets the clip-path attribute.
+func (e *FeTurbulenceElement) ClipPath(clipPath String) *FeTurbulenceElement {
+	e.Attrs["clip-path"] = clipPath
+	return e
+}
+
+// ClipRule sets the clip-rule attribute.
+func (e *FeTurbulenceElement) ClipRule(clipRule String) *FeTurbulenceElement {
+	e.Attrs["clip-rule"] = clipRule
+	return e
+}
+
+// Color sets the color attribute.
+func (e *FeTurbulenceElement) Color(color Color) *FeTurbulenceElement {
+	e.Attrs["color"] = color
+	return e
+}
+
+// ColorInterpolation sets the color-interpolation attribute.
+func (e *FeTurbulenceElement) ColorInterpolation(colorInterpolation String) *FeTurbulenceElement {
+	e.Attrs["color-interpolation"] = colorInterpolation
+	return e
+}
+
+// ColorInterpolationFilters sets the color-interpolation-filters attribute.
+func (e *FeTurbulenceElement) ColorInterpolationFilters(colorInterpolationFilters String) *FeTurbulenceElement {
+	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
+	return e
+}
+
+// ColorRendering sets the color-rendering attribute.
+func (e *FeTurbulenceElement) ColorRendering(colorRendering String) *FeTurbulenceElement {
+	e.Attrs["color-rendering"] = colorRendering
+	return e
+}
+
+// Cursor sets the cursor attribute.
+func (e *FeTurbulenceElement) Cursor(cursor String) *FeTurbulenceElement {
+	e.Attrs["cursor"] = cursor
+	return e
+}
+
+// Direction sets the direction attribute.
+func (e *FeTurbulenceElement) Direction(direction String) *FeTurbulenceElement {
+	e.Attrs["direction"] = direction
+	return e
+}
+
+// Display sets the display attribute.
+func (e *FeTurbulenceElement) Display(display String) *FeTurbulenceElement {
+	e.Attrs["display"] = display
+	return e
+}
+
+// DominantBaseline sets the dominant-baseline attribute.
+func (e *FeTurbulenceElement) DominantBaseline(dominantBaseline String) *FeTurbulenceElement {
+	e.Attrs["dominant-baseline"] = dominantBaseline
+	return e
+}
+
+// Fill sets the fill attribute.
+func (e *FeTurbulenceElement) Fill(fill String) *FeTurbulenceElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *FeTurbulenceElement) FillColor(fill Color) *FeTurbulenceElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *FeTurbulenceElement) FillPaint(fill Paint) *FeTurbulenceElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillOpacity sets the fill-opacity attribute.
+func (e *FeTurbulenceElement) FillOpacity(fillOpacity Float64) *FeTurbulenceElement {
+	e.Attrs["fill-opacity"] = fillOpacity
+	return e
+}
+
+// FillRule sets the fill-rule attribute.
+func (e *FeTurbulenceElement) FillRule(fillRule String) *FeTurbulenceElement {
+	e.Attrs["fill-rule"] = fillRule
+	return e
+}
+
+// Filter sets the filter attribute.
+func (e *FeTurbulenceElement) Filter(filter String) *FeTurbulenceElement {
+	e.Attrs["filter"] = filter
+	return e
+}
+
+// FloodColor sets the flood-color attribute.
+func (e *FeTurbulenceElement) FloodColor(floodColor Color) *FeTurbulenceElement {
+	e.Attrs["flood-color"] = floodColor
+	return e
+}
+
+// FloodOpacity sets the flood-opacity attribute.
+func (e *FeTurbulenceElement) FloodOpacity(floodOpacity Float64) *FeTurbulenceElement {
+	e.Attrs["flood-opacity"] = floodOpacity
+	return e
+}
+
+// FontFamily sets the font-family attribute.
+func (e *FeTurbulenceElement) FontFamily(fontFamily String) *FeTurbulenceElement {
+	e.Attrs["font-family"] = fontFamily
+	return e
+}
+
+// FontSize sets the font-size attribute.
+func (e *FeTurbulenceElement) FontSize(fontSize String) *FeTurbulenceElement {
+	e.Attrs["font-size"] = fontSize
+	return e
+}
+
+// FontSizeAdjust sets the font-size-adjust attribute.
+func (e *FeTurbulenceElement) FontSizeAdjust(fontSizeAdjust String) *FeTurbulenceElement {
+	e.Attrs["font-size-adjust"] = fontSizeAdjust
+	return e
+}
+
+// FontStretch sets the font-stretch attribute.
+func (e *FeTurbulenceElement) FontStretch(fontStretch String) *FeTurbulenceElement {
+	e.Attrs["font-stretch"] = fontStretch
+	return e
+}
+
+// FontStyle sets the font-style attribute.
+func (e *FeTurbulenceElement) FontStyle(fontStyle String) *FeTurbulenceElement {
+	e.Attrs["font-style"] = fontStyle
+	return e
+}
+
+// FontVariant sets the font-variant attribute.
+func (e *FeTurbulenceElement) FontVariant(fontVariant String) *FeTurbulenceElement {
+	e.Attrs["font-variant"] = fontVariant
+	return e
+}
+
+// FontWeight sets the font-weight attribute.
+func (e *FeTurbulenceElement) FontWeight(fontWeight String) *FeTurbulenceElement {
+	e.Attrs["font-weight"] = fontWeight
+	return e
+}
+
+// GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
+func (e *FeTurbulenceElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *FeTurbulenceElement {
+	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
+	return e
+}
+
+// GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
+func (e *FeTurbulenceElement) GlyphOrientationVertical(glyphOrientationVertical String) *FeTurbulenceElement {
+	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
+	return e
+}
+
+// ImageRendering sets the image-rendering attribute.
+func (e *FeTurbulenceElement) ImageRendering(imageRendering String) *FeTurbulenceElement {
+	e.Attrs["image-rendering"] = imageRendering
+	return e
+}
+
+// LetterSpacing sets the letter-spacing attribute.
+func (e *FeTurbulenceElement) LetterSpacing(letterSpacing String) *FeTurbulenceElement {
+	e.Attrs["letter-spacing"] = letterSpacing
+	return e
+}
+
+// LightingColor sets the lighting-color attribute.
+func (e *FeTurbulenceElement) LightingColor(lightingColor Color) *FeTurbulenceElement {
+	e.Attrs["lighting-color"] = lightingColor
+	return e
+}
+
+// MarkerEnd sets the marker-end attribute.
+func (e *FeTurbulenceElement) MarkerEnd(markerEnd String) *FeTurbulenceElement {
+	e.Attrs["marker-end"] = markerEnd
+	return e
+}
+
+// MarkerMid sets the marker-mid attribute.
+func (e *FeTurbulenceElement) MarkerMid(markerMid String) *FeTurbulenceElement {
+	e.Attrs["marker-mid"] = markerMid
+	return e
+}
+
+// MarkerStart sets the marker-start attribute.
+func (e *FeTurbulenceElement) MarkerStart(markerStart String) *FeTurbulenceElement {
+	e.Attrs["marker-start"] = markerStart
+	return e
+}
+
+// Mask sets the mask attribute.
+func (e *FeTurbulenceElement) Mask(mask String) *FeTurbulenceElement {
+	e.Attrs["mask"] = mask
+	return e
+}
+
+// Opacity sets the opacity attribute.
+func (e *FeTurbulenceElement) Opacity(opacity Float64) *FeTurbulenceElement {
+	e.Attrs["opacity"] = opacity
+	return e
+}
+
+// Overflow sets the overflow attribute.
+func (e *FeTurbulenceElement) Overflow(overflow String) *FeTurbulenceElement {
+	e.Attrs["overflow"] = overflow
+	return e
+}
+
+// PaintOrder sets the paint-order attribute.
+func (e *FeTurbulenceElement) PaintOrder(paintOrder String) *FeTurbulenceElement {
+	e.Attrs["paint-order"] = paintOrder
+	return e
+}
+
+// PointerEvents sets the pointer-events attribute.
+func (e *FeTurbulenceElement) PointerEvents(pointerEvents String) *FeTurbulenceElement {
+	e.Attrs["pointer-events"] = pointerEvents
+	return e
+}
+
+// ShapeRendering sets the shape-rendering attribute.
+func (e *FeTurbulenceElement) ShapeRendering(shapeRendering String) *FeTurbulenceElement {
+	e.Attrs["shape-rendering"] = shapeRendering
+	return e
+}
+
+// StopColor sets the stop-color attribute.
+func (e *FeTurbulenceElement) StopColor(stopColor Color) *FeTurbulenceElement {
+	e.Attrs["stop-color"] = stopColor
+	return e
+}
+
+// StopOpacity sets the stop-opacity attribute.
+func (e *FeTurbulenceElement) StopOpacity(stopOpacity Float64) *FeTurbulenceElement {
+	e.Attrs["stop-opacity"] = stopOpacity
+	return e
+}
+
+// Stroke sets the stroke attribute.
+func (e *FeTurbulenceElement) Stroke(stroke String) *FeTurbulenceElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *FeTurbulenceElement) StrokeColor(stroke Color) *FeTurbulenceElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *FeTurbulenceElement) StrokePaint(stroke Paint) *FeTurbulenceElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeDashArray sets the stroke-dasharray attribute.
+func (e *FeTurbulenceElement) StrokeDashArray(strokeDashArray String) *FeTurbulenceElement {
+	e.Attrs["stroke-dasharray"] = strokeDashArray
+	return e
+}
+
+// StrokeDashOffset sets the stroke-dashoffset attribute.
+func (e *FeTurbulenceElement) StrokeDashOffset(strokeDashOffset Float64) *FeTurbulenceElement {
+	e.Attrs["stroke-dashoffset"] = strokeDashOffset
+	return e
+}
+
+// StrokeLineCap sets the stroke-linecap attribute.
+func (e *FeTurbulenceElement) StrokeLineCap(strokeLineCap String) *FeTurbulenceElement {
+	e.Attrs["stroke-linecap"] = strokeLineCap
+	return e
+}
+
+// StrokeLineJoin sets the stroke-linejoin attribute.
+func (e *FeTurbulenceElement) StrokeLineJoin(strokeLineJoin String) *FeTurbulenceElement {
+	e.Attrs["stroke-linejoin"] = strokeLineJoin
+	return e
+}
+
+// StrokeMiterLimit sets the stroke-miterlimit attribute.
+func (e *FeTurbulenceElement) StrokeMiterLimit(strokeMiterLimit Float64) *FeTurbulenceElement {
+	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
+	return e
+}
+
+// StrokeOpacity sets the stroke-opacity attribute.
+func (e *FeTurbulenceElement) StrokeOpacity(strokeOpacity Float64) *FeTurbulenceElement {
+	e.Attrs["stroke-opacity"] = strokeOpacity
+	return e
+}
+
+// StrokeWidth sets the stroke-width attribute.
+func (e *FeTurbulenceElement) StrokeWidth(strokeWidth Length) *FeTurbulenceElement {
+	e.Attrs["stroke-width"] = strokeWidth
+	return e
+}
+
+// TextAnchor sets the text-anchor attribute.
+func (e *FeTurbulenceElement) TextAnchor(textAnchor String) *FeTurbulenceElement {
+	e.Attrs["text-anchor"] = textAnchor
+	return e
+}
+
+// TextDecoration sets the text-decoration attribute.
+func (e *FeTurbulenceElement) TextDecoration(textDecoration String) *FeTurbulenceElement {
+	e.Attrs["text-decoration"] = textDecoration
+	return e
+}
+
+// TextOverflow sets the text-overflow attribute.
+func (e *FeTurbulenceElement) TextOverflow(textOverflow String) *FeTurbulenceElement {
+	e.Attrs["text-overflow"] = textOverflow
+	return e
+}
+
+// TextRendering sets the text-rendering attribute.
+func (e *FeTurbulenceElement) TextRendering(textRendering String) *FeTurbulenceElement {
+	e.Attrs["text-rendering"] = textRendering
+	return e
+}
+
+// Transform sets the transform attribute.
+func (e *FeTurbulenceElement) Transform(transform Transform) *FeTurbulenceElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
+// UnicodeBiDi sets the unicode-bidi attribute.
+func (e *FeTurbulenceElement) UnicodeBiDi(unicodeBiDi String) *FeTurbulenceElement {
+	e.Attrs["unicode-bidi"] = unicodeBiDi
+	return e
+}
+
+// VectorEffect sets the vector-effect attribute.
+func (e *FeTurbulenceElement) VectorEffect(vectorEffect String) *FeTurbulenceElement {
+	e.Attrs["vector-effect"] = vectorEffect
+	return e
+}
+
+// Visibility sets the visibility attribute.
+func (e *FeTurbulenceElement) Visibility(visibility String) *FeTurbulenceElement {
+	e.Attrs["visibility"] = visibility
+	return e
+}
+
+// WhiteSpace sets the white-space attribute.
+func (e *FeTurbulenceElement) WhiteSpace(whiteSpace String) *FeTurbulenceElement {
+	e.Attrs["white-space"] = whiteSpace
+	return e
+}
+
+// WordSpacing sets the word-spacing attribute.
+func (e *FeTurbulenceElement) WordSpacing(wordSpacing String) *FeTurbulenceElement {
+	e.Attrs["word-spacing"] = wordSpacing
+	return e
+}
+
+// WritingMode sets the writing-mode attribute.
+func (e *FeTurbulenceElement) WritingMode(writingMode String) *FeTurbulenceElement {
+	e.Attrs["writing-mode"] = writingMode
+	return e
+}
+
+// X sets the x attribute.
+func (e *FeTurbulenceElement) X(x Length) *FeTurbulenceElement {
+	e.Attrs["x"] = x
+	return e
+}
+
+// Y sets the y attribute.
+func (e *FeTurbulenceElement) Y(y Length) *FeTurbulenceElement {
+	e.Attrs["y"] = y
+	return e
+}
+
+// Width sets the width attribute.
+func (e *FeTurbulenceElement) Width(width Length) *FeTurbulenceElement {
+	e.Attrs["width"] = width
+	return e
+}
+
+// Height sets the height attribute.
+func (e *FeTurbulenceElement) Height(height Length) *FeTurbulenceElement {
+	e.Attrs["height"] = height
+	return e
+}
+
+// Result sets the result attribute.
+func (e *FeTurbulenceElement) Result(result String) *FeTurbulenceElement {
+	e.Attrs["result"] = result
+	return e
+}
+
+// BaseFrequency sets the baseFrequency attribute.
+func (e *FeTurbulenceElement) BaseFrequency(baseFrequency Numbers) *FeTurbulenceElement {
+	e.Attrs["baseFrequency"] = baseFrequency
+	return e
+}
+
+// NumOctaves sets the numOctaves attribute.
+func (e *FeTurbulenceElement) NumOctaves(numOctaves Int) *FeTurbulenceElement {
+	e.Attrs["numOctaves"] = numOctaves
+	return e
+}
+
+// Seed sets the seed attribute.
+func (e *FeTurbulenceElement) Seed(seed Float64) *FeTurbulenceElement {
+	e.Attrs["seed"] = seed
+	return e
+}
+
+// StitchTiles sets the stitchTiles attribute.
+func (e *FeTurbulenceElement) StitchTiles(stitchTiles String) *FeTurbulenceElement {
+	e.Attrs["stitchTiles"] = stitchTiles
+	return e
+}
+
+// Type sets the type attribute.
+func (e *FeTurbulenceElement) Type(_type String) *FeTurbulenceElement {
+	e.Attrs["type"] = _type
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *FeTurbulenceElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *FeTurbulenceElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *FeTurbulenceElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *FeTurbulenceElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *FeTurbulenceElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *FeTurbulenceElement) TagName() string {
+	return "feTurbulence"
+}
+
+// decode decodes e from d.
+func (e *FeTurbulenceElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *FeTurbulenceElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "x":
+		return parseLength(value)
+	case "y":
+		return parseLength(value)
+	case "width":
+		return parseLength(value)
+	case "height":
+		return parseLength(value)
+	case "baseFrequency":
+		return parseNumbers(value)
+	case "numOctaves":
+		return parseInt(value)
+	case "seed":
+		return parseFloat64(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A FilterElement is a filter element.
+type FilterElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// Filter returns a new FilterElement.
+func Filter(children ...Element) *FilterElement {
+	return &FilterElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *FilterElement) AppendChildren(children ...Element) *FilterElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *FilterElement) RemoveAttrs(names ...string) *FilterElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *FilterElement) ID(id String) *FilterElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *FilterElement) TabIndex(tabIndex Int) *FilterElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *FilterElement) Lang(lang String) *FilterElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *FilterElement) Class(class String) *FilterElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *FilterElement) Style(style String) *FilterElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// AlignmentBaseline sets the alignment-baseline attribute.
+func (e *FilterElement) AlignmentBaseline(alignmentBaseline String) *FilterElement {
+	e.Attrs["alignment-baseline"] = alignmentBaseline
+	return e
+}
+
+// BaselineShift sets the baseline-shift attribute.
+func (e *FilterElement) BaselineShift(baselineShift String) *FilterElement {
+	e.Attrs["baseline-shift"] = baselineShift
+	return e
+}
+
+// ClipPath sets the clip-path attribute.
+func (e *FilterElement) ClipPath(clipPath String) *FilterElement {
+	e.Attrs["clip-path"] = clipPath
+	return e
+}
+
+// ClipRule sets the clip-rule attribute.
+func (e *FilterElement) ClipRule(clipRule String) *FilterElement {
+	e.Attrs["clip-rule"] = clipRule
+	return e
+}
+
+// Color sets the color attribute.
+func (e *FilterElement) Color(color Color) *FilterElement {
+	e.Attrs["color"] = color
+	return e
+}
+
+// ColorInterpolation sets the color-interpolation attribute.
+func (e *FilterElement) ColorInterpolation(colorInterpolation String) *FilterElement {
+	e.Attrs["color-interpolation"] = colorInterpolation
+	return e
+}
+
+// ColorInterpolationFilters sets the color-interpolation-filters attribute.
+func (e *FilterElement) ColorInterpolationFilters(colorInterpolationFilters String) *FilterElement {
+	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
+	return e
+}
+
+// ColorRendering sets the color-rendering attribute.
+func (e *FilterElement) ColorRendering(colorRendering String) *FilterElement {
+	e.Attrs["color-rendering"] = colorRendering
+	return e
+}
+
+// Cursor sets the cursor attribute.
+func (e *FilterElement) Cursor(cursor String) *FilterElement {
+	e.Attrs["cursor"] = cursor
+	return e
+}
+
+// Direction sets the direction attribute.
+func (e *FilterElement) Direction(direction String) *FilterElement {
+	e.Attrs["direction"] = direction
+	return e
+}
+
+// Display sets the display attribute.
+func (e *FilterElement) Display(display String) *FilterElement {
+	e.Attrs["display"] = display
+	return e
+}
+
+// DominantBaseline sets the dominant-baseline attribute.
+func (e *FilterElement) DominantBaseline(dominantBaseline String) *FilterElement {
+	e.Attrs["dominant-baseline"] = dominantBaseline
+	return e
+}
+
+// Fill sets the fill attribute.
+func (e *FilterElement) Fill(fill String) *FilterElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *FilterElement) FillColor(fill Color) *FilterElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *FilterElement) FillPaint(fill Paint) *FilterElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillOpacity sets the fill-opacity attribute.
+func (e *FilterElement) FillOpacity(fillOpacity Float64) *FilterElement {
+	e.Attrs["fill-opacity"] = fillOpacity
+	return e
+}
+
+// FillRule sets the fill-rule attribute.
+func (e *FilterElement) FillRule(fillRule String) *FilterElement {
+	e.Attrs["fill-rule"] = fillRule
+	return e
+}
+
+// Filter sets the filter attribute.
+func (e *FilterElement) Filter(filter String) *FilterElement {
+	e.Attrs["filter"] = filter
+	return e
+}
+
+// FloodColor sets the flood-color attribute.
+func (e *FilterElement) FloodColor(floodColor Color) *FilterElement {
+	e.Attrs["flood-color"] = floodColor
+	return e
+}
+
+// FloodOpacity sets the flood-opacity attribute.
+func (e *FilterElement) FloodOpacity(floodOpacity Float64) *FilterElement {
+	e.Attrs["flood-opacity"] = floodOpacity
+	return e
+}
+
+// FontFamily sets the font-family attribute.
+func (e *FilterElement) FontFamily(fontFamily String) *FilterElement {
+	e.Attrs["font-family"] = fontFamily
+	return e
+}
+
+// FontSize sets the font-size attribute.
+func (e *FilterElement) FontSize(fontSize String) *FilterElement {
+	e.Attrs["font-size"] = fontSize
+	return e
+}
+
+// FontSizeAdjust sets the font-size-adjust attribute.
+func (e *FilterElement) FontSizeAdjust(fontSizeAdjust String) *FilterElement {
+	e.Attrs["font-size-adjust"] = fontSizeAdjust
+	return e
+}
+
+// FontStretch sets the font-stretch attribute.
+func (e *FilterElement) FontStretch(fontStretch String) *FilterElement {
+	e.Attrs["font-stretch"] = fontStretch
+	return e
+}
+
+// FontStyle sets the font-style attribute.
+func (e *FilterElement) FontStyle(fontStyle String) *FilterElement {
+	e.Attrs["font-style"] = fontStyle
+	return e
+}
+
+// FontVariant sets the font-variant attribute.
+func (e *FilterElement) FontVariant(fontVariant String) *FilterElement {
+	e.Attrs["font-variant"] = fontVariant
+	return e
+}
+
+// FontWeight sets the font-weight attribute.
+func (e *FilterElement) FontWeight(fontWeight String) *FilterElement {
+	e.Attrs["font-weight"] = fontWeight
+	return e
+}
+
+// GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
+func (e *FilterElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *FilterElement {
+	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
+	return e
+}
+
+// GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
+func (e *FilterElement) GlyphOrientationVertical(glyphOrientationVertical String) *FilterElement {
+	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
+	return e
+}
+
+// ImageRendering sets the image-rendering attribute.
+func (e *FilterElement) ImageRendering(imageRendering String) *FilterElement {
+	e.Attrs["image-rendering"] = imageRendering
+	return e
+}
+
+// LetterSpacing sets the letter-spacing attribute.
+func (e *FilterElement) LetterSpacing(letterSpacing String) *FilterElement {
+	e.Attrs["letter-spacing"] = letterSpacing
+	return e
+}
+
+// LightingColor sets the lighting-color attribute.
+func (e *FilterElement) LightingColor(lightingColor Color) *FilterElement {
+	e.Attrs["lighting-color"] = lightingColor
+	return e
+}
+
+// MarkerEnd sets the marker-end attribute.
+func (e *FilterElement) MarkerEnd(markerEnd String) *FilterElement {
+	e.Attrs["marker-end"] = markerEnd
+	return e
+}
+
+// MarkerMid sets the marker-mid attribute.
+func (e *FilterElement) MarkerMid(markerMid String) *FilterElement {
+	e.Attrs["marker-mid"] = markerMid
+	return e
+}
+
+// MarkerStart sets the marker-start attribute.
+func (e *FilterElement) MarkerStart(markerStart String) *FilterElement {
+	e.Attrs["marker-start"] = markerStart
+	return e
+}
+
+// Mask sets the mask attribute.
+func (e *FilterElement) Mask(mask String) *FilterElement {
+	e.Attrs["mask"] = mask
+	return e
+}
+
+// Opacity sets the opacity attribute.
+func (e *FilterElement) Opacity(opacity Float64) *FilterElement {
+	e.Attrs["opacity"] = opacity
+	return e
+}
+
+// Overflow sets the overflow attribute.
+func (e *FilterElement) Overflow(overflow String) *FilterElement {
+	e.Attrs["overflow"] = overflow
+	return e
+}
+
+// PaintOrder sets the paint-order attribute.
+func (e *FilterElement) PaintOrder(paintOrder String) *FilterElement {
+	e.Attrs["paint-order"] = paintOrder
+	return e
+}
+
+// PointerEvents sets the pointer-events attribute.
+func (e *FilterElement) PointerEvents(pointerEvents String) *FilterElement {
+	e.Attrs["pointer-events"] = pointerEvents
+	return e
+}
+
+// ShapeRendering sets the shape-rendering attribute.
+func (e *FilterElement) ShapeRendering(shapeRendering String) *FilterElement {
+	e.Attrs["shape-rendering"] = shapeRendering
+	return e
+}
+
+// StopColor sets the stop-color attribute.
+func (e *FilterElement) StopColor(stopColor Color) *FilterElement {
+	e.Attrs["stop-color"] = stopColor
+	return e
+}
+
+// StopOpacity sets the stop-opacity attribute.
+func (e *FilterElement) StopOpacity(stopOpacity Float64) *FilterElement {
+	e.Attrs["stop-opacity"] = stopOpacity
+	return e
+}
+
+// Stroke sets the stroke attribute.
+func (e *FilterElement) Stroke(stroke String) *FilterElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *FilterElement) StrokeColor(stroke Color) *FilterElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *FilterElement) StrokePaint(stroke Paint) *FilterElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeDashArray sets the stroke-dasharray attribute.
+func (e *FilterElement) StrokeDashArray(strokeDashArray String) *FilterElement {
+	e.Attrs["stroke-dasharray"] = strokeDashArray
+	return e
+}
+
+// StrokeDashOffset sets the stroke-dashoffset attribute.
+func (e *FilterElement) StrokeDashOffset(strokeDashOffset Float64) *FilterElement {
+	e.Attrs["stroke-dashoffset"] = strokeDashOffset
+	return e
+}
+
+// StrokeLineCap sets the stroke-linecap attribute.
+func (e *FilterElement) StrokeLineCap(strokeLineCap String) *FilterElement {
+	e.Attrs["stroke-linecap"] = strokeLineCap
+	return e
+}
+
+// StrokeLineJoin sets the stroke-linejoin attribute.
+func (e *FilterElement) StrokeLineJoin(strokeLineJoin String) *FilterElement {
+	e.Attrs["stroke-linejoin"] = strokeLineJoin
+	return e
+}
+
+// StrokeMiterLimit sets the stroke-miterlimit attribute.
+func (e *FilterElement) StrokeMiterLimit(strokeMiterLimit Float64) *FilterElement {
+	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
+	return e
+}
+
+// StrokeOpacity sets the stroke-opacity attribute.
+func (e *FilterElement) StrokeOpacity(strokeOpacity Float64) *FilterElement {
+	e.Attrs["stroke-opacity"] = strokeOpacity
+	return e
+}
+
+// StrokeWidth sets the stroke-width attribute.
+func (e *FilterElement) StrokeWidth(strokeWidth Length) *FilterElement {
+	e.Attrs["stroke-width"] = strokeWidth
+	return e
+}
+
+// TextAnchor sets the text-anchor attribute.
+func (e *FilterElement) TextAnchor(textAnchor String) *FilterElement {
+	e.Attrs["text-anchor"] = textAnchor
+	return e
+}
+
+// TextDecoration sets the text-decoration attribute.
+func (e *FilterElement) TextDecoration(textDecoration String) *FilterElement {
+	e.Attrs["text-decoration"] = textDecoration
+	return e
+}
+
+// TextOverflow sets the text-overflow attribute.
+func (e *FilterElement) TextOverflow(textOverflow String) *FilterElement {
+	e.Attrs["text-overflow"] = textOverflow
+	return e
+}
+
+// TextRendering sets the text-rendering attribute.
+func (e *FilterElement) TextRendering(textRendering String) *FilterElement {
+	e.Attrs["text-rendering"] = textRendering
+	return e
+}
+
+// Transform sets the transform attribute.
+func (e *FilterElement) Transform(transform Transform) *FilterElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
+// UnicodeBiDi sets the unicode-bidi attribute.
+func (e *FilterElement) UnicodeBiDi(unicodeBiDi String) *FilterElement {
+	e.Attrs["unicode-bidi"] = unicodeBiDi
+	return e
+}
+
+// VectorEffect sets the vector-effect attribute.
+func (e *FilterElement) VectorEffect(vectorEffect String) *FilterElement {
+	e.Attrs["vector-effect"] = vectorEffect
+	return e
+}
+
+// Visibility sets the visibility attribute.
+func (e *FilterElement) Visibility(visibility String) *FilterElement {
+	e.Attrs["visibility"] = visibility
+	return e
+}
+
+// WhiteSpace sets the white-space attribute.
+func (e *FilterElement) WhiteSpace(whiteSpace String) *FilterElement {
+	e.Attrs["white-space"] = whiteSpace
+	return e
+}
+
+// WordSpacing sets the word-spacing attribute.
+func (e *FilterElement) WordSpacing(wordSpacing String) *FilterElement {
+	e.Attrs["word-spacing"] = wordSpacing
+	return e
+}
+
+// WritingMode sets the writing-mode attribute.
+func (e *FilterElement) WritingMode(writingMode String) *FilterElement {
+	e.Attrs["writing-mode"] = writingMode
+	return e
+}
+
+// FilterUnits sets the filterUnits attribute.
+func (e *FilterElement) FilterUnits(filterUnits String) *FilterElement {
+	e.Attrs["filterUnits"] = filterUnits
+	return e
+}
+
+// PrimitiveUnits sets the primitiveUnits attribute.
+func (e *FilterElement) PrimitiveUnits(primitiveUnits String) *FilterElement {
+	e.Attrs["primitiveUnits"] = primitiveUnits
+	return e
+}
+
+// Href sets the href attribute.
+func (e *FilterElement) Href(href String) *FilterElement {
+	e.Attrs["href"] = href
+	return e
+}
+
+// X sets the x attribute.
+func (e *FilterElement) X(x Length) *FilterElement {
+	e.Attrs["x"] = x
+	return e
+}
+
+// Y sets the y attribute.
+func (e *FilterElement) Y(y Length) *FilterElement {
+	e.Attrs["y"] = y
+	return e
+}
+
+// Width sets the width attribute.
+func (e *FilterElement) Width(width Length) *FilterElement {
+	e.Attrs["width"] = width
+	return e
+}
+
+// Height sets the height attribute.
+func (e *FilterElement) Height(height Length) *FilterElement {
+	e.Attrs["height"] = height
+	return e
+}
+
+// WidthHeight sets the width and height attributes.
+func (e *FilterElement) WidthHeight(width, height float64, lengthFunc LengthFunc) *FilterElement {
+	e.Attrs["width"] = lengthFunc(width)
+	e.Attrs["height"] = lengthFunc(height)
+	return e
+}
+
+// XY sets the x and y attributes.
+func (e *FilterElement) XY(x, y float64, lengthFunc LengthFunc) *FilterElement {
+	e.Attrs["x"] = lengthFunc(x)
+	e.Attrs["y"] = lengthFunc(y)
+	return e
+}
+
+// XYWidthHeight sets the x, y, width, and height attributes.
+func (e *FilterElement) XYWidthHeight(x, y, width, height float64, lengthFunc LengthFunc) *FilterElement {
+	e.Attrs["x"] = lengthFunc(x)
+	e.Attrs["y"] = lengthFunc(y)
+	e.Attrs["width"] = lengthFunc(width)
+	e.Attrs["height"] = lengthFunc(height)
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *FilterElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *FilterElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *FilterElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *FilterElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *FilterElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *FilterElement) TagName() string {
+	return "filter"
+}
+
+// decode decodes e from d.
+func (e *FilterElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *FilterElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "x":
+		return parseLength(value)
+	case "y":
+		return parseLength(value)
+	case "width":
+		return parseLength(value)
+	case "height":
+		return parseLength(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A ForeignObjectElement is a foreignObject element.
+type ForeignObjectElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// ForeignObject returns a new ForeignObjectElement.
+func ForeignObject(children ...Element) *ForeignObjectElement {
+	return &ForeignObjectElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *ForeignObjectElement) AppendChildren(children ...Element) *ForeignObjectElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *ForeignObjectElement) RemoveAttrs(names ...string) *ForeignObjectElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *ForeignObjectElement) ID(id String) *ForeignObjectElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *ForeignObjectElement) TabIndex(tabIndex Int) *ForeignObjectElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *ForeignObjectElement) Lang(lang String) *ForeignObjectElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *ForeignObjectElement) Class(class String) *ForeignObjectElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *ForeignObjectElement) Style(style String) *ForeignObjectElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// AlignmentBaseline sets the alignment-baseline attribute.
+func (e *ForeignObjectElement) AlignmentBaseline(alignmentBaseline String) *ForeignObjectElement {
+	e.Attrs["alignment-baseline"] = alignmentBaseline
+	return e
+}
+
+// BaselineShift sets the baseline-shift attribute.
+func (e *ForeignObjectElement) BaselineShift(baselineShift String) *ForeignObjectElement {
+	e.Attrs["baseline-shift"] = baselineShift
+	return e
+}
+
+// ClipPath sets the clip-path attribute.
+func (e *ForeignObjectElement) ClipPath(clipPath String) *ForeignObjectElement {
+	e.Attrs["clip-path"] = clipPath
+	return e
+}
+
+// ClipRule sets the clip-rule attribute.
+func (e *ForeignObjectElement) ClipRule(clipRule String) *ForeignObjectElement {
+	e.Attrs["clip-rule"] = clipRule
+	return e
+}
+
+// Color sets the color attribute.
+func (e *ForeignObjectElement) Color(color Color) *ForeignObjectElement {
+	e.Attrs["color"] = color
+	return e
+}
+
+// ColorInterpolation sets the color-interpolation attribute.
+func (e *ForeignObjectElement) ColorInterpolation(colorInterpolation String) *ForeignObjectElement {
+	e.Attrs["color-interpolation"] = colorInterpolation
+	return e
+}
+
+// ColorInterpolationFilters sets the color-interpolation-filters attribute.
+func (e *ForeignObjectElement) ColorInterpolationFilters(colorInterpolationFilters String) *ForeignObjectElement {
+	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
+	return e
+}
+
+// ColorRendering sets the color-rendering attribute.
+func (e *ForeignObjectElement) ColorRendering(colorRendering String) *ForeignObjectElement {
+	e.Attrs["color-rendering"] = colorRendering
+	return e
+}
+
+// Cursor sets the cursor attribute.
+func (e *ForeignObjectElement) Cursor(cursor String) *ForeignObjectElement {
+	e.Attrs["cursor"] = cursor
+	return e
+}
+
+// Direction sets the direction attribute.
+func (e *ForeignObjectElement) Direction(direction String) *ForeignObjectElement {
+	e.Attrs["direction"] = direction
+	return e
+}
+
+// Display sets the display attribute.
+func (e *ForeignObjectElement) Display(display String) *ForeignObjectElement {
+	e.Attrs["display"] = display
+	return e
+}
+
+// DominantBaseline sets the dominant-baseline attribute.
+func (e *ForeignObjectElement) DominantBaseline(dominantBaseline String) *ForeignObjectElement {
+	e.Attrs["dominant-baseline"] = dominantBaseline
+	return e
+}
+
+// Fill sets the fill attribute.
+func (e *ForeignObjectElement) Fill(fill String) *ForeignObjectElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *ForeignObjectElement) FillColor(fill Color) *ForeignObjectElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *ForeignObjectElement) FillPaint(fill Paint) *ForeignObjectElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillOpacity sets the fill-opacity attribute.
+func (e *ForeignObjectElement) FillOpacity(fillOpacity Float64) *ForeignObjectElement {
+	e.Attrs["fill-opacity"] = fillOpacity
+	return e
+}
+
+// FillRule sets the fill-rule attribute.
+func (e *ForeignObjectElement) FillRule(fillRule String) *ForeignObjectElement {
+	e.Attrs["fill-rule"] = fillRule
+	return e
+}
+
+// Filter sets the filter attribute.
+func (e *ForeignObjectElement) Filter(filter String) *ForeignObjectElement {
+	e.Attrs["filter"] = filter
+	return e
+}
+
+// FloodColor sets the flood-color attribute.
+func (e *ForeignObjectElement) FloodColor(floodColor Color) *ForeignObjectElement {
+	e.Attrs["flood-color"] = floodColor
+	return e
+}
+
+// FloodOpacity sets the flood-opacity attribute.
+func (e *ForeignObjectElement) FloodOpacity(floodOpacity Float64) *ForeignObjectElement {
+	e.Attrs["flood-opacity"] = floodOpacity
+	return e
+}
+
+// FontFamily sets the font-family attribute.
+func (e *ForeignObjectElement) FontFamily(fontFamily String) *ForeignObjectElement {
+	e.Attrs["font-family"] = fontFamily
+	return e
+}
+
+// FontSize sets the font-size attribute.
+func (e *ForeignObjectElement) FontSize(fontSize String) *ForeignObjectElement {
+	e.Attrs["font-size"] = fontSize
+	return e
+}
+
+// FontSizeAdjust sets the font-size-adjust attribute.
+func (e *ForeignObjectElement) FontSizeAdjust(fontSizeAdjust String) *ForeignObjectElement {
+	e.Attrs["font-size-adjust"] = fontSizeAdjust
+	return e
+}
+
+// FontStretch sets the font-stretch attribute.
+func (e *ForeignObjectElement) FontStretch(fontStretch String) *ForeignObjectElement {
+	e.Attrs["font-stretch"] = fontStretch
+	return e
+}
+
+// FontStyle sets the font-style attribute.
+func (e *ForeignObjectElement) FontStyle(fontStyle String) *ForeignObjectElement {
+	e.Attrs["font-style"] = fontStyle
+	return e
+}
+
+// FontVariant sets the font-variant attribute.
+func (e *ForeignObjectElement) FontVariant(fontVariant String) *ForeignObjectElement {
+	e.Attrs["font-variant"] = fontVariant
+	return e
+}
+
+// FontWeight sets the font-weight attribute.
+func (e *ForeignObjectElement) FontWeight(fontWeight String) *ForeignObjectElement {
+	e.Attrs["font-weight"] = fontWeight
+	return e
+}
+
+// GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
+func (e *ForeignObjectElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *ForeignObjectElement {
+	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
+	return e
+}
+
+// GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
+func (e *ForeignObjectElement) GlyphOrientationVertical(glyphOrientationVertical String) *ForeignObjectElement {
+	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
+	return e
+}
+
+// ImageRendering sets the image-rendering attribute.
+func (e *ForeignObjectElement) ImageRendering(imageRendering String) *ForeignObjectElement {
+	e.Attrs["image-rendering"] = imageRendering
+	return e
+}
+
+// LetterSpacing sets the letter-spacing attribute.
+func (e *ForeignObjectElement) LetterSpacing(letterSpacing String) *ForeignObjectElement {
+	e.Attrs["letter-spacing"] = letterSpacing
+	return e
+}
+
+// LightingColor sets the lighting-color attribute.
+func (e *ForeignObjectElement) LightingColor(lightingColor Color) *ForeignObjectElement {
+	e.Attrs["lighting-color"] = lightingColor
+	return e
+}
+
+// MarkerEnd sets the marker-end attribute.
+func (e *ForeignObjectElement) MarkerEnd(markerEnd String) *ForeignObjectElement {
+	e.Attrs["marker-end"] = markerEnd
+	return e
+}
+
+// MarkerMid sets the marker-mid attribute.
+func (e *ForeignObjectElement) MarkerMid(markerMid String) *ForeignObjectElement {
+	e.Attrs["marker-mid"] = markerMid
+	return e
+}
+
+// MarkerStart sets the marker-start attribute.
+func (e *ForeignObjectElement) MarkerStart(markerStart String) *ForeignObjectElement {
+	e.Attrs["marker-start"] = markerStart
+	return e
+}
+
+// Mask sets the mask attribute.
+func (e *ForeignObjectElement) Mask(mask String) *ForeignObjectElement {
+	e.Attrs["mask"] = mask
+	return e
+}
+
+// Opacity sets the opacity attribute.
+func (e *ForeignObjectElement) Opacity(opacity Float64) *ForeignObjectElement {
+	e.Attrs["opacity"] = opacity
+	return e
+}
+
+// Overflow sets the overflow attribute.
+func (e *ForeignObjectElement) Overflow(overflow String) *ForeignObjectElement {
+	e.Attrs["overflow"] = overflow
+	return e
+}
+
+// PaintOrder sets the paint-order attribute.
+func (e *ForeignObjectElement) PaintOrder(paintOrder String) *ForeignObjectElement {
+	e.Attrs["paint-order"] = paintOrder
+	return e
+}
+
+// PointerEvents sets the pointer-events attribute.
+func (e *ForeignObjectElement) PointerEvents(pointerEvents String) *ForeignObjectElement {
+	e.Attrs["pointer-events"] = pointerEvents
+	return e
+}
+
+// ShapeRendering sets the shape-rendering attribute.
+func (e *ForeignObjectElement) ShapeRendering(shapeRendering String) *ForeignObjectElement {
+	e.Attrs["shape-rendering"] = shapeRendering
+	return e
+}
+
+// StopColor sets the stop-color attribute.
+func (e *ForeignObjectElement) StopColor(stopColor Color) *ForeignObjectElement {
+	e.Attrs["stop-color"] = stopColor
+	return e
+}
+
+// StopOpacity sets the stop-opacity attribute.
+func (e *ForeignObjectElement) StopOpacity(stopOpacity Float64) *ForeignObjectElement {
+	e.Attrs["stop-opacity"] = stopOpacity
+	return e
+}
+
+// Stroke sets the stroke attribute.
+func (e *ForeignObjectElement) Stroke(stroke String) *ForeignObjectElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *ForeignObjectElement) StrokeColor(stroke Color) *ForeignObjectElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *ForeignObjectElement) StrokePaint(stroke Paint) *ForeignObjectElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeDashArray sets the stroke-dasharray attribute.
+func (e *ForeignObjectElement) StrokeDashArray(strokeDashArray String) *ForeignObjectElement {
+	e.Attrs["stroke-dasharray"] = strokeDashArray
+	return e
+}
+
+// StrokeDashOffset sets the stroke-dashoffset attribute.
+func (e *ForeignObjectElement) StrokeDashOffset(strokeDashOffset Float64) *ForeignObjectElement {
+	e.Attrs["stroke-dashoffset"] = strokeDashOffset
+	return e
+}
+
+// StrokeLineCap sets the stroke-linecap attribute.
+func (e *ForeignObjectElement) StrokeLineCap(strokeLineCap String) *ForeignObjectElement {
+	e.Attrs["stroke-linecap"] = strokeLineCap
+	return e
+}
+
+// StrokeLineJoin sets the stroke-linejoin attribute.
+func (e *ForeignObjectElement) StrokeLineJoin(strokeLineJoin String) *ForeignObjectElement {
+	e.Attrs["stroke-linejoin"] = strokeLineJoin
+	return e
+}
+
+// StrokeMiterLimit sets the stroke-miterlimit attribute.
+func (e *ForeignObjectElement) StrokeMiterLimit(strokeMiterLimit Float64) *ForeignObjectElement {
+	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
+	return e
+}
+
+// StrokeOpacity sets the stroke-opacity attribute.
+func (e *ForeignObjectElement) StrokeOpacity(strokeOpacity Float64) *ForeignObjectElement {
+	e.Attrs["stroke-opacity"] = strokeOpacity
+	return e
+}
+
+// StrokeWidth sets the stroke-width attribute.
+func (e *ForeignObjectElement) StrokeWidth(strokeWidth Length) *ForeignObjectElement {
+	e.Attrs["stroke-width"] = strokeWidth
+	return e
+}
+
+// TextAnchor sets the text-anchor attribute.
+func (e *ForeignObjectElement) TextAnchor(textAnchor String) *ForeignObjectElement {
+	e.Attrs["text-anchor"] = textAnchor
+	return e
+}
+
+// TextDecoration sets the text-decoration attribute.
+func (e *ForeignObjectElement) TextDecoration(textDecoration String) *ForeignObjectElement {
+	e.Attrs["text-decoration"] = textDecoration
+	return e
+}
+
+// TextOverflow sets the text-overflow attribute.
+func (e *ForeignObjectElement) TextOverflow(textOverflow String) *ForeignObjectElement {
+	e.Attrs["text-overflow"] = textOverflow
+	return e
+}
+
+// TextRendering sets the text-rendering attribute.
+func (e *ForeignObjectElement) TextRendering(textRendering String) *ForeignObjectElement {
+	e.Attrs["text-rendering"] = textRendering
+	return e
+}
+
+// Transform sets the transform attribute.
+func (e *ForeignObjectElement) Transform(transform Transform) *ForeignObjectElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
+// UnicodeBiDi sets the unicode-bidi attribute.
+func (e *ForeignObjectElement) UnicodeBiDi(unicodeBiDi String) *ForeignObjectElement {
+	e.Attrs["unicode-bidi"] = unicodeBiDi
+	return e
+}
+
+// VectorEffect sets the vector-effect attribute.
+func (e *ForeignObjectElement) VectorEffect(vectorEffect String) *ForeignObjectElement {
+	e.Attrs["vector-effect"] = vectorEffect
+	return e
+}
+
+// Visibility sets the visibility attribute.
+func (e *ForeignObjectElement) Visibility(visibility String) *ForeignObjectElement {
+	e.Attrs["visibility"] = visibility
+	return e
+}
+
+// WhiteSpace sets the white-space attribute.
+func (e *ForeignObjectElement) WhiteSpace(whiteSpace String) *ForeignObjectElement {
+	e.Attrs["white-space"] = whiteSpace
+	return e
+}
+
+// WordSpacing sets the word-spacing attribute.
+func (e *ForeignObjectElement) WordSpacing(wordSpacing String) *ForeignObjectElement {
+	e.Attrs["word-spacing"] = wordSpacing
+	return e
+}
+
+// WritingMode sets the writing-mode attribute.
+func (e *ForeignObjectElement) WritingMode(writingMode String) *ForeignObjectElement {
+	e.Attrs["writing-mode"] = writingMode
+	return e
+}
+
+// RequiredExtensions sets the requiredExtensions attribute.
+func (e *ForeignObjectElement) RequiredExtensions(requiredExtensions String) *ForeignObjectElement {
+	e.Attrs["requiredExtensions"] = requiredExtensions
+	return e
+}
+
+// SystemLanguage sets the systemLanguage attribute.
+func (e *ForeignObjectElement) SystemLanguage(systemLanguage String) *ForeignObjectElement {
+	e.Attrs["systemLanguage"] = systemLanguage
+	return e
+}
+
+// Href sets the href attribute.
+func (e *ForeignObjectElement) Href(href String) *ForeignObjectElement {
+	e.Attrs["href"] = href
+	return e
+}
+
+// X sets the x attribute.
+func (e *ForeignObjectElement) X(x Length) *ForeignObjectElement {
+	e.Attrs["x"] = x
+	return e
+}
+
+// Y sets the y attribute.
+func (e *ForeignObjectElement) Y(y Length) *ForeignObjectElement {
+	e.Attrs["y"] = y
+	return e
+}
+
+// Width sets the width attribute.
+func (e *ForeignObjectElement) Width(width Length) *ForeignObjectElement {
+	e.Attrs["width"] = width
+	return e
+}
+
+// Height sets the height attribute.
+func (e *ForeignObjectElement) Height(height Length) *ForeignObjectElement {
+	e.Attrs["height"] = height
+	return e
+}
+
+// WidthHeight sets the width and height attributes.
+func (e *ForeignObjectElement) WidthHeight(width, height float64, lengthFunc LengthFunc) *ForeignObjectElement {
+	e.Attrs["width"] = lengthFunc(width)
+	e.Attrs["height"] = lengthFunc(height)
+	return e
+}
+
+// XY sets the x and y attributes.
+func (e *ForeignObjectElement) XY(x, y float64, lengthFunc LengthFunc) *ForeignObjectElement {
+	e.Attrs["x"] = lengthFunc(x)
+	e.Attrs["y"] = lengthFunc(y)
+	return e
+}
+
+// XYWidthHeight sets the x, y, width, and height attributes.
+func (e *ForeignObjectElement) XYWidthHeight(x, y, width, height float64, lengthFunc LengthFunc) *ForeignObjectElement {
+	e.Attrs["x"] = lengthFunc(x)
+	e.Attrs["y"] = lengthFunc(y)
+	e.Attrs["width"] = lengthFunc(width)
+	e.Attrs["height"] = lengthFunc(height)
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *ForeignObjectElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *ForeignObjectElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *ForeignObjectElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *ForeignObjectElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *ForeignObjectElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *ForeignObjectElement) TagName() string {
+	return "foreignObject"
+}
+
+// decode decodes e from d.
+func (e *ForeignObjectElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *ForeignObjectElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "x":
+		return parseLength(value)
+	case "y":
+		return parseLength(value)
+	case "width":
+		return parseLength(value)
+	case "height":
+		return parseLength(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A GElement is a g element.
+type GElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// G returns a new GElement.
+func G(children ...Element) *GElement {
+	return &GElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *GElement) AppendChildren(children ...Element) *GElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *GElement) RemoveAttrs(names ...string) *GElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *GElement) ID(id String) *GElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *GElement) TabIndex(tabIndex Int) *GElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *GElement) Lang(lang String) *GElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *GElement) Class(class String) *GElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *GElement) Style(style String) *GElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// AlignmentBaseline sets the alignment-baseline attribute.
+func (e *GElement) AlignmentBaseline(alignmentBaseline String) *GElement {
+	e.Attrs["alignment-baseline"] = alignmentBaseline
+	return e
+}
+
+// BaselineShift sets the baseline-shift attribute.
+func (e *GElement) BaselineShift(baselineShift String) *GElement {
+	e.Attrs["baseline-shift"] = baselineShift
+	return e
+}
+
+// ClipPath sets the clip-path attribute.
+func (e *GElement) ClipPath(clipPath String) *GElement {
+	e.Attrs["clip-path"] = clipPath
+	return e
+}
+
+// ClipRule sets the clip-rule attribute.
+func (e *GElement) ClipRule(clipRule String) *GElement {
+	e.Attrs["clip-rule"] = clipRule
+	return e
+}
+
+// Color sets the color attribute.
+func (e *GElement) Color(color Color) *GElement {
+	e.Attrs["color"] = color
+	return e
+}
+
+// ColorInterpolation sets the color-interpolation attribute.
+func (e *GElement) ColorInterpolation(colorInterpolation String) *GElement {
+	e.Attrs["color-interpolation"] = colorInterpolation
+	return e
+}
+
+// ColorInterpolationFilters sets the color-interpolation-filters attribute.
+func (e *GElement) ColorInterpolationFilters(colorInterpolationFilters String) *GElement {
+	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
+	return e
+}
+
+// ColorRendering sets the color-rendering attribute.
+func (e *GElement) ColorRendering(colorRendering String) *GElement {
+	e.Attrs["color-rendering"] = colorRendering
+	return e
+}
+
+// Cursor sets the cursor attribute.
+func (e *GElement) Cursor(cursor String) *GElement {
+	e.Attrs["cursor"] = cursor
+	return e
+}
+
+// Direction sets the direction attribute.
+func (e *GElement) Direction(direction String) *GElement {
+	e.Attrs["direction"] = direction
+	return e
+}
+
+// Display sets the display attribute.
+func (e *GElement) Display(display String) *GElement {
+	e.Attrs["display"] = display
+	return e
+}
+
+// DominantBaseline sets the dominant-baseline attribute.
+func (e *GElement) DominantBaseline(dominantBaseline String) *GElement {
+	e.Attrs["dominant-baseline"] = dominantBaseline
+	return e
+}
+
+// Fill sets the fill attribute.
+func (e *GElement) Fill(fill String) *GElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *GElement) FillColor(fill Color) *GElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *GElement) FillPaint(fill Paint) *GElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillOpacity sets the fill-opacity attribute.
+func (e *GElement) FillOpacity(fillOpacity Float64) *GElement {
+	e.Attrs["fill-opacity"] = fillOpacity
+	return e
+}
+
+// FillRule sets the fill-rule attribute.
+func (e *GElement) FillRule(fillRule String) *GElement {
+	e.Attrs["fill-rule"] = fillRule
+	return e
+}
+
+// Filter sets the filter attribute.
+func (e *GElement) Filter(filter String) *GElement {
+	e.Attrs["filter"] = filter
+	return e
+}
+
+// FloodColor sets the flood-color attribute.
+func (e *GElement) FloodColor(floodColor Color) *GElement {
+	e.Attrs["flood-color"] = floodColor
+	return e
+}
+
+// FloodOpacity sets the flood-opacity attribute.
+func (e *GElement) FloodOpacity(floodOpacity Float64) *GElement {
+	e.Attrs["flood-opacity"] = floodOpacity
+	return e
+}
+
+// FontFamily sets the font-family attribute.
+func (e *GElement) FontFamily(fontFamily String) *GElement {
+	e.Attrs["font-family"] = fontFamily
+	return e
+}
+
+// FontSize sets the font-size attribute.
+func (e *GElement) FontSize(fontSize String) *GElement {
+	e.Attrs["font-size"] = fontSize
+	return e
+}
+
+// FontSizeAdjust sets the font-size-adjust attribute.
+func (e *GElement) FontSizeAdjust(fontSizeAdjust String) *GElement {
+	e.Attrs["font-size-adjust"] = fontSizeAdjust
+	return e
+}
+
+// FontStretch sets the font-stretch attribute.
+func (e *GElement) FontStretch(fontStretch String) *GElement {
+	e.Attrs["font-stretch"] = fontStretch
+	return e
+}
+
+// FontStyle sets the font-style attribute.
+func (e *GElement) FontStyle(fontStyle String) *GElement {
+	e.Attrs["font-style"] = fontStyle
+	return e
+}
+
+// FontVariant sets the font-variant attribute.
+func (e *GElement) FontVariant(fontVariant String) *GElement {
+	e.Attrs["font-variant"] = fontVariant
+	return e
+}
+
+// FontWeight sets the font-weight attribute.
+func (e *GElement) FontWeight(fontWeight String) *GElement {
+	e.Attrs["font-weight"] = fontWeight
+	return e
+}
+
+// GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
+func (e *GElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *GElement {
+	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
+	return e
+}
+
+// GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
+func (e *GElement) GlyphOrientationVertical(glyphOrientationVertical String) *GElement {
+	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
+	return e
+}
+
+// ImageRendering sets the image-rendering attribute.
+func (e *GElement) ImageRendering(imageRendering String) *GElement {
+	e.Attrs["image-rendering"] = imageRendering
+	return e
+}
+
+// LetterSpacing sets the letter-spacing attribute.
+func (e *GElement) LetterSpacing(letterSpacing String) *GElement {
+	e.Attrs["letter-spacing"] = letterSpacing
+	return e
+}
+
+// LightingColor sets the lighting-color attribute.
+func (e *GElement) LightingColor(lightingColor Color) *GElement {
+	e.Attrs["lighting-color"] = lightingColor
+	return e
+}
+
+// MarkerEnd sets the marker-end attribute.
+func (e *GElement) MarkerEnd(markerEnd String) *GElement {
+	e.Attrs["marker-end"] = markerEnd
+	return e
+}
+
+// MarkerMid sets the marker-mid attribute.
+func (e *GElement) MarkerMid(markerMid String) *GElement {
+	e.Attrs["marker-mid"] = markerMid
+	return e
+}
+
+// MarkerStart sets the marker-start attribute.
+func (e *GElement) MarkerStart(markerStart String) *GElement {
+	e.Attrs["marker-start"] = markerStart
+	return e
+}
+
+// Mask sets the mask attribute.
+func (e *GElement) Mask(mask String) *GElement {
+	e.Attrs["mask"] = mask
+	return e
+}
+
+// Opacity sets the opacity attribute.
+func (e *GElement) Opacity(opacity Float64) *GElement {
+	e.Attrs["opacity"] = opacity
+	return e
+}
+
+// Overflow sets the overflow attribute.
+func (e *GElement) Overflow(overflow String) *GElement {
+	e.Attrs["overflow"] = overflow
+	return e
+}
+
+// PaintOrder sets the paint-order attribute.
+func (e *GElement) PaintOrder(paintOrder String) *GElement {
+	e.Attrs["paint-order"] = paintOrder
+	return e
+}
+
+// PointerEvents sets the pointer-events attribute.
+func (e *GElement) PointerEvents(pointerEvents String) *GElement {
+	e.Attrs["pointer-events"] = pointerEvents
+	return e
+}
+
+// ShapeRendering sets the shape-rendering attribute.
+func (e *GElement) ShapeRendering(shapeRendering String) *GElement {
+	e.Attrs["shape-rendering"] = shapeRendering
+	return e
+}
+
+// StopColor sets the stop-color attribute.
+func (e *GElement) StopColor(stopColor Color) *GElement {
+	e.Attrs["stop-color"] = stopColor
+	return e
+}
+
+// StopOpacity sets the stop-opacity attribute.
+func (e *GElement) StopOpacity(stopOpacity Float64) *GElement {
+	e.Attrs["stop-opacity"] = stopOpacity
+	return e
+}
+
+// Stroke sets the stroke attribute.
+func (e *GElement) Stroke(stroke String) *GElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *GElement) StrokeColor(stroke Color) *GElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *GElement) StrokePaint(stroke Paint) *GElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeDashArray sets the stroke-dasharray attribute.
+func (e *GElement) StrokeDashArray(strokeDashArray String) *GElement {
+	e.Attrs["stroke-dasharray"] = strokeDashArray
+	return e
+}
+
+// StrokeDashOffset sets the stroke-dashoffset attribute.
+func (e *GElement) StrokeDashOffset(strokeDashOffset Float64) *GElement {
+	e.Attrs["stroke-dashoffset"] = strokeDashOffset
+	return e
+}
+
+// StrokeLineCap sets the stroke-linecap attribute.
+func (e *GElement) StrokeLineCap(strokeLineCap String) *GElement {
+	e.Attrs["stroke-linecap"] = strokeLineCap
+	return e
+}
+
+// StrokeLineJoin sets the stroke-linejoin attribute.
+func (e *GElement) StrokeLineJoin(strokeLineJoin String) *GElement {
+	e.Attrs["stroke-linejoin"] = strokeLineJoin
+	return e
+}
+
+// StrokeMiterLimit sets the stroke-miterlimit attribute.
+func (e *GElement) StrokeMiterLimit(strokeMiterLimit Float64) *GElement {
+	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
+	return e
+}
+
+// StrokeOpacity sets the stroke-opacity attribute.
+func (e *GElement) StrokeOpacity(strokeOpacity Float64) *GElement {
+	e.Attrs["stroke-opacity"] = strokeOpacity
+	return e
+}
+
+// StrokeWidth sets the stroke-width attribute.
+func (e *GElement) StrokeWidth(strokeWidth Length) *GElement {
+	e.Attrs["stroke-width"] = strokeWidth
+	return e
+}
+
+// TextAnchor sets the text-anchor attribute.
+func (e *GElement) TextAnchor(textAnchor String) *GElement {
+	e.Attrs["text-anchor"] = textAnchor
+	return e
+}
+
+// TextDecoration sets the text-decoration attribute.
+func (e *GElement) TextDecoration(textDecoration String) *GElement {
+	e.Attrs["text-decoration"] = textDecoration
+	return e
+}
+
+// TextOverflow sets the text-overflow attribute.
+func (e *GElement) TextOverflow(textOverflow String) *GElement {
+	e.Attrs["text-overflow"] = textOverflow
+	return e
+}
+
+// TextRendering sets the text-rendering attribute.
+func (e *GElement) TextRendering(textRendering String) *GElement {
+	e.Attrs["text-rendering"] = textRendering
+	return e
+}
+
+// Transform sets the transform attribute.
+func (e *GElement) Transform(transform Transform) *GElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
+// UnicodeBiDi sets the unicode-bidi attribute.
+func (e *GElement) UnicodeBiDi(unicodeBiDi String) *GElement {
+	e.Attrs["unicode-bidi"] = unicodeBiDi
+	return e
+}
+
+// VectorEffect sets the vector-effect attribute.
+func (e *GElement) VectorEffect(vectorEffect String) *GElement {
+	e.Attrs["vector-effect"] = vectorEffect
+	return e
+}
+
+// Visibility sets the visibility attribute.
+func (e *GElement) Visibility(visibility String) *GElement {
+	e.Attrs["visibility"] = visibility
+	return e
+}
+
+// WhiteSpace sets the white-space attribute.
+func (e *GElement) WhiteSpace(whiteSpace String) *GElement {
+	e.Attrs["white-space"] = whiteSpace
+	return e
+}
+
+// WordSpacing sets the word-spacing attribute.
+func (e *GElement) WordSpacing(wordSpacing String) *GElement {
+	e.Attrs["word-spacing"] = wordSpacing
+	return e
+}
+
+// WritingMode sets the writing-mode attribute.
+func (e *GElement) WritingMode(writingMode String) *GElement {
+	e.Attrs["writing-mode"] = writingMode
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *GElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *GElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *GElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *GElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *GElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *GElement) TagName() string {
+	return "g"
+}
+
+// decode decodes e from d.
+func (e *GElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *GElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A ImageElement is a image element.
+type ImageElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// Image returns a new ImageElement.
+func Image(children ...Element) *ImageElement {
+	return &ImageElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *ImageElement) AppendChildren(children ...Element) *ImageElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *ImageElement) RemoveAttrs(names ...string) *ImageElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// ID sets the id attribute.
+func (e *ImageElement) ID(id String) *ImageElement {
+	e.Attrs["id"] = id
+	return e
+}
+
+// TabIndex sets the tabindex attribute.
+func (e *ImageElement) TabIndex(tabIndex Int) *ImageElement {
+	e.Attrs["tabindex"] = tabIndex
+	return e
+}
+
+// Lang sets the lang attribute.
+func (e *ImageElement) Lang(lang String) *ImageElement {
+	e.Attrs["lang"] = lang
+	return e
+}
+
+// Class sets the class attribute.
+func (e *ImageElement) Class(class String) *ImageElement {
+	e.Attrs["class"] = class
+	return e
+}
+
+// Style sets the style attribute.
+func (e *ImageElement) Style(style String) *ImageElement {
+	e.Attrs["style"] = style
+	return e
+}
+
+// AlignmentBaseline sets the alignment-baseline attribute.
+func (e *ImageElement) AlignmentBaseline(alignmentBaseline String) *ImageElement {
+	e.Attrs["alignment-baseline"] = alignmentBaseline
+	return e
+}
+
+// BaselineShift sets the baseline-shift attribute.
+func (e *ImageElement) BaselineShift(baselineShift String) *ImageElement {
+	e.Attrs["baseline-shift"] = baselineShift
+	return e
+}
+
+// ClipPath sets the clip-path attribute.
+func (e *ImageElement) ClipPath(clipPath String) *ImageElement {
+	e.Attrs["clip-path"] = clipPath
+	return e
+}
+
+// ClipRule sets the clip-rule attribute.
+func (e *ImageElement) ClipRule(clipRule String) *ImageElement {
+	e.Attrs["clip-rule"] = clipRule
+	return e
+}
+
+// Color sets the color attribute.
+func (e *ImageElement) Color(color Color) *ImageElement {
+	e.Attrs["color"] = color
+	return e
+}
+
+// ColorInterpolation sets the color-interpolation attribute.
+func (e *ImageElement) ColorInterpolation(colorInterpolation String) *ImageElement {
+	e.Attrs["color-interpolation"] = colorInterpolation
+	return e
+}
+
+// ColorInterpolationFilters sets the color-interpolation-filters attribute.
+func (e *ImageElement) ColorInterpolationFilters(colorInterpolationFilters String) *ImageElement {
+	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
+	return e
+}
+
+// ColorRendering sets the color-rendering attribute.
+func (e *ImageElement) ColorRendering(colorRendering String) *ImageElement {
+	e.Attrs["color-rendering"] = colorRendering
+	return e
+}
+
+// Cursor sets the cursor attribute.
+func (e *ImageElement) Cursor(cursor String) *ImageElement {
+	e.Attrs["cursor"] = cursor
+	return e
+}
+
+// Direction sets the direction attribute.
+func (e *ImageElement) Direction(direction String) *ImageElement {
+	e.Attrs["direction"] = direction
+	return e
+}
+
+// Display sets the display attribute.
+func (e *ImageElement) Display(display String) *ImageElement {
+	e.Attrs["display"] = display
+	return e
+}
+
+// DominantBaseline sets the dominant-baseline attribute.
+func (e *ImageElement) DominantBaseline(dominantBaseline String) *ImageElement {
+	e.Attrs["dominant-baseline"] = dominantBaseline
+	return e
+}
+
+// Fill sets the fill attribute.
+func (e *ImageElement) Fill(fill String) *ImageElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *ImageElement) FillColor(fill Color) *ImageElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *ImageElement) FillPaint(fill Paint) *ImageElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillOpacity sets the fill-opacity attribute.
+func (e *ImageElement) FillOpacity(fillOpacity Float64) *ImageElement {
+	e.Attrs["fill-opacity"] = fillOpacity
+	return e
+}
+
+// FillRule sets the fill-rule attribute.
+func (e *ImageElement) FillRule(fillRule String) *ImageElement {
+	e.Attrs["fill-rule"] = fillRule
+	return e
+}
+
+// Filter sets the filter attribute.
+func (e *ImageElement) Filter(filter String) *ImageElement {
+	e.Attrs["filter"] = filter
+	return e
+}
+
+// FloodColor sets the flood-color attribute.
+func (e *ImageElement) FloodColor(floodColor Color) *ImageElement {
+	e.Attrs["flood-color"] = floodColor
+	return e
+}
+
+// FloodOpacity sets the flood-opacity attribute.
+func (e *ImageElement) FloodOpacity(floodOpacity Float64) *ImageElement {
+	e.Attrs["flood-opacity"] = floodOpacity
+	return e
+}
+
+// FontFamily sets the font-family attribute.
+func (e *ImageElement) FontFamily(fontFamily String) *ImageElement {
+	e.Attrs["font-family"] = fontFamily
+	return e
+}
+
+// FontSize sets the font-size attribute.
+func (e *ImageElement) FontSize(fontSize String) *ImageElement {
+	e.Attrs["font-size"] = fontSize
+	return e
+}
+
+// FontSizeAdjust sets the font-size-adjust attribute.
+func (e *ImageElement) FontSizeAdjust(fontSizeAdjust String) *ImageElement {
+	e.Attrs["font-size-adjust"] = fontSizeAdjust
+	return e
+}
+
+// FontStretch sets the font-stretch attribute.
+func (e *ImageElement) FontStretch(fontStretch String) *ImageElement {
+	e.Attrs["font-stretch"] = fontStretch
+	return e
+}
+
+// FontStyle sets the font-style attribute.
+func (e *ImageElement) FontStyle(fontStyle String) *ImageElement {
+	e.Attrs["font-style"] = fontStyle
+	return e
+}
+
+// FontVariant sets the font-variant attribute.
+func (e *ImageElement) FontVariant(fontVariant String) *ImageElement {
+	e.Attrs["font-variant"] = fontVariant
+	return e
+}
+
+// FontWeight sets the font-weight attribute.
+func (e *ImageElement) FontWeight(fontWeight String) *ImageElement {
+	e.Attrs["font-weight"] = fontWeight
+	return e
+}
+
+// GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
+func (e *ImageElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *ImageElement {
+	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
+	return e
+}
+
+// GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
+func (e *ImageElement) GlyphOrientationVertical(glyphOrientationVertical String) *ImageElement {
+	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
+	return e
+}
+
+// ImageRendering sets the image-rendering attribute.
+func (e *ImageElement) ImageRendering(imageRendering String) *ImageElement {
+	e.Attrs["image-rendering"] = imageRendering
+	return e
+}
+
+// LetterSpacing sets the letter-spacing attribute.
+func (e *ImageElement) LetterSpacing(letterSpacing String) *ImageElement {
+	e.Attrs["letter-spacing"] = letterSpacing
+	return e
+}
+
+// LightingColor sets the lighting-color attribute.
+func (e *ImageElement) LightingColor(lightingColor Color) *ImageElement {
+	e.Attrs["lighting-color"] = lightingColor
+	return e
+}
+
+// MarkerEnd sets the marker-end attribute.
+func (e *ImageElement) MarkerEnd(markerEnd String) *ImageElement {
+	e.Attrs["marker-end"] = markerEnd
+	return e
+}
+
+// MarkerMid sets the marker-mid attribute.
+func (e *ImageElement) MarkerMid(markerMid String) *ImageElement {
+	e.Attrs["marker-mid"] = markerMid
+	return e
+}
+
+// MarkerStart sets the marker-start attribute.
+func (e *ImageElement) MarkerStart(markerStart String) *ImageElement {
+	e.Attrs["marker-start"] = markerStart
+	return e
+}
+
+// Mask sets the mask attribute.
+func (e *ImageElement) Mask(mask String) *ImageElement {
+	e.Attrs["mask"] = mask
+	return e
+}
+
+// Opacity sets the opacity attribute.
+func (e *ImageElement) Opacity(opacity Float64) *ImageElement {
+	e.Attrs["opacity"] = opacity
+	return e
+}
+
+// Overflow sets the overflow attribute.
+func (e *ImageElement) Overflow(overflow String) *ImageElement {
+	e.Attrs["overflow"] = overflow
+	return e
+}
+
+// PaintOrder sets the paint-order attribute.
+func (e *ImageElement) PaintOrder(paintOrder String) *ImageElement {
+	e.Attrs["paint-order"] = paintOrder
+	return e
+}
+
+// PointerEvents sets the pointer-events attribute.
+func (e *ImageElement) PointerEvents(pointerEvents String) *ImageElement {
+	e.Attrs["pointer-events"] = pointerEvents
+	return e
+}
+
+// ShapeRendering sets the shape-rendering attribute.
+func (e *ImageElement) ShapeRendering(shapeRendering String) *ImageElement {
+	e.Attrs["shape-rendering"] = shapeRendering
+	return e
+}
+
+// StopColor sets the stop-color attribute.
+func (e *ImageElement) StopColor(stopColor Color) *ImageElement {
+	e.Attrs["stop-color"] = stopColor
+	return e
+}
+
+// StopOpacity sets the stop-opacity attribute.
+func (e *ImageElement) StopOpacity(stopOpacity Float64) *ImageElement {
+	e.Attrs["stop-opacity"] = stopOpacity
+	return e
+}
+
+// Stroke sets the stroke attribute.
+func (e *ImageElement) Stroke(stroke String) *ImageElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *ImageElement) StrokeColor(stroke Color) *ImageElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *ImageElement) StrokePaint(stroke Paint) *ImageElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeDashArray sets the stroke-dasharray attribute.
+func (e *ImageElement) StrokeDashArray(strokeDashArray String) *ImageElement {
+	e.Attrs["stroke-dasharray"] = strokeDashArray
+	return e
+}
+
+// StrokeDashOffset sets the stroke-dashoffset attribute.
+func (e *ImageElement) StrokeDashOffset(strokeDashOffset Float64) *ImageElement {
+	e.Attrs["stroke-dashoffset"] = strokeDashOffset
 	return e
 }
 
 // StrokeLineCap sets the stroke-linecap attribute.
-func (e *ClipPathElement) StrokeLineCap(strokeLineCap String) *ClipPathElement {
+func (e *ImageElement) StrokeLineCap(strokeLineCap String) *ImageElement {
 	e.Attrs["stroke-linecap"] = strokeLineCap
 	return e
 }
 
 // StrokeLineJoin sets the stroke-linejoin attribute.
-func (e *ClipPathElement) StrokeLineJoin(strokeLineJoin String) *ClipPathElement {
+func (e *ImageElement) StrokeLineJoin(strokeLineJoin String) *ImageElement {
 	e.Attrs["stroke-linejoin"] = strokeLineJoin
 	return e
 }
 
 // StrokeMiterLimit sets the stroke-miterlimit attribute.
-func (e *ClipPathElement) StrokeMiterLimit(strokeMiterLimit Float64) *ClipPathElement {
+func (e *ImageElement) StrokeMiterLimit(strokeMiterLimit Float64) *ImageElement {
 	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
 	return e
 }
 
 // StrokeOpacity sets the stroke-opacity attribute.
-func (e *ClipPathElement) StrokeOpacity(strokeOpacity Float64) *ClipPathElement {
+func (e *ImageElement) StrokeOpacity(strokeOpacity Float64) *ImageElement {
 	e.Attrs["stroke-opacity"] = strokeOpacity
 	return e
 }
 
 // StrokeWidth sets the stroke-width attribute.
-func (e *ClipPathElement) StrokeWidth(strokeWidth Length) *ClipPathElement {
+func (e *ImageElement) StrokeWidth(strokeWidth Length) *ImageElement {
 	e.Attrs["stroke-width"] = strokeWidth
 	return e
 }
 
 // TextAnchor sets the text-anchor attribute.
-func (e *ClipPathElement) TextAnchor(textAnchor String) *ClipPathElement {
+func (e *ImageElement) TextAnchor(textAnchor String) *ImageElement {
 	e.Attrs["text-anchor"] = textAnchor
 	return e
 }
 
 // TextDecoration sets the text-decoration attribute.
-func (e *ClipPathElement) TextDecoration(textDecoration String) *ClipPathElement {
+func (e *ImageElement) TextDecoration(textDecoration String) *ImageElement {
 	e.Attrs["text-decoration"] = textDecoration
 	return e
 }
 
 // TextOverflow sets the text-overflow attribute.
-func (e *ClipPathElement) TextOverflow(textOverflow String) *ClipPathElement {
+func (e *ImageElement) TextOverflow(textOverflow String) *ImageElement {
 	e.Attrs["text-overflow"] = textOverflow
 	return e
 }
 
 // TextRendering sets the text-rendering attribute.
-func (e *ClipPathElement) TextRendering(textRendering String) *ClipPathElement {
+func (e *ImageElement) TextRendering(textRendering String) *ImageElement {
 	e.Attrs["text-rendering"] = textRendering
 	return e
 }
 
+// Transform sets the transform attribute.
+func (e *ImageElement) Transform(transform Transform) *ImageElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
 // UnicodeBiDi sets the unicode-bidi attribute.
-func (e *ClipPathElement) UnicodeBiDi(unicodeBiDi String) *ClipPathElement {
+func (e *ImageElement) UnicodeBiDi(unicodeBiDi String) *ImageElement {
 	e.Attrs["unicode-bidi"] = unicodeBiDi
 	return e
 }
 
 // VectorEffect sets the vector-effect attribute.
-func (e *ClipPathElement) VectorEffect(vectorEffect String) *ClipPathElement {
+func (e *ImageElement) VectorEffect(vectorEffect String) *ImageElement {
 	e.Attrs["vector-effect"] = vectorEffect
 	return e
 }
 
 // Visibility sets the visibility attribute.
-func (e *ClipPathElement) Visibility(visibility String) *ClipPathElement {
+func (e *ImageElement) Visibility(visibility String) *ImageElement {
 	e.Attrs["visibility"] = visibility
 	return e
 }
 
 // WhiteSpace sets the white-space attribute.
-func (e *ClipPathElement) WhiteSpace(whiteSpace String) *ClipPathElement {
+func (e *ImageElement) WhiteSpace(whiteSpace String) *ImageElement {
 	e.Attrs["white-space"] = whiteSpace
 	return e
 }
 
 // WordSpacing sets the word-spacing attribute.
-func (e *ClipPathElement) WordSpacing(wordSpacing String) *ClipPathElement {
+func (e *ImageElement) WordSpacing(wordSpacing String) *ImageElement {
 	e.Attrs["word-spacing"] = wordSpacing
 	return e
 }
 
 // WritingMode sets the writing-mode attribute.
-func (e *ClipPathElement) WritingMode(writingMode String) *ClipPathElement {
+func (e *ImageElement) WritingMode(writingMode String) *ImageElement {
 	e.Attrs["writing-mode"] = writingMode
 	return e
 }
 
-// ExternalResourcesRequired sets the externalResourcesRequired attribute.
-func (e *ClipPathElement) ExternalResourcesRequired(externalResourcesRequired String) *ClipPathElement {
-	e.Attrs["externalResourcesRequired"] = externalResourcesRequired
+// PreserveAspectRatio sets the preserveAspectRatio attribute.
+func (e *ImageElement) PreserveAspectRatio(preserveAspectRatio String) *ImageElement {
+	e.Attrs["preserveAspectRatio"] = preserveAspectRatio
 	return e
 }
 
-// Transform sets the transform attribute.
-func (e *ClipPathElement) Transform(transform String) *ClipPathElement {
-	e.Attrs["transform"] = transform
+// Href sets the href attribute.
+func (e *ImageElement) Href(href String) *ImageElement {
+	e.Attrs["href"] = href
 	return e
 }
 
-// ClipPathUnits sets the clipPathUnits attribute.
-func (e *ClipPathElement) ClipPathUnits(clipPathUnits String) *ClipPathElement {
-	e.Attrs["clipPathUnits"] = clipPathUnits
+// CrossOrigin sets the crossorigin attribute.
+func (e *ImageElement) CrossOrigin(crossOrigin String) *ImageElement {
+	e.Attrs["crossorigin"] = crossOrigin
 	return e
 }
 
-// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
-func (e *ClipPathElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
-	return encodeElement(encoder, "clipPath", e.Attrs, e.Children)
+// X sets the x attribute.
+func (e *ImageElement) X(x Length) *ImageElement {
+	e.Attrs["x"] = x
+	return e
 }
 
-// A DefsElement is a defs element.
-type DefsElement struct {
-	Attrs    map[string]AttrValue
-	Children []Element
+// Y sets the y attribute.
+func (e *ImageElement) Y(y Length) *ImageElement {
+	e.Attrs["y"] = y
+	return e
 }
 
-// Defs returns a new DefsElement.
-func Defs(children ...Element) *DefsElement {
-	return &DefsElement{
-		Attrs:    map[string]AttrValue{},
-		Children: children,
-	}
+// Width sets the width attribute.
+func (e *ImageElement) Width(width Length) *ImageElement {
+	e.Attrs["width"] = width
+	return e
 }
 
-// AppendChildren appends the given children.
-func (e *DefsElement) AppendChildren(children ...Element) *DefsElement {
-	e.Children = append(e.Children, children...)
+// Height sets the height attribute.
+func (e *ImageElement) Height(height Length) *ImageElement {
+	e.Attrs["height"] = height
+	return e
+}
+
+// WidthHeight sets the width and height attributes.
+func (e *ImageElement) WidthHeight(width, height float64, lengthFunc LengthFunc) *ImageElement {
+	e.Attrs["width"] = lengthFunc(width)
+	e.Attrs["height"] = lengthFunc(height)
+	return e
+}
+
+// XY sets the x and y attributes.
+func (e *ImageElement) XY(x, y float64, lengthFunc LengthFunc) *ImageElement {
+	e.Attrs["x"] = lengthFunc(x)
+	e.Attrs["y"] = lengthFunc(y)
+	return e
+}
+
+// XYWidthHeight sets the x, y, width, and height attributes.
+func (e *ImageElement) XYWidthHeight(x, y, width, height float64, lengthFunc LengthFunc) *ImageElement {
+	e.Attrs["x"] = lengthFunc(x)
+	e.Attrs["y"] = lengthFunc(y)
+	e.Attrs["width"] = lengthFunc(width)
+	e.Attrs["height"] = lengthFunc(height)
 	return e
 }
 
 // MarshallXML implements encoding/xml.Marshaller.MarshalXML.
-func (e *DefsElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
-	return encodeElement(encoder, "defs", e.Attrs, e.Children)
+func (e *ImageElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
 }
 
-// A DescElement is a desc element.
-type DescElement struct {
-	Attrs    map[string]AttrValue
-	Children []Element
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *ImageElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
 }
 
-// Desc returns a new DescElement.
-func Desc(children ...Element) *DescElement {
-	return &DescElement{
-		Attrs:    map[string]AttrValue{},
-		Children: children,
-	}
+// Attributes implements Node.Attributes.
+func (e *ImageElement) Attributes() map[string]AttrValue {
+	return e.Attrs
 }
 
-// AppendChildren appends the given children.
-func (e *DescElement) AppendChildren(children ...Element) *DescElement {
-	e.Children = append(e.Children, children...)
-	return e
+// ChildElements implements Node.ChildElements.
+func (e *ImageElement) ChildElements() []Element {
+	return e.Children
 }
 
-// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
-func (e *DescElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
-	return encodeElement(encoder, "desc", e.Attrs, e.Children)
+// SetChildElements implements Container.SetChildElements.
+func (e *ImageElement) SetChildElements(children []Element) {
+	e.Children = children
 }
 
-// An EllipseElement is an ellipse element.
-type EllipseElement struct {
+// TagName implements Node.TagName.
+func (e *ImageElement) TagName() string {
+	return "image"
+}
+
+// decode decodes e from d.
+func (e *ImageElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *ImageElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "x":
+		return parseLength(value)
+	case "y":
+		return parseLength(value)
+	case "width":
+		return parseLength(value)
+	case "height":
+		return parseLength(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A LineElement is a line element.
+type LineElement struct {
 	Attrs    map[string]AttrValue
 	Children []Element
 }
 
-// Ellipse returns a new EllipseElement.
-func Ellipse(children ...Element) *EllipseElement {
-	return &EllipseElement{
+// Line returns a new LineElement.
+func Line(children ...Element) *LineElement {
+	return &LineElement{
 		Attrs:    map[string]AttrValue{},
 		Children: children,
 	}
 }
 
 // AppendChildren appends the given children.
-func (e *EllipseElement) AppendChildren(children ...Element) *EllipseElement {
+func (e *LineElement) AppendChildren(children ...Element) *LineElement {
 	e.Children = append(e.Children, children...)
 	return e
 }
 
+// RemoveAttrs removes the attributes with the given names.
+func (e *LineElement) RemoveAttrs(names ...string) *LineElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
 // ID sets the id attribute.
-func (e *EllipseElement) ID(id String) *EllipseElement {
+func (e *LineElement) ID(id String) *LineElement {
 	e.Attrs["id"] = id
 	return e
 }
 
 // TabIndex sets the tabindex attribute.
-func (e *EllipseElement) TabIndex(tabIndex Int) *EllipseElement {
+func (e *LineElement) TabIndex(tabIndex Int) *LineElement {
 	e.Attrs["tabindex"] = tabIndex
 	return e
 }
 
 // Lang sets the lang attribute.
-func (e *EllipseElement) Lang(lang String) *EllipseElement {
+func (e *LineElement) Lang(lang String) *LineElement {
 	e.Attrs["lang"] = lang
 	return e
 }
 
 // Class sets the class attribute.
-func (e *EllipseElement) Class(class String) *EllipseElement {
+func (e *LineElement) Class(class String) *LineElement {
 	e.Attrs["class"] = class
 	return e
 }
 
 // Style sets the style attribute.
-func (e *EllipseElement) Style(style String) *EllipseElement {
+func (e *LineElement) Style(style String) *LineElement {
 	e.Attrs["style"] = style
 	return e
 }
 
 // AlignmentBaseline sets the alignment-baseline attribute.
-func (e *EllipseElement) AlignmentBaseline(alignmentBaseline String) *EllipseElement {
+func (e *LineElement) AlignmentBaseline(alignmentBaseline String) *LineElement {
 	e.Attrs["alignment-baseline"] = alignmentBaseline
 	return e
 }
 
 // BaselineShift sets the baseline-shift attribute.
-func (e *EllipseElement) BaselineShift(baselineShift String) *EllipseElement {
+func (e *LineElement) BaselineShift(baselineShift String) *LineElement {
 	e.Attrs["baseline-shift"] = baselineShift
 	return e
 }
 
 // ClipPath sets the clip-path attribute.
-func (e *EllipseElement) ClipPath(clipPath String) *EllipseElement {
+func (e *LineElement) ClipPath(clipPath String) *LineElement {
 	e.Attrs["clip-path"] = clipPath
 	return e
 }
 
 // ClipRule sets the clip-rule attribute.
-func (e *EllipseElement) ClipRule(clipRule String) *EllipseElement {
+func (e *LineElement) ClipRule(clipRule String) *LineElement {
 	e.Attrs["clip-rule"] = clipRule
 	return e
 }
 
 // Color sets the color attribute.
-func (e *EllipseElement) Color(color String) *EllipseElement {
+func (e *LineElement) Color(color Color) *LineElement {
 	e.Attrs["color"] = color
 	return e
 }
 
 // ColorInterpolation sets the color-interpolation attribute.
-func (e *EllipseElement) ColorInterpolation(colorInterpolation String) *EllipseElement {
+func (e *LineElement) ColorInterpolation(colorInterpolation String) *LineElement {
 	e.Attrs["color-interpolation"] = colorInterpolation
 	return e
 }
 
 // ColorInterpolationFilters sets the color-interpolation-filters attribute.
-func (e *EllipseElement) ColorInterpolationFilters(colorInterpolationFilters String) *EllipseElement {
+func (e *LineElement) ColorInterpolationFilters(colorInterpolationFilters String) *LineElement {
 	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
 	return e
 }
 
 // ColorRendering sets the color-rendering attribute.
-func (e *EllipseElement) ColorRendering(colorRendering String) *EllipseElement {
+func (e *LineElement) ColorRendering(colorRendering String) *LineElement {
 	e.Attrs["color-rendering"] = colorRendering
 	return e
 }
 
 // Cursor sets the cursor attribute.
-func (e *EllipseElement) Cursor(cursor String) *EllipseElement {
+func (e *LineElement) Cursor(cursor String) *LineElement {
 	e.Attrs["cursor"] = cursor
 	return e
 }
 
 // Direction sets the direction attribute.
-func (e *EllipseElement) Direction(direction String) *EllipseElement {
+func (e *LineElement) Direction(direction String) *LineElement {
 	e.Attrs["direction"] = direction
 	return e
 }
 
 // Display sets the display attribute.
-func (e *EllipseElement) Display(display String) *EllipseElement {
+func (e *LineElement) Display(display String) *LineElement {
 	e.Attrs["display"] = display
 	return e
 }
 
 // DominantBaseline sets the dominant-baseline attribute.
-func (e *EllipseElement) DominantBaseline(dominantBaseline String) *EllipseElement {
+func (e *LineElement) DominantBaseline(dominantBaseline String) *LineElement {
 	e.Attrs["dominant-baseline"] = dominantBaseline
 	return e
 }
 
 // Fill sets the fill attribute.
-func (e *EllipseElement) Fill(fill String) *EllipseElement {
+func (e *LineElement) Fill(fill String) *LineElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *LineElement) FillColor(fill Color) *LineElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *LineElement) FillPaint(fill Paint) *LineElement {
 	e.Attrs["fill"] = fill
 	return e
 }
 
 // FillOpacity sets the fill-opacity attribute.
-func (e *EllipseElement) FillOpacity(fillOpacity Float64) *EllipseElement {
+func (e *LineElement) FillOpacity(fillOpacity Float64) *LineElement {
 	e.Attrs["fill-opacity"] = fillOpacity
 	return e
 }
 
 // FillRule sets the fill-rule attribute.
-func (e *EllipseElement) FillRule(fillRule String) *EllipseElement {
+func (e *LineElement) FillRule(fillRule String) *LineElement {
 	e.Attrs["fill-rule"] = fillRule
 	return e
 }
 
 // Filter sets the filter attribute.
-func (e *EllipseElement) Filter(filter String) *EllipseElement {
+func (e *LineElement) Filter(filter String) *LineElement {
 	e.Attrs["filter"] = filter
 	return e
 }
 
 // FloodColor sets the flood-color attribute.
-func (e *EllipseElement) FloodColor(floodColor String) *EllipseElement {
+func (e *LineElement) FloodColor(floodColor Color) *LineElement {
 	e.Attrs["flood-color"] = floodColor
 	return e
 }
 
 // FloodOpacity sets the flood-opacity attribute.
-func (e *EllipseElement) FloodOpacity(floodOpacity Float64) *EllipseElement {
+func (e *LineElement) FloodOpacity(floodOpacity Float64) *LineElement {
 	e.Attrs["flood-opacity"] = floodOpacity
 	return e
 }
 
 // FontFamily sets the font-family attribute.
-func (e *EllipseElement) FontFamily(fontFamily String) *EllipseElement {
+func (e *LineElement) FontFamily(fontFamily String) *LineElement {
 	e.Attrs["font-family"] = fontFamily
 	return e
 }
 
 // FontSize sets the font-size attribute.
-func (e *EllipseElement) FontSize(fontSize String) *EllipseElement {
+func (e *LineElement) FontSize(fontSize String) *LineElement {
 	e.Attrs["font-size"] = fontSize
 	return e
 }
 
 // FontSizeAdjust sets the font-size-adjust attribute.
-func (e *EllipseElement) FontSizeAdjust(fontSizeAdjust String) *EllipseElement {
+func (e *LineElement) FontSizeAdjust(fontSizeAdjust String) *LineElement {
 	e.Attrs["font-size-adjust"] = fontSizeAdjust
 	return e
 }
 
 // FontStretch sets the font-stretch attribute.
-func (e *EllipseElement) FontStretch(fontStretch String) *EllipseElement {
+func (e *LineElement) FontStretch(fontStretch String) *LineElement {
 	e.Attrs["font-stretch"] = fontStretch
 	return e
 }
 
 // FontStyle sets the font-style attribute.
-func (e *EllipseElement) FontStyle(fontStyle String) *EllipseElement {
+func (e *LineElement) FontStyle(fontStyle String) *LineElement {
 	e.Attrs["font-style"] = fontStyle
 	return e
 }
 
 // FontVariant sets the font-variant attribute.
-func (e *EllipseElement) FontVariant(fontVariant String) *EllipseElement {
+func (e *LineElement) FontVariant(fontVariant String) *LineElement {
 	e.Attrs["font-variant"] = fontVariant
 	return e
 }
 
 // FontWeight sets the font-weight attribute.
-func (e *EllipseElement) FontWeight(fontWeight String) *EllipseElement {
+func (e *LineElement) FontWeight(fontWeight String) *LineElement {
 	e.Attrs["font-weight"] = fontWeight
 	return e
 }
 
 // GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
-func (e *EllipseElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *EllipseElement {
+func (e *LineElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *LineElement {
 	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
 	return e
 }
 
 // GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
-func (e *EllipseElement) GlyphOrientationVertical(glyphOrientationVertical String) *EllipseElement {
+func (e *LineElement) GlyphOrientationVertical(glyphOrientationVertical String) *LineElement {
 	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
 	return e
 }
 
 // ImageRendering sets the image-rendering attribute.
-func (e *EllipseElement) ImageRendering(imageRendering String) *EllipseElement {
+func (e *LineElement) ImageRendering(imageRendering String) *LineElement {
 	e.Attrs["image-rendering"] = imageRendering
 	return e
 }
 
 // LetterSpacing sets the letter-spacing attribute.
-func (e *EllipseElement) LetterSpacing(letterSpacing String) *EllipseElement {
+func (e *LineElement) LetterSpacing(letterSpacing String) *LineElement {
 	e.Attrs["letter-spacing"] = letterSpacing
 	return e
 }
 
 // LightingColor sets the lighting-color attribute.
-func (e *EllipseElement) LightingColor(lightingColor String) *EllipseElement {
+func (e *LineElement) LightingColor(lightingColor Color) *LineElement {
 	e.Attrs["lighting-color"] = lightingColor
 	return e
 }
 
 // MarkerEnd sets the marker-end attribute.
-func (e *EllipseElement) MarkerEnd(markerEnd String) *EllipseElement {
+func (e *LineElement) MarkerEnd(markerEnd String) *LineElement {
 	e.Attrs["marker-end"] = markerEnd
 	return e
 }
 
 // MarkerMid sets the marker-mid attribute.
-func (e *EllipseElement) MarkerMid(markerMid String) *EllipseElement {
+func (e *LineElement) MarkerMid(markerMid String) *LineElement {
 	e.Attrs["marker-mid"] = markerMid
 	return e
 }
 
 // MarkerStart sets the marker-start attribute.
-func (e *EllipseElement) MarkerStart(markerStart String) *EllipseElement {
+func (e *LineElement) MarkerStart(markerStart String) *LineElement {
 	e.Attrs["marker-start"] = markerStart
 	return e
 }
 
 // Mask sets the mask attribute.
-func (e *EllipseElement) Mask(mask String) *EllipseElement {
+func (e *LineElement) Mask(mask String) *LineElement {
 	e.Attrs["mask"] = mask
 	return e
 }
 
 // Opacity sets the opacity attribute.
-func (e *EllipseElement) Opacity(opacity Float64) *EllipseElement {
+func (e *LineElement) Opacity(opacity Float64) *LineElement {
 	e.Attrs["opacity"] = opacity
 	return e
 }
 
 // Overflow sets the overflow attribute.
-func (e *EllipseElement) Overflow(overflow String) *EllipseElement {
+func (e *LineElement) Overflow(overflow String) *LineElement {
 	e.Attrs["overflow"] = overflow
 	return e
 }
 
 // PaintOrder sets the paint-order attribute.
-func (e *EllipseElement) PaintOrder(paintOrder String) *EllipseElement {
+func (e *LineElement) PaintOrder(paintOrder String) *LineElement {
 	e.Attrs["paint-order"] = paintOrder
 	return e
 }
 
 // PointerEvents sets the pointer-events attribute.
-func (e *EllipseElement) PointerEvents(pointerEvents String) *EllipseElement {
+func (e *LineElement) PointerEvents(pointerEvents String) *LineElement {
 	e.Attrs["pointer-events"] = pointerEvents
 	return e
 }
 
 // ShapeRendering sets the shape-rendering attribute.
-func (e *EllipseElement) ShapeRendering(shapeRendering String) *EllipseElement {
+func (e *LineElement) ShapeRendering(shapeRendering String) *LineElement {
 	e.Attrs["shape-rendering"] = shapeRendering
 	return e
 }
 
 // StopColor sets the stop-color attribute.
-func (e *EllipseElement) StopColor(stopColor String) *EllipseElement {
+func (e *LineElement) StopColor(stopColor Color) *LineElement {
 	e.Attrs["stop-color"] = stopColor
 	return e
 }
 
 // StopOpacity sets the stop-opacity attribute.
-func (e *EllipseElement) StopOpacity(stopOpacity Float64) *EllipseElement {
+func (e *LineElement) StopOpacity(stopOpacity Float64) *LineElement {
 	e.Attrs["stop-opacity"] = stopOpacity
 	return e
 }
 
 // Stroke sets the stroke attribute.
-func (e *EllipseElement) Stroke(stroke String) *EllipseElement {
+func (e *LineElement) Stroke(stroke String) *LineElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *LineElement) StrokeColor(stroke Color) *LineElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *LineElement) StrokePaint(stroke Paint) *LineElement {
 	e.Attrs["stroke"] = stroke
 	return e
 }
 
 // StrokeDashArray sets the stroke-dasharray attribute.
-func (e *EllipseElement) StrokeDashArray(strokeDashArray String) *EllipseElement {
+func (e *LineElement) StrokeDashArray(strokeDashArray String) *LineElement {
 	e.Attrs["stroke-dasharray"] = strokeDashArray
 	return e
 }
 
 // StrokeDashOffset sets the stroke-dashoffset attribute.
-func (e *EllipseElement) StrokeDashOffset(strokeDashOffset Float64) *EllipseElement {
+func (e *LineElement) StrokeDashOffset(strokeDashOffset Float64) *LineElement {
 	e.Attrs["stroke-dashoffset"] = strokeDashOffset
 	return e
 }
 
 // StrokeLineCap sets the stroke-linecap attribute.
-func (e *EllipseElement) StrokeLineCap(strokeLineCap String) *EllipseElement {
+func (e *LineElement) StrokeLineCap(strokeLineCap String) *LineElement {
 	e.Attrs["stroke-linecap"] = strokeLineCap
 	return e
 }
 
 // StrokeLineJoin sets the stroke-linejoin attribute.
-func (e *EllipseElement) StrokeLineJoin(strokeLineJoin String) *EllipseElement {
+func (e *LineElement) StrokeLineJoin(strokeLineJoin String) *LineElement {
 	e.Attrs["stroke-linejoin"] = strokeLineJoin
 	return e
 }
 
 // StrokeMiterLimit sets the stroke-miterlimit attribute.
-func (e *EllipseElement) StrokeMiterLimit(strokeMiterLimit Float64) *EllipseElement {
+func (e *LineElement) StrokeMiterLimit(strokeMiterLimit Float64) *LineElement {
 	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
 	return e
 }
 
 // StrokeOpacity sets the stroke-opacity attribute.
-func (e *EllipseElement) StrokeOpacity(strokeOpacity Float64) *EllipseElement {
+func (e *LineElement) StrokeOpacity(strokeOpacity Float64) *LineElement {
 	e.Attrs["stroke-opacity"] = strokeOpacity
 	return e
 }
 
 // StrokeWidth sets the stroke-width attribute.
-func (e *EllipseElement) StrokeWidth(strokeWidth Length) *EllipseElement {
+func (e *LineElement) StrokeWidth(strokeWidth Length) *LineElement {
 	e.Attrs["stroke-width"] = strokeWidth
 	return e
 }
 
 // TextAnchor sets the text-anchor attribute.
-func (e *EllipseElement) TextAnchor(textAnchor String) *EllipseElement {
+func (e *LineElement) TextAnchor(textAnchor String) *LineElement {
 	e.Attrs["text-anchor"] = textAnchor
 	return e
 }
 
 // TextDecoration sets the text-decoration attribute.
-func (e *EllipseElement) TextDecoration(textDecoration String) *EllipseElement {
+func (e *LineElement) TextDecoration(textDecoration String) *LineElement {
 	e.Attrs["text-decoration"] = textDecoration
 	return e
 }
 
 // TextOverflow sets the text-overflow attribute.
-func (e *EllipseElement) TextOverflow(textOverflow String) *EllipseElement {
+func (e *LineElement) TextOverflow(textOverflow String) *LineElement {
 	e.Attrs["text-overflow"] = textOverflow
 	return e
 }
 
 // TextRendering sets the text-rendering attribute.
-func (e *EllipseElement) TextRendering(textRendering String) *EllipseElement {
+func (e *LineElement) TextRendering(textRendering String) *LineElement {
 	e.Attrs["text-rendering"] = textRendering
 	return e
 }
 
+// Transform sets the transform attribute.
+func (e *LineElement) Transform(transform Transform) *LineElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
 // UnicodeBiDi sets the unicode-bidi attribute.
-func (e *EllipseElement) UnicodeBiDi(unicodeBiDi String) *EllipseElement {
+func (e *LineElement) UnicodeBiDi(unicodeBiDi String) *LineElement {
 	e.Attrs["unicode-bidi"] = unicodeBiDi
 	return e
 }
 
 // VectorEffect sets the vector-effect attribute.
-func (e *EllipseElement) VectorEffect(vectorEffect String) *EllipseElement {
+func (e *LineElement) VectorEffect(vectorEffect String) *LineElement {
 	e.Attrs["vector-effect"] = vectorEffect
 	return e
 }
 
 // Visibility sets the visibility attribute.
-func (e *EllipseElement) Visibility(visibility String) *EllipseElement {
+func (e *LineElement) Visibility(visibility String) *LineElement {
 	e.Attrs["visibility"] = visibility
 	return e
 }
 
 // WhiteSpace sets the white-space attribute.
-func (e *EllipseElement) WhiteSpace(whiteSpace String) *EllipseElement {
+func (e *LineElement) WhiteSpace(whiteSpace String) *LineElement {
 	e.Attrs["white-space"] = whiteSpace
 	return e
 }
 
 // WordSpacing sets the word-spacing attribute.
-func (e *EllipseElement) WordSpacing(wordSpacing String) *EllipseElement {
+func (e *LineElement) WordSpacing(wordSpacing String) *LineElement {
 	e.Attrs["word-spacing"] = wordSpacing
 	return e
 }
 
 // WritingMode sets the writing-mode attribute.
-func (e *EllipseElement) WritingMode(writingMode String) *EllipseElement {
+func (e *LineElement) WritingMode(writingMode String) *LineElement {
 	e.Attrs["writing-mode"] = writingMode
 	return e
 }
 
 // PathLength sets the pathLength attribute.
-func (e *EllipseElement) PathLength(pathLength String) *EllipseElement {
+func (e *LineElement) PathLength(pathLength String) *LineElement {
 	e.Attrs["pathLength"] = pathLength
 	return e
 }
 
-// CX sets the cx attribute.
-func (e *EllipseElement) CX(cx Length) *EllipseElement {
-	e.Attrs["cx"] = cx
+// X1 sets the x1 attribute.
+func (e *LineElement) X1(x1 String) *LineElement {
+	e.Attrs["x1"] = x1
 	return e
 }
 
-// CY sets the cy attribute.
-func (e *EllipseElement) CY(cy Length) *EllipseElement {
-	e.Attrs["cy"] = cy
+// Y1 sets the y1 attribute.
+func (e *LineElement) Y1(y1 String) *LineElement {
+	e.Attrs["y1"] = y1
 	return e
 }
 
-// RX sets the rx attribute.
-func (e *EllipseElement) RX(rx Length) *EllipseElement {
-	e.Attrs["rx"] = rx
+// X2 sets the x2 attribute.
+func (e *LineElement) X2(x2 String) *LineElement {
+	e.Attrs["x2"] = x2
 	return e
 }
 
-// RY sets the ry attribute.
-func (e *EllipseElement) RY(ry Length) *EllipseElement {
-	e.Attrs["ry"] = ry
+// Y2 sets the y2 attribute.
+func (e *LineElement) Y2(y2 String) *LineElement {
+	e.Attrs["y2"] = y2
 	return e
 }
 
-// CXCY sets the cx and cy attributes.
-func (e *EllipseElement) CXCY(cx, cy float64, lengthFunc LengthFunc) *EllipseElement {
-	e.Attrs["cx"] = lengthFunc(cx)
-	e.Attrs["cy"] = lengthFunc(cy)
+// X1Y1X2Y2 sets the x1, y1, x2, and y2 attributes.
+func (e *LineElement) X1Y1X2Y2(x1, y1, x2, y2 float64) *LineElement {
+	e.Attrs["x1"] = Float64(x1)
+	e.Attrs["y1"] = Float64(y1)
+	e.Attrs["x2"] = Float64(x2)
+	e.Attrs["y2"] = Float64(y2)
 	return e
 }
 
-// RXRY sets the rx and ry attributes.
-func (e *EllipseElement) RXRY(rx, ry float64, lengthFunc LengthFunc) *EllipseElement {
-	e.Attrs["rx"] = lengthFunc(rx)
-	e.Attrs["ry"] = lengthFunc(ry)
-	return e
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *LineElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
 }
 
-// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
-func (e *EllipseElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
-	return encodeElement(encoder, "ellipse", e.Attrs, e.Children)
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *LineElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
 }
 
-// A ForeignObjectElement is a foreignObject element.
-type ForeignObjectElement struct {
+// Attributes implements Node.Attributes.
+func (e *LineElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *LineElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *LineElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *LineElement) TagName() string {
+	return "line"
+}
+
+// decode decodes e from d.
+func (e *LineElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *LineElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A LinearGradientElement is a linearGradient element.
+type LinearGradientElement struct {
 	Attrs    map[string]AttrValue
 	Children []Element
 }
 
-// ForeignObject returns a new ForeignObjectElement.
-func ForeignObject(children ...Element) *ForeignObjectElement {
-	return &ForeignObjectElement{
+// LinearGradient returns a new LinearGradientElement.
+func LinearGradient(children ...Element) *LinearGradientElement {
+	return &LinearGradientElement{
 		Attrs:    map[string]AttrValue{},
 		Children: children,
 	}
 }
 
 // AppendChildren appends the given children.
-func (e *ForeignObjectElement) AppendChildren(children ...Element) *ForeignObjectElement {
+func (e *LinearGradientElement) AppendChildren(children ...Element) *LinearGradientElement {
 	e.Children = append(e.Children, children...)
 	return e
 }
 
+// RemoveAttrs removes the attributes with the given names.
+func (e *LinearGradientElement) RemoveAttrs(names ...string) *LinearGradientElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
 // ID sets the id attribute.
-func (e *ForeignObjectElement) ID(id String) *ForeignObjectElement {
+func (e *LinearGradientElement) ID(id String) *LinearGradientElement {
 	e.Attrs["id"] = id
 	return e
 }
 
 // TabIndex sets the tabindex attribute.
-func (e *ForeignObjectElement) TabIndex(tabIndex Int) *ForeignObjectElement {
+func (e *LinearGradientElement) TabIndex(tabIndex Int) *LinearGradientElement {
 	e.Attrs["tabindex"] = tabIndex
 	return e
 }
 
 // Lang sets the lang attribute.
-func (e *ForeignObjectElement) Lang(lang String) *ForeignObjectElement {
+func (e *LinearGradientElement) Lang(lang String) *LinearGradientElement {
 	e.Attrs["lang"] = lang
 	return e
 }
 
 // Class sets the class attribute.
-func (e *ForeignObjectElement) Class(class String) *ForeignObjectElement {
+func (e *LinearGradientElement) Class(class String) *LinearGradientElement {
 	e.Attrs["class"] = class
 	return e
 }
 
 // Style sets the style attribute.
-func (e *ForeignObjectElement) Style(style String) *ForeignObjectElement {
+func (e *LinearGradientElement) Style(style String) *LinearGradientElement {
 	e.Attrs["style"] = style
 	return e
 }
 
 // AlignmentBaseline sets the alignment-baseline attribute.
-func (e *ForeignObjectElement) AlignmentBaseline(alignmentBaseline String) *ForeignObjectElement {
+func (e *LinearGradientElement) AlignmentBaseline(alignmentBaseline String) *LinearGradientElement {
 	e.Attrs["alignment-baseline"] = alignmentBaseline
 	return e
 }
 
 // BaselineShift sets the baseline-shift attribute.
-func (e *ForeignObjectElement) BaselineShift(baselineShift String) *ForeignObjectElement {
+func (e *LinearGradientElement) BaselineShift(baselineShift String) *LinearGradientElement {
 	e.Attrs["baseline-shift"] = baselineShift
 	return e
 }
 
 // ClipPath sets the clip-path attribute.
-func (e *ForeignObjectElement) ClipPath(clipPath String) *ForeignObjectElement {
+func (e *LinearGradientElement) ClipPath(clipPath String) *LinearGradientElement {
 	e.Attrs["clip-path"] = clipPath
 	return e
 }
 
 // ClipRule sets the clip-rule attribute.
-func (e *ForeignObjectElement) ClipRule(clipRule String) *ForeignObjectElement {
+func (e *LinearGradientElement) ClipRule(clipRule String) *LinearGradientElement {
 	e.Attrs["clip-rule"] = clipRule
 	return e
 }
 
 // Color sets the color attribute.
-func (e *ForeignObjectElement) Color(color String) *ForeignObjectElement {
+func (e *LinearGradientElement) Color(color Color) *LinearGradientElement {
 	e.Attrs["color"] = color
 	return e
 }
 
 // ColorInterpolation sets the color-interpolation attribute.
-func (e *ForeignObjectElement) ColorInterpolation(colorInterpolation String) *ForeignObjectElement {
+func (e *LinearGradientElement) ColorInterpolation(colorInterpolation String) *LinearGradientElement {
 	e.Attrs["color-interpolation"] = colorInterpolation
 	return e
 }
 
 // ColorInterpolationFilters sets the color-interpolation-filters attribute.
-func (e *ForeignObjectElement) ColorInterpolationFilters(colorInterpolationFilters String) *ForeignObjectElement {
+func (e *LinearGradientElement) ColorInterpolationFilters(colorInterpolationFilters String) *LinearGradientElement {
 	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
 	return e
 }
 
 // ColorRendering sets the color-rendering attribute.
-func (e *ForeignObjectElement) ColorRendering(colorRendering String) *ForeignObjectElement {
+func (e *LinearGradientElement) ColorRendering(colorRendering String) *LinearGradientElement {
 	e.Attrs["color-rendering"] = colorRendering
 	return e
 }
 
 // Cursor sets the cursor attribute.
-func (e *ForeignObjectElement) Cursor(cursor String) *ForeignObjectElement {
+func (e *LinearGradientElement) Cursor(cursor String) *LinearGradientElement {
 	e.Attrs["cursor"] = cursor
 	return e
 }
 
 // Direction sets the direction attribute.
-func (e *ForeignObjectElement) Direction(direction String) *ForeignObjectElement {
+func (e *LinearGradientElement) Direction(direction String) *LinearGradientElement {
 	e.Attrs["direction"] = direction
 	return e
 }
 
 // Display sets the display attribute.
-func (e *ForeignObjectElement) Display(display String) *ForeignObjectElement {
+func (e *LinearGradientElement) Display(display String) *LinearGradientElement {
 	e.Attrs["display"] = display
 	return e
 }
 
 // DominantBaseline sets the dominant-baseline attribute.
-func (e *ForeignObjectElement) DominantBaseline(dominantBaseline String) *ForeignObjectElement {
+func (e *LinearGradientElement) DominantBaseline(dominantBaseline String) *LinearGradientElement {
 	e.Attrs["dominant-baseline"] = dominantBaseline
 	return e
 }
 
 // Fill sets the fill attribute.
-func (e *ForeignObjectElement) Fill(fill String) *ForeignObjectElement {
+func (e *LinearGradientElement) Fill(fill String) *LinearGradientElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *LinearGradientElement) FillColor(fill Color) *LinearGradientElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *LinearGradientElement) FillPaint(fill Paint) *LinearGradientElement {
 	e.Attrs["fill"] = fill
 	return e
 }
 
 // FillOpacity sets the fill-opacity attribute.
-func (e *ForeignObjectElement) FillOpacity(fillOpacity Float64) *ForeignObjectElement {
+func (e *LinearGradientElement) FillOpacity(fillOpacity Float64) *LinearGradientElement {
 	e.Attrs["fill-opacity"] = fillOpacity
 	return e
 }
 
 // FillRule sets the fill-rule attribute.
-func (e *ForeignObjectElement) FillRule(fillRule String) *ForeignObjectElement {
+func (e *LinearGradientElement) FillRule(fillRule String) *LinearGradientElement {
 	e.Attrs["fill-rule"] = fillRule
 	return e
 }
 
 // Filter sets the filter attribute.
-func (e *ForeignObjectElement) Filter(filter String) *ForeignObjectElement {
+func (e *LinearGradientElement) Filter(filter String) *LinearGradientElement {
 	e.Attrs["filter"] = filter
 	return e
 }
 
 // FloodColor sets the flood-color attribute.
-func (e *ForeignObjectElement) FloodColor(floodColor String) *ForeignObjectElement {
+func (e *LinearGradientElement) FloodColor(floodColor Color) *LinearGradientElement {
 	e.Attrs["flood-color"] = floodColor
 	return e
 }
 
 // FloodOpacity sets the flood-opacity attribute.
-func (e *ForeignObjectElement) FloodOpacity(floodOpacity Float64) *ForeignObjectElement {
+func (e *LinearGradientElement) FloodOpacity(floodOpacity Float64) *LinearGradientElement {
 	e.Attrs["flood-opacity"] = floodOpacity
 	return e
 }
 
 // FontFamily sets the font-family attribute.
-func (e *ForeignObjectElement) FontFamily(fontFamily String) *ForeignObjectElement {
+func (e *LinearGradientElement) FontFamily(fontFamily String) *LinearGradientElement {
 	e.Attrs["font-family"] = fontFamily
 	return e
 }
 
 // FontSize sets the font-size attribute.
-func (e *ForeignObjectElement) FontSize(fontSize String) *ForeignObjectElement {
+func (e *LinearGradientElement) FontSize(fontSize String) *LinearGradientElement {
 	e.Attrs["font-size"] = fontSize
 	return e
 }
 
 // FontSizeAdjust sets the font-size-adjust attribute.
-func (e *ForeignObjectElement) FontSizeAdjust(fontSizeAdjust String) *ForeignObjectElement {
+func (e *LinearGradientElement) FontSizeAdjust(fontSizeAdjust String) *LinearGradientElement {
 	e.Attrs["font-size-adjust"] = fontSizeAdjust
 	return e
 }
 
 // FontStretch sets the font-stretch attribute.
-func (e *ForeignObjectElement) FontStretch(fontStretch String) *ForeignObjectElement {
+func (e *LinearGradientElement) FontStretch(fontStretch String) *LinearGradientElement {
 	e.Attrs["font-stretch"] = fontStretch
 	return e
 }
 
 // FontStyle sets the font-style attribute.
-func (e *ForeignObjectElement) FontStyle(fontStyle String) *ForeignObjectElement {
+func (e *LinearGradientElement) FontStyle(fontStyle String) *LinearGradientElement {
 	e.Attrs["font-style"] = fontStyle
 	return e
 }
 
 // FontVariant sets the font-variant attribute.
-func (e *ForeignObjectElement) FontVariant(fontVariant String) *ForeignObjectElement {
+func (e *LinearGradientElement) FontVariant(fontVariant String) *LinearGradientElement {
 	e.Attrs["font-variant"] = fontVariant
 	return e
 }
 
 // FontWeight sets the font-weight attribute.
-func (e *ForeignObjectElement) FontWeight(fontWeight String) *ForeignObjectElement {
+func (e *LinearGradientElement) FontWeight(fontWeight String) *LinearGradientElement {
 	e.Attrs["font-weight"] = fontWeight
 	return e
 }
 
 // GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
-func (e *ForeignObjectElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *ForeignObjectElement {
+func (e *LinearGradientElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *LinearGradientElement {
 	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
 	return e
 }
 
 // GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
-func (e *ForeignObjectElement) GlyphOrientationVertical(glyphOrientationVertical String) *ForeignObjectElement {
+func (e *LinearGradientElement) GlyphOrientationVertical(glyphOrientationVertical String) *LinearGradientElement {
 	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
 	return e
 }
 
 // ImageRendering sets the image-rendering attribute.
-func (e *ForeignObjectElement) ImageRendering(imageRendering String) *ForeignObjectElement {
+func (e *LinearGradientElement) ImageRendering(imageRendering String) *LinearGradientElement {
 	e.Attrs["image-rendering"] = imageRendering
 	return e
 }
 
 // LetterSpacing sets the letter-spacing attribute.
-func (e *ForeignObjectElement) LetterSpacing(letterSpacing String) *ForeignObjectElement {
+func (e *LinearGradientElement) LetterSpacing(letterSpacing String) *LinearGradientElement {
 	e.Attrs["letter-spacing"] = letterSpacing
 	return e
 }
 
 // LightingColor sets the lighting-color attribute.
-func (e *ForeignObjectElement) LightingColor(lightingColor String) *ForeignObjectElement {
+func (e *LinearGradientElement) LightingColor(lightingColor Color) *LinearGradientElement {
 	e.Attrs["lighting-color"] = lightingColor
 	return e
 }
 
 // MarkerEnd sets the marker-end attribute.
-func (e *ForeignObjectElement) MarkerEnd(markerEnd String) *ForeignObjectElement {
+func (e *LinearGradientElement) MarkerEnd(markerEnd String) *LinearGradientElement {
 	e.Attrs["marker-end"] = markerEnd
 	return e
 }
 
 // MarkerMid sets the marker-mid attribute.
-func (e *ForeignObjectElement) MarkerMid(markerMid String) *ForeignObjectElement {
+func (e *LinearGradientElement) MarkerMid(markerMid String) *LinearGradientElement {
 	e.Attrs["marker-mid"] = markerMid
 	return e
 }
 
 // MarkerStart sets the marker-start attribute.
-func (e *ForeignObjectElement) MarkerStart(markerStart String) *ForeignObjectElement {
+func (e *LinearGradientElement) MarkerStart(markerStart String) *LinearGradientElement {
 	e.Attrs["marker-start"] = markerStart
 	return e
 }
 
 // Mask sets the mask attribute.
-func (e *ForeignObjectElement) Mask(mask String) *ForeignObjectElement {
+func (e *LinearGradientElement) Mask(mask String) *LinearGradientElement {
 	e.Attrs["mask"] = mask
 	return e
 }
 
 // Opacity sets the opacity attribute.
-func (e *ForeignObjectElement) Opacity(opacity Float64) *ForeignObjectElement {
+func (e *LinearGradientElement) Opacity(opacity Float64) *LinearGradientElement {
 	e.Attrs["opacity"] = opacity
 	return e
 }
 
 // Overflow sets the overflow attribute.
-func (e *ForeignObjectElement) Overflow(overflow String) *ForeignObjectElement {
+func (e *LinearGradientElement) Overflow(overflow String) *LinearGradientElement {
 	e.Attrs["overflow"] = overflow
 	return e
 }
 
 // PaintOrder sets the paint-order attribute.
-func (e *ForeignObjectElement) PaintOrder(paintOrder String) *ForeignObjectElement {
+func (e *LinearGradientElement) PaintOrder(paintOrder String) *LinearGradientElement {
 	e.Attrs["paint-order"] = paintOrder
 	return e
 }
 
 // PointerEvents sets the pointer-events attribute.
-func (e *ForeignObjectElement) PointerEvents(pointerEvents String) *ForeignObjectElement {
+func (e *LinearGradientElement) PointerEvents(pointerEvents String) *LinearGradientElement {
 	e.Attrs["pointer-events"] = pointerEvents
 	return e
 }
 
 // ShapeRendering sets the shape-rendering attribute.
-func (e *ForeignObjectElement) ShapeRendering(shapeRendering String) *ForeignObjectElement {
+func (e *LinearGradientElement) ShapeRendering(shapeRendering String) *LinearGradientElement {
 	e.Attrs["shape-rendering"] = shapeRendering
 	return e
 }
 
 // StopColor sets the stop-color attribute.
-func (e *ForeignObjectElement) StopColor(stopColor String) *ForeignObjectElement {
+func (e *LinearGradientElement) StopColor(stopColor Color) *LinearGradientElement {
 	e.Attrs["stop-color"] = stopColor
 	return e
 }
 
 // StopOpacity sets the stop-opacity attribute.
-func (e *ForeignObjectElement) StopOpacity(stopOpacity Float64) *ForeignObjectElement {
+func (e *LinearGradientElement) StopOpacity(stopOpacity Float64) *LinearGradientElement {
 	e.Attrs["stop-opacity"] = stopOpacity
 	return e
 }
 
 // Stroke sets the stroke attribute.
-func (e *ForeignObjectElement) Stroke(stroke String) *ForeignObjectElement {
+func (e *LinearGradientElement) Stroke(stroke String) *LinearGradientElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *LinearGradientElement) StrokeColor(stroke Color) *LinearGradientElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *LinearGradientElement) StrokePaint(stroke Paint) *LinearGradientElement {
 	e.Attrs["stroke"] = stroke
 	return e
 }
 
 // StrokeDashArray sets the stroke-dasharray attribute.
-func (e *ForeignObjectElement) StrokeDashArray(strokeDashArray String) *ForeignObjectElement {
+func (e *LinearGradientElement) StrokeDashArray(strokeDashArray String) *LinearGradientElement {
 	e.Attrs["stroke-dasharray"] = strokeDashArray
 	return e
 }
 
 // StrokeDashOffset sets the stroke-dashoffset attribute.
-func (e *ForeignObjectElement) StrokeDashOffset(strokeDashOffset Float64) *ForeignObjectElement {
+func (e *LinearGradientElement) StrokeDashOffset(strokeDashOffset Float64) *LinearGradientElement {
 	e.Attrs["stroke-dashoffset"] = strokeDashOffset
 	return e
 }
 
 // StrokeLineCap sets the stroke-linecap attribute.
-func (e *ForeignObjectElement) StrokeLineCap(strokeLineCap String) *ForeignObjectElement {
+func (e *LinearGradientElement) StrokeLineCap(strokeLineCap String) *LinearGradientElement {
 	e.Attrs["stroke-linecap"] = strokeLineCap
 	return e
 }
 
 // StrokeLineJoin sets the stroke-linejoin attribute.
-func (e *ForeignObjectElement) StrokeLineJoin(strokeLineJoin String) *ForeignObjectElement {
+func (e *LinearGradientElement) StrokeLineJoin(strokeLineJoin String) *LinearGradientElement {
 	e.Attrs["stroke-linejoin"] = strokeLineJoin
 	return e
 }
 
 // StrokeMiterLimit sets the stroke-miterlimit attribute.
-func (e *ForeignObjectElement) StrokeMiterLimit(strokeMiterLimit Float64) *ForeignObjectElement {
+func (e *LinearGradientElement) StrokeMiterLimit(strokeMiterLimit Float64) *LinearGradientElement {
 	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
 	return e
 }
 
 // StrokeOpacity sets the stroke-opacity attribute.
-func (e *ForeignObjectElement) StrokeOpacity(strokeOpacity Float64) *ForeignObjectElement {
+func (e *LinearGradientElement) StrokeOpacity(strokeOpacity Float64) *LinearGradientElement {
 	e.Attrs["stroke-opacity"] = strokeOpacity
 	return e
 }
 
 // StrokeWidth sets the stroke-width attribute.
-func (e *ForeignObjectElement) StrokeWidth(strokeWidth Length) *ForeignObjectElement {
+func (e *LinearGradientElement) StrokeWidth(strokeWidth Length) *LinearGradientElement {
 	e.Attrs["stroke-width"] = strokeWidth
 	return e
 }
 
 // TextAnchor sets the text-anchor attribute.
-func (e *ForeignObjectElement) TextAnchor(textAnchor String) *ForeignObjectElement {
+func (e *LinearGradientElement) TextAnchor(textAnchor String) *LinearGradientElement {
 	e.Attrs["text-anchor"] = textAnchor
 	return e
 }
 
 // TextDecoration sets the text-decoration attribute.
-func (e *ForeignObjectElement) TextDecoration(textDecoration String) *ForeignObjectElement {
+func (e *LinearGradientElement) TextDecoration(textDecoration String) *LinearGradientElement {
 	e.Attrs["text-decoration"] = textDecoration
 	return e
 }
 
 // TextOverflow sets the text-overflow attribute.
-func (e *ForeignObjectElement) TextOverflow(textOverflow String) *ForeignObjectElement {
+func (e *LinearGradientElement) TextOverflow(textOverflow String) *LinearGradientElement {
 	e.Attrs["text-overflow"] = textOverflow
 	return e
 }
 
 // TextRendering sets the text-rendering attribute.
-func (e *ForeignObjectElement) TextRendering(textRendering String) *ForeignObjectElement {
+func (e *LinearGradientElement) TextRendering(textRendering String) *LinearGradientElement {
 	e.Attrs["text-rendering"] = textRendering
 	return e
 }
 
+// Transform sets the transform attribute.
+func (e *LinearGradientElement) Transform(transform Transform) *LinearGradientElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
 // UnicodeBiDi sets the unicode-bidi attribute.
-func (e *ForeignObjectElement) UnicodeBiDi(unicodeBiDi String) *ForeignObjectElement {
+func (e *LinearGradientElement) UnicodeBiDi(unicodeBiDi String) *LinearGradientElement {
 	e.Attrs["unicode-bidi"] = unicodeBiDi
 	return e
 }
 
 // VectorEffect sets the vector-effect attribute.
-func (e *ForeignObjectElement) VectorEffect(vectorEffect String) *ForeignObjectElement {
+func (e *LinearGradientElement) VectorEffect(vectorEffect String) *LinearGradientElement {
 	e.Attrs["vector-effect"] = vectorEffect
 	return e
 }
 
 // Visibility sets the visibility attribute.
-func (e *ForeignObjectElement) Visibility(visibility String) *ForeignObjectElement {
+func (e *LinearGradientElement) Visibility(visibility String) *LinearGradientElement {
 	e.Attrs["visibility"] = visibility
 	return e
 }
 
 // WhiteSpace sets the white-space attribute.
-func (e *ForeignObjectElement) WhiteSpace(whiteSpace String) *ForeignObjectElement {
+func (e *LinearGradientElement) WhiteSpace(whiteSpace String) *LinearGradientElement {
 	e.Attrs["white-space"] = whiteSpace
 	return e
 }
 
 // WordSpacing sets the word-spacing attribute.
-func (e *ForeignObjectElement) WordSpacing(wordSpacing String) *ForeignObjectElement {
+func (e *LinearGradientElement) WordSpacing(wordSpacing String) *LinearGradientElement {
 	e.Attrs["word-spacing"] = wordSpacing
 	return e
 }
 
 // WritingMode sets the writing-mode attribute.
-func (e *ForeignObjectElement) WritingMode(writingMode String) *ForeignObjectElement {
+func (e *LinearGradientElement) WritingMode(writingMode String) *LinearGradientElement {
 	e.Attrs["writing-mode"] = writingMode
 	return e
 }
 
-// RequiredExtensions sets the requiredExtensions attribute.
-func (e *ForeignObjectElement) RequiredExtensions(requiredExtensions String) *ForeignObjectElement {
-	e.Attrs["requiredExtensions"] = requiredExtensions
+// X1 sets the x1 attribute.
+func (e *LinearGradientElement) X1(x1 Length) *LinearGradientElement {
+	e.Attrs["x1"] = x1
 	return e
 }
 
-// SystemLanguage sets the systemLanguage attribute.
-func (e *ForeignObjectElement) SystemLanguage(systemLanguage String) *ForeignObjectElement {
-	e.Attrs["systemLanguage"] = systemLanguage
+// Y1 sets the y1 attribute.
+func (e *LinearGradientElement) Y1(y1 Length) *LinearGradientElement {
+	e.Attrs["y1"] = y1
 	return e
 }
 
-// Href sets the href attribute.
-func (e *ForeignObjectElement) Href(href String) *ForeignObjectElement {
-	e.Attrs["href"] = href
+// X2 sets the x2 attribute.
+func (e *LinearGradientElement) X2(x2 Length) *LinearGradientElement {
+	e.Attrs["x2"] = x2
 	return e
 }
 
-// X sets the x attribute.
-func (e *ForeignObjectElement) X(x Length) *ForeignObjectElement {
-	e.Attrs["x"] = x
+// Y2 sets the y2 attribute.
+func (e *LinearGradientElement) Y2(y2 Length) *LinearGradientElement {
+	e.Attrs["y2"] = y2
 	return e
 }
 
-// Y sets the y attribute.
-func (e *ForeignObjectElement) Y(y Length) *ForeignObjectElement {
-	e.Attrs["y"] = y
+// GradientUnits sets the gradientUnits attribute.
+func (e *LinearGradientElement) GradientUnits(gradientUnits String) *LinearGradientElement {
+	e.Attrs["gradientUnits"] = gradientUnits
 	return e
 }
 
-// Width sets the width attribute.
-func (e *ForeignObjectElement) Width(width Length) *ForeignObjectElement {
-	e.Attrs["width"] = width
+// GradientTransform sets the gradientTransform attribute.
+func (e *LinearGradientElement) GradientTransform(gradientTransform Transform) *LinearGradientElement {
+	e.Attrs["gradientTransform"] = gradientTransform
 	return e
 }
 
-// Height sets the height attribute.
-func (e *ForeignObjectElement) Height(height Length) *ForeignObjectElement {
-	e.Attrs["height"] = height
+// SpreadMethod sets the spreadMethod attribute.
+func (e *LinearGradientElement) SpreadMethod(spreadMethod String) *LinearGradientElement {
+	e.Attrs["spreadMethod"] = spreadMethod
 	return e
 }
 
-// WidthHeight sets the width and height attributes.
-func (e *ForeignObjectElement) WidthHeight(width, height float64, lengthFunc LengthFunc) *ForeignObjectElement {
-	e.Attrs["width"] = lengthFunc(width)
-	e.Attrs["height"] = lengthFunc(height)
+// Href sets the href attribute.
+func (e *LinearGradientElement) Href(href String) *LinearGradientElement {
+	e.Attrs["href"] = href
 	return e
 }
 
-// XY sets the x and y attributes.
-func (e *ForeignObjectElement) XY(x, y float64, lengthFunc LengthFunc) *ForeignObjectElement {
-	e.Attrs["x"] = lengthFunc(x)
-	e.Attrs["y"] = lengthFunc(y)
-	return e
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *LinearGradientElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
 }
 
-// XYWidthHeight sets the x, y, width, and height attributes.
-func (e *ForeignObjectElement) XYWidthHeight(x, y, width, height float64, lengthFunc LengthFunc) *ForeignObjectElement {
-	e.Attrs["x"] = lengthFunc(x)
-	e.Attrs["y"] = lengthFunc(y)
-	e.Attrs["width"] = lengthFunc(width)
-	e.Attrs["height"] = lengthFunc(height)
-	return e
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *LinearGradientElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
 }
 
-// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
-func (e *ForeignObjectElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
-	return encodeElement(encoder, "foreignObject", e.Attrs, e.Children)
+// Attributes implements Node.Attributes.
+func (e *LinearGradientElement) Attributes() map[string]AttrValue {
+	return e.Attrs
 }
 
-// A GElement is a g element.
-type GElement struct {
+// ChildElements implements Node.ChildElements.
+func (e *LinearGradientElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *LinearGradientElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *LinearGradientElement) TagName() string {
+	return "linearGradient"
+}
+
+// decode decodes e from d.
+func (e *LinearGradientElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *LinearGradientElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "x1":
+		return parseLength(value)
+	case "y1":
+		return parseLength(value)
+	case "x2":
+		return parseLength(value)
+	case "y2":
+		return parseLength(value)
+	case "gradientTransform":
+		return parseTransform(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A MarkerElement is a marker element.
+type MarkerElement struct {
 	Attrs    map[string]AttrValue
 	Children []Element
 }
 
-// G returns a new GElement.
-func G(children ...Element) *GElement {
-	return &GElement{
+// Marker returns a new MarkerElement.
+func Marker(children ...Element) *MarkerElement {
+	return &MarkerElement{
 		Attrs:    map[string]AttrValue{},
 		Children: children,
 	}
 }
 
 // AppendChildren appends the given children.
-func (e *GElement) AppendChildren(children ...Element) *GElement {
+func (e *MarkerElement) AppendChildren(children ...Element) *MarkerElement {
 	e.Children = append(e.Children, children...)
 	return e
 }
 
+// RemoveAttrs removes the attributes with the given names.
+func (e *MarkerElement) RemoveAttrs(names ...string) *MarkerElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
 // ID sets the id attribute.
-func (e *GElement) ID(id String) *GElement {
+func (e *MarkerElement) ID(id String) *MarkerElement {
 	e.Attrs["id"] = id
 	return e
 }
 
 // TabIndex sets the tabindex attribute.
-func (e *GElement) TabIndex(tabIndex Int) *GElement {
+func (e *MarkerElement) TabIndex(tabIndex Int) *MarkerElement {
 	e.Attrs["tabindex"] = tabIndex
 	return e
 }
 
 // Lang sets the lang attribute.
-func (e *GElement) Lang(lang String) *GElement {
+func (e *MarkerElement) Lang(lang String) *MarkerElement {
 	e.Attrs["lang"] = lang
 	return e
 }
 
 // Class sets the class attribute.
-func (e *GElement) Class(class String) *GElement {
+func (e *MarkerElement) Class(class String) *MarkerElement {
 	e.Attrs["class"] = class
 	return e
 }
 
 // Style sets the style attribute.
-func (e *GElement) Style(style String) *GElement {
+func (e *MarkerElement) Style(style String) *MarkerElement {
 	e.Attrs["style"] = style
 	return e
 }
 
 // AlignmentBaseline sets the alignment-baseline attribute.
-func (e *GElement) AlignmentBaseline(alignmentBaseline String) *GElement {
+func (e *MarkerElement) AlignmentBaseline(alignmentBaseline String) *MarkerElement {
 	e.Attrs["alignment-baseline"] = alignmentBaseline
 	return e
 }
 
 // BaselineShift sets the baseline-shift attribute.
-func (e *GElement) BaselineShift(baselineShift String) *GElement {
+func (e *MarkerElement) BaselineShift(baselineShift String) *MarkerElement {
 	e.Attrs["baseline-shift"] = baselineShift
 	return e
 }
 
 // ClipPath sets the clip-path attribute.
-func (e *GElement) ClipPath(clipPath String) *GElement {
+func (e *MarkerElement) ClipPath(clipPath String) *MarkerElement {
 	e.Attrs["clip-path"] = clipPath
 	return e
 }
 
 // ClipRule sets the clip-rule attribute.
-func (e *GElement) ClipRule(clipRule String) *GElement {
+func (e *MarkerElement) ClipRule(clipRule String) *MarkerElement {
 	e.Attrs["clip-rule"] = clipRule
 	return e
 }
 
 // Color sets the color attribute.
-func (e *GElement) Color(color String) *GElement {
+func (e *MarkerElement) Color(color Color) *MarkerElement {
 	e.Attrs["color"] = color
 	return e
 }
 
 // ColorInterpolation sets the color-interpolation attribute.
-func (e *GElement) ColorInterpolation(colorInterpolation String) *GElement {
+func (e *MarkerElement) ColorInterpolation(colorInterpolation String) *MarkerElement {
 	e.Attrs["color-interpolation"] = colorInterpolation
 	return e
 }
 
 // ColorInterpolationFilters sets the color-interpolation-filters attribute.
-func (e *GElement) ColorInterpolationFilters(colorInterpolationFilters String) *GElement {
+func (e *MarkerElement) ColorInterpolationFilters(colorInterpolationFilters String) *MarkerElement {
 	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
 	return e
 }
 
 // ColorRendering sets the color-rendering attribute.
-func (e *GElement) ColorRendering(colorRendering String) *GElement {
+func (e *MarkerElement) ColorRendering(colorRendering String) *MarkerElement {
 	e.Attrs["color-rendering"] = colorRendering
 	return e
 }
 
 // Cursor sets the cursor attribute.
-func (e *GElement) Cursor(cursor String) *GElement {
+func (e *MarkerElement) Cursor(cursor String) *MarkerElement {
 	e.Attrs["cursor"] = cursor
 	return e
 }
 
 // Direction sets the direction attribute.
-func (e *GElement) Direction(direction String) *GElement {
+func (e *MarkerElement) Direction(direction String) *MarkerElement {
 	e.Attrs["direction"] = direction
 	return e
 }
 
 // Display sets the display attribute.
-func (e *GElement) Display(display String) *GElement {
+func (e *MarkerElement) Display(display String) *MarkerElement {
 	e.Attrs["display"] = display
 	return e
 }
 
 // DominantBaseline sets the dominant-baseline attribute.
-func (e *GElement) DominantBaseline(dominantBaseline String) *GElement {
+func (e *MarkerElement) DominantBaseline(dominantBaseline String) *MarkerElement {
 	e.Attrs["dominant-baseline"] = dominantBaseline
 	return e
 }
 
 // Fill sets the fill attribute.
-func (e *GElement) Fill(fill String) *GElement {
+func (e *MarkerElement) Fill(fill String) *MarkerElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *MarkerElement) FillColor(fill Color) *MarkerElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *MarkerElement) FillPaint(fill Paint) *MarkerElement {
 	e.Attrs["fill"] = fill
 	return e
 }
 
 // FillOpacity sets the fill-opacity attribute.
-func (e *GElement) FillOpacity(fillOpacity Float64) *GElement {
+func (e *MarkerElement) FillOpacity(fillOpacity Float64) *MarkerElement {
 	e.Attrs["fill-opacity"] = fillOpacity
 	return e
 }
 
 // FillRule sets the fill-rule attribute.
-func (e *GElement) FillRule(fillRule String) *GElement {
+func (e *MarkerElement) FillRule(fillRule String) *MarkerElement {
 	e.Attrs["fill-rule"] = fillRule
 	return e
 }
 
 // Filter sets the filter attribute.
-func (e *GElement) Filter(filter String) *GElement {
+func (e *MarkerElement) Filter(filter String) *MarkerElement {
 	e.Attrs["filter"] = filter
 	return e
 }
 
 // FloodColor sets the flood-color attribute.
-func (e *GElement) FloodColor(floodColor String) *GElement {
+func (e *MarkerElement) FloodColor(floodColor Color) *MarkerElement {
 	e.Attrs["flood-color"] = floodColor
 	return e
 }
 
 // FloodOpacity sets the flood-opacity attribute.
-func (e *GElement) FloodOpacity(floodOpacity Float64) *GElement {
+func (e *MarkerElement) FloodOpacity(floodOpacity Float64) *MarkerElement {
 	e.Attrs["flood-opacity"] = floodOpacity
 	return e
 }
 
 // FontFamily sets the font-family attribute.
-func (e *GElement) FontFamily(fontFamily String) *GElement {
+func (e *MarkerElement) FontFamily(fontFamily String) *MarkerElement {
 	e.Attrs["font-family"] = fontFamily
 	return e
 }
 
 // FontSize sets the font-size attribute.
-func (e *GElement) FontSize(fontSize String) *GElement {
+func (e *MarkerElement) FontSize(fontSize String) *MarkerElement {
 	e.Attrs["font-size"] = fontSize
 	return e
 }
 
 // FontSizeAdjust sets the font-size-adjust attribute.
-func (e *GElement) FontSizeAdjust(fontSizeAdjust String) *GElement {
+func (e *MarkerElement) FontSizeAdjust(fontSizeAdjust String) *MarkerElement {
 	e.Attrs["font-size-adjust"] = fontSizeAdjust
 	return e
 }
 
 // FontStretch sets the font-stretch attribute.
-func (e *GElement) FontStretch(fontStretch String) *GElement {
+func (e *MarkerElement) FontStretch(fontStretch String) *MarkerElement {
 	e.Attrs["font-stretch"] = fontStretch
 	return e
 }
 
 // FontStyle sets the font-style attribute.
-func (e *GElement) FontStyle(fontStyle String) *GElement {
+func (e *MarkerElement) FontStyle(fontStyle String) *MarkerElement {
 	e.Attrs["font-style"] = fontStyle
 	return e
 }
 
 // FontVariant sets the font-variant attribute.
-func (e *GElement) FontVariant(fontVariant String) *GElement {
+func (e *MarkerElement) FontVariant(fontVariant String) *MarkerElement {
 	e.Attrs["font-variant"] = fontVariant
 	return e
 }
 
 // FontWeight sets the font-weight attribute.
-func (e *GElement) FontWeight(fontWeight String) *GElement {
+func (e *MarkerElement) FontWeight(fontWeight String) *MarkerElement {
 	e.Attrs["font-weight"] = fontWeight
 	return e
 }
 
 // GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
-func (e *GElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *GElement {
+func (e *MarkerElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *MarkerElement {
 	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
 	return e
 }
 
 // GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
-func (e *GElement) GlyphOrientationVertical(glyphOrientationVertical String) *GElement {
+func (e *MarkerElement) GlyphOrientationVertical(glyphOrientationVertical String) *MarkerElement {
 	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
 	return e
 }
 
 // ImageRendering sets the image-rendering attribute.
-func (e *GElement) ImageRendering(imageRendering String) *GElement {
+func (e *MarkerElement) ImageRendering(imageRendering String) *MarkerElement {
 	e.Attrs["image-rendering"] = imageRendering
 	return e
 }
 
 // LetterSpacing sets the letter-spacing attribute.
-func (e *GElement) LetterSpacing(letterSpacing String) *GElement {
+func (e *MarkerElement) LetterSpacing(letterSpacing String) *MarkerElement {
 	e.Attrs["letter-spacing"] = letterSpacing
 	return e
 }
 
 // LightingColor sets the lighting-color attribute.
-func (e *GElement) LightingColor(lightingColor String) *GElement {
+func (e *MarkerElement) LightingColor(lightingColor Color) *MarkerElement {
 	e.Attrs["lighting-color"] = lightingColor
 	return e
 }
 
 // MarkerEnd sets the marker-end attribute.
-func (e *GElement) MarkerEnd(markerEnd String) *GElement {
+func (e *MarkerElement) MarkerEnd(markerEnd String) *MarkerElement {
 	e.Attrs["marker-end"] = markerEnd
 	return e
 }
 
 // MarkerMid sets the marker-mid attribute.
-func (e *GElement) MarkerMid(markerMid String) *GElement {
+func (e *MarkerElement) MarkerMid(markerMid String) *MarkerElement {
 	e.Attrs["marker-mid"] = markerMid
 	return e
 }
 
 // MarkerStart sets the marker-start attribute.
-func (e *GElement) MarkerStart(markerStart String) *GElement {
+func (e *MarkerElement) MarkerStart(markerStart String) *MarkerElement {
 	e.Attrs["marker-start"] = markerStart
 	return e
 }
 
 // Mask sets the mask attribute.
-func (e *GElement) Mask(mask String) *GElement {
+func (e *MarkerElement) Mask(mask String) *MarkerElement {
 	e.Attrs["mask"] = mask
 	return e
 }
 
 // Opacity sets the opacity attribute.
-func (e *GElement) Opacity(opacity Float64) *GElement {
+func (e *MarkerElement) Opacity(opacity Float64) *MarkerElement {
 	e.Attrs["opacity"] = opacity
 	return e
 }
 
 // Overflow sets the overflow attribute.
-func (e *GElement) Overflow(overflow String) *GElement {
+func (e *MarkerElement) Overflow(overflow String) *MarkerElement {
 	e.Attrs["overflow"] = overflow
 	return e
 }
 
 // PaintOrder sets the paint-order attribute.
-func (e *GElement) PaintOrder(paintOrder String) *GElement {
+func (e *MarkerElement) PaintOrder(paintOrder String) *MarkerElement {
 	e.Attrs["paint-order"] = paintOrder
 	return e
 }
 
 // PointerEvents sets the pointer-events attribute.
-func (e *GElement) PointerEvents(pointerEvents String) *GElement {
+func (e *MarkerElement) PointerEvents(pointerEvents String) *MarkerElement {
 	e.Attrs["pointer-events"] = pointerEvents
 	return e
 }
 
 // ShapeRendering sets the shape-rendering attribute.
-func (e *GElement) ShapeRendering(shapeRendering String) *GElement {
+func (e *MarkerElement) ShapeRendering(shapeRendering String) *MarkerElement {
 	e.Attrs["shape-rendering"] = shapeRendering
 	return e
 }
 
 // StopColor sets the stop-color attribute.
-func (e *GElement) StopColor(stopColor String) *GElement {
+func (e *MarkerElement) StopColor(stopColor Color) *MarkerElement {
 	e.Attrs["stop-color"] = stopColor
 	return e
 }
 
 // StopOpacity sets the stop-opacity attribute.
-func (e *GElement) StopOpacity(stopOpacity Float64) *GElement {
+func (e *MarkerElement) StopOpacity(stopOpacity Float64) *MarkerElement {
 	e.Attrs["stop-opacity"] = stopOpacity
 	return e
 }
 
 // Stroke sets the stroke attribute.
-func (e *GElement) Stroke(stroke String) *GElement {
+func (e *MarkerElement) Stroke(stroke String) *MarkerElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *MarkerElement) StrokeColor(stroke Color) *MarkerElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *MarkerElement) StrokePaint(stroke Paint) *MarkerElement {
 	e.Attrs["stroke"] = stroke
 	return e
 }
 
 // StrokeDashArray sets the stroke-dasharray attribute.
-func (e *GElement) StrokeDashArray(strokeDashArray String) *GElement {
+func (e *MarkerElement) StrokeDashArray(strokeDashArray String) *MarkerElement {
 	e.Attrs["stroke-dasharray"] = strokeDashArray
 	return e
 }
 
 // StrokeDashOffset sets the stroke-dashoffset attribute.
-func (e *GElement) StrokeDashOffset(strokeDashOffset Float64) *GElement {
+func (e *MarkerElement) StrokeDashOffset(strokeDashOffset Float64) *MarkerElement {
 	e.Attrs["stroke-dashoffset"] = strokeDashOffset
 	return e
 }
 
 // StrokeLineCap sets the stroke-linecap attribute.
-func (e *GElement) StrokeLineCap(strokeLineCap String) *GElement {
+func (e *MarkerElement) StrokeLineCap(strokeLineCap String) *MarkerElement {
 	e.Attrs["stroke-linecap"] = strokeLineCap
 	return e
 }
 
 // StrokeLineJoin sets the stroke-linejoin attribute.
-func (e *GElement) StrokeLineJoin(strokeLineJoin String) *GElement {
+func (e *MarkerElement) StrokeLineJoin(strokeLineJoin String) *MarkerElement {
 	e.Attrs["stroke-linejoin"] = strokeLineJoin
 	return e
 }
 
 // StrokeMiterLimit sets the stroke-miterlimit attribute.
-func (e *GElement) StrokeMiterLimit(strokeMiterLimit Float64) *GElement {
+func (e *MarkerElement) StrokeMiterLimit(strokeMiterLimit Float64) *MarkerElement {
 	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
 	return e
 }
 
 // StrokeOpacity sets the stroke-opacity attribute.
-func (e *GElement) StrokeOpacity(strokeOpacity Float64) *GElement {
+func (e *MarkerElement) StrokeOpacity(strokeOpacity Float64) *MarkerElement {
 	e.Attrs["stroke-opacity"] = strokeOpacity
 	return e
 }
 
 // StrokeWidth sets the stroke-width attribute.
-func (e *GElement) StrokeWidth(strokeWidth Length) *GElement {
+func (e *MarkerElement) StrokeWidth(strokeWidth Length) *MarkerElement {
 	e.Attrs["stroke-width"] = strokeWidth
 	return e
 }
 
 // TextAnchor sets the text-anchor attribute.
-func (e *GElement) TextAnchor(textAnchor String) *GElement {
+func (e *MarkerElement) TextAnchor(textAnchor String) *MarkerElement {
 	e.Attrs["text-anchor"] = textAnchor
 	return e
 }
 
 // TextDecoration sets the text-decoration attribute.
-func (e *GElement) TextDecoration(textDecoration String) *GElement {
+func (e *MarkerElement) TextDecoration(textDecoration String) *MarkerElement {
 	e.Attrs["text-decoration"] = textDecoration
 	return e
 }
 
 // TextOverflow sets the text-overflow attribute.
-func (e *GElement) TextOverflow(textOverflow String) *GElement {
+func (e *MarkerElement) TextOverflow(textOverflow String) *MarkerElement {
 	e.Attrs["text-overflow"] = textOverflow
 	return e
 }
 
 // TextRendering sets the text-rendering attribute.
-func (e *GElement) TextRendering(textRendering String) *GElement {
+func (e *MarkerElement) TextRendering(textRendering String) *MarkerElement {
 	e.Attrs["text-rendering"] = textRendering
 	return e
 }
 
+// Transform sets the transform attribute.
+func (e *MarkerElement) Transform(transform Transform) *MarkerElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
 // UnicodeBiDi sets the unicode-bidi attribute.
-func (e *GElement) UnicodeBiDi(unicodeBiDi String) *GElement {
+func (e *MarkerElement) UnicodeBiDi(unicodeBiDi String) *MarkerElement {
 	e.Attrs["unicode-bidi"] = unicodeBiDi
 	return e
 }
 
 // VectorEffect sets the vector-effect attribute.
-func (e *GElement) VectorEffect(vectorEffect String) *GElement {
+func (e *MarkerElement) VectorEffect(vectorEffect String) *MarkerElement {
 	e.Attrs["vector-effect"] = vectorEffect
 	return e
 }
 
 // Visibility sets the visibility attribute.
-func (e *GElement) Visibility(visibility String) *GElement {
+func (e *MarkerElement) Visibility(visibility String) *MarkerElement {
 	e.Attrs["visibility"] = visibility
 	return e
 }
 
 // WhiteSpace sets the white-space attribute.
-func (e *GElement) WhiteSpace(whiteSpace String) *GElement {
+func (e *MarkerElement) WhiteSpace(whiteSpace String) *MarkerElement {
 	e.Attrs["white-space"] = whiteSpace
 	return e
 }
 
 // WordSpacing sets the word-spacing attribute.
-func (e *GElement) WordSpacing(wordSpacing String) *GElement {
+func (e *MarkerElement) WordSpacing(wordSpacing String) *MarkerElement {
 	e.Attrs["word-spacing"] = wordSpacing
 	return e
 }
 
 // WritingMode sets the writing-mode attribute.
-func (e *GElement) WritingMode(writingMode String) *GElement {
+func (e *MarkerElement) WritingMode(writingMode String) *MarkerElement {
 	e.Attrs["writing-mode"] = writingMode
 	return e
 }
 
+// ViewBox sets the viewBox attribute.
+func (e *MarkerElement) ViewBox(minX, minY, width, height float64) *MarkerElement {
+	e.Attrs["viewBox"] = ViewBox{MinX: minX, MinY: minY, Width: width, Height: height}
+	return e
+}
+
+// PreserveAspectRatio sets the preserveAspectRatio attribute.
+func (e *MarkerElement) PreserveAspectRatio(preserveAspectRatio String) *MarkerElement {
+	e.Attrs["preserveAspectRatio"] = preserveAspectRatio
+	return e
+}
+
+// RefX sets the refX attribute.
+func (e *MarkerElement) RefX(refX Float64) *MarkerElement {
+	e.Attrs["refX"] = refX
+	return e
+}
+
+// RefY sets the refY attribute.
+func (e *MarkerElement) RefY(refY Float64) *MarkerElement {
+	e.Attrs["refY"] = refY
+	return e
+}
+
+// MarkerUnits sets the markerUnits attribute.
+func (e *MarkerElement) MarkerUnits(markerUnits String) *MarkerElement {
+	e.Attrs["markerUnits"] = markerUnits
+	return e
+}
+
+// MarkerWidth sets the markerWidth attribute.
+func (e *MarkerElement) MarkerWidth(markerWidth Float64) *MarkerElement {
+	e.Attrs["markerWidth"] = markerWidth
+	return e
+}
+
+// MarkerHeight sets the markerHeight attribute.
+func (e *MarkerElement) MarkerHeight(markerHeight Float64) *MarkerElement {
+	e.Attrs["markerHeight"] = markerHeight
+	return e
+}
+
+// Orient sets the orient attribute.
+func (e *MarkerElement) Orient(orient String) *MarkerElement {
+	e.Attrs["orient"] = orient
+	return e
+}
+
+// RefXY sets the refX and refY attributes.
+func (e *MarkerElement) RefXY(refX, refY float64) *MarkerElement {
+	e.Attrs["refX"] = Float64(refX)
+	e.Attrs["refY"] = Float64(refY)
+	return e
+}
+
+// MarkerWidthHeight sets the markerWidth and markerHeight attributes.
+func (e *MarkerElement) MarkerWidthHeight(markerWidth, markerHeight float64) *MarkerElement {
+	e.Attrs["markerWidth"] = Float64(markerWidth)
+	e.Attrs["markerHeight"] = Float64(markerHeight)
+	return e
+}
+
 // MarshallXML implements encoding/xml.Marshaller.MarshalXML.
-func (e *GElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
-	return encodeElement(encoder, "g", e.Attrs, e.Children)
+func (e *MarkerElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
 }
 
-// A ImageElement is a image element.
-type ImageElement struct {
-	Attrs map[string]AttrValue
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *MarkerElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
 }
 
-// Image returns a new ImageElement.
-func Image() *ImageElement {
-	return &ImageElement{
-		Attrs: map[string]AttrValue{},
+// Attributes implements Node.Attributes.
+func (e *MarkerElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *MarkerElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *MarkerElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *MarkerElement) TagName() string {
+	return "marker"
+}
+
+// decode decodes e from d.
+func (e *MarkerElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
 	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *MarkerElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "viewBox":
+		return parseViewBox(value)
+	case "refX":
+		return parseFloat64(value)
+	case "refY":
+		return parseFloat64(value)
+	case "markerWidth":
+		return parseFloat64(value)
+	case "markerHeight":
+		return parseFloat64(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A MaskElement is a mask element.
+type MaskElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// Mask returns a new MaskElement.
+func Mask(children ...Element) *MaskElement {
+	return &MaskElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *MaskElement) AppendChildren(children ...Element) *MaskElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *MaskElement) RemoveAttrs(names ...string) *MaskElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
 }
 
 // ID sets the id attribute.
-func (e *ImageElement) ID(id String) *ImageElement {
+func (e *MaskElement) ID(id String) *MaskElement {
 	e.Attrs["id"] = id
 	return e
 }
 
 // TabIndex sets the tabindex attribute.
-func (e *ImageElement) TabIndex(tabIndex Int) *ImageElement {
+func (e *MaskElement) TabIndex(tabIndex Int) *MaskElement {
 	e.Attrs["tabindex"] = tabIndex
 	return e
 }
 
 // Lang sets the lang attribute.
-func (e *ImageElement) Lang(lang String) *ImageElement {
+func (e *MaskElement) Lang(lang String) *MaskElement {
 	e.Attrs["lang"] = lang
 	return e
 }
 
 // Class sets the class attribute.
-func (e *ImageElement) Class(class String) *ImageElement {
+func (e *MaskElement) Class(class String) *MaskElement {
 	e.Attrs["class"] = class
 	return e
 }
 
 // Style sets the style attribute.
-func (e *ImageElement) Style(style String) *ImageElement {
+func (e *MaskElement) Style(style String) *MaskElement {
 	e.Attrs["style"] = style
 	return e
 }
 
 // AlignmentBaseline sets the alignment-baseline attribute.
-func (e *ImageElement) AlignmentBaseline(alignmentBaseline String) *ImageElement {
+func (e *MaskElement) AlignmentBaseline(alignmentBaseline String) *MaskElement {
 	e.Attrs["alignment-baseline"] = alignmentBaseline
 	return e
 }
 
 // BaselineShift sets the baseline-shift attribute.
-func (e *ImageElement) BaselineShift(baselineShift String) *ImageElement {
+func (e *MaskElement) BaselineShift(baselineShift String) *MaskElement {
 	e.Attrs["baseline-shift"] = baselineShift
 	return e
 }
 
 // ClipPath sets the clip-path attribute.
-func (e *ImageElement) ClipPath(clipPath String) *ImageElement {
+func (e *MaskElement) ClipPath(clipPath String) *MaskElement {
 	e.Attrs["clip-path"] = clipPath
 	return e
 }
 
 // ClipRule sets the clip-rule attribute.
-func (e *ImageElement) ClipRule(clipRule String) *ImageElement {
+func (e *MaskElement) ClipRule(clipRule String) *MaskElement {
 	e.Attrs["clip-rule"] = clipRule
 	return e
 }
 
 // Color sets the color attribute.
-func (e *ImageElement) Color(color String) *ImageElement {
+func (e *MaskElement) Color(color Color) *MaskElement {
 	e.Attrs["color"] = color
 	return e
 }
 
 // ColorInterpolation sets the color-interpolation attribute.
-func (e *ImageElement) ColorInterpolation(colorInterpolation String) *ImageElement {
+func (e *MaskElement) ColorInterpolation(colorInterpolation String) *MaskElement {
 	e.Attrs["color-interpolation"] = colorInterpolation
 	return e
 }
 
 // ColorInterpolationFilters sets the color-interpolation-filters attribute.
-func (e *ImageElement) ColorInterpolationFilters(colorInterpolationFilters String) *ImageElement {
+func (e *MaskElement) ColorInterpolationFilters(colorInterpolationFilters String) *MaskElement {
 	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
 	return e
 }
 
 // ColorRendering sets the color-rendering attribute.
-func (e *ImageElement) ColorRendering(colorRendering String) *ImageElement {
+func (e *MaskElement) ColorRendering(colorRendering String) *MaskElement {
 	e.Attrs["color-rendering"] = colorRendering
 	return e
 }
 
 // Cursor sets the cursor attribute.
-func (e *ImageElement) Cursor(cursor String) *ImageElement {
+func (e *MaskElement) Cursor(cursor String) *MaskElement {
 	e.Attrs["cursor"] = cursor
 	return e
 }
 
 // Direction sets the direction attribute.
-func (e *ImageElement) Direction(direction String) *ImageElement {
+func (e *MaskElement) Direction(direction String) *MaskElement {
 	e.Attrs["direction"] = direction
 	return e
 }
 
 // Display sets the display attribute.
-func (e *ImageElement) Display(display String) *ImageElement {
+func (e *MaskElement) Display(display String) *MaskElement {
 	e.Attrs["display"] = display
 	return e
 }
 
 // DominantBaseline sets the dominant-baseline attribute.
-func (e *ImageElement) DominantBaseline(dominantBaseline String) *ImageElement {
+func (e *MaskElement) DominantBaseline(dominantBaseline String) *MaskElement {
 	e.Attrs["dominant-baseline"] = dominantBaseline
 	return e
 }
 
 // Fill sets the fill attribute.
-func (e *ImageElement) Fill(fill String) *ImageElement {
+func (e *MaskElement) Fill(fill String) *MaskElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *MaskElement) FillColor(fill Color) *MaskElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *MaskElement) FillPaint(fill Paint) *MaskElement {
 	e.Attrs["fill"] = fill
 	return e
 }
 
 // FillOpacity sets the fill-opacity attribute.
-func (e *ImageElement) FillOpacity(fillOpacity Float64) *ImageElement {
+func (e *MaskElement) FillOpacity(fillOpacity Float64) *MaskElement {
 	e.Attrs["fill-opacity"] = fillOpacity
 	return e
 }
 
 // FillRule sets the fill-rule attribute.
-func (e *ImageElement) FillRule(fillRule String) *ImageElement {
+func (e *MaskElement) FillRule(fillRule String) *MaskElement {
 	e.Attrs["fill-rule"] = fillRule
 	return e
 }
 
 // Filter sets the filter attribute.
-func (e *ImageElement) Filter(filter String) *ImageElement {
+func (e *MaskElement) Filter(filter String) *MaskElement {
 	e.Attrs["filter"] = filter
 	return e
 }
 
 // FloodColor sets the flood-color attribute.
-func (e *ImageElement) FloodColor(floodColor String) *ImageElement {
+func (e *MaskElement) FloodColor(floodColor Color) *MaskElement {
 	e.Attrs["flood-color"] = floodColor
 	return e
 }
 
 // FloodOpacity sets the flood-opacity attribute.
-func (e *ImageElement) FloodOpacity(floodOpacity Float64) *ImageElement {
+func (e *MaskElement) FloodOpacity(floodOpacity Float64) *MaskElement {
 	e.Attrs["flood-opacity"] = floodOpacity
 	return e
 }
 
 // FontFamily sets the font-family attribute.
-func (e *ImageElement) FontFamily(fontFamily String) *ImageElement {
+func (e *MaskElement) FontFamily(fontFamily String) *MaskElement {
 	e.Attrs["font-family"] = fontFamily
 	return e
 }
 
 // FontSize sets the font-size attribute.
-func (e *ImageElement) FontSize(fontSize String) *ImageElement {
+func (e *MaskElement) FontSize(fontSize String) *MaskElement {
 	e.Attrs["font-size"] = fontSize
 	return e
 }
 
 // FontSizeAdjust sets the font-size-adjust attribute.
-func (e *ImageElement) FontSizeAdjust(fontSizeAdjust String) *ImageElement {
+func (e *MaskElement) FontSizeAdjust(fontSizeAdjust String) *MaskElement {
 	e.Attrs["font-size-adjust"] = fontSizeAdjust
 	return e
 }
 
 // FontStretch sets the font-stretch attribute.
-func (e *ImageElement) FontStretch(fontStretch String) *ImageElement {
+func (e *MaskElement) FontStretch(fontStretch String) *MaskElement {
 	e.Attrs["font-stretch"] = fontStretch
 	return e
 }
 
 // FontStyle sets the font-style attribute.
-func (e *ImageElement) FontStyle(fontStyle String) *ImageElement {
+func (e *MaskElement) FontStyle(fontStyle String) *MaskElement {
 	e.Attrs["font-style"] = fontStyle
 	return e
 }
 
 // FontVariant sets the font-variant attribute.
-func (e *ImageElement) FontVariant(fontVariant String) *ImageElement {
+func (e *MaskElement) FontVariant(fontVariant String) *MaskElement {
 	e.Attrs["font-variant"] = fontVariant
 	return e
 }
 
 // FontWeight sets the font-weight attribute.
-func (e *ImageElement) FontWeight(fontWeight String) *ImageElement {
+func (e *MaskElement) FontWeight(fontWeight String) *MaskElement {
 	e.Attrs["font-weight"] = fontWeight
 	return e
 }
 
 // GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
-func (e *ImageElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *ImageElement {
+func (e *MaskElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *MaskElement {
 	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
 	return e
 }
 
 // GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
-func (e *ImageElement) GlyphOrientationVertical(glyphOrientationVertical String) *ImageElement {
+func (e *MaskElement) GlyphOrientationVertical(glyphOrientationVertical String) *MaskElement {
 	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
 	return e
 }
 
 // ImageRendering sets the image-rendering attribute.
-func (e *ImageElement) ImageRendering(imageRendering String) *ImageElement {
+func (e *MaskElement) ImageRendering(imageRendering String) *MaskElement {
 	e.Attrs["image-rendering"] = imageRendering
 	return e
 }
 
 // LetterSpacing sets the letter-spacing attribute.
-func (e *ImageElement) LetterSpacing(letterSpacing String) *ImageElement {
+func (e *MaskElement) LetterSpacing(letterSpacing String) *MaskElement {
 	e.Attrs["letter-spacing"] = letterSpacing
 	return e
 }
 
 // LightingColor sets the lighting-color attribute.
-func (e *ImageElement) LightingColor(lightingColor String) *ImageElement {
+func (e *MaskElement) LightingColor(lightingColor Color) *MaskElement {
 	e.Attrs["lighting-color"] = lightingColor
 	return e
 }
 
 // MarkerEnd sets the marker-end attribute.
-func (e *ImageElement) MarkerEnd(markerEnd String) *ImageElement {
+func (e *MaskElement) MarkerEnd(markerEnd String) *MaskElement {
 	e.Attrs["marker-end"] = markerEnd
 	return e
 }
 
 // MarkerMid sets the marker-mid attribute.
-func (e *ImageElement) MarkerMid(markerMid String) *ImageElement {
+func (e *MaskElement) MarkerMid(markerMid String) *MaskElement {
 	e.Attrs["marker-mid"] = markerMid
 	return e
 }
 
 // MarkerStart sets the marker-start attribute.
-func (e *ImageElement) MarkerStart(markerStart String) *ImageElement {
+func (e *MaskElement) MarkerStart(markerStart String) *MaskElement {
 	e.Attrs["marker-start"] = markerStart
 	return e
 }
 
 // Mask sets the mask attribute.
-func (e *ImageElement) Mask(mask String) *ImageElement {
+func (e *MaskElement) Mask(mask String) *MaskElement {
 	e.Attrs["mask"] = mask
 	return e
 }
 
 // Opacity sets the opacity attribute.
-func (e *ImageElement) Opacity(opacity Float64) *ImageElement {
+func (e *MaskElement) Opacity(opacity Float64) *MaskElement {
 	e.Attrs["opacity"] = opacity
 	return e
 }
 
 // Overflow sets the overflow attribute.
-func (e *ImageElement) Overflow(overflow String) *ImageElement {
+func (e *MaskElement) Overflow(overflow String) *MaskElement {
 	e.Attrs["overflow"] = overflow
 	return e
 }
 
 // PaintOrder sets the paint-order attribute.
-func (e *ImageElement) PaintOrder(paintOrder String) *ImageElement {
+func (e *MaskElement) PaintOrder(paintOrder String) *MaskElement {
 	e.Attrs["paint-order"] = paintOrder
 	return e
 }
 
 // PointerEvents sets the pointer-events attribute.
-func (e *ImageElement) PointerEvents(pointerEvents String) *ImageElement {
+func (e *MaskElement) PointerEvents(pointerEvents String) *MaskElement {
 	e.Attrs["pointer-events"] = pointerEvents
 	return e
 }
 
 // ShapeRendering sets the shape-rendering attribute.
-func (e *ImageElement) ShapeRendering(shapeRendering String) *ImageElement {
+func (e *MaskElement) ShapeRendering(shapeRendering String) *MaskElement {
 	e.Attrs["shape-rendering"] = shapeRendering
 	return e
 }
 
 // StopColor sets the stop-color attribute.
-func (e *ImageElement) StopColor(stopColor String) *ImageElement {
+func (e *MaskElement) StopColor(stopColor Color) *MaskElement {
 	e.Attrs["stop-color"] = stopColor
 	return e
 }
 
 // StopOpacity sets the stop-opacity attribute.
-func (e *ImageElement) StopOpacity(stopOpacity Float64) *ImageElement {
+func (e *MaskElement) StopOpacity(stopOpacity Float64) *MaskElement {
 	e.Attrs["stop-opacity"] = stopOpacity
 	return e
 }
 
 // Stroke sets the stroke attribute.
-func (e *ImageElement) Stroke(stroke String) *ImageElement {
+func (e *MaskElement) Stroke(stroke String) *MaskElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *MaskElement) StrokeColor(stroke Color) *MaskElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *MaskElement) StrokePaint(stroke Paint) *MaskElement {
 	e.Attrs["stroke"] = stroke
 	return e
 }
 
 // StrokeDashArray sets the stroke-dasharray attribute.
-func (e *ImageElement) StrokeDashArray(strokeDashArray String) *ImageElement {
+func (e *MaskElement) StrokeDashArray(strokeDashArray String) *MaskElement {
 	e.Attrs["stroke-dasharray"] = strokeDashArray
 	return e
 }
 
 // StrokeDashOffset sets the stroke-dashoffset attribute.
-func (e *ImageElement) StrokeDashOffset(strokeDashOffset Float64) *ImageElement {
+func (e *MaskElement) StrokeDashOffset(strokeDashOffset Float64) *MaskElement {
 	e.Attrs["stroke-dashoffset"] = strokeDashOffset
 	return e
 }
 
 // StrokeLineCap sets the stroke-linecap attribute.
-func (e *ImageElement) StrokeLineCap(strokeLineCap String) *ImageElement {
+func (e *MaskElement) StrokeLineCap(strokeLineCap String) *MaskElement {
 	e.Attrs["stroke-linecap"] = strokeLineCap
 	return e
 }
 
 // StrokeLineJoin sets the stroke-linejoin attribute.
-func (e *ImageElement) StrokeLineJoin(strokeLineJoin String) *ImageElement {
+func (e *MaskElement) StrokeLineJoin(strokeLineJoin String) *MaskElement {
 	e.Attrs["stroke-linejoin"] = strokeLineJoin
 	return e
 }
 
 // StrokeMiterLimit sets the stroke-miterlimit attribute.
-func (e *ImageElement) StrokeMiterLimit(strokeMiterLimit Float64) *ImageElement {
+func (e *MaskElement) StrokeMiterLimit(strokeMiterLimit Float64) *MaskElement {
 	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
 	return e
 }
 
 // StrokeOpacity sets the stroke-opacity attribute.
-func (e *ImageElement) StrokeOpacity(strokeOpacity Float64) *ImageElement {
+func (e *MaskElement) StrokeOpacity(strokeOpacity Float64) *MaskElement {
 	e.Attrs["stroke-opacity"] = strokeOpacity
 	return e
 }
 
 // StrokeWidth sets the stroke-width attribute.
-func (e *ImageElement) StrokeWidth(strokeWidth Length) *ImageElement {
+func (e *MaskElement) StrokeWidth(strokeWidth Length) *MaskElement {
 	e.Attrs["stroke-width"] = strokeWidth
 	return e
 }
 
 // TextAnchor sets the text-anchor attribute.
-func (e *ImageElement) TextAnchor(textAnchor String) *ImageElement {
+func (e *MaskElement) TextAnchor(textAnchor String) *MaskElement {
 	e.Attrs["text-anchor"] = textAnchor
 	return e
 }
 
 // TextDecoration sets the text-decoration attribute.
-func (e *ImageElement) TextDecoration(textDecoration String) *ImageElement {
+func (e *MaskElement) TextDecoration(textDecoration String) *MaskElement {
 	e.Attrs["text-decoration"] = textDecoration
 	return e
 }
 
 // TextOverflow sets the text-overflow attribute.
-func (e *ImageElement) TextOverflow(textOverflow String) *ImageElement {
+func (e *MaskElement) TextOverflow(textOverflow String) *MaskElement {
 	e.Attrs["text-overflow"] = textOverflow
 	return e
 }
 
 // TextRendering sets the text-rendering attribute.
-func (e *ImageElement) TextRendering(textRendering String) *ImageElement {
+func (e *MaskElement) TextRendering(textRendering String) *MaskElement {
 	e.Attrs["text-rendering"] = textRendering
 	return e
 }
 
-// UnicodeBiDi sets the unicode-bidi attribute.
-func (e *ImageElement) UnicodeBiDi(unicodeBiDi String) *ImageElement {
-	e.Attrs["unicode-bidi"] = unicodeBiDi
+// Transform sets the transform attribute.
+func (e *MaskElement) Transform(transform Transform) *MaskElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
+// UnicodeBiDi sets the unicode-bidi attribute.
+func (e *MaskElement) UnicodeBiDi(unicodeBiDi String) *MaskElement {
+	e.Attrs["unicode-bidi"] = unicodeBiDi
+	return e
+}
+
+// VectorEffect sets the vector-effect attribute.
+func (e *MaskElement) VectorEffect(vectorEffect String) *MaskElement {
+	e.Attrs["vector-effect"] = vectorEffect
+	return e
+}
+
+// Visibility sets the visibility attribute.
+func (e *MaskElement) Visibility(visibility String) *MaskElement {
+	e.Attrs["visibility"] = visibility
+	return e
+}
+
+// WhiteSpace sets the white-space attribute.
+func (e *MaskElement) WhiteSpace(whiteSpace String) *MaskElement {
+	e.Attrs["white-space"] = whiteSpace
+	return e
+}
+
+// WordSpacing sets the word-spacing attribute.
+func (e *MaskElement) WordSpacing(wordSpacing String) *MaskElement {
+	e.Attrs["word-spacing"] = wordSpacing
+	return e
+}
+
+// WritingMode sets the writing-mode attribute.
+func (e *MaskElement) WritingMode(writingMode String) *MaskElement {
+	e.Attrs["writing-mode"] = writingMode
+	return e
+}
+
+// MaskUnits sets the maskUnits attribute.
+func (e *MaskElement) MaskUnits(maskUnits String) *MaskElement {
+	e.Attrs["maskUnits"] = maskUnits
+	return e
+}
+
+// MaskContentUnits sets the maskContentUnits attribute.
+func (e *MaskElement) MaskContentUnits(maskContentUnits String) *MaskElement {
+	e.Attrs["maskContentUnits"] = maskContentUnits
+	return e
+}
+
+// X sets the x attribute.
+func (e *MaskElement) X(x Length) *MaskElement {
+	e.Attrs["x"] = x
+	return e
+}
+
+// Y sets the y attribute.
+func (e *MaskElement) Y(y Length) *MaskElement {
+	e.Attrs["y"] = y
+	return e
+}
+
+// Width sets the width attribute.
+func (e *MaskElement) Width(width Length) *MaskElement {
+	e.Attrs["width"] = width
+	return e
+}
+
+// Height sets the height attribute.
+func (e *MaskElement) Height(height Length) *MaskElement {
+	e.Attrs["height"] = height
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *MaskElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *MaskElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *MaskElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *MaskElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *MaskElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *MaskElement) TagName() string {
+	return "mask"
+}
+
+// decode decodes e from d.
+func (e *MaskElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *MaskElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "x":
+		return parseLength(value)
+	case "y":
+		return parseLength(value)
+	case "width":
+		return parseLength(value)
+	case "height":
+		return parseLength(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A MetadataElement is a metadata element.
+type MetadataElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// Metadata returns a new MetadataElement.
+func Metadata(children ...Element) *MetadataElement {
+	return &MetadataElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *MetadataElement) AppendChildren(children ...Element) *MetadataElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *MetadataElement) RemoveAttrs(names ...string) *MetadataElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *MetadataElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *MetadataElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *MetadataElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *MetadataElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *MetadataElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *MetadataElement) TagName() string {
+	return "metadata"
+}
+
+// decode decodes e from d.
+func (e *MetadataElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *MetadataElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	default:
+		return String(value), nil
+	}
+}
+
+// A MPathElement is a mpath element.
+type MPathElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// MPath returns a new MPathElement.
+func MPath(children ...Element) *MPathElement {
+	return &MPathElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *MPathElement) AppendChildren(children ...Element) *MPathElement {
+	e.Children = append(e.Children, children...)
 	return e
 }
 
-// VectorEffect sets the vector-effect attribute.
-func (e *ImageElement) VectorEffect(vectorEffect String) *ImageElement {
-	e.Attrs["vector-effect"] = vectorEffect
+// RemoveAttrs removes the attributes with the given names.
+func (e *MPathElement) RemoveAttrs(names ...string) *MPathElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
 	return e
 }
 
-// Visibility sets the visibility attribute.
-func (e *ImageElement) Visibility(visibility String) *ImageElement {
-	e.Attrs["visibility"] = visibility
+// ID sets the id attribute.
+func (e *MPathElement) ID(id String) *MPathElement {
+	e.Attrs["id"] = id
 	return e
 }
 
-// WhiteSpace sets the white-space attribute.
-func (e *ImageElement) WhiteSpace(whiteSpace String) *ImageElement {
-	e.Attrs["white-space"] = whiteSpace
+// TabIndex sets the tabindex attribute.
+func (e *MPathElement) TabIndex(tabIndex Int) *MPathElement {
+	e.Attrs["tabindex"] = tabIndex
 	return e
 }
 
-// WordSpacing sets the word-spacing attribute.
-func (e *ImageElement) WordSpacing(wordSpacing String) *ImageElement {
-	e.Attrs["word-spacing"] = wordSpacing
+// Lang sets the lang attribute.
+func (e *MPathElement) Lang(lang String) *MPathElement {
+	e.Attrs["lang"] = lang
 	return e
 }
 
-// WritingMode sets the writing-mode attribute.
-func (e *ImageElement) WritingMode(writingMode String) *ImageElement {
-	e.Attrs["writing-mode"] = writingMode
+// Class sets the class attribute.
+func (e *MPathElement) Class(class String) *MPathElement {
+	e.Attrs["class"] = class
 	return e
 }
 
-// PreserveAspectRatio sets the preserveAspectRatio attribute.
-func (e *ImageElement) PreserveAspectRatio(preserveAspectRatio String) *ImageElement {
-	e.Attrs["preserveAspectRatio"] = preserveAspectRatio
+// Style sets the style attribute.
+func (e *MPathElement) Style(style String) *MPathElement {
+	e.Attrs["style"] = style
 	return e
 }
 
 // Href sets the href attribute.
-func (e *ImageElement) Href(href String) *ImageElement {
+func (e *MPathElement) Href(href String) *MPathElement {
 	e.Attrs["href"] = href
 	return e
 }
 
-// CrossOrigin sets the crossorigin attribute.
-func (e *ImageElement) CrossOrigin(crossOrigin String) *ImageElement {
-	e.Attrs["crossorigin"] = crossOrigin
-	return e
-}
-
-// X sets the x attribute.
-func (e *ImageElement) X(x Length) *ImageElement {
-	e.Attrs["x"] = x
-	return e
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *MPathElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
 }
 
-// Y sets the y attribute.
-func (e *ImageElement) Y(y Length) *ImageElement {
-	e.Attrs["y"] = y
-	return e
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *MPathElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
 }
 
-// Width sets the width attribute.
-func (e *ImageElement) Width(width Length) *ImageElement {
-	e.Attrs["width"] = width
-	return e
+// Attributes implements Node.Attributes.
+func (e *MPathElement) Attributes() map[string]AttrValue {
+	return e.Attrs
 }
 
-// Height sets the height attribute.
-func (e *ImageElement) Height(height Length) *ImageElement {
-	e.Attrs["height"] = height
-	return e
+// ChildElements implements Node.ChildElements.
+func (e *MPathElement) ChildElements() []Element {
+	return e.Children
 }
 
-// WidthHeight sets the width and height attributes.
-func (e *ImageElement) WidthHeight(width, height float64, lengthFunc LengthFunc) *ImageElement {
-	e.Attrs["width"] = lengthFunc(width)
-	e.Attrs["height"] = lengthFunc(height)
-	return e
+// SetChildElements implements Container.SetChildElements.
+func (e *MPathElement) SetChildElements(children []Element) {
+	e.Children = children
 }
 
-// XY sets the x and y attributes.
-func (e *ImageElement) XY(x, y float64, lengthFunc LengthFunc) *ImageElement {
-	e.Attrs["x"] = lengthFunc(x)
-	e.Attrs["y"] = lengthFunc(y)
-	return e
+// TagName implements Node.TagName.
+func (e *MPathElement) TagName() string {
+	return "mpath"
 }
 
-// XYWidthHeight sets the x, y, width, and height attributes.
-func (e *ImageElement) XYWidthHeight(x, y, width, height float64, lengthFunc LengthFunc) *ImageElement {
-	e.Attrs["x"] = lengthFunc(x)
-	e.Attrs["y"] = lengthFunc(y)
-	e.Attrs["width"] = lengthFunc(width)
-	e.Attrs["height"] = lengthFunc(height)
-	return e
+// decode decodes e from d.
+func (e *MPathElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
 }
 
-// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
-func (e *ImageElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
-	return encodeElement(encoder, "image", e.Attrs, nil)
+// parseAttr parses the value of the attribute name.
+func (e *MPathElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	default:
+		return String(value), nil
+	}
 }
 
-// A LineElement is a line element.
-type LineElement struct {
+// A PathElement is a path element.
+type PathElement struct {
 	Attrs    map[string]AttrValue
 	Children []Element
 }
 
-// Line returns a new LineElement.
-func Line(children ...Element) *LineElement {
-	return &LineElement{
+// Path returns a new PathElement.
+func Path(children ...Element) *PathElement {
+	return &PathElement{
 		Attrs:    map[string]AttrValue{},
 		Children: children,
 	}
 }
 
 // AppendChildren appends the given children.
-func (e *LineElement) AppendChildren(children ...Element) *LineElement {
+func (e *PathElement) AppendChildren(children ...Element) *PathElement {
 	e.Children = append(e.Children, children...)
 	return e
 }
 
+// RemoveAttrs removes the attributes with the given names.
+func (e *PathElement) RemoveAttrs(names ...string) *PathElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
 // ID sets the id attribute.
-func (e *LineElement) ID(id String) *LineElement {
+func (e *PathElement) ID(id String) *PathElement {
 	e.Attrs["id"] = id
 	return e
 }
 
 // TabIndex sets the tabindex attribute.
-func (e *LineElement) TabIndex(tabIndex Int) *LineElement {
+func (e *PathElement) TabIndex(tabIndex Int) *PathElement {
 	e.Attrs["tabindex"] = tabIndex
 	return e
 }
 
 // Lang sets the lang attribute.
-func (e *LineElement) Lang(lang String) *LineElement {
+func (e *PathElement) Lang(lang String) *PathElement {
 	e.Attrs["lang"] = lang
 	return e
 }
 
 // Class sets the class attribute.
-func (e *LineElement) Class(class String) *LineElement {
+func (e *PathElement) Class(class String) *PathElement {
 	e.Attrs["class"] = class
 	return e
 }
 
 // Style sets the style attribute.
-func (e *LineElement) Style(style String) *LineElement {
+func (e *PathElement) Style(style String) *PathElement {
 	e.Attrs["style"] = style
 	return e
 }
 
 // AlignmentBaseline sets the alignment-baseline attribute.
-func (e *LineElement) AlignmentBaseline(alignmentBaseline String) *LineElement {
+func (e *PathElement) AlignmentBaseline(alignmentBaseline String) *PathElement {
 	e.Attrs["alignment-baseline"] = alignmentBaseline
 	return e
 }
 
 // BaselineShift sets the baseline-shift attribute.
-func (e *LineElement) BaselineShift(baselineShift String) *LineElement {
+func (e *PathElement) BaselineShift(baselineShift String) *PathElement {
 	e.Attrs["baseline-shift"] = baselineShift
 	return e
 }
 
 // ClipPath sets the clip-path attribute.
-func (e *LineElement) ClipPath(clipPath String) *LineElement {
+func (e *PathElement) ClipPath(clipPath String) *PathElement {
 	e.Attrs["clip-path"] = clipPath
 	return e
 }
 
 // ClipRule sets the clip-rule attribute.
-func (e *LineElement) ClipRule(clipRule String) *LineElement {
+func (e *PathElement) ClipRule(clipRule String) *PathElement {
 	e.Attrs["clip-rule"] = clipRule
 	return e
 }
 
 // Color sets the color attribute.
-func (e *LineElement) Color(color String) *LineElement {
+func (e *PathElement) Color(color Color) *PathElement {
 	e.Attrs["color"] = color
 	return e
 }
 
 // ColorInterpolation sets the color-interpolation attribute.
-func (e *LineElement) ColorInterpolation(colorInterpolation String) *LineElement {
+func (e *PathElement) ColorInterpolation(colorInterpolation String) *PathElement {
 	e.Attrs["color-interpolation"] = colorInterpolation
 	return e
 }
 
 // ColorInterpolationFilters sets the color-interpolation-filters attribute.
-func (e *LineElement) ColorInterpolationFilters(colorInterpolationFilters String) *LineElement {
+func (e *PathElement) ColorInterpolationFilters(colorInterpolationFilters String) *PathElement {
 	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
 	return e
 }
 
 // ColorRendering sets the color-rendering attribute.
-func (e *LineElement) ColorRendering(colorRendering String) *LineElement {
+func (e *PathElement) ColorRendering(colorRendering String) *PathElement {
 	e.Attrs["color-rendering"] = colorRendering
 	return e
 }
 
 // Cursor sets the cursor attribute.
-func (e *LineElement) Cursor(cursor String) *LineElement {
+func (e *PathElement) Cursor(cursor String) *PathElement {
 	e.Attrs["cursor"] = cursor
 	return e
 }
 
 // Direction sets the direction attribute.
-func (e *LineElement) Direction(direction String) *LineElement {
+func (e *PathElement) Direction(direction String) *PathElement {
 	e.Attrs["direction"] = direction
 	return e
 }
 
 // Display sets the display attribute.
-func (e *LineElement) Display(display String) *LineElement {
+func (e *PathElement) Display(display String) *PathElement {
 	e.Attrs["display"] = display
 	return e
 }
 
 // DominantBaseline sets the dominant-baseline attribute.
-func (e *LineElement) DominantBaseline(dominantBaseline String) *LineElement {
+func (e *PathElement) DominantBaseline(dominantBaseline String) *PathElement {
 	e.Attrs["dominant-baseline"] = dominantBaseline
 	return e
 }
 
 // Fill sets the fill attribute.
-func (e *LineElement) Fill(fill String) *LineElement {
+func (e *PathElement) Fill(fill String) *PathElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *PathElement) FillColor(fill Color) *PathElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *PathElement) FillPaint(fill Paint) *PathElement {
 	e.Attrs["fill"] = fill
 	return e
 }
 
 // FillOpacity sets the fill-opacity attribute.
-func (e *LineElement) FillOpacity(fillOpacity Float64) *LineElement {
+func (e *PathElement) FillOpacity(fillOpacity Float64) *PathElement {
 	e.Attrs["fill-opacity"] = fillOpacity
 	return e
 }
 
 // FillRule sets the fill-rule attribute.
-func (e *LineElement) FillRule(fillRule String) *LineElement {
+func (e *PathElement) FillRule(fillRule String) *PathElement {
 	e.Attrs["fill-rule"] = fillRule
 	return e
 }
 
 // Filter sets the filter attribute.
-func (e *LineElement) Filter(filter String) *LineElement {
+func (e *PathElement) Filter(filter String) *PathElement {
 	e.Attrs["filter"] = filter
 	return e
 }
 
 // FloodColor sets the flood-color attribute.
-func (e *LineElement) FloodColor(floodColor String) *LineElement {
+func (e *PathElement) FloodColor(floodColor Color) *PathElement {
 	e.Attrs["flood-color"] = floodColor
 	return e
 }
 
 // FloodOpacity sets the flood-opacity attribute.
-func (e *LineElement) FloodOpacity(floodOpacity Float64) *LineElement {
+func (e *PathElement) FloodOpacity(floodOpacity Float64) *PathElement {
 	e.Attrs["flood-opacity"] = floodOpacity
 	return e
 }
 
 // FontFamily sets the font-family attribute.
-func (e *LineElement) FontFamily(fontFamily String) *LineElement {
+func (e *PathElement) FontFamily(fontFamily String) *PathElement {
 	e.Attrs["font-family"] = fontFamily
 	return e
 }
 
 // FontSize sets the font-size attribute.
-func (e *LineElement) FontSize(fontSize String) *LineElement {
+func (e *PathElement) FontSize(fontSize String) *PathElement {
 	e.Attrs["font-size"] = fontSize
 	return e
 }
 
 // FontSizeAdjust sets the font-size-adjust attribute.
-func (e *LineElement) FontSizeAdjust(fontSizeAdjust String) *LineElement {
+func (e *PathElement) FontSizeAdjust(fontSizeAdjust String) *PathElement {
 	e.Attrs["font-size-adjust"] = fontSizeAdjust
 	return e
 }
 
 // FontStretch sets the font-stretch attribute.
-func (e *LineElement) FontStretch(fontStretch String) *LineElement {
+func (e *PathElement) FontStretch(fontStretch String) *PathElement {
 	e.Attrs["font-stretch"] = fontStretch
 	return e
 }
 
 // FontStyle sets the font-style attribute.
-func (e *LineElement) FontStyle(fontStyle String) *LineElement {
+func (e *PathElement) FontStyle(fontStyle String) *PathElement {
 	e.Attrs["font-style"] = fontStyle
 	return e
 }
 
 // FontVariant sets the font-variant attribute.
-func (e *LineElement) FontVariant(fontVariant String) *LineElement {
+func (e *PathElement) FontVariant(fontVariant String) *PathElement {
 	e.Attrs["font-variant"] = fontVariant
 	return e
 }
 
 // FontWeight sets the font-weight attribute.
-func (e *LineElement) FontWeight(fontWeight String) *LineElement {
+func (e *PathElement) FontWeight(fontWeight String) *PathElement {
 	e.Attrs["font-weight"] = fontWeight
 	return e
 }
 
 // GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
-func (e *LineElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *LineElement {
+func (e *PathElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *PathElement {
 	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
 	return e
 }
 
 // GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
-func (e *LineElement) GlyphOrientationVertical(glyphOrientationVertical String) *LineElement {
+func (e *PathElement) GlyphOrientationVertical(glyphOrientationVertical String) *PathElement {
 	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
 	return e
 }
 
 // ImageRendering sets the image-rendering attribute.
-func (e *LineElement) ImageRendering(imageRendering String) *LineElement {
+func (e *PathElement) ImageRendering(imageRendering String) *PathElement {
 	e.Attrs["image-rendering"] = imageRendering
 	return e
 }
 
 // LetterSpacing sets the letter-spacing attribute.
-func (e *LineElement) LetterSpacing(letterSpacing String) *LineElement {
+func (e *PathElement) LetterSpacing(letterSpacing String) *PathElement {
 	e.Attrs["letter-spacing"] = letterSpacing
 	return e
 }
 
 // LightingColor sets the lighting-color attribute.
-func (e *LineElement) LightingColor(lightingColor String) *LineElement {
+func (e *PathElement) LightingColor(lightingColor Color) *PathElement {
 	e.Attrs["lighting-color"] = lightingColor
 	return e
 }
 
 // MarkerEnd sets the marker-end attribute.
-func (e *LineElement) MarkerEnd(markerEnd String) *LineElement {
+func (e *PathElement) MarkerEnd(markerEnd String) *PathElement {
 	e.Attrs["marker-end"] = markerEnd
 	return e
 }
 
 // MarkerMid sets the marker-mid attribute.
-func (e *LineElement) MarkerMid(markerMid String) *LineElement {
+func (e *PathElement) MarkerMid(markerMid String) *PathElement {
 	e.Attrs["marker-mid"] = markerMid
 	return e
 }
 
 // MarkerStart sets the marker-start attribute.
-func (e *LineElement) MarkerStart(markerStart String) *LineElement {
+func (e *PathElement) MarkerStart(markerStart String) *PathElement {
 	e.Attrs["marker-start"] = markerStart
 	return e
 }
 
 // Mask sets the mask attribute.
-func (e *LineElement) Mask(mask String) *LineElement {
+func (e *PathElement) Mask(mask String) *PathElement {
 	e.Attrs["mask"] = mask
 	return e
 }
 
 // Opacity sets the opacity attribute.
-func (e *LineElement) Opacity(opacity Float64) *LineElement {
+func (e *PathElement) Opacity(opacity Float64) *PathElement {
 	e.Attrs["opacity"] = opacity
 	return e
 }
 
 // Overflow sets the overflow attribute.
-func (e *LineElement) Overflow(overflow String) *LineElement {
+func (e *PathElement) Overflow(overflow String) *PathElement {
 	e.Attrs["overflow"] = overflow
 	return e
 }
 
 // PaintOrder sets the paint-order attribute.
-func (e *LineElement) PaintOrder(paintOrder String) *LineElement {
+func (e *PathElement) PaintOrder(paintOrder String) *PathElement {
 	e.Attrs["paint-order"] = paintOrder
 	return e
 }
 
 // PointerEvents sets the pointer-events attribute.
-func (e *LineElement) PointerEvents(pointerEvents String) *LineElement {
+func (e *PathElement) PointerEvents(pointerEvents String) *PathElement {
 	e.Attrs["pointer-events"] = pointerEvents
 	return e
 }
 
 // ShapeRendering sets the shape-rendering attribute.
-func (e *LineElement) ShapeRendering(shapeRendering String) *LineElement {
+func (e *PathElement) ShapeRendering(shapeRendering String) *PathElement {
 	e.Attrs["shape-rendering"] = shapeRendering
 	return e
 }
 
 // StopColor sets the stop-color attribute.
-func (e *LineElement) StopColor(stopColor String) *LineElement {
+func (e *PathElement) StopColor(stopColor Color) *PathElement {
 	e.Attrs["stop-color"] = stopColor
 	return e
 }
 
 // StopOpacity sets the stop-opacity attribute.
-func (e *LineElement) StopOpacity(stopOpacity Float64) *LineElement {
+func (e *PathElement) StopOpacity(stopOpacity Float64) *PathElement {
 	e.Attrs["stop-opacity"] = stopOpacity
 	return e
 }
 
 // Stroke sets the stroke attribute.
-func (e *LineElement) Stroke(stroke String) *LineElement {
+func (e *PathElement) Stroke(stroke String) *PathElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *PathElement) StrokeColor(stroke Color) *PathElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *PathElement) StrokePaint(stroke Paint) *PathElement {
 	e.Attrs["stroke"] = stroke
 	return e
 }
 
 // StrokeDashArray sets the stroke-dasharray attribute.
-func (e *LineElement) StrokeDashArray(strokeDashArray String) *LineElement {
+func (e *PathElement) StrokeDashArray(strokeDashArray String) *PathElement {
 	e.Attrs["stroke-dasharray"] = strokeDashArray
 	return e
 }
 
 // StrokeDashOffset sets the stroke-dashoffset attribute.
-func (e *LineElement) StrokeDashOffset(strokeDashOffset Float64) *LineElement {
+func (e *PathElement) StrokeDashOffset(strokeDashOffset Float64) *PathElement {
 	e.Attrs["stroke-dashoffset"] = strokeDashOffset
 	return e
 }
 
 // StrokeLineCap sets the stroke-linecap attribute.
-func (e *LineElement) StrokeLineCap(strokeLineCap String) *LineElement {
+func (e *PathElement) StrokeLineCap(strokeLineCap String) *PathElement {
 	e.Attrs["stroke-linecap"] = strokeLineCap
 	return e
 }
 
 // StrokeLineJoin sets the stroke-linejoin attribute.
-func (e *LineElement) StrokeLineJoin(strokeLineJoin String) *LineElement {
+func (e *PathElement) StrokeLineJoin(strokeLineJoin String) *PathElement {
 	e.Attrs["stroke-linejoin"] = strokeLineJoin
 	return e
 }
 
 // StrokeMiterLimit sets the stroke-miterlimit attribute.
-func (e *LineElement) StrokeMiterLimit(strokeMiterLimit Float64) *LineElement {
+func (e *PathElement) StrokeMiterLimit(strokeMiterLimit Float64) *PathElement {
 	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
 	return e
 }
 
 // StrokeOpacity sets the stroke-opacity attribute.
-func (e *LineElement) StrokeOpacity(strokeOpacity Float64) *LineElement {
+func (e *PathElement) StrokeOpacity(strokeOpacity Float64) *PathElement {
 	e.Attrs["stroke-opacity"] = strokeOpacity
 	return e
 }
 
 // StrokeWidth sets the stroke-width attribute.
-func (e *LineElement) StrokeWidth(strokeWidth Length) *LineElement {
+func (e *PathElement) StrokeWidth(strokeWidth Length) *PathElement {
 	e.Attrs["stroke-width"] = strokeWidth
 	return e
 }
 
 // TextAnchor sets the text-anchor attribute.
-func (e *LineElement) TextAnchor(textAnchor String) *LineElement {
+func (e *PathElement) TextAnchor(textAnchor String) *PathElement {
 	e.Attrs["text-anchor"] = textAnchor
 	return e
 }
 
 // TextDecoration sets the text-decoration attribute.
-func (e *LineElement) TextDecoration(textDecoration String) *LineElement {
+func (e *PathElement) TextDecoration(textDecoration String) *PathElement {
 	e.Attrs["text-decoration"] = textDecoration
 	return e
 }
 
 // TextOverflow sets the text-overflow attribute.
-func (e *LineElement) TextOverflow(textOverflow String) *LineElement {
+func (e *PathElement) TextOverflow(textOverflow String) *PathElement {
 	e.Attrs["text-overflow"] = textOverflow
 	return e
 }
 
 // TextRendering sets the text-rendering attribute.
-func (e *LineElement) TextRendering(textRendering String) *LineElement {
+func (e *PathElement) TextRendering(textRendering String) *PathElement {
 	e.Attrs["text-rendering"] = textRendering
 	return e
 }
 
+// Transform sets the transform attribute.
+func (e *PathElement) Transform(transform Transform) *PathElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
 // UnicodeBiDi sets the unicode-bidi attribute.
-func (e *LineElement) UnicodeBiDi(unicodeBiDi String) *LineElement {
+func (e *PathElement) UnicodeBiDi(unicodeBiDi String) *PathElement {
 	e.Attrs["unicode-bidi"] = unicodeBiDi
 	return e
 }
 
 // VectorEffect sets the vector-effect attribute.
-func (e *LineElement) VectorEffect(vectorEffect String) *LineElement {
+func (e *PathElement) VectorEffect(vectorEffect String) *PathElement {
 	e.Attrs["vector-effect"] = vectorEffect
 	return e
 }
 
 // Visibility sets the visibility attribute.
-func (e *LineElement) Visibility(visibility String) *LineElement {
+func (e *PathElement) Visibility(visibility String) *PathElement {
 	e.Attrs["visibility"] = visibility
 	return e
 }
 
 // WhiteSpace sets the white-space attribute.
-func (e *LineElement) WhiteSpace(whiteSpace String) *LineElement {
+func (e *PathElement) WhiteSpace(whiteSpace String) *PathElement {
 	e.Attrs["white-space"] = whiteSpace
 	return e
 }
 
 // WordSpacing sets the word-spacing attribute.
-func (e *LineElement) WordSpacing(wordSpacing String) *LineElement {
+func (e *PathElement) WordSpacing(wordSpacing String) *PathElement {
 	e.Attrs["word-spacing"] = wordSpacing
 	return e
 }
 
 // WritingMode sets the writing-mode attribute.
-func (e *LineElement) WritingMode(writingMode String) *LineElement {
+func (e *PathElement) WritingMode(writingMode String) *PathElement {
 	e.Attrs["writing-mode"] = writingMode
 	return e
 }
 
-// PathLength sets the pathLength attribute.
-func (e *LineElement) PathLength(pathLength String) *LineElement {
-	e.Attrs["pathLength"] = pathLength
+// D sets the d attribute.
+func (e *PathElement) D(d AttrValue) *PathElement {
+	e.Attrs["d"] = d
 	return e
 }
 
-// X1 sets the x1 attribute.
-func (e *LineElement) X1(x1 String) *LineElement {
-	e.Attrs["x1"] = x1
-	return e
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *PathElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
 }
 
-// Y1 sets the y1 attribute.
-func (e *LineElement) Y1(y1 String) *LineElement {
-	e.Attrs["y1"] = y1
-	return e
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *PathElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
 }
 
-// X2 sets the x2 attribute.
-func (e *LineElement) X2(x2 String) *LineElement {
-	e.Attrs["x2"] = x2
-	return e
+// Attributes implements Node.Attributes.
+func (e *PathElement) Attributes() map[string]AttrValue {
+	return e.Attrs
 }
 
-// Y2 sets the y2 attribute.
-func (e *LineElement) Y2(y2 String) *LineElement {
-	e.Attrs["y2"] = y2
-	return e
+// ChildElements implements Node.ChildElements.
+func (e *PathElement) ChildElements() []Element {
+	return e.Children
 }
 
-// X1Y1X2Y2 sets the x1, y1, x2, and y2 attributes.
-func (e *LineElement) X1Y1X2Y2(x1, y1, x2, y2 float64) *LineElement {
-	e.Attrs["x1"] = Float64(x1)
-	e.Attrs["y1"] = Float64(y1)
-	e.Attrs["x2"] = Float64(x2)
-	e.Attrs["y2"] = Float64(y2)
-	return e
+// SetChildElements implements Container.SetChildElements.
+func (e *PathElement) SetChildElements(children []Element) {
+	e.Children = children
 }
 
-// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
-func (e *LineElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
-	return encodeElement(encoder, "line", e.Attrs, e.Children)
+// TagName implements Node.TagName.
+func (e *PathElement) TagName() string {
+	return "path"
 }
 
-// A MarkerElement is a marker element.
-type MarkerElement struct {
+// decode decodes e from d.
+func (e *PathElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *PathElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "d":
+		return parsePath(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A PatternElement is a pattern element.
+type PatternElement struct {
 	Attrs    map[string]AttrValue
 	Children []Element
 }
 
-// Marker returns a new MarkerElement.
-func Marker(children ...Element) *MarkerElement {
-	return &MarkerElement{
+// Pattern returns a new PatternElement.
+func Pattern(children ...Element) *PatternElement {
+	return &PatternElement{
 		Attrs:    map[string]AttrValue{},
 		Children: children,
 	}
 }
 
 // AppendChildren appends the given children.
-func (e *MarkerElement) AppendChildren(children ...Element) *MarkerElement {
+func (e *PatternElement) AppendChildren(children ...Element) *PatternElement {
 	e.Children = append(e.Children, children...)
 	return e
 }
 
+// RemoveAttrs removes the attributes with the given names.
+func (e *PatternElement) RemoveAttrs(names ...string) *PatternElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
 // ID sets the id attribute.
-func (e *MarkerElement) ID(id String) *MarkerElement {
+func (e *PatternElement) ID(id String) *PatternElement {
 	e.Attrs["id"] = id
 	return e
 }
 
 // TabIndex sets the tabindex attribute.
-func (e *MarkerElement) TabIndex(tabIndex Int) *MarkerElement {
+func (e *PatternElement) TabIndex(tabIndex Int) *PatternElement {
 	e.Attrs["tabindex"] = tabIndex
 	return e
 }
 
 // Lang sets the lang attribute.
-func (e *MarkerElement) Lang(lang String) *MarkerElement {
+func (e *PatternElement) Lang(lang String) *PatternElement {
 	e.Attrs["lang"] = lang
 	return e
 }
 
 // Class sets the class attribute.
-func (e *MarkerElement) Class(class String) *MarkerElement {
+func (e *PatternElement) Class(class String) *PatternElement {
 	e.Attrs["class"] = class
 	return e
 }
 
 // Style sets the style attribute.
-func (e *MarkerElement) Style(style String) *MarkerElement {
+func (e *PatternElement) Style(style String) *PatternElement {
 	e.Attrs["style"] = style
 	return e
 }
 
 // AlignmentBaseline sets the alignment-baseline attribute.
-func (e *MarkerElement) AlignmentBaseline(alignmentBaseline String) *MarkerElement {
+func (e *PatternElement) AlignmentBaseline(alignmentBaseline String) *PatternElement {
 	e.Attrs["alignment-baseline"] = alignmentBaseline
 	return e
 }
 
 // BaselineShift sets the baseline-shift attribute.
-func (e *MarkerElement) BaselineShift(baselineShift String) *MarkerElement {
+func (e *PatternElement) BaselineShift(baselineShift String) *PatternElement {
 	e.Attrs["baseline-shift"] = baselineShift
 	return e
 }
 
 // ClipPath sets the clip-path attribute.
-func (e *MarkerElement) ClipPath(clipPath String) *MarkerElement {
+func (e *PatternElement) ClipPath(clipPath String) *PatternElement {
 	e.Attrs["clip-path"] = clipPath
 	return e
 }
 
 // ClipRule sets the clip-rule attribute.
-func (e *MarkerElement) ClipRule(clipRule String) *MarkerElement {
+func (e *PatternElement) ClipRule(clipRule String) *PatternElement {
 	e.Attrs["clip-rule"] = clipRule
 	return e
 }
 
 // Color sets the color attribute.
-func (e *MarkerElement) Color(color String) *MarkerElement {
+func (e *PatternElement) Color(color Color) *PatternElement {
 	e.Attrs["color"] = color
 	return e
 }
 
 // ColorInterpolation sets the color-interpolation attribute.
-func (e *MarkerElement) ColorInterpolation(colorInterpolation String) *MarkerElement {
+func (e *PatternElement) ColorInterpolation(colorInterpolation String) *PatternElement {
 	e.Attrs["color-interpolation"] = colorInterpolation
 	return e
 }
 
 // ColorInterpolationFilters sets the color-interpolation-filters attribute.
-func (e *MarkerElement) ColorInterpolationFilters(colorInterpolationFilters String) *MarkerElement {
+func (e *PatternElement) ColorInterpolationFilters(colorInterpolationFilters String) *PatternElement {
 	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
 	return e
 }
 
 // ColorRendering sets the color-rendering attribute.
-func (e *MarkerElement) ColorRendering(colorRendering String) *MarkerElement {
+func (e *PatternElement) ColorRendering(colorRendering String) *PatternElement {
 	e.Attrs["color-rendering"] = colorRendering
 	return e
 }
 
 // Cursor sets the cursor attribute.
-func (e *MarkerElement) Cursor(cursor String) *MarkerElement {
+func (e *PatternElement) Cursor(cursor String) *PatternElement {
 	e.Attrs["cursor"] = cursor
 	return e
 }
 
 // Direction sets the direction attribute.
-func (e *MarkerElement) Direction(direction String) *MarkerElement {
+func (e *PatternElement) Direction(direction String) *PatternElement {
 	e.Attrs["direction"] = direction
 	return e
 }
 
 // Display sets the display attribute.
-func (e *MarkerElement) Display(display String) *MarkerElement {
+func (e *PatternElement) Display(display String) *PatternElement {
 	e.Attrs["display"] = display
 	return e
 }
 
 // DominantBaseline sets the dominant-baseline attribute.
-func (e *MarkerElement) DominantBaseline(dominantBaseline String) *MarkerElement {
+func (e *PatternElement) DominantBaseline(dominantBaseline String) *PatternElement {
 	e.Attrs["dominant-baseline"] = dominantBaseline
 	return e
 }
 
 // Fill sets the fill attribute.
-func (e *MarkerElement) Fill(fill String) *MarkerElement {
+func (e *PatternElement) Fill(fill String) *PatternElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *PatternElement) FillColor(fill Color) *PatternElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *PatternElement) FillPaint(fill Paint) *PatternElement {
 	e.Attrs["fill"] = fill
 	return e
 }
 
 // FillOpacity sets the fill-opacity attribute.
-func (e *MarkerElement) FillOpacity(fillOpacity Float64) *MarkerElement {
+func (e *PatternElement) FillOpacity(fillOpacity Float64) *PatternElement {
 	e.Attrs["fill-opacity"] = fillOpacity
 	return e
 }
 
 // FillRule sets the fill-rule attribute.
-func (e *MarkerElement) FillRule(fillRule String) *MarkerElement {
+func (e *PatternElement) FillRule(fillRule String) *PatternElement {
 	e.Attrs["fill-rule"] = fillRule
 	return e
 }
 
 // Filter sets the filter attribute.
-func (e *MarkerElement) Filter(filter String) *MarkerElement {
+func (e *PatternElement) Filter(filter String) *PatternElement {
 	e.Attrs["filter"] = filter
 	return e
 }
 
 // FloodColor sets the flood-color attribute.
-func (e *MarkerElement) FloodColor(floodColor String) *MarkerElement {
+func (e *PatternElement) FloodColor(floodColor Color) *PatternElement {
 	e.Attrs["flood-color"] = floodColor
 	return e
 }
 
 // FloodOpacity sets the flood-opacity attribute.
-func (e *MarkerElement) FloodOpacity(floodOpacity Float64) *MarkerElement {
+func (e *PatternElement) FloodOpacity(floodOpacity Float64) *PatternElement {
 	e.Attrs["flood-opacity"] = floodOpacity
 	return e
 }
 
 // FontFamily sets the font-family attribute.
-func (e *MarkerElement) FontFamily(fontFamily String) *MarkerElement {
+func (e *PatternElement) FontFamily(fontFamily String) *PatternElement {
 	e.Attrs["font-family"] = fontFamily
 	return e
 }
 
 // FontSize sets the font-size attribute.
-func (e *MarkerElement) FontSize(fontSize String) *MarkerElement {
+func (e *PatternElement) FontSize(fontSize String) *PatternElement {
 	e.Attrs["font-size"] = fontSize
 	return e
 }
 
 // FontSizeAdjust sets the font-size-adjust attribute.
-func (e *MarkerElement) FontSizeAdjust(fontSizeAdjust String) *MarkerElement {
+func (e *PatternElement) FontSizeAdjust(fontSizeAdjust String) *PatternElement {
 	e.Attrs["font-size-adjust"] = fontSizeAdjust
 	return e
 }
 
 // FontStretch sets the font-stretch attribute.
-func (e *MarkerElement) FontStretch(fontStretch String) *MarkerElement {
+func (e *PatternElement) FontStretch(fontStretch String) *PatternElement {
 	e.Attrs["font-stretch"] = fontStretch
 	return e
 }
 
 // FontStyle sets the font-style attribute.
-func (e *MarkerElement) FontStyle(fontStyle String) *MarkerElement {
+func (e *PatternElement) FontStyle(fontStyle String) *PatternElement {
 	e.Attrs["font-style"] = fontStyle
 	return e
 }
 
 // FontVariant sets the font-variant attribute.
-func (e *MarkerElement) FontVariant(fontVariant String) *MarkerElement {
+func (e *PatternElement) FontVariant(fontVariant String) *PatternElement {
 	e.Attrs["font-variant"] = fontVariant
 	return e
 }
 
 // FontWeight sets the font-weight attribute.
-func (e *MarkerElement) FontWeight(fontWeight String) *MarkerElement {
+func (e *PatternElement) FontWeight(fontWeight String) *PatternElement {
 	e.Attrs["font-weight"] = fontWeight
 	return e
 }
 
 // GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
-func (e *MarkerElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *MarkerElement {
+func (e *PatternElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *PatternElement {
 	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
 	return e
 }
 
 // GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
-func (e *MarkerElement) GlyphOrientationVertical(glyphOrientationVertical String) *MarkerElement {
+func (e *PatternElement) GlyphOrientationVertical(glyphOrientationVertical String) *PatternElement {
 	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
 	return e
 }
 
 // ImageRendering sets the image-rendering attribute.
-func (e *MarkerElement) ImageRendering(imageRendering String) *MarkerElement {
+func (e *PatternElement) ImageRendering(imageRendering String) *PatternElement {
 	e.Attrs["image-rendering"] = imageRendering
 	return e
 }
 
 // LetterSpacing sets the letter-spacing attribute.
-func (e *MarkerElement) LetterSpacing(letterSpacing String) *MarkerElement {
+func (e *PatternElement) LetterSpacing(letterSpacing String) *PatternElement {
 	e.Attrs["letter-spacing"] = letterSpacing
 	return e
 }
 
 // LightingColor sets the lighting-color attribute.
-func (e *MarkerElement) LightingColor(lightingColor String) *MarkerElement {
+func (e *PatternElement) LightingColor(lightingColor Color) *PatternElement {
 	e.Attrs["lighting-color"] = lightingColor
 	return e
 }
 
 // MarkerEnd sets the marker-end attribute.
-func (e *MarkerElement) MarkerEnd(markerEnd String) *MarkerElement {
+func (e *PatternElement) MarkerEnd(markerEnd String) *PatternElement {
 	e.Attrs["marker-end"] = markerEnd
 	return e
 }
 
 // MarkerMid sets the marker-mid attribute.
-func (e *MarkerElement) MarkerMid(markerMid String) *MarkerElement {
+func (e *PatternElement) MarkerMid(markerMid String) *PatternElement {
 	e.Attrs["marker-mid"] = markerMid
 	return e
 }
 
 // MarkerStart sets the marker-start attribute.
-func (e *MarkerElement) MarkerStart(markerStart String) *MarkerElement {
+func (e *PatternElement) MarkerStart(markerStart String) *PatternElement {
 	e.Attrs["marker-start"] = markerStart
 	return e
 }
 
 // Mask sets the mask attribute.
-func (e *MarkerElement) Mask(mask String) *MarkerElement {
+func (e *PatternElement) Mask(mask String) *PatternElement {
 	e.Attrs["mask"] = mask
 	return e
 }
 
 // Opacity sets the opacity attribute.
-func (e *MarkerElement) Opacity(opacity Float64) *MarkerElement {
+func (e *PatternElement) Opacity(opacity Float64) *PatternElement {
 	e.Attrs["opacity"] = opacity
 	return e
 }
 
 // Overflow sets the overflow attribute.
-func (e *MarkerElement) Overflow(overflow String) *MarkerElement {
+func (e *PatternElement) Overflow(overflow String) *PatternElement {
 	e.Attrs["overflow"] = overflow
 	return e
 }
 
 // PaintOrder sets the paint-order attribute.
-func (e *MarkerElement) PaintOrder(paintOrder String) *MarkerElement {
+func (e *PatternElement) PaintOrder(paintOrder String) *PatternElement {
 	e.Attrs["paint-order"] = paintOrder
 	return e
 }
 
 // PointerEvents sets the pointer-events attribute.
-func (e *MarkerElement) PointerEvents(pointerEvents String) *MarkerElement {
+func (e *PatternElement) PointerEvents(pointerEvents String) *PatternElement {
 	e.Attrs["pointer-events"] = pointerEvents
 	return e
 }
 
 // ShapeRendering sets the shape-rendering attribute.
-func (e *MarkerElement) ShapeRendering(shapeRendering String) *MarkerElement {
+func (e *PatternElement) ShapeRendering(shapeRendering String) *PatternElement {
 	e.Attrs["shape-rendering"] = shapeRendering
 	return e
 }
 
 // StopColor sets the stop-color attribute.
-func (e *MarkerElement) StopColor(stopColor String) *MarkerElement {
+func (e *PatternElement) StopColor(stopColor Color) *PatternElement {
 	e.Attrs["stop-color"] = stopColor
 	return e
 }
 
 // StopOpacity sets the stop-opacity attribute.
-func (e *MarkerElement) StopOpacity(stopOpacity Float64) *MarkerElement {
+func (e *PatternElement) StopOpacity(stopOpacity Float64) *PatternElement {
 	e.Attrs["stop-opacity"] = stopOpacity
 	return e
 }
 
 // Stroke sets the stroke attribute.
-func (e *MarkerElement) Stroke(stroke String) *MarkerElement {
+func (e *PatternElement) Stroke(stroke String) *PatternElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *PatternElement) StrokeColor(stroke Color) *PatternElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *PatternElement) StrokePaint(stroke Paint) *PatternElement {
 	e.Attrs["stroke"] = stroke
 	return e
 }
 
 // StrokeDashArray sets the stroke-dasharray attribute.
-func (e *MarkerElement) StrokeDashArray(strokeDashArray String) *MarkerElement {
+func (e *PatternElement) StrokeDashArray(strokeDashArray String) *PatternElement {
 	e.Attrs["stroke-dasharray"] = strokeDashArray
 	return e
 }
 
 // StrokeDashOffset sets the stroke-dashoffset attribute.
-func (e *MarkerElement) StrokeDashOffset(strokeDashOffset Float64) *MarkerElement {
+func (e *PatternElement) StrokeDashOffset(strokeDashOffset Float64) *PatternElement {
 	e.Attrs["stroke-dashoffset"] = strokeDashOffset
 	return e
 }
 
 // StrokeLineCap sets the stroke-linecap attribute.
-func (e *MarkerElement) StrokeLineCap(strokeLineCap String) *MarkerElement {
+func (e *PatternElement) StrokeLineCap(strokeLineCap String) *PatternElement {
 	e.Attrs["stroke-linecap"] = strokeLineCap
 	return e
 }
 
 // StrokeLineJoin sets the stroke-linejoin attribute.
-func (e *MarkerElement) StrokeLineJoin(strokeLineJoin String) *MarkerElement {
+func (e *PatternElement) StrokeLineJoin(strokeLineJoin String) *PatternElement {
 	e.Attrs["stroke-linejoin"] = strokeLineJoin
 	return e
 }
 
 // StrokeMiterLimit sets the stroke-miterlimit attribute.
-func (e *MarkerElement) StrokeMiterLimit(strokeMiterLimit Float64) *MarkerElement {
+func (e *PatternElement) StrokeMiterLimit(strokeMiterLimit Float64) *PatternElement {
 	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
 	return e
 }
 
 // StrokeOpacity sets the stroke-opacity attribute.
-func (e *MarkerElement) StrokeOpacity(strokeOpacity Float64) *MarkerElement {
+func (e *PatternElement) StrokeOpacity(strokeOpacity Float64) *PatternElement {
 	e.Attrs["stroke-opacity"] = strokeOpacity
 	return e
 }
 
 // StrokeWidth sets the stroke-width attribute.
-func (e *MarkerElement) StrokeWidth(strokeWidth Length) *MarkerElement {
+func (e *PatternElement) StrokeWidth(strokeWidth Length) *PatternElement {
 	e.Attrs["stroke-width"] = strokeWidth
 	return e
 }
 
 // TextAnchor sets the text-anchor attribute.
-func (e *MarkerElement) TextAnchor(textAnchor String) *MarkerElement {
+func (e *PatternElement) TextAnchor(textAnchor String) *PatternElement {
 	e.Attrs["text-anchor"] = textAnchor
 	return e
 }
 
 // TextDecoration sets the text-decoration attribute.
-func (e *MarkerElement) TextDecoration(textDecoration String) *MarkerElement {
+func (e *PatternElement) TextDecoration(textDecoration String) *PatternElement {
 	e.Attrs["text-decoration"] = textDecoration
 	return e
 }
 
 // TextOverflow sets the text-overflow attribute.
-func (e *MarkerElement) TextOverflow(textOverflow String) *MarkerElement {
+func (e *PatternElement) TextOverflow(textOverflow String) *PatternElement {
 	e.Attrs["text-overflow"] = textOverflow
 	return e
 }
 
 // TextRendering sets the text-rendering attribute.
-func (e *MarkerElement) TextRendering(textRendering String) *MarkerElement {
+func (e *PatternElement) TextRendering(textRendering String) *PatternElement {
 	e.Attrs["text-rendering"] = textRendering
 	return e
 }
 
+// Transform sets the transform attribute.
+func (e *PatternElement) Transform(transform Transform) *PatternElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
 // UnicodeBiDi sets the unicode-bidi attribute.
-func (e *MarkerElement) UnicodeBiDi(unicodeBiDi String) *MarkerElement {
+func (e *PatternElement) UnicodeBiDi(unicodeBiDi String) *PatternElement {
 	e.Attrs["unicode-bidi"] = unicodeBiDi
 	return e
 }
 
 // VectorEffect sets the vector-effect attribute.
-func (e *MarkerElement) VectorEffect(vectorEffect String) *MarkerElement {
+func (e *PatternElement) VectorEffect(vectorEffect String) *PatternElement {
 	e.Attrs["vector-effect"] = vectorEffect
 	return e
 }
 
 // Visibility sets the visibility attribute.
-func (e *MarkerElement) Visibility(visibility String) *MarkerElement {
+func (e *PatternElement) Visibility(visibility String) *PatternElement {
 	e.Attrs["visibility"] = visibility
 	return e
 }
 
 // WhiteSpace sets the white-space attribute.
-func (e *MarkerElement) WhiteSpace(whiteSpace String) *MarkerElement {
+func (e *PatternElement) WhiteSpace(whiteSpace String) *PatternElement {
 	e.Attrs["white-space"] = whiteSpace
 	return e
 }
 
 // WordSpacing sets the word-spacing attribute.
-func (e *MarkerElement) WordSpacing(wordSpacing String) *MarkerElement {
+func (e *PatternElement) WordSpacing(wordSpacing String) *PatternElement {
 	e.Attrs["word-spacing"] = wordSpacing
 	return e
 }
 
 // WritingMode sets the writing-mode attribute.
-func (e *MarkerElement) WritingMode(writingMode String) *MarkerElement {
+func (e *PatternElement) WritingMode(writingMode String) *PatternElement {
 	e.Attrs["writing-mode"] = writingMode
 	return e
 }
 
 // ViewBox sets the viewBox attribute.
-func (e *MarkerElement) ViewBox(minX, minY, width, height float64) *MarkerElement {
+func (e *PatternElement) ViewBox(minX, minY, width, height float64) *PatternElement {
 	e.Attrs["viewBox"] = ViewBox{MinX: minX, MinY: minY, Width: width, Height: height}
 	return e
 }
 
 // PreserveAspectRatio sets the preserveAspectRatio attribute.
-func (e *MarkerElement) PreserveAspectRatio(preserveAspectRatio String) *MarkerElement {
+func (e *PatternElement) PreserveAspectRatio(preserveAspectRatio String) *PatternElement {
 	e.Attrs["preserveAspectRatio"] = preserveAspectRatio
 	return e
 }
 
-// RefX sets the refX attribute.
-func (e *MarkerElement) RefX(refX Float64) *MarkerElement {
-	e.Attrs["refX"] = refX
+// PatternUnits sets the patternUnits attribute.
+func (e *PatternElement) PatternUnits(patternUnits String) *PatternElement {
+	e.Attrs["patternUnits"] = patternUnits
 	return e
 }
 
-// RefY sets the refY attribute.
-func (e *MarkerElement) RefY(refY Float64) *MarkerElement {
-	e.Attrs["refY"] = refY
+// PatternContentUnits sets the patternContentUnits attribute.
+func (e *PatternElement) PatternContentUnits(patternContentUnits String) *PatternElement {
+	e.Attrs["patternContentUnits"] = patternContentUnits
 	return e
 }
 
-// MarkerUnits sets the markerUnits attribute.
-func (e *MarkerElement) MarkerUnits(markerUnits String) *MarkerElement {
-	e.Attrs["markerUnits"] = markerUnits
+// PatternTransform sets the patternTransform attribute.
+func (e *PatternElement) PatternTransform(patternTransform Transform) *PatternElement {
+	e.Attrs["patternTransform"] = patternTransform
 	return e
 }
 
-// MarkerWidth sets the markerWidth attribute.
-func (e *MarkerElement) MarkerWidth(markerWidth Float64) *MarkerElement {
-	e.Attrs["markerWidth"] = markerWidth
+// Href sets the href attribute.
+func (e *PatternElement) Href(href String) *PatternElement {
+	e.Attrs["href"] = href
 	return e
 }
 
-// MarkerHeight sets the markerHeight attribute.
-func (e *MarkerElement) MarkerHeight(markerHeight Float64) *MarkerElement {
-	e.Attrs["markerHeight"] = markerHeight
+// X sets the x attribute.
+func (e *PatternElement) X(x Length) *PatternElement {
+	e.Attrs["x"] = x
 	return e
 }
 
-// Orient sets the orient attribute.
-func (e *MarkerElement) Orient(orient String) *MarkerElement {
-	e.Attrs["orient"] = orient
+// Y sets the y attribute.
+func (e *PatternElement) Y(y Length) *PatternElement {
+	e.Attrs["y"] = y
 	return e
 }
 
-// RefXY sets the refX and refY attributes.
-func (e *MarkerElement) RefXY(refX, refY float64) *MarkerElement {
-	e.Attrs["refX"] = Float64(refX)
-	e.Attrs["refY"] = Float64(refY)
+// Width sets the width attribute.
+func (e *PatternElement) Width(width Length) *PatternElement {
+	e.Attrs["width"] = width
 	return e
 }
 
-// MarkerWidthHeight sets the markerWidth and markerHeight attributes.
-func (e *MarkerElement) MarkerWidthHeight(markerWidth, markerHeight float64) *MarkerElement {
-	e.Attrs["markerWidth"] = Float64(markerWidth)
-	e.Attrs["markerHeight"] = Float64(markerHeight)
+// Height sets the height attribute.
+func (e *PatternElement) Height(height Length) *PatternElement {
+	e.Attrs["height"] = height
 	return e
 }
 
 // MarshallXML implements encoding/xml.Marshaller.MarshalXML.
-func (e *MarkerElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
-	return encodeElement(encoder, "marker", e.Attrs, e.Children)
+func (e *PatternElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
 }
 
-// A MaskElement is a mask element.
-type MaskElement struct {
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *PatternElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *PatternElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *PatternElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *PatternElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *PatternElement) TagName() string {
+	return "pattern"
+}
+
+// decode decodes e from d.
+func (e *PatternElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *PatternElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "viewBox":
+		return parseViewBox(value)
+	case "patternTransform":
+		return parseTransform(value)
+	case "x":
+		return parseLength(value)
+	case "y":
+		return parseLength(value)
+	case "width":
+		return parseLength(value)
+	case "height":
+		return parseLength(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A PolygonElement is a polygon element.
+type PolygonElement struct {
 	Attrs    map[string]AttrValue
 	Children []Element
 }
 
-// Mask returns a new MaskElement.
-func Mask(children ...Element) *MaskElement {
-	return &MaskElement{
+// Polygon returns a new PolygonElement.
+func Polygon(children ...Element) *PolygonElement {
+	return &PolygonElement{
 		Attrs:    map[string]AttrValue{},
 		Children: children,
 	}
 }
 
 // AppendChildren appends the given children.
-func (e *MaskElement) AppendChildren(children ...Element) *MaskElement {
+func (e *PolygonElement) AppendChildren(children ...Element) *PolygonElement {
 	e.Children = append(e.Children, children...)
 	return e
 }
 
+// RemoveAttrs removes the attributes with the given names.
+func (e *PolygonElement) RemoveAttrs(names ...string) *PolygonElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
 // ID sets the id attribute.
-func (e *MaskElement) ID(id String) *MaskElement {
+func (e *PolygonElement) ID(id String) *PolygonElement {
 	e.Attrs["id"] = id
 	return e
 }
 
 // TabIndex sets the tabindex attribute.
-func (e *MaskElement) TabIndex(tabIndex Int) *MaskElement {
+func (e *PolygonElement) TabIndex(tabIndex Int) *PolygonElement {
 	e.Attrs["tabindex"] = tabIndex
 	return e
 }
 
 // Lang sets the lang attribute.
-func (e *MaskElement) Lang(lang String) *MaskElement {
+func (e *PolygonElement) Lang(lang String) *PolygonElement {
 	e.Attrs["lang"] = lang
 	return e
 }
 
 // Class sets the class attribute.
-func (e *MaskElement) Class(class String) *MaskElement {
+func (e *PolygonElement) Class(class String) *PolygonElement {
 	e.Attrs["class"] = class
 	return e
 }
 
 // Style sets the style attribute.
-func (e *MaskElement) Style(style String) *MaskElement {
+func (e *PolygonElement) Style(style String) *PolygonElement {
 	e.Attrs["style"] = style
 	return e
 }
 
 // AlignmentBaseline sets the alignment-baseline attribute.
-func (e *MaskElement) AlignmentBaseline(alignmentBaseline String) *MaskElement {
+func (e *PolygonElement) AlignmentBaseline(alignmentBaseline String) *PolygonElement {
 	e.Attrs["alignment-baseline"] = alignmentBaseline
 	return e
 }
 
 // BaselineShift sets the baseline-shift attribute.
-func (e *MaskElement) BaselineShift(baselineShift String) *MaskElement {
+func (e *PolygonElement) BaselineShift(baselineShift String) *PolygonElement {
 	e.Attrs["baseline-shift"] = baselineShift
 	return e
 }
 
 // ClipPath sets the clip-path attribute.
-func (e *MaskElement) ClipPath(clipPath String) *MaskElement {
+func (e *PolygonElement) ClipPath(clipPath String) *PolygonElement {
 	e.Attrs["clip-path"] = clipPath
 	return e
 }
 
 // ClipRule sets the clip-rule attribute.
-func (e *MaskElement) ClipRule(clipRule String) *MaskElement {
+func (e *PolygonElement) ClipRule(clipRule String) *PolygonElement {
 	e.Attrs["clip-rule"] = clipRule
 	return e
 }
 
 // Color sets the color attribute.
-func (e *MaskElement) Color(color String) *MaskElement {
+func (e *PolygonElement) Color(color Color) *PolygonElement {
 	e.Attrs["color"] = color
 	return e
 }
 
 // ColorInterpolation sets the color-interpolation attribute.
-func (e *MaskElement) ColorInterpolation(colorInterpolation String) *MaskElement {
+func (e *PolygonElement) ColorInterpolation(colorInterpolation String) *PolygonElement {
 	e.Attrs["color-interpolation"] = colorInterpolation
 	return e
 }
 
 // ColorInterpolationFilters sets the color-interpolation-filters attribute.
-func (e *MaskElement) ColorInterpolationFilters(colorInterpolationFilters String) *MaskElement {
+func (e *PolygonElement) ColorInterpolationFilters(colorInterpolationFilters String) *PolygonElement {
 	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
 	return e
 }
 
 // ColorRendering sets the color-rendering attribute.
-func (e *MaskElement) ColorRendering(colorRendering String) *MaskElement {
+func (e *PolygonElement) ColorRendering(colorRendering String) *PolygonElement {
 	e.Attrs["color-rendering"] = colorRendering
 	return e
 }
 
 // Cursor sets the cursor attribute.
-func (e *MaskElement) Cursor(cursor String) *MaskElement {
+func (e *PolygonElement) Cursor(cursor String) *PolygonElement {
 	e.Attrs["cursor"] = cursor
 	return e
 }
 
 // Direction sets the direction attribute.
-func (e *MaskElement) Direction(direction String) *MaskElement {
+func (e *PolygonElement) Direction(direction String) *PolygonElement {
 	e.Attrs["direction"] = direction
 	return e
 }
 
 // Display sets the display attribute.
-func (e *MaskElement) Display(display String) *MaskElement {
+func (e *PolygonElement) Display(display String) *PolygonElement {
 	e.Attrs["display"] = display
 	return e
 }
 
 // DominantBaseline sets the dominant-baseline attribute.
-func (e *MaskElement) DominantBaseline(dominantBaseline String) *MaskElement {
+func (e *PolygonElement) DominantBaseline(dominantBaseline String) *PolygonElement {
 	e.Attrs["dominant-baseline"] = dominantBaseline
 	return e
 }
 
 // Fill sets the fill attribute.
-func (e *MaskElement) Fill(fill String) *MaskElement {
+func (e *PolygonElement) Fill(fill String) *PolygonElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *PolygonElement) FillColor(fill Color) *PolygonElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *PolygonElement) FillPaint(fill Paint) *PolygonElement {
 	e.Attrs["fill"] = fill
 	return e
 }
 
 // FillOpacity sets the fill-opacity attribute.
-func (e *MaskElement) FillOpacity(fillOpacity Float64) *MaskElement {
+func (e *PolygonElement) FillOpacity(fillOpacity Float64) *PolygonElement {
 	e.Attrs["fill-opacity"] = fillOpacity
 	return e
 }
 
 // FillRule sets the fill-rule attribute.
-func (e *MaskElement) FillRule(fillRule String) *MaskElement {
+func (e *PolygonElement) FillRule(fillRule String) *PolygonElement {
 	e.Attrs["fill-rule"] = fillRule
 	return e
 }
 
 // Filter sets the filter attribute.
-func (e *MaskElement) Filter(filter String) *MaskElement {
+func (e *PolygonElement) Filter(filter String) *PolygonElement {
 	e.Attrs["filter"] = filter
 	return e
 }
 
 // FloodColor sets the flood-color attribute.
-func (e *MaskElement) FloodColor(floodColor String) *MaskElement {
+func (e *PolygonElement) FloodColor(floodColor Color) *PolygonElement {
 	e.Attrs["flood-color"] = floodColor
 	return e
 }
 
 // FloodOpacity sets the flood-opacity attribute.
-func (e *MaskElement) FloodOpacity(floodOpacity Float64) *MaskElement {
+func (e *PolygonElement) FloodOpacity(floodOpacity Float64) *PolygonElement {
 	e.Attrs["flood-opacity"] = floodOpacity
 	return e
 }
 
 // FontFamily sets the font-family attribute.
-func (e *MaskElement) FontFamily(fontFamily String) *MaskElement {
+func (e *PolygonElement) FontFamily(fontFamily String) *PolygonElement {
 	e.Attrs["font-family"] = fontFamily
 	return e
 }
 
 // FontSize sets the font-size attribute.
-func (e *MaskElement) FontSize(fontSize String) *MaskElement {
+func (e *PolygonElement) FontSize(fontSize String) *PolygonElement {
 	e.Attrs["font-size"] = fontSize
 	return e
 }
 
 // FontSizeAdjust sets the font-size-adjust attribute.
-func (e *MaskElement) FontSizeAdjust(fontSizeAdjust String) *MaskElement {
+func (e *PolygonElement) FontSizeAdjust(fontSizeAdjust String) *PolygonElement {
 	e.Attrs["font-size-adjust"] = fontSizeAdjust
 	return e
 }
 
 // FontStretch sets the font-stretch attribute.
-func (e *MaskElement) FontStretch(fontStretch String) *MaskElement {
+func (e *PolygonElement) FontStretch(fontStretch String) *PolygonElement {
 	e.Attrs["font-stretch"] = fontStretch
 	return e
 }
 
 // FontStyle sets the font-style attribute.
-func (e *MaskElement) FontStyle(fontStyle String) *MaskElement {
+func (e *PolygonElement) FontStyle(fontStyle String) *PolygonElement {
 	e.Attrs["font-style"] = fontStyle
 	return e
 }
 
 // FontVariant sets the font-variant attribute.
-func (e *MaskElement) FontVariant(fontVariant String) *MaskElement {
+func (e *PolygonElement) FontVariant(fontVariant String) *PolygonElement {
 	e.Attrs["font-variant"] = fontVariant
 	return e
 }
 
 // FontWeight sets the font-weight attribute.
-func (e *MaskElement) FontWeight(fontWeight String) *MaskElement {
+func (e *PolygonElement) FontWeight(fontWeight String) *PolygonElement {
 	e.Attrs["font-weight"] = fontWeight
 	return e
 }
 
 // GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
-func (e *MaskElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *MaskElement {
+func (e *PolygonElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *PolygonElement {
 	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
 	return e
 }
 
 // GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
-func (e *MaskElement) GlyphOrientationVertical(glyphOrientationVertical String) *MaskElement {
+func (e *PolygonElement) GlyphOrientationVertical(glyphOrientationVertical String) *PolygonElement {
 	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
 	return e
 }
 
 // ImageRendering sets the image-rendering attribute.
-func (e *MaskElement) ImageRendering(imageRendering String) *MaskElement {
+func (e *PolygonElement) ImageRendering(imageRendering String) *PolygonElement {
 	e.Attrs["image-rendering"] = imageRendering
 	return e
 }
 
 // LetterSpacing sets the letter-spacing attribute.
-func (e *MaskElement) LetterSpacing(letterSpacing String) *MaskElement {
+func (e *PolygonElement) LetterSpacing(letterSpacing String) *PolygonElement {
 	e.Attrs["letter-spacing"] = letterSpacing
 	return e
 }
 
 // LightingColor sets the lighting-color attribute.
-func (e *MaskElement) LightingColor(lightingColor String) *MaskElement {
+func (e *PolygonElement) LightingColor(lightingColor Color) *PolygonElement {
 	e.Attrs["lighting-color"] = lightingColor
 	return e
 }
 
 // MarkerEnd sets the marker-end attribute.
-func (e *MaskElement) MarkerEnd(markerEnd String) *MaskElement {
+func (e *PolygonElement) MarkerEnd(markerEnd String) *PolygonElement {
 	e.Attrs["marker-end"] = markerEnd
 	return e
 }
 
 // MarkerMid sets the marker-mid attribute.
-func (e *MaskElement) MarkerMid(markerMid String) *MaskElement {
+func (e *PolygonElement) MarkerMid(markerMid String) *PolygonElement {
 	e.Attrs["marker-mid"] = markerMid
 	return e
 }
 
 // MarkerStart sets the marker-start attribute.
-func (e *MaskElement) MarkerStart(markerStart String) *MaskElement {
+func (e *PolygonElement) MarkerStart(markerStart String) *PolygonElement {
 	e.Attrs["marker-start"] = markerStart
 	return e
 }
 
 // Mask sets the mask attribute.
-func (e *MaskElement) Mask(mask String) *MaskElement {
+func (e *PolygonElement) Mask(mask String) *PolygonElement {
 	e.Attrs["mask"] = mask
 	return e
 }
 
 // Opacity sets the opacity attribute.
-func (e *MaskElement) Opacity(opacity Float64) *MaskElement {
+func (e *PolygonElement) Opacity(opacity Float64) *PolygonElement {
 	e.Attrs["opacity"] = opacity
 	return e
 }
 
 // Overflow sets the overflow attribute.
-func (e *MaskElement) Overflow(overflow String) *MaskElement {
+func (e *PolygonElement) Overflow(overflow String) *PolygonElement {
 	e.Attrs["overflow"] = overflow
 	return e
 }
 
 // PaintOrder sets the paint-order attribute.
-func (e *MaskElement) PaintOrder(paintOrder String) *MaskElement {
+func (e *PolygonElement) PaintOrder(paintOrder String) *PolygonElement {
 	e.Attrs["paint-order"] = paintOrder
 	return e
 }
 
 // PointerEvents sets the pointer-events attribute.
-func (e *MaskElement) PointerEvents(pointerEvents String) *MaskElement {
+func (e *PolygonElement) PointerEvents(pointerEvents String) *PolygonElement {
 	e.Attrs["pointer-events"] = pointerEvents
 	return e
 }
 
 // ShapeRendering sets the shape-rendering attribute.
-func (e *MaskElement) ShapeRendering(shapeRendering String) *MaskElement {
+func (e *PolygonElement) ShapeRendering(shapeRendering String) *PolygonElement {
 	e.Attrs["shape-rendering"] = shapeRendering
 	return e
 }
 
 // StopColor sets the stop-color attribute.
-func (e *MaskElement) StopColor(stopColor String) *MaskElement {
+func (e *PolygonElement) StopColor(stopColor Color) *PolygonElement {
 	e.Attrs["stop-color"] = stopColor
 	return e
 }
 
 // StopOpacity sets the stop-opacity attribute.
-func (e *MaskElement) StopOpacity(stopOpacity Float64) *MaskElement {
+func (e *PolygonElement) StopOpacity(stopOpacity Float64) *PolygonElement {
 	e.Attrs["stop-opacity"] = stopOpacity
 	return e
 }
 
 // Stroke sets the stroke attribute.
-func (e *MaskElement) Stroke(stroke String) *MaskElement {
+func (e *PolygonElement) Stroke(stroke String) *PolygonElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *PolygonElement) StrokeColor(stroke Color) *PolygonElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *PolygonElement) StrokePaint(stroke Paint) *PolygonElement {
 	e.Attrs["stroke"] = stroke
 	return e
 }
 
 // StrokeDashArray sets the stroke-dasharray attribute.
-func (e *MaskElement) StrokeDashArray(strokeDashArray String) *MaskElement {
+func (e *PolygonElement) StrokeDashArray(strokeDashArray String) *PolygonElement {
 	e.Attrs["stroke-dasharray"] = strokeDashArray
 	return e
 }
 
 // StrokeDashOffset sets the stroke-dashoffset attribute.
-func (e *MaskElement) StrokeDashOffset(strokeDashOffset Float64) *MaskElement {
+func (e *PolygonElement) StrokeDashOffset(strokeDashOffset Float64) *PolygonElement {
 	e.Attrs["stroke-dashoffset"] = strokeDashOffset
 	return e
 }
 
 // StrokeLineCap sets the stroke-linecap attribute.
-func (e *MaskElement) StrokeLineCap(strokeLineCap String) *MaskElement {
+func (e *PolygonElement) StrokeLineCap(strokeLineCap String) *PolygonElement {
 	e.Attrs["stroke-linecap"] = strokeLineCap
 	return e
 }
 
 // StrokeLineJoin sets the stroke-linejoin attribute.
-func (e *MaskElement) StrokeLineJoin(strokeLineJoin String) *MaskElement {
+func (e *PolygonElement) StrokeLineJoin(strokeLineJoin String) *PolygonElement {
 	e.Attrs["stroke-linejoin"] = strokeLineJoin
 	return e
 }
 
 // StrokeMiterLimit sets the stroke-miterlimit attribute.
-func (e *MaskElement) StrokeMiterLimit(strokeMiterLimit Float64) *MaskElement {
+func (e *PolygonElement) StrokeMiterLimit(strokeMiterLimit Float64) *PolygonElement {
 	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
 	return e
 }
 
 // StrokeOpacity sets the stroke-opacity attribute.
-func (e *MaskElement) StrokeOpacity(strokeOpacity Float64) *MaskElement {
+func (e *PolygonElement) StrokeOpacity(strokeOpacity Float64) *PolygonElement {
 	e.Attrs["stroke-opacity"] = strokeOpacity
 	return e
 }
 
 // StrokeWidth sets the stroke-width attribute.
-func (e *MaskElement) StrokeWidth(strokeWidth Length) *MaskElement {
+func (e *PolygonElement) StrokeWidth(strokeWidth Length) *PolygonElement {
 	e.Attrs["stroke-width"] = strokeWidth
 	return e
 }
 
 // TextAnchor sets the text-anchor attribute.
-func (e *MaskElement) TextAnchor(textAnchor String) *MaskElement {
+func (e *PolygonElement) TextAnchor(textAnchor String) *PolygonElement {
 	e.Attrs["text-anchor"] = textAnchor
 	return e
 }
 
 // TextDecoration sets the text-decoration attribute.
-func (e *MaskElement) TextDecoration(textDecoration String) *MaskElement {
+func (e *PolygonElement) TextDecoration(textDecoration String) *PolygonElement {
 	e.Attrs["text-decoration"] = textDecoration
 	return e
 }
 
 // TextOverflow sets the text-overflow attribute.
-func (e *MaskElement) TextOverflow(textOverflow String) *MaskElement {
+func (e *PolygonElement) TextOverflow(textOverflow String) *PolygonElement {
 	e.Attrs["text-overflow"] = textOverflow
 	return e
 }
 
 // TextRendering sets the text-rendering attribute.
-func (e *MaskElement) TextRendering(textRendering String) *MaskElement {
+func (e *PolygonElement) TextRendering(textRendering String) *PolygonElement {
 	e.Attrs["text-rendering"] = textRendering
 	return e
 }
 
+// Transform sets the transform attribute.
+func (e *PolygonElement) Transform(transform Transform) *PolygonElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
 // UnicodeBiDi sets the unicode-bidi attribute.
-func (e *MaskElement) UnicodeBiDi(unicodeBiDi String) *MaskElement {
+func (e *PolygonElement) UnicodeBiDi(unicodeBiDi String) *PolygonElement {
 	e.Attrs["unicode-bidi"] = unicodeBiDi
 	return e
 }
 
 // VectorEffect sets the vector-effect attribute.
-func (e *MaskElement) VectorEffect(vectorEffect String) *MaskElement {
+func (e *PolygonElement) VectorEffect(vectorEffect String) *PolygonElement {
 	e.Attrs["vector-effect"] = vectorEffect
 	return e
 }
 
 // Visibility sets the visibility attribute.
-func (e *MaskElement) Visibility(visibility String) *MaskElement {
+func (e *PolygonElement) Visibility(visibility String) *PolygonElement {
 	e.Attrs["visibility"] = visibility
 	return e
 }
 
 // WhiteSpace sets the white-space attribute.
-func (e *MaskElement) WhiteSpace(whiteSpace String) *MaskElement {
+func (e *PolygonElement) WhiteSpace(whiteSpace String) *PolygonElement {
 	e.Attrs["white-space"] = whiteSpace
 	return e
 }
 
 // WordSpacing sets the word-spacing attribute.
-func (e *MaskElement) WordSpacing(wordSpacing String) *MaskElement {
+func (e *PolygonElement) WordSpacing(wordSpacing String) *PolygonElement {
 	e.Attrs["word-spacing"] = wordSpacing
 	return e
 }
 
 // WritingMode sets the writing-mode attribute.
-func (e *MaskElement) WritingMode(writingMode String) *MaskElement {
+func (e *PolygonElement) WritingMode(writingMode String) *PolygonElement {
 	e.Attrs["writing-mode"] = writingMode
 	return e
 }
 
-// MaskUnits sets the maskUnits attribute.
-func (e *MaskElement) MaskUnits(maskUnits String) *MaskElement {
-	e.Attrs["maskUnits"] = maskUnits
+// PathLength sets the pathLength attribute.
+func (e *PolygonElement) PathLength(pathLength String) *PolygonElement {
+	e.Attrs["pathLength"] = pathLength
 	return e
 }
 
-// MaskContentUnits sets the maskContentUnits attribute.
-func (e *MaskElement) MaskContentUnits(maskContentUnits String) *MaskElement {
-	e.Attrs["maskContentUnits"] = maskContentUnits
+// Points sets the points attribute.
+func (e *PolygonElement) Points(points Points) *PolygonElement {
+	e.Attrs["points"] = points
 	return e
 }
 
-// X sets the x attribute.
-func (e *MaskElement) X(x Length) *MaskElement {
-	e.Attrs["x"] = x
-	return e
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *PolygonElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
 }
 
-// Y sets the y attribute.
-func (e *MaskElement) Y(y Length) *MaskElement {
-	e.Attrs["y"] = y
-	return e
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *PolygonElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
 }
 
-// Width sets the width attribute.
-func (e *MaskElement) Width(width Length) *MaskElement {
-	e.Attrs["width"] = width
-	return e
+// Attributes implements Node.Attributes.
+func (e *PolygonElement) Attributes() map[string]AttrValue {
+	return e.Attrs
 }
 
-// Height sets the height attribute.
-func (e *MaskElement) Height(height Length) *MaskElement {
-	e.Attrs["height"] = height
-	return e
+// ChildElements implements Node.ChildElements.
+func (e *PolygonElement) ChildElements() []Element {
+	return e.Children
 }
 
-// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
-func (e *MaskElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
-	return encodeElement(encoder, "mask", e.Attrs, e.Children)
+// SetChildElements implements Container.SetChildElements.
+func (e *PolygonElement) SetChildElements(children []Element) {
+	e.Children = children
 }
 
-// A PathElement is a path element.
-type PathElement struct {
-	Attrs map[string]AttrValue
+// TagName implements Node.TagName.
+func (e *PolygonElement) TagName() string {
+	return "polygon"
 }
 
-// Path returns a new PathElement.
-func Path() *PathElement {
-	return &PathElement{
-		Attrs: map[string]AttrValue{},
+// decode decodes e from d.
+func (e *PolygonElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *PolygonElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "points":
+		return parsePoints(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A PolylineElement is a polyline element.
+type PolylineElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// Polyline returns a new PolylineElement.
+func Polyline(children ...Element) *PolylineElement {
+	return &PolylineElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *PolylineElement) AppendChildren(children ...Element) *PolylineElement {
+	e.Children = append(e.Children, children...)
+	return e
+}
+
+// RemoveAttrs removes the attributes with the given names.
+func (e *PolylineElement) RemoveAttrs(names ...string) *PolylineElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
 	}
+	return e
 }
 
 // ID sets the id attribute.
-func (e *PathElement) ID(id String) *PathElement {
+func (e *PolylineElement) ID(id String) *PolylineElement {
 	e.Attrs["id"] = id
 	return e
 }
 
 // TabIndex sets the tabindex attribute.
-func (e *PathElement) TabIndex(tabIndex Int) *PathElement {
+func (e *PolylineElement) TabIndex(tabIndex Int) *PolylineElement {
 	e.Attrs["tabindex"] = tabIndex
 	return e
 }
 
 // Lang sets the lang attribute.
-func (e *PathElement) Lang(lang String) *PathElement {
+func (e *PolylineElement) Lang(lang String) *PolylineElement {
 	e.Attrs["lang"] = lang
 	return e
 }
 
 // Class sets the class attribute.
-func (e *PathElement) Class(class String) *PathElement {
+func (e *PolylineElement) Class(class String) *PolylineElement {
 	e.Attrs["class"] = class
 	return e
 }
 
 // Style sets the style attribute.
-func (e *PathElement) Style(style String) *PathElement {
+func (e *PolylineElement) Style(style String) *PolylineElement {
 	e.Attrs["style"] = style
 	return e
 }
 
 // AlignmentBaseline sets the alignment-baseline attribute.
-func (e *PathElement) AlignmentBaseline(alignmentBaseline String) *PathElement {
+func (e *PolylineElement) AlignmentBaseline(alignmentBaseline String) *PolylineElement {
 	e.Attrs["alignment-baseline"] = alignmentBaseline
 	return e
 }
 
 // BaselineShift sets the baseline-shift attribute.
-func (e *PathElement) BaselineShift(baselineShift String) *PathElement {
+func (e *PolylineElement) BaselineShift(baselineShift String) *PolylineElement {
 	e.Attrs["baseline-shift"] = baselineShift
 	return e
 }
 
 // ClipPath sets the clip-path attribute.
-func (e *PathElement) ClipPath(clipPath String) *PathElement {
+func (e *PolylineElement) ClipPath(clipPath String) *PolylineElement {
 	e.Attrs["clip-path"] = clipPath
 	return e
 }
 
 // ClipRule sets the clip-rule attribute.
-func (e *PathElement) ClipRule(clipRule String) *PathElement {
+func (e *PolylineElement) ClipRule(clipRule String) *PolylineElement {
 	e.Attrs["clip-rule"] = clipRule
 	return e
 }
 
 // Color sets the color attribute.
-func (e *PathElement) Color(color String) *PathElement {
+func (e *PolylineElement) Color(color Color) *PolylineElement {
 	e.Attrs["color"] = color
 	return e
 }
 
 // ColorInterpolation sets the color-interpolation attribute.
-func (e *PathElement) ColorInterpolation(colorInterpolation String) *PathElement {
+func (e *PolylineElement) ColorInterpolation(colorInterpolation String) *PolylineElement {
 	e.Attrs["color-interpolation"] = colorInterpolation
 	return e
 }
 
 // ColorInterpolationFilters sets the color-interpolation-filters attribute.
-func (e *PathElement) ColorInterpolationFilters(colorInterpolationFilters String) *PathElement {
+func (e *PolylineElement) ColorInterpolationFilters(colorInterpolationFilters String) *PolylineElement {
 	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
 	return e
 }
 
 // ColorRendering sets the color-rendering attribute.
-func (e *PathElement) ColorRendering(colorRendering String) *PathElement {
+func (e *PolylineElement) ColorRendering(colorRendering String) *PolylineElement {
 	e.Attrs["color-rendering"] = colorRendering
 	return e
 }
 
 // Cursor sets the cursor attribute.
-func (e *PathElement) Cursor(cursor String) *PathElement {
+func (e *PolylineElement) Cursor(cursor String) *PolylineElement {
 	e.Attrs["cursor"] = cursor
 	return e
 }
 
 // Direction sets the direction attribute.
-func (e *PathElement) Direction(direction String) *PathElement {
+func (e *PolylineElement) Direction(direction String) *PolylineElement {
 	e.Attrs["direction"] = direction
 	return e
 }
 
 // Display sets the display attribute.
-func (e *PathElement) Display(display String) *PathElement {
+func (e *PolylineElement) Display(display String) *PolylineElement {
 	e.Attrs["display"] = display
 	return e
 }
 
 // DominantBaseline sets the dominant-baseline attribute.
-func (e *PathElement) DominantBaseline(dominantBaseline String) *PathElement {
+func (e *PolylineElement) DominantBaseline(dominantBaseline String) *PolylineElement {
 	e.Attrs["dominant-baseline"] = dominantBaseline
 	return e
 }
 
 // Fill sets the fill attribute.
-func (e *PathElement) Fill(fill String) *PathElement {
+func (e *PolylineElement) Fill(fill String) *PolylineElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *PolylineElement) FillColor(fill Color) *PolylineElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *PolylineElement) FillPaint(fill Paint) *PolylineElement {
 	e.Attrs["fill"] = fill
 	return e
 }
 
 // FillOpacity sets the fill-opacity attribute.
-func (e *PathElement) FillOpacity(fillOpacity Float64) *PathElement {
+func (e *PolylineElement) FillOpacity(fillOpacity Float64) *PolylineElement {
 	e.Attrs["fill-opacity"] = fillOpacity
 	return e
 }
 
 // FillRule sets the fill-rule attribute.
-func (e *PathElement) FillRule(fillRule String) *PathElement {
+func (e *PolylineElement) FillRule(fillRule String) *PolylineElement {
 	e.Attrs["fill-rule"] = fillRule
 	return e
 }
 
 // Filter sets the filter attribute.
-func (e *PathElement) Filter(filter String) *PathElement {
+func (e *PolylineElement) Filter(filter String) *PolylineElement {
 	e.Attrs["filter"] = filter
 	return e
 }
 
 // FloodColor sets the flood-color attribute.
-func (e *PathElement) FloodColor(floodColor String) *PathElement {
+func (e *PolylineElement) FloodColor(floodColor Color) *PolylineElement {
 	e.Attrs["flood-color"] = floodColor
 	return e
 }
 
 // FloodOpacity sets the flood-opacity attribute.
-func (e *PathElement) FloodOpacity(floodOpacity Float64) *PathElement {
+func (e *PolylineElement) FloodOpacity(floodOpacity Float64) *PolylineElement {
 	e.Attrs["flood-opacity"] = floodOpacity
 	return e
 }
 
 // FontFamily sets the font-family attribute.
-func (e *PathElement) FontFamily(fontFamily String) *PathElement {
+func (e *PolylineElement) FontFamily(fontFamily String) *PolylineElement {
 	e.Attrs["font-family"] = fontFamily
 	return e
 }
 
 // FontSize sets the font-size attribute.
-func (e *PathElement) FontSize(fontSize String) *PathElement {
+func (e *PolylineElement) FontSize(fontSize String) *PolylineElement {
 	e.Attrs["font-size"] = fontSize
 	return e
 }
 
 // FontSizeAdjust sets the font-size-adjust attribute.
-func (e *PathElement) FontSizeAdjust(fontSizeAdjust String) *PathElement {
+func (e *PolylineElement) FontSizeAdjust(fontSizeAdjust String) *PolylineElement {
 	e.Attrs["font-size-adjust"] = fontSizeAdjust
 	return e
 }
 
 // FontStretch sets the font-stretch attribute.
-func (e *PathElement) FontStretch(fontStretch String) *PathElement {
+func (e *PolylineElement) FontStretch(fontStretch String) *PolylineElement {
 	e.Attrs["font-stretch"] = fontStretch
 	return e
 }
 
 // FontStyle sets the font-style attribute.
-func (e *PathElement) FontStyle(fontStyle String) *PathElement {
+func (e *PolylineElement) FontStyle(fontStyle String) *PolylineElement {
 	e.Attrs["font-style"] = fontStyle
 	return e
 }
 
 // FontVariant sets the font-variant attribute.
-func (e *PathElement) FontVariant(fontVariant String) *PathElement {
+func (e *PolylineElement) FontVariant(fontVariant String) *PolylineElement {
 	e.Attrs["font-variant"] = fontVariant
 	return e
 }
 
 // FontWeight sets the font-weight attribute.
-func (e *PathElement) FontWeight(fontWeight String) *PathElement {
+func (e *PolylineElement) FontWeight(fontWeight String) *PolylineElement {
 	e.Attrs["font-weight"] = fontWeight
 	return e
 }
 
 // GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
-func (e *PathElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *PathElement {
+func (e *PolylineElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *PolylineElement {
 	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
 	return e
 }
 
 // GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
-func (e *PathElement) GlyphOrientationVertical(glyphOrientationVertical String) *PathElement {
+func (e *PolylineElement) GlyphOrientationVertical(glyphOrientationVertical String) *PolylineElement {
 	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
 	return e
 }
 
 // ImageRendering sets the image-rendering attribute.
-func (e *PathElement) ImageRendering(imageRendering String) *PathElement {
+func (e *PolylineElement) ImageRendering(imageRendering String) *PolylineElement {
 	e.Attrs["image-rendering"] = imageRendering
 	return e
 }
 
 // LetterSpacing sets the letter-spacing attribute.
-func (e *PathElement) LetterSpacing(letterSpacing String) *PathElement {
+func (e *PolylineElement) LetterSpacing(letterSpacing String) *PolylineElement {
 	e.Attrs["letter-spacing"] = letterSpacing
 	return e
 }
 
 // LightingColor sets the lighting-color attribute.
-func (e *PathElement) LightingColor(lightingColor String) *PathElement {
+func (e *PolylineElement) LightingColor(lightingColor Color) *PolylineElement {
 	e.Attrs["lighting-color"] = lightingColor
 	return e
 }
 
 // MarkerEnd sets the marker-end attribute.
-func (e *PathElement) MarkerEnd(markerEnd String) *PathElement {
+func (e *PolylineElement) MarkerEnd(markerEnd String) *PolylineElement {
 	e.Attrs["marker-end"] = markerEnd
 	return e
 }
 
 // MarkerMid sets the marker-mid attribute.
-func (e *PathElement) MarkerMid(markerMid String) *PathElement {
+func (e *PolylineElement) MarkerMid(markerMid String) *PolylineElement {
 	e.Attrs["marker-mid"] = markerMid
 	return e
 }
 
 // MarkerStart sets the marker-start attribute.
-func (e *PathElement) MarkerStart(markerStart String) *PathElement {
+func (e *PolylineElement) MarkerStart(markerStart String) *PolylineElement {
 	e.Attrs["marker-start"] = markerStart
 	return e
 }
 
 // Mask sets the mask attribute.
-func (e *PathElement) Mask(mask String) *PathElement {
+func (e *PolylineElement) Mask(mask String) *PolylineElement {
 	e.Attrs["mask"] = mask
 	return e
 }
 
 // Opacity sets the opacity attribute.
-func (e *PathElement) Opacity(opacity Float64) *PathElement {
+func (e *PolylineElement) Opacity(opacity Float64) *PolylineElement {
 	e.Attrs["opacity"] = opacity
 	return e
 }
 
 // Overflow sets the overflow attribute.
-func (e *PathElement) Overflow(overflow String) *PathElement {
+func (e *PolylineElement) Overflow(overflow String) *PolylineElement {
 	e.Attrs["overflow"] = overflow
 	return e
 }
 
 // PaintOrder sets the paint-order attribute.
-func (e *PathElement) PaintOrder(paintOrder String) *PathElement {
+func (e *PolylineElement) PaintOrder(paintOrder String) *PolylineElement {
 	e.Attrs["paint-order"] = paintOrder
 	return e
 }
 
 // PointerEvents sets the pointer-events attribute.
-func (e *PathElement) PointerEvents(pointerEvents String) *PathElement {
+func (e *PolylineElement) PointerEvents(pointerEvents String) *PolylineElement {
 	e.Attrs["pointer-events"] = pointerEvents
 	return e
 }
 
 // ShapeRendering sets the shape-rendering attribute.
-func (e *PathElement) ShapeRendering(shapeRendering String) *PathElement {
+func (e *PolylineElement) ShapeRendering(shapeRendering String) *PolylineElement {
 	e.Attrs["shape-rendering"] = shapeRendering
 	return e
 }
 
 // StopColor sets the stop-color attribute.
-func (e *PathElement) StopColor(stopColor String) *PathElement {
+func (e *PolylineElement) StopColor(stopColor Color) *PolylineElement {
 	e.Attrs["stop-color"] = stopColor
 	return e
 }
 
 // StopOpacity sets the stop-opacity attribute.
-func (e *PathElement) StopOpacity(stopOpacity Float64) *PathElement {
+func (e *PolylineElement) StopOpacity(stopOpacity Float64) *PolylineElement {
 	e.Attrs["stop-opacity"] = stopOpacity
 	return e
 }
 
 // Stroke sets the stroke attribute.
-func (e *PathElement) Stroke(stroke String) *PathElement {
+func (e *PolylineElement) Stroke(stroke String) *PolylineElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *PolylineElement) StrokeColor(stroke Color) *PolylineElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *PolylineElement) StrokePaint(stroke Paint) *PolylineElement {
 	e.Attrs["stroke"] = stroke
 	return e
 }
 
 // StrokeDashArray sets the stroke-dasharray attribute.
-func (e *PathElement) StrokeDashArray(strokeDashArray String) *PathElement {
+func (e *PolylineElement) StrokeDashArray(strokeDashArray String) *PolylineElement {
 	e.Attrs["stroke-dasharray"] = strokeDashArray
 	return e
 }
 
 // StrokeDashOffset sets the stroke-dashoffset attribute.
-func (e *PathElement) StrokeDashOffset(strokeDashOffset Float64) *PathElement {
+func (e *PolylineElement) StrokeDashOffset(strokeDashOffset Float64) *PolylineElement {
 	e.Attrs["stroke-dashoffset"] = strokeDashOffset
 	return e
 }
 
 // StrokeLineCap sets the stroke-linecap attribute.
-func (e *PathElement) StrokeLineCap(strokeLineCap String) *PathElement {
+func (e *PolylineElement) StrokeLineCap(strokeLineCap String) *PolylineElement {
 	e.Attrs["stroke-linecap"] = strokeLineCap
 	return e
 }
 
 // StrokeLineJoin sets the stroke-linejoin attribute.
-func (e *PathElement) StrokeLineJoin(strokeLineJoin String) *PathElement {
+func (e *PolylineElement) StrokeLineJoin(strokeLineJoin String) *PolylineElement {
 	e.Attrs["stroke-linejoin"] = strokeLineJoin
 	return e
 }
 
 // StrokeMiterLimit sets the stroke-miterlimit attribute.
-func (e *PathElement) StrokeMiterLimit(strokeMiterLimit Float64) *PathElement {
+func (e *PolylineElement) StrokeMiterLimit(strokeMiterLimit Float64) *PolylineElement {
 	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
 	return e
 }
 
 // StrokeOpacity sets the stroke-opacity attribute.
-func (e *PathElement) StrokeOpacity(strokeOpacity Float64) *PathElement {
+func (e *PolylineElement) StrokeOpacity(strokeOpacity Float64) *PolylineElement {
 	e.Attrs["stroke-opacity"] = strokeOpacity
 	return e
 }
 
 // StrokeWidth sets the stroke-width attribute.
-func (e *PathElement) StrokeWidth(strokeWidth Length) *PathElement {
+func (e *PolylineElement) StrokeWidth(strokeWidth Length) *PolylineElement {
 	e.Attrs["stroke-width"] = strokeWidth
 	return e
 }
 
 // TextAnchor sets the text-anchor attribute.
-func (e *PathElement) TextAnchor(textAnchor String) *PathElement {
+func (e *PolylineElement) TextAnchor(textAnchor String) *PolylineElement {
 	e.Attrs["text-anchor"] = textAnchor
 	return e
 }
 
 // TextDecoration sets the text-decoration attribute.
-func (e *PathElement) TextDecoration(textDecoration String) *PathElement {
+func (e *PolylineElement) TextDecoration(textDecoration String) *PolylineElement {
 	e.Attrs["text-decoration"] = textDecoration
 	return e
 }
 
 // TextOverflow sets the text-overflow attribute.
-func (e *PathElement) TextOverflow(textOverflow String) *PathElement {
+func (e *PolylineElement) TextOverflow(textOverflow String) *PolylineElement {
 	e.Attrs["text-overflow"] = textOverflow
 	return e
 }
 
 // TextRendering sets the text-rendering attribute.
-func (e *PathElement) TextRendering(textRendering String) *PathElement {
+func (e *PolylineElement) TextRendering(textRendering String) *PolylineElement {
 	e.Attrs["text-rendering"] = textRendering
 	return e
 }
 
+// Transform sets the transform attribute.
+func (e *PolylineElement) Transform(transform Transform) *PolylineElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
 // UnicodeBiDi sets the unicode-bidi attribute.
-func (e *PathElement) UnicodeBiDi(unicodeBiDi String) *PathElement {
+func (e *PolylineElement) UnicodeBiDi(unicodeBiDi String) *PolylineElement {
 	e.Attrs["unicode-bidi"] = unicodeBiDi
 	return e
 }
 
 // VectorEffect sets the vector-effect attribute.
-func (e *PathElement) VectorEffect(vectorEffect String) *PathElement {
+func (e *PolylineElement) VectorEffect(vectorEffect String) *PolylineElement {
 	e.Attrs["vector-effect"] = vectorEffect
 	return e
 }
 
 // Visibility sets the visibility attribute.
-func (e *PathElement) Visibility(visibility String) *PathElement {
+func (e *PolylineElement) Visibility(visibility String) *PolylineElement {
 	e.Attrs["visibility"] = visibility
 	return e
 }
 
 // WhiteSpace sets the white-space attribute.
-func (e *PathElement) WhiteSpace(whiteSpace String) *PathElement {
+func (e *PolylineElement) WhiteSpace(whiteSpace String) *PolylineElement {
 	e.Attrs["white-space"] = whiteSpace
 	return e
 }
 
 // WordSpacing sets the word-spacing attribute.
-func (e *PathElement) WordSpacing(wordSpacing String) *PathElement {
+func (e *PolylineElement) WordSpacing(wordSpacing String) *PolylineElement {
 	e.Attrs["word-spacing"] = wordSpacing
 	return e
 }
 
 // WritingMode sets the writing-mode attribute.
-func (e *PathElement) WritingMode(writingMode String) *PathElement {
+func (e *PolylineElement) WritingMode(writingMode String) *PolylineElement {
 	e.Attrs["writing-mode"] = writingMode
 	return e
 }
 
-// D sets the d attribute.
-func (e *PathElement) D(d AttrValue) *PathElement {
-	e.Attrs["d"] = d
+// PathLength sets the pathLength attribute.
+func (e *PolylineElement) PathLength(pathLength String) *PolylineElement {
+	e.Attrs["pathLength"] = pathLength
+	return e
+}
+
+// Points sets the points attribute.
+func (e *PolylineElement) Points(points Points) *PolylineElement {
+	e.Attrs["points"] = points
 	return e
 }
 
 // MarshallXML implements encoding/xml.Marshaller.MarshalXML.
-func (e *PathElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
-	return encodeElement(encoder, "path", e.Attrs, nil)
+func (e *PolylineElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
 }
 
-// A PatternElement is a pattern element.
-type PatternElement struct {
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *PolylineElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *PolylineElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *PolylineElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *PolylineElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *PolylineElement) TagName() string {
+	return "polyline"
+}
+
+// decode decodes e from d.
+func (e *PolylineElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *PolylineElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "points":
+		return parsePoints(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A RadialGradientElement is a radialGradient element.
+type RadialGradientElement struct {
 	Attrs    map[string]AttrValue
 	Children []Element
 }
 
-// Pattern returns a new PatternElement.
-func Pattern(children ...Element) *PatternElement {
-	return &PatternElement{
+// RadialGradient returns a new RadialGradientElement.
+func RadialGradient(children ...Element) *RadialGradientElement {
+	return &RadialGradientElement{
 		Attrs:    map[string]AttrValue{},
 		Children: children,
 	}
 }
 
 // AppendChildren appends the given children.
-func (e *PatternElement) AppendChildren(children ...Element) *PatternElement {
+func (e *RadialGradientElement) AppendChildren(children ...Element) *RadialGradientElement {
 	e.Children = append(e.Children, children...)
 	return e
 }
 
+// RemoveAttrs removes the attributes with the given names.
+func (e *RadialGradientElement) RemoveAttrs(names ...string) *RadialGradientElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
 // ID sets the id attribute.
-func (e *PatternElement) ID(id String) *PatternElement {
+func (e *RadialGradientElement) ID(id String) *RadialGradientElement {
 	e.Attrs["id"] = id
 	return e
 }
 
 // TabIndex sets the tabindex attribute.
-func (e *PatternElement) TabIndex(tabIndex Int) *PatternElement {
+func (e *RadialGradientElement) TabIndex(tabIndex Int) *RadialGradientElement {
 	e.Attrs["tabindex"] = tabIndex
 	return e
 }
 
 // Lang sets the lang attribute.
-func (e *PatternElement) Lang(lang String) *PatternElement {
+func (e *RadialGradientElement) Lang(lang String) *RadialGradientElement {
 	e.Attrs["lang"] = lang
 	return e
 }
 
 // Class sets the class attribute.
-func (e *PatternElement) Class(class String) *PatternElement {
+func (e *RadialGradientElement) Class(class String) *RadialGradientElement {
 	e.Attrs["class"] = class
 	return e
 }
 
 // Style sets the style attribute.
-func (e *PatternElement) Style(style String) *PatternElement {
+func (e *RadialGradientElement) Style(style String) *RadialGradientElement {
 	e.Attrs["style"] = style
 	return e
 }
 
 // AlignmentBaseline sets the alignment-baseline attribute.
-func (e *PatternElement) AlignmentBaseline(alignmentBaseline String) *PatternElement {
+func (e *RadialGradientElement) AlignmentBaseline(alignmentBaseline String) *RadialGradientElement {
 	e.Attrs["alignment-baseline"] = alignmentBaseline
 	return e
 }
 
 // BaselineShift sets the baseline-shift attribute.
-func (e *PatternElement) BaselineShift(baselineShift String) *PatternElement {
+func (e *RadialGradientElement) BaselineShift(baselineShift String) *RadialGradientElement {
 	e.Attrs["baseline-shift"] = baselineShift
 	return e
 }
 
 // ClipPath sets the clip-path attribute.
-func (e *PatternElement) ClipPath(clipPath String) *PatternElement {
+func (e *RadialGradientElement) ClipPath(clipPath String) *RadialGradientElement {
 	e.Attrs["clip-path"] = clipPath
 	return e
 }
 
 // ClipRule sets the clip-rule attribute.
-func (e *PatternElement) ClipRule(clipRule String) *PatternElement {
+func (e *RadialGradientElement) ClipRule(clipRule String) *RadialGradientElement {
 	e.Attrs["clip-rule"] = clipRule
 	return e
 }
 
 // Color sets the color attribute.
-func (e *PatternElement) Color(color String) *PatternElement {
+func (e *RadialGradientElement) Color(color Color) *RadialGradientElement {
 	e.Attrs["color"] = color
 	return e
 }
 
 // ColorInterpolation sets the color-interpolation attribute.
-func (e *PatternElement) ColorInterpolation(colorInterpolation String) *PatternElement {
+func (e *RadialGradientElement) ColorInterpolation(colorInterpolation String) *RadialGradientElement {
 	e.Attrs["color-interpolation"] = colorInterpolation
 	return e
 }
 
 // ColorInterpolationFilters sets the color-interpolation-filters attribute.
-func (e *PatternElement) ColorInterpolationFilters(colorInterpolationFilters String) *PatternElement {
+func (e *RadialGradientElement) ColorInterpolationFilters(colorInterpolationFilters String) *RadialGradientElement {
 	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
 	return e
 }
 
 // ColorRendering sets the color-rendering attribute.
-func (e *PatternElement) ColorRendering(colorRendering String) *PatternElement {
+func (e *RadialGradientElement) ColorRendering(colorRendering String) *RadialGradientElement {
 	e.Attrs["color-rendering"] = colorRendering
 	return e
 }
 
 // Cursor sets the cursor attribute.
-func (e *PatternElement) Cursor(cursor String) *PatternElement {
+func (e *RadialGradientElement) Cursor(cursor String) *RadialGradientElement {
 	e.Attrs["cursor"] = cursor
 	return e
 }
 
 // Direction sets the direction attribute.
-func (e *PatternElement) Direction(direction String) *PatternElement {
+func (e *RadialGradientElement) Direction(direction String) *RadialGradientElement {
 	e.Attrs["direction"] = direction
 	return e
 }
 
 // Display sets the display attribute.
-func (e *PatternElement) Display(display String) *PatternElement {
+func (e *RadialGradientElement) Display(display String) *RadialGradientElement {
 	e.Attrs["display"] = display
 	return e
 }
 
 // DominantBaseline sets the dominant-baseline attribute.
-func (e *PatternElement) DominantBaseline(dominantBaseline String) *PatternElement {
+func (e *RadialGradientElement) DominantBaseline(dominantBaseline String) *RadialGradientElement {
 	e.Attrs["dominant-baseline"] = dominantBaseline
 	return e
 }
 
 // Fill sets the fill attribute.
-func (e *PatternElement) Fill(fill String) *PatternElement {
+func (e *RadialGradientElement) Fill(fill String) *RadialGradientElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *RadialGradientElement) FillColor(fill Color) *RadialGradientElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *RadialGradientElement) FillPaint(fill Paint) *RadialGradientElement {
 	e.Attrs["fill"] = fill
 	return e
 }
 
 // FillOpacity sets the fill-opacity attribute.
-func (e *PatternElement) FillOpacity(fillOpacity Float64) *PatternElement {
+func (e *RadialGradientElement) FillOpacity(fillOpacity Float64) *RadialGradientElement {
 	e.Attrs["fill-opacity"] = fillOpacity
 	return e
 }
 
 // FillRule sets the fill-rule attribute.
-func (e *PatternElement) FillRule(fillRule String) *PatternElement {
+func (e *RadialGradientElement) FillRule(fillRule String) *RadialGradientElement {
 	e.Attrs["fill-rule"] = fillRule
 	return e
 }
 
 // Filter sets the filter attribute.
-func (e *PatternElement) Filter(filter String) *PatternElement {
+func (e *RadialGradientElement) Filter(filter String) *RadialGradientElement {
 	e.Attrs["filter"] = filter
 	return e
 }
 
 // FloodColor sets the flood-color attribute.
-func (e *PatternElement) FloodColor(floodColor String) *PatternElement {
+func (e *RadialGradientElement) FloodColor(floodColor Color) *RadialGradientElement {
 	e.Attrs["flood-color"] = floodColor
 	return e
 }
 
 // FloodOpacity sets the flood-opacity attribute.
-func (e *PatternElement) FloodOpacity(floodOpacity Float64) *PatternElement {
+func (e *RadialGradientElement) FloodOpacity(floodOpacity Float64) *RadialGradientElement {
 	e.Attrs["flood-opacity"] = floodOpacity
 	return e
 }
 
 // FontFamily sets the font-family attribute.
-func (e *PatternElement) FontFamily(fontFamily String) *PatternElement {
+func (e *RadialGradientElement) FontFamily(fontFamily String) *RadialGradientElement {
 	e.Attrs["font-family"] = fontFamily
 	return e
 }
 
 // FontSize sets the font-size attribute.
-func (e *PatternElement) FontSize(fontSize String) *PatternElement {
+func (e *RadialGradientElement) FontSize(fontSize String) *RadialGradientElement {
 	e.Attrs["font-size"] = fontSize
 	return e
 }
 
 // FontSizeAdjust sets the font-size-adjust attribute.
-func (e *PatternElement) FontSizeAdjust(fontSizeAdjust String) *PatternElement {
+func (e *RadialGradientElement) FontSizeAdjust(fontSizeAdjust String) *RadialGradientElement {
 	e.Attrs["font-size-adjust"] = fontSizeAdjust
 	return e
 }
 
 // FontStretch sets the font-stretch attribute.
-func (e *PatternElement) FontStretch(fontStretch String) *PatternElement {
+func (e *RadialGradientElement) FontStretch(fontStretch String) *RadialGradientElement {
 	e.Attrs["font-stretch"] = fontStretch
 	return e
 }
 
 // FontStyle sets the font-style attribute.
-func (e *PatternElement) FontStyle(fontStyle String) *PatternElement {
+func (e *RadialGradientElement) FontStyle(fontStyle String) *RadialGradientElement {
 	e.Attrs["font-style"] = fontStyle
 	return e
 }
 
 // FontVariant sets the font-variant attribute.
-func (e *PatternElement) FontVariant(fontVariant String) *PatternElement {
+func (e *RadialGradientElement) FontVariant(fontVariant String) *RadialGradientElement {
 	e.Attrs["font-variant"] = fontVariant
 	return e
 }
 
 // FontWeight sets the font-weight attribute.
-func (e *PatternElement) FontWeight(fontWeight String) *PatternElement {
+func (e *RadialGradientElement) FontWeight(fontWeight String) *RadialGradientElement {
 	e.Attrs["font-weight"] = fontWeight
 	return e
 }
 
 // GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
-func (e *PatternElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *PatternElement {
+func (e *RadialGradientElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *RadialGradientElement {
 	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
 	return e
 }
 
 // GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
-func (e *PatternElement) GlyphOrientationVertical(glyphOrientationVertical String) *PatternElement {
+func (e *RadialGradientElement) GlyphOrientationVertical(glyphOrientationVertical String) *RadialGradientElement {
 	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
 	return e
 }
 
 // ImageRendering sets the image-rendering attribute.
-func (e *PatternElement) ImageRendering(imageRendering String) *PatternElement {
+func (e *RadialGradientElement) ImageRendering(imageRendering String) *RadialGradientElement {
 	e.Attrs["image-rendering"] = imageRendering
 	return e
 }
 
 // LetterSpacing sets the letter-spacing attribute.
-func (e *PatternElement) LetterSpacing(letterSpacing String) *PatternElement {
+func (e *RadialGradientElement) LetterSpacing(letterSpacing String) *RadialGradientElement {
 	e.Attrs["letter-spacing"] = letterSpacing
 	return e
 }
 
 // LightingColor sets the lighting-color attribute.
-func (e *PatternElement) LightingColor(lightingColor String) *PatternElement {
+func (e *RadialGradientElement) LightingColor(lightingColor Color) *RadialGradientElement {
 	e.Attrs["lighting-color"] = lightingColor
 	return e
 }
 
 // MarkerEnd sets the marker-end attribute.
-func (e *PatternElement) MarkerEnd(markerEnd String) *PatternElement {
+func (e *RadialGradientElement) MarkerEnd(markerEnd String) *RadialGradientElement {
 	e.Attrs["marker-end"] = markerEnd
 	return e
 }
 
 // MarkerMid sets the marker-mid attribute.
-func (e *PatternElement) MarkerMid(markerMid String) *PatternElement {
+func (e *RadialGradientElement) MarkerMid(markerMid String) *RadialGradientElement {
 	e.Attrs["marker-mid"] = markerMid
 	return e
 }
 
 // MarkerStart sets the marker-start attribute.
-func (e *PatternElement) MarkerStart(markerStart String) *PatternElement {
+func (e *RadialGradientElement) MarkerStart(markerStart String) *RadialGradientElement {
 	e.Attrs["marker-start"] = markerStart
 	return e
 }
 
 // Mask sets the mask attribute.
-func (e *PatternElement) Mask(mask String) *PatternElement {
+func (e *RadialGradientElement) Mask(mask String) *RadialGradientElement {
 	e.Attrs["mask"] = mask
 	return e
 }
 
 // Opacity sets the opacity attribute.
-func (e *PatternElement) Opacity(opacity Float64) *PatternElement {
+func (e *RadialGradientElement) Opacity(opacity Float64) *RadialGradientElement {
 	e.Attrs["opacity"] = opacity
 	return e
 }
 
 // Overflow sets the overflow attribute.
-func (e *PatternElement) Overflow(overflow String) *PatternElement {
+func (e *RadialGradientElement) Overflow(overflow String) *RadialGradientElement {
 	e.Attrs["overflow"] = overflow
 	return e
 }
 
 // PaintOrder sets the paint-order attribute.
-func (e *PatternElement) PaintOrder(paintOrder String) *PatternElement {
+func (e *RadialGradientElement) PaintOrder(paintOrder String) *RadialGradientElement {
 	e.Attrs["paint-order"] = paintOrder
 	return e
 }
 
 // PointerEvents sets the pointer-events attribute.
-func (e *PatternElement) PointerEvents(pointerEvents String) *PatternElement {
+func (e *RadialGradientElement) PointerEvents(pointerEvents String) *RadialGradientElement {
 	e.Attrs["pointer-events"] = pointerEvents
 	return e
 }
 
 // ShapeRendering sets the shape-rendering attribute.
-func (e *PatternElement) ShapeRendering(shapeRendering String) *PatternElement {
+func (e *RadialGradientElement) ShapeRendering(shapeRendering String) *RadialGradientElement {
 	e.Attrs["shape-rendering"] = shapeRendering
 	return e
 }
 
 // StopColor sets the stop-color attribute.
-func (e *PatternElement) StopColor(stopColor String) *PatternElement {
+func (e *RadialGradientElement) StopColor(stopColor Color) *RadialGradientElement {
 	e.Attrs["stop-color"] = stopColor
 	return e
 }
 
 // StopOpacity sets the stop-opacity attribute.
-func (e *PatternElement) StopOpacity(stopOpacity Float64) *PatternElement {
+func (e *RadialGradientElement) StopOpacity(stopOpacity Float64) *RadialGradientElement {
 	e.Attrs["stop-opacity"] = stopOpacity
 	return e
 }
 
 // Stroke sets the stroke attribute.
-func (e *PatternElement) Stroke(stroke String) *PatternElement {
+func (e *RadialGradientElement) Stroke(stroke String) *RadialGradientElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *RadialGradientElement) StrokeColor(stroke Color) *RadialGradientElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *RadialGradientElement) StrokePaint(stroke Paint) *RadialGradientElement {
 	e.Attrs["stroke"] = stroke
 	return e
 }
 
 // StrokeDashArray sets the stroke-dasharray attribute.
-func (e *PatternElement) StrokeDashArray(strokeDashArray String) *PatternElement {
+func (e *RadialGradientElement) StrokeDashArray(strokeDashArray String) *RadialGradientElement {
 	e.Attrs["stroke-dasharray"] = strokeDashArray
 	return e
 }
 
 // StrokeDashOffset sets the stroke-dashoffset attribute.
-func (e *PatternElement) StrokeDashOffset(strokeDashOffset Float64) *PatternElement {
+func (e *RadialGradientElement) StrokeDashOffset(strokeDashOffset Float64) *RadialGradientElement {
 	e.Attrs["stroke-dashoffset"] = strokeDashOffset
 	return e
 }
 
 // StrokeLineCap sets the stroke-linecap attribute.
-func (e *PatternElement) StrokeLineCap(strokeLineCap String) *PatternElement {
+func (e *RadialGradientElement) StrokeLineCap(strokeLineCap String) *RadialGradientElement {
 	e.Attrs["stroke-linecap"] = strokeLineCap
 	return e
 }
 
 // StrokeLineJoin sets the stroke-linejoin attribute.
-func (e *PatternElement) StrokeLineJoin(strokeLineJoin String) *PatternElement {
+func (e *RadialGradientElement) StrokeLineJoin(strokeLineJoin String) *RadialGradientElement {
 	e.Attrs["stroke-linejoin"] = strokeLineJoin
 	return e
 }
 
 // StrokeMiterLimit sets the stroke-miterlimit attribute.
-func (e *PatternElement) StrokeMiterLimit(strokeMiterLimit Float64) *PatternElement {
+func (e *RadialGradientElement) StrokeMiterLimit(strokeMiterLimit Float64) *RadialGradientElement {
 	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
 	return e
 }
 
 // StrokeOpacity sets the stroke-opacity attribute.
-func (e *PatternElement) StrokeOpacity(strokeOpacity Float64) *PatternElement {
+func (e *RadialGradientElement) StrokeOpacity(strokeOpacity Float64) *RadialGradientElement {
 	e.Attrs["stroke-opacity"] = strokeOpacity
 	return e
 }
 
 // StrokeWidth sets the stroke-width attribute.
-func (e *PatternElement) StrokeWidth(strokeWidth Length) *PatternElement {
+func (e *RadialGradientElement) StrokeWidth(strokeWidth Length) *RadialGradientElement {
 	e.Attrs["stroke-width"] = strokeWidth
 	return e
 }
 
 // TextAnchor sets the text-anchor attribute.
-func (e *PatternElement) TextAnchor(textAnchor String) *PatternElement {
+func (e *RadialGradientElement) TextAnchor(textAnchor String) *RadialGradientElement {
 	e.Attrs["text-anchor"] = textAnchor
 	return e
 }
 
 // TextDecoration sets the text-decoration attribute.
-func (e *PatternElement) TextDecoration(textDecoration String) *PatternElement {
+func (e *RadialGradientElement) TextDecoration(textDecoration String) *RadialGradientElement {
 	e.Attrs["text-decoration"] = textDecoration
 	return e
 }
 
 // TextOverflow sets the text-overflow attribute.
-func (e *PatternElement) TextOverflow(textOverflow String) *PatternElement {
+func (e *RadialGradientElement) TextOverflow(textOverflow String) *RadialGradientElement {
 	e.Attrs["text-overflow"] = textOverflow
 	return e
 }
 
 // TextRendering sets the text-rendering attribute.
-func (e *PatternElement) TextRendering(textRendering String) *PatternElement {
+func (e *RadialGradientElement) TextRendering(textRendering String) *RadialGradientElement {
 	e.Attrs["text-rendering"] = textRendering
 	return e
 }
 
+// Transform sets the transform attribute.
+func (e *RadialGradientElement) Transform(transform Transform) *RadialGradientElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
 // UnicodeBiDi sets the unicode-bidi attribute.
-func (e *PatternElement) UnicodeBiDi(unicodeBiDi String) *PatternElement {
+func (e *RadialGradientElement) UnicodeBiDi(unicodeBiDi String) *RadialGradientElement {
 	e.Attrs["unicode-bidi"] = unicodeBiDi
 	return e
 }
 
 // VectorEffect sets the vector-effect attribute.
-func (e *PatternElement) VectorEffect(vectorEffect String) *PatternElement {
+func (e *RadialGradientElement) VectorEffect(vectorEffect String) *RadialGradientElement {
 	e.Attrs["vector-effect"] = vectorEffect
 	return e
 }
 
 // Visibility sets the visibility attribute.
-func (e *PatternElement) Visibility(visibility String) *PatternElement {
+func (e *RadialGradientElement) Visibility(visibility String) *RadialGradientElement {
 	e.Attrs["visibility"] = visibility
 	return e
 }
 
 // WhiteSpace sets the white-space attribute.
-func (e *PatternElement) WhiteSpace(whiteSpace String) *PatternElement {
+func (e *RadialGradientElement) WhiteSpace(whiteSpace String) *RadialGradientElement {
 	e.Attrs["white-space"] = whiteSpace
 	return e
 }
 
 // WordSpacing sets the word-spacing attribute.
-func (e *PatternElement) WordSpacing(wordSpacing String) *PatternElement {
+func (e *RadialGradientElement) WordSpacing(wordSpacing String) *RadialGradientElement {
 	e.Attrs["word-spacing"] = wordSpacing
 	return e
 }
 
 // WritingMode sets the writing-mode attribute.
-func (e *PatternElement) WritingMode(writingMode String) *PatternElement {
+func (e *RadialGradientElement) WritingMode(writingMode String) *RadialGradientElement {
 	e.Attrs["writing-mode"] = writingMode
 	return e
 }
 
-// ViewBox sets the viewBox attribute.
-func (e *PatternElement) ViewBox(minX, minY, width, height float64) *PatternElement {
-	e.Attrs["viewBox"] = ViewBox{MinX: minX, MinY: minY, Width: width, Height: height}
+// CX sets the cx attribute.
+func (e *RadialGradientElement) CX(cx Length) *RadialGradientElement {
+	e.Attrs["cx"] = cx
 	return e
 }
 
-// PreserveAspectRatio sets the preserveAspectRatio attribute.
-func (e *PatternElement) PreserveAspectRatio(preserveAspectRatio String) *PatternElement {
-	e.Attrs["preserveAspectRatio"] = preserveAspectRatio
+// CY sets the cy attribute.
+func (e *RadialGradientElement) CY(cy Length) *RadialGradientElement {
+	e.Attrs["cy"] = cy
 	return e
 }
 
-// PatternUnits sets the patternUnits attribute.
-func (e *PatternElement) PatternUnits(patternUnits String) *PatternElement {
-	e.Attrs["patternUnits"] = patternUnits
+// R sets the r attribute.
+func (e *RadialGradientElement) R(r Length) *RadialGradientElement {
+	e.Attrs["r"] = r
 	return e
 }
 
-// PatternContentUnits sets the patternContentUnits attribute.
-func (e *PatternElement) PatternContentUnits(patternContentUnits String) *PatternElement {
-	e.Attrs["patternContentUnits"] = patternContentUnits
+// FX sets the fx attribute.
+func (e *RadialGradientElement) FX(fx Length) *RadialGradientElement {
+	e.Attrs["fx"] = fx
 	return e
 }
 
-// PatternTransform sets the patternTransform attribute.
-func (e *PatternElement) PatternTransform(patternTransform String) *PatternElement {
-	e.Attrs["patternTransform"] = patternTransform
+// FY sets the fy attribute.
+func (e *RadialGradientElement) FY(fy Length) *RadialGradientElement {
+	e.Attrs["fy"] = fy
 	return e
 }
 
-// Href sets the href attribute.
-func (e *PatternElement) Href(href String) *PatternElement {
-	e.Attrs["href"] = href
+// FR sets the fr attribute.
+func (e *RadialGradientElement) FR(fr Length) *RadialGradientElement {
+	e.Attrs["fr"] = fr
 	return e
 }
 
-// X sets the x attribute.
-func (e *PatternElement) X(x Length) *PatternElement {
-	e.Attrs["x"] = x
+// GradientUnits sets the gradientUnits attribute.
+func (e *RadialGradientElement) GradientUnits(gradientUnits String) *RadialGradientElement {
+	e.Attrs["gradientUnits"] = gradientUnits
 	return e
 }
 
-// Y sets the y attribute.
-func (e *PatternElement) Y(y Length) *PatternElement {
-	e.Attrs["y"] = y
+// GradientTransform sets the gradientTransform attribute.
+func (e *RadialGradientElement) GradientTransform(gradientTransform Transform) *RadialGradientElement {
+	e.Attrs["gradientTransform"] = gradientTransform
 	return e
 }
 
-// Width sets the width attribute.
-func (e *PatternElement) Width(width Length) *PatternElement {
-	e.Attrs["width"] = width
+// SpreadMethod sets the spreadMethod attribute.
+func (e *RadialGradientElement) SpreadMethod(spreadMethod String) *RadialGradientElement {
+	e.Attrs["spreadMethod"] = spreadMethod
 	return e
 }
 
-// Height sets the height attribute.
-func (e *PatternElement) Height(height Length) *PatternElement {
-	e.Attrs["height"] = height
+// Href sets the href attribute.
+func (e *RadialGradientElement) Href(href String) *RadialGradientElement {
+	e.Attrs["href"] = href
 	return e
 }
 
-// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
-func (e *PatternElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
-	return encodeElement(encoder, "pattern", e.Attrs, e.Children)
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *RadialGradientElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *RadialGradientElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *RadialGradientElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *RadialGradientElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *RadialGradientElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *RadialGradientElement) TagName() string {
+	return "radialGradient"
 }
 
-// A PolygonElement is a polygon element.
-type PolygonElement struct {
+// decode decodes e from d.
+func (e *RadialGradientElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *RadialGradientElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "cx":
+		return parseLength(value)
+	case "cy":
+		return parseLength(value)
+	case "r":
+		return parseLength(value)
+	case "fx":
+		return parseLength(value)
+	case "fy":
+		return parseLength(value)
+	case "fr":
+		return parseLength(value)
+	case "gradientTransform":
+		return parseTransform(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// A RectElement is a rect element.
+type RectElement struct {
 	Attrs    map[string]AttrValue
 	Children []Element
 }
 
-// Polygon returns a new PolygonElement.
-func Polygon(children ...Element) *PolygonElement {
-	return &PolygonElement{
+// Rect returns a new RectElement.
+func Rect(children ...Element) *RectElement {
+	return &RectElement{
 		Attrs:    map[string]AttrValue{},
 		Children: children,
 	}
 }
 
 // AppendChildren appends the given children.
-func (e *PolygonElement) AppendChildren(children ...Element) *PolygonElement {
+func (e *RectElement) AppendChildren(children ...Element) *RectElement {
 	e.Children = append(e.Children, children...)
 	return e
 }
 
+// RemoveAttrs removes the attributes with the given names.
+func (e *RectElement) RemoveAttrs(names ...string) *RectElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
 // ID sets the id attribute.
-func (e *PolygonElement) ID(id String) *PolygonElement {
+func (e *RectElement) ID(id String) *RectElement {
 	e.Attrs["id"] = id
 	return e
 }
 
 // TabIndex sets the tabindex attribute.
-func (e *PolygonElement) TabIndex(tabIndex Int) *PolygonElement {
+func (e *RectElement) TabIndex(tabIndex Int) *RectElement {
 	e.Attrs["tabindex"] = tabIndex
 	return e
 }
 
 // Lang sets the lang attribute.
-func (e *PolygonElement) Lang(lang String) *PolygonElement {
+func (e *RectElement) Lang(lang String) *RectElement {
 	e.Attrs["lang"] = lang
 	return e
 }
 
 // Class sets the class attribute.
-func (e *PolygonElement) Class(class String) *PolygonElement {
+func (e *RectElement) Class(class String) *RectElement {
 	e.Attrs["class"] = class
 	return e
 }
 
 // Style sets the style attribute.
-func (e *PolygonElement) Style(style String) *PolygonElement {
+func (e *RectElement) Style(style String) *RectElement {
 	e.Attrs["style"] = style
 	return e
 }
 
 // AlignmentBaseline sets the alignment-baseline attribute.
-func (e *PolygonElement) AlignmentBaseline(alignmentBaseline String) *PolygonElement {
+func (e *RectElement) AlignmentBaseline(alignmentBaseline String) *RectElement {
 	e.Attrs["alignment-baseline"] = alignmentBaseline
 	return e
 }
 
 // BaselineShift sets the baseline-shift attribute.
-func (e *PolygonElement) BaselineShift(baselineShift String) *PolygonElement {
+func (e *RectElement) BaselineShift(baselineShift String) *RectElement {
 	e.Attrs["baseline-shift"] = baselineShift
 	return e
 }
 
 // ClipPath sets the clip-path attribute.
-func (e *PolygonElement) ClipPath(clipPath String) *PolygonElement {
+func (e *RectElement) ClipPath(clipPath String) *RectElement {
 	e.Attrs["clip-path"] = clipPath
 	return e
 }
 
 // ClipRule sets the clip-rule attribute.
-func (e *PolygonElement) ClipRule(clipRule String) *PolygonElement {
+func (e *RectElement) ClipRule(clipRule String) *RectElement {
 	e.Attrs["clip-rule"] = clipRule
 	return e
 }
 
 // Color sets the color attribute.
-func (e *PolygonElement) Color(color String) *PolygonElement {
+func (e *RectElement) Color(color Color) *RectElement {
 	e.Attrs["color"] = color
 	return e
 }
 
 // ColorInterpolation sets the color-interpolation attribute.
-func (e *PolygonElement) ColorInterpolation(colorInterpolation String) *PolygonElement {
+func (e *RectElement) ColorInterpolation(colorInterpolation String) *RectElement {
 	e.Attrs["color-interpolation"] = colorInterpolation
 	return e
 }
 
 // ColorInterpolationFilters sets the color-interpolation-filters attribute.
-func (e *PolygonElement) ColorInterpolationFilters(colorInterpolationFilters String) *PolygonElement {
+func (e *RectElement) ColorInterpolationFilters(colorInterpolationFilters String) *RectElement {
 	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
 	return e
 }
 
 // ColorRendering sets the color-rendering attribute.
-func (e *PolygonElement) ColorRendering(colorRendering String) *PolygonElement {
+func (e *RectElement) ColorRendering(colorRendering String) *RectElement {
 	e.Attrs["color-rendering"] = colorRendering
 	return e
 }
 
 // Cursor sets the cursor attribute.
-func (e *PolygonElement) Cursor(cursor String) *PolygonElement {
+func (e *RectElement) Cursor(cursor String) *RectElement {
 	e.Attrs["cursor"] = cursor
 	return e
 }
 
 // Direction sets the direction attribute.
-func (e *PolygonElement) Direction(direction String) *PolygonElement {
+func (e *RectElement) Direction(direction String) *RectElement {
 	e.Attrs["direction"] = direction
 	return e
 }
 
 // Display sets the display attribute.
-func (e *PolygonElement) Display(display String) *PolygonElement {
+func (e *RectElement) Display(display String) *RectElement {
 	e.Attrs["display"] = display
 	return e
 }
 
 // DominantBaseline sets the dominant-baseline attribute.
-func (e *PolygonElement) DominantBaseline(dominantBaseline String) *PolygonElement {
+func (e *RectElement) DominantBaseline(dominantBaseline String) *RectElement {
 	e.Attrs["dominant-baseline"] = dominantBaseline
 	return e
 }
 
 // Fill sets the fill attribute.
-func (e *PolygonElement) Fill(fill String) *PolygonElement {
+func (e *RectElement) Fill(fill String) *RectElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *RectElement) FillColor(fill Color) *RectElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *RectElement) FillPaint(fill Paint) *RectElement {
 	e.Attrs["fill"] = fill
 	return e
 }
 
 // FillOpacity sets the fill-opacity attribute.
-func (e *PolygonElement) FillOpacity(fillOpacity Float64) *PolygonElement {
+func (e *RectElement) FillOpacity(fillOpacity Float64) *RectElement {
 	e.Attrs["fill-opacity"] = fillOpacity
 	return e
 }
 
 // FillRule sets the fill-rule attribute.
-func (e *PolygonElement) FillRule(fillRule String) *PolygonElement {
+func (e *RectElement) FillRule(fillRule String) *RectElement {
 	e.Attrs["fill-rule"] = fillRule
 	return e
 }
 
 // Filter sets the filter attribute.
-func (e *PolygonElement) Filter(filter String) *PolygonElement {
+func (e *RectElement) Filter(filter String) *RectElement {
 	e.Attrs["filter"] = filter
 	return e
 }
 
 // FloodColor sets the flood-color attribute.
-func (e *PolygonElement) FloodColor(floodColor String) *PolygonElement {
+func (e *RectElement) FloodColor(floodColor Color) *RectElement {
 	e.Attrs["flood-color"] = floodColor
 	return e
 }
 
 // FloodOpacity sets the flood-opacity attribute.
-func (e *PolygonElement) FloodOpacity(floodOpacity Float64) *PolygonElement {
+func (e *RectElement) FloodOpacity(floodOpacity Float64) *RectElement {
 	e.Attrs["flood-opacity"] = floodOpacity
 	return e
 }
 
 // FontFamily sets the font-family attribute.
-func (e *PolygonElement) FontFamily(fontFamily String) *PolygonElement {
+func (e *RectElement) FontFamily(fontFamily String) *RectElement {
 	e.Attrs["font-family"] = fontFamily
 	return e
 }
 
 // FontSize sets the font-size attribute.
-func (e *PolygonElement) FontSize(fontSize String) *PolygonElement {
+func (e *RectElement) FontSize(fontSize String) *RectElement {
 	e.Attrs["font-size"] = fontSize
 	return e
 }
 
 // FontSizeAdjust sets the font-size-adjust attribute.
-func (e *PolygonElement) FontSizeAdjust(fontSizeAdjust String) *PolygonElement {
+func (e *RectElement) FontSizeAdjust(fontSizeAdjust String) *RectElement {
 	e.Attrs["font-size-adjust"] = fontSizeAdjust
 	return e
 }
 
 // FontStretch sets the font-stretch attribute.
-func (e *PolygonElement) FontStretch(fontStretch String) *PolygonElement {
+func (e *RectElement) FontStretch(fontStretch String) *RectElement {
 	e.Attrs["font-stretch"] = fontStretch
 	return e
 }
 
 // FontStyle sets the font-style attribute.
-func (e *PolygonElement) FontStyle(fontStyle String) *PolygonElement {
+func (e *RectElement) FontStyle(fontStyle String) *RectElement {
 	e.Attrs["font-style"] = fontStyle
 	return e
 }
 
 // FontVariant sets the font-variant attribute.
-func (e *PolygonElement) FontVariant(fontVariant String) *PolygonElement {
+func (e *RectElement) FontVariant(fontVariant String) *RectElement {
 	e.Attrs["font-variant"] = fontVariant
 	return e
 }
 
 // FontWeight sets the font-weight attribute.
-func (e *PolygonElement) FontWeight(fontWeight String) *PolygonElement {
+func (e *RectElement) FontWeight(fontWeight String) *RectElement {
 	e.Attrs["font-weight"] = fontWeight
 	return e
 }
 
 // GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
-func (e *PolygonElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *PolygonElement {
+func (e *RectElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *RectElement {
 	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
 	return e
 }
 
 // GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
-func (e *PolygonElement) GlyphOrientationVertical(glyphOrientationVertical String) *PolygonElement {
+func (e *RectElement) GlyphOrientationVertical(glyphOrientationVertical String) *RectElement {
 	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
 	return e
 }
 
 // ImageRendering sets the image-rendering attribute.
-func (e *PolygonElement) ImageRendering(imageRendering String) *PolygonElement {
+func (e *RectElement) ImageRendering(imageRendering String) *RectElement {
 	e.Attrs["image-rendering"] = imageRendering
 	return e
 }
 
 // LetterSpacing sets the letter-spacing attribute.
-func (e *PolygonElement) LetterSpacing(letterSpacing String) *PolygonElement {
+func (e *RectElement) LetterSpacing(letterSpacing String) *RectElement {
 	e.Attrs["letter-spacing"] = letterSpacing
 	return e
 }
 
 // LightingColor sets the lighting-color attribute.
-func (e *PolygonElement) LightingColor(lightingColor String) *PolygonElement {
+func (e *RectElement) LightingColor(lightingColor Color) *RectElement {
 	e.Attrs["lighting-color"] = lightingColor
 	return e
 }
 
 // MarkerEnd sets the marker-end attribute.
-func (e *PolygonElement) MarkerEnd(markerEnd String) *PolygonElement {
+func (e *RectElement) MarkerEnd(markerEnd String) *RectElement {
 	e.Attrs["marker-end"] = markerEnd
 	return e
 }
 
 // MarkerMid sets the marker-mid attribute.
-func (e *PolygonElement) MarkerMid(markerMid String) *PolygonElement {
+func (e *RectElement) MarkerMid(markerMid String) *RectElement {
 	e.Attrs["marker-mid"] = markerMid
 	return e
 }
 
 // MarkerStart sets the marker-start attribute.
-func (e *PolygonElement) MarkerStart(markerStart String) *PolygonElement {
+func (e *RectElement) MarkerStart(markerStart String) *RectElement {
 	e.Attrs["marker-start"] = markerStart
 	return e
 }
 
 // Mask sets the mask attribute.
-func (e *PolygonElement) Mask(mask String) *PolygonElement {
+func (e *RectElement) Mask(mask String) *RectElement {
 	e.Attrs["mask"] = mask
 	return e
 }
 
 // Opacity sets the opacity attribute.
-func (e *PolygonElement) Opacity(opacity Float64) *PolygonElement {
+func (e *RectElement) Opacity(opacity Float64) *RectElement {
 	e.Attrs["opacity"] = opacity
 	return e
 }
 
 // Overflow sets the overflow attribute.
-func (e *PolygonElement) Overflow(overflow String) *PolygonElement {
+func (e *RectElement) Overflow(overflow String) *RectElement {
 	e.Attrs["overflow"] = overflow
 	return e
 }
 
 // PaintOrder sets the paint-order attribute.
-func (e *PolygonElement) PaintOrder(paintOrder String) *PolygonElement {
+func (e *RectElement) PaintOrder(paintOrder String) *RectElement {
 	e.Attrs["paint-order"] = paintOrder
 	return e
 }
 
 // PointerEvents sets the pointer-events attribute.
-func (e *PolygonElement) PointerEvents(pointerEvents String) *PolygonElement {
+func (e *RectElement) PointerEvents(pointerEvents String) *RectElement {
 	e.Attrs["pointer-events"] = pointerEvents
 	return e
 }
 
 // ShapeRendering sets the shape-rendering attribute.
-func (e *PolygonElement) ShapeRendering(shapeRendering String) *PolygonElement {
+func (e *RectElement) ShapeRendering(shapeRendering String) *RectElement {
 	e.Attrs["shape-rendering"] = shapeRendering
 	return e
 }
 
 // StopColor sets the stop-color attribute.
-func (e *PolygonElement) StopColor(stopColor String) *PolygonElement {
+func (e *RectElement) StopColor(stopColor Color) *RectElement {
 	e.Attrs["stop-color"] = stopColor
 	return e
 }
 
 // StopOpacity sets the stop-opacity attribute.
-func (e *PolygonElement) StopOpacity(stopOpacity Float64) *PolygonElement {
+func (e *RectElement) StopOpacity(stopOpacity Float64) *RectElement {
 	e.Attrs["stop-opacity"] = stopOpacity
 	return e
 }
 
 // Stroke sets the stroke attribute.
-func (e *PolygonElement) Stroke(stroke String) *PolygonElement {
-	e.Attrs["stroke"] = stroke
-	return e
-}
-
-// StrokeDashArray sets the stroke-dasharray attribute.
-func (e *PolygonElement) StrokeDashArray(strokeDashArray String) *PolygonElement {
-	e.Attrs["stroke-dasharray"] = strokeDashArray
-	return e
-}
-
-// StrokeDashOffset sets the stroke-dashoffset attribute.
-func (e *PolygonElement) StrokeDashOffset(strokeDashOffset Float64) *PolygonElement {
-	e.Attrs["stroke-dashoffset"] = strokeDashOffset
-	return e
-}
-
-// StrokeLineCap sets the stroke-linecap attribute.
-func (e *PolygonElement) StrokeLineCap(strokeLineCap String) *PolygonElement {
-	e.Attrs["stroke-linecap"] = strokeLineCap
-	return e
-}
-
-// StrokeLineJoin sets the stroke-linejoin attribute.
-func (e *PolygonElement) StrokeLineJoin(strokeLineJoin String) *PolygonElement {
-	e.Attrs["stroke-linejoin"] = strokeLineJoin
-	return e
-}
-
-// StrokeMiterLimit sets the stroke-miterlimit attribute.
-func (e *PolygonElement) StrokeMiterLimit(strokeMiterLimit Float64) *PolygonElement {
-	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
-	return e
-}
-
-// StrokeOpacity sets the stroke-opacity attribute.
-func (e *PolygonElement) StrokeOpacity(strokeOpacity Float64) *PolygonElement {
-	e.Attrs["stroke-opacity"] = strokeOpacity
-	return e
-}
-
-// StrokeWidth sets the stroke-width attribute.
-func (e *PolygonElement) StrokeWidth(strokeWidth Length) *PolygonElement {
-	e.Attrs["stroke-width"] = strokeWidth
-	return e
-}
-
-// TextAnchor sets the text-anchor attribute.
-func (e *PolygonElement) TextAnchor(textAnchor String) *PolygonElement {
-	e.Attrs["text-anchor"] = textAnchor
-	return e
-}
-
-// TextDecoration sets the text-decoration attribute.
-func (e *PolygonElement) TextDecoration(textDecoration String) *PolygonElement {
-	e.Attrs["text-decoration"] = textDecoration
-	return e
-}
-
-// TextOverflow sets the text-overflow attribute.
-func (e *PolygonElement) TextOverflow(textOverflow String) *PolygonElement {
-	e.Attrs["text-overflow"] = textOverflow
-	return e
-}
-
-// TextRendering sets the text-rendering attribute.
-func (e *PolygonElement) TextRendering(textRendering String) *PolygonElement {
-	e.Attrs["text-rendering"] = textRendering
-	return e
-}
-
-// UnicodeBiDi sets the unicode-bidi attribute.
-func (e *PolygonElement) UnicodeBiDi(unicodeBiDi String) *PolygonElement {
-	e.Attrs["unicode-bidi"] = unicodeBiDi
-	return e
-}
-
-// VectorEffect sets the vector-effect attribute.
-func (e *PolygonElement) VectorEffect(vectorEffect String) *PolygonElement {
-	e.Attrs["vector-effect"] = vectorEffect
-	return e
-}
-
-// Visibility sets the visibility attribute.
-func (e *PolygonElement) Visibility(visibility String) *PolygonElement {
-	e.Attrs["visibility"] = visibility
-	return e
-}
-
-// WhiteSpace sets the white-space attribute.
-func (e *PolygonElement) WhiteSpace(whiteSpace String) *PolygonElement {
-	e.Attrs["white-space"] = whiteSpace
-	return e
-}
-
-// WordSpacing sets the word-spacing attribute.
-func (e *PolygonElement) WordSpacing(wordSpacing String) *PolygonElement {
-	e.Attrs["word-spacing"] = wordSpacing
-	return e
-}
-
-// WritingMode sets the writing-mode attribute.
-func (e *PolygonElement) WritingMode(writingMode String) *PolygonElement {
-	e.Attrs["writing-mode"] = writingMode
-	return e
-}
-
-// PathLength sets the pathLength attribute.
-func (e *PolygonElement) PathLength(pathLength String) *PolygonElement {
-	e.Attrs["pathLength"] = pathLength
-	return e
-}
-
-// Points sets the points attribute.
-func (e *PolygonElement) Points(points Points) *PolygonElement {
-	e.Attrs["points"] = points
-	return e
-}
-
-// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
-func (e *PolygonElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
-	return encodeElement(encoder, "polygon", e.Attrs, e.Children)
-}
-
-// A PolylineElement is a polyline element.
-type PolylineElement struct {
-	Attrs    map[string]AttrValue
-	Children []Element
-}
-
-// Polyline returns a new PolylineElement.
-func Polyline(children ...Element) *PolylineElement {
-	return &PolylineElement{
-		Attrs:    map[string]AttrValue{},
-		Children: children,
-	}
-}
-
-// AppendChildren appends the given children.
-func (e *PolylineElement) AppendChildren(children ...Element) *PolylineElement {
-	e.Children = append(e.Children, children...)
+func (e *RectElement) Stroke(stroke String) *RectElement {
+	e.Attrs["stroke"] = stroke
 	return e
 }
 
-// ID sets the id attribute.
-func (e *PolylineElement) ID(id String) *PolylineElement {
-	e.Attrs["id"] = id
+// StrokeColor sets the stroke attribute to a Color.
+func (e *RectElement) StrokeColor(stroke Color) *RectElement {
+	e.Attrs["stroke"] = stroke
 	return e
 }
 
-// TabIndex sets the tabindex attribute.
-func (e *PolylineElement) TabIndex(tabIndex Int) *PolylineElement {
-	e.Attrs["tabindex"] = tabIndex
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *RectElement) StrokePaint(stroke Paint) *RectElement {
+	e.Attrs["stroke"] = stroke
 	return e
 }
 
-// Lang sets the lang attribute.
-func (e *PolylineElement) Lang(lang String) *PolylineElement {
-	e.Attrs["lang"] = lang
+// StrokeDashArray sets the stroke-dasharray attribute.
+func (e *RectElement) StrokeDashArray(strokeDashArray String) *RectElement {
+	e.Attrs["stroke-dasharray"] = strokeDashArray
 	return e
 }
 
-// Class sets the class attribute.
-func (e *PolylineElement) Class(class String) *PolylineElement {
-	e.Attrs["class"] = class
+// StrokeDashOffset sets the stroke-dashoffset attribute.
+func (e *RectElement) StrokeDashOffset(strokeDashOffset Float64) *RectElement {
+	e.Attrs["stroke-dashoffset"] = strokeDashOffset
 	return e
 }
 
-// Style sets the style attribute.
-func (e *PolylineElement) Style(style String) *PolylineElement {
-	e.Attrs["style"] = style
+// StrokeLineCap sets the stroke-linecap attribute.
+func (e *RectElement) StrokeLineCap(strokeLineCap String) *RectElement {
+	e.Attrs["stroke-linecap"] = strokeLineCap
 	return e
 }
 
-// AlignmentBaseline sets the alignment-baseline attribute.
-func (e *PolylineElement) AlignmentBaseline(alignmentBaseline String) *PolylineElement {
-	e.Attrs["alignment-baseline"] = alignmentBaseline
+// StrokeLineJoin sets the stroke-linejoin attribute.
+func (e *RectElement) StrokeLineJoin(strokeLineJoin String) *RectElement {
+	e.Attrs["stroke-linejoin"] = strokeLineJoin
 	return e
 }
 
-// BaselineShift sets the baseline-shift attribute.
-func (e *PolylineElement) BaselineShift(baselineShift String) *PolylineElement {
-	e.Attrs["baseline-shift"] = baselineShift
+// StrokeMiterLimit sets the stroke-miterlimit attribute.
+func (e *RectElement) StrokeMiterLimit(strokeMiterLimit Float64) *RectElement {
+	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
 	return e
 }
 
-// ClipPath sets the clip-path attribute.
-func (e *PolylineElement) ClipPath(clipPath String) *PolylineElement {
-	e.Attrs["clip-path"] = clipPath
+// StrokeOpacity sets the stroke-opacity attribute.
+func (e *RectElement) StrokeOpacity(strokeOpacity Float64) *RectElement {
+	e.Attrs["stroke-opacity"] = strokeOpacity
 	return e
 }
 
-// ClipRule sets the clip-rule attribute.
-func (e *PolylineElement) ClipRule(clipRule String) *PolylineElement {
-	e.Attrs["clip-rule"] = clipRule
+// StrokeWidth sets the stroke-width attribute.
+func (e *RectElement) StrokeWidth(strokeWidth Length) *RectElement {
+	e.Attrs["stroke-width"] = strokeWidth
 	return e
 }
 
-// Color sets the color attribute.
-func (e *PolylineElement) Color(color String) *PolylineElement {
-	e.Attrs["color"] = color
+// TextAnchor sets the text-anchor attribute.
+func (e *RectElement) TextAnchor(textAnchor String) *RectElement {
+	e.Attrs["text-anchor"] = textAnchor
 	return e
 }
 
-// ColorInterpolation sets the color-interpolation attribute.
-func (e *PolylineElement) ColorInterpolation(colorInterpolation String) *PolylineElement {
-	e.Attrs["color-interpolation"] = colorInterpolation
+// TextDecoration sets the text-decoration attribute.
+func (e *RectElement) TextDecoration(textDecoration String) *RectElement {
+	e.Attrs["text-decoration"] = textDecoration
 	return e
 }
 
-// ColorInterpolationFilters sets the color-interpolation-filters attribute.
-func (e *PolylineElement) ColorInterpolationFilters(colorInterpolationFilters String) *PolylineElement {
-	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
+// TextOverflow sets the text-overflow attribute.
+func (e *RectElement) TextOverflow(textOverflow String) *RectElement {
+	e.Attrs["text-overflow"] = textOverflow
 	return e
 }
 
-// ColorRendering sets the color-rendering attribute.
-func (e *PolylineElement) ColorRendering(colorRendering String) *PolylineElement {
-	e.Attrs["color-rendering"] = colorRendering
+// TextRendering sets the text-rendering attribute.
+func (e *RectElement) TextRendering(textRendering String) *RectElement {
+	e.Attrs["text-rendering"] = textRendering
 	return e
 }
 
-// Cursor sets the cursor attribute.
-func (e *PolylineElement) Cursor(cursor String) *PolylineElement {
-	e.Attrs["cursor"] = cursor
+// Transform sets the transform attribute.
+func (e *RectElement) Transform(transform Transform) *RectElement {
+	e.Attrs["transform"] = transform
 	return e
 }
 
-// Direction sets the direction attribute.
-func (e *PolylineElement) Direction(direction String) *PolylineElement {
-	e.Attrs["direction"] = direction
+// UnicodeBiDi sets the unicode-bidi attribute.
+func (e *RectElement) UnicodeBiDi(unicodeBiDi String) *RectElement {
+	e.Attrs["unicode-bidi"] = unicodeBiDi
 	return e
 }
 
-// Display sets the display attribute.
-func (e *PolylineElement) Display(display String) *PolylineElement {
-	e.Attrs["display"] = display
+// VectorEffect sets the vector-effect attribute.
+func (e *RectElement) VectorEffect(vectorEffect String) *RectElement {
+	e.Attrs["vector-effect"] = vectorEffect
 	return e
 }
 
-// DominantBaseline sets the dominant-baseline attribute.
-func (e *PolylineElement) DominantBaseline(dominantBaseline String) *PolylineElement {
-	e.Attrs["dominant-baseline"] = dominantBaseline
+// Visibility sets the visibility attribute.
+func (e *RectElement) Visibility(visibility String) *RectElement {
+	e.Attrs["visibility"] = visibility
 	return e
 }
 
-// Fill sets the fill attribute.
-func (e *PolylineElement) Fill(fill String) *PolylineElement {
-	e.Attrs["fill"] = fill
+// WhiteSpace sets the white-space attribute.
+func (e *RectElement) WhiteSpace(whiteSpace String) *RectElement {
+	e.Attrs["white-space"] = whiteSpace
 	return e
 }
 
-// FillOpacity sets the fill-opacity attribute.
-func (e *PolylineElement) FillOpacity(fillOpacity Float64) *PolylineElement {
-	e.Attrs["fill-opacity"] = fillOpacity
+// WordSpacing sets the word-spacing attribute.
+func (e *RectElement) WordSpacing(wordSpacing String) *RectElement {
+	e.Attrs["word-spacing"] = wordSpacing
 	return e
 }
 
-// FillRule sets the fill-rule attribute.
-func (e *PolylineElement) FillRule(fillRule String) *PolylineElement {
-	e.Attrs["fill-rule"] = fillRule
+// WritingMode sets the writing-mode attribute.
+func (e *RectElement) WritingMode(writingMode String) *RectElement {
+	e.Attrs["writing-mode"] = writingMode
 	return e
 }
 
-// Filter sets the filter attribute.
-func (e *PolylineElement) Filter(filter String) *PolylineElement {
-	e.Attrs["filter"] = filter
+// PathLength sets the pathLength attribute.
+func (e *RectElement) PathLength(pathLength String) *RectElement {
+	e.Attrs["pathLength"] = pathLength
 	return e
 }
 
-// FloodColor sets the flood-color attribute.
-func (e *PolylineElement) FloodColor(floodColor String) *PolylineElement {
-	e.Attrs["flood-color"] = floodColor
+// X sets the x attribute.
+func (e *RectElement) X(x Length) *RectElement {
+	e.Attrs["x"] = x
 	return e
 }
 
-// FloodOpacity sets the flood-opacity attribute.
-func (e *PolylineElement) FloodOpacity(floodOpacity Float64) *PolylineElement {
-	e.Attrs["flood-opacity"] = floodOpacity
+// Y sets the y attribute.
+func (e *RectElement) Y(y Length) *RectElement {
+	e.Attrs["y"] = y
 	return e
 }
 
-// FontFamily sets the font-family attribute.
-func (e *PolylineElement) FontFamily(fontFamily String) *PolylineElement {
-	e.Attrs["font-family"] = fontFamily
+// Width sets the width attribute.
+func (e *RectElement) Width(width Length) *RectElement {
+	e.Attrs["width"] = width
 	return e
 }
 
-// FontSize sets the font-size attribute.
-func (e *PolylineElement) FontSize(fontSize String) *PolylineElement {
-	e.Attrs["font-size"] = fontSize
+// Height sets the height attribute.
+func (e *RectElement) Height(height Length) *RectElement {
+	e.Attrs["height"] = height
 	return e
 }
 
-// FontSizeAdjust sets the font-size-adjust attribute.
-func (e *PolylineElement) FontSizeAdjust(fontSizeAdjust String) *PolylineElement {
-	e.Attrs["font-size-adjust"] = fontSizeAdjust
+// RX sets the rx attribute.
+func (e *RectElement) RX(rx Length) *RectElement {
+	e.Attrs["rx"] = rx
 	return e
 }
 
-// FontStretch sets the font-stretch attribute.
-func (e *PolylineElement) FontStretch(fontStretch String) *PolylineElement {
-	e.Attrs["font-stretch"] = fontStretch
+// RY sets the ry attribute.
+func (e *RectElement) RY(ry Length) *RectElement {
+	e.Attrs["ry"] = ry
 	return e
 }
 
-// FontStyle sets the font-style attribute.
-func (e *PolylineElement) FontStyle(fontStyle String) *PolylineElement {
-	e.Attrs["font-style"] = fontStyle
+// RXRY sets the rx and ry attributes.
+func (e *RectElement) RXRY(rx, ry float64, lengthFunc LengthFunc) *RectElement {
+	e.Attrs["rx"] = lengthFunc(rx)
+	e.Attrs["ry"] = lengthFunc(ry)
 	return e
 }
 
-// FontVariant sets the font-variant attribute.
-func (e *PolylineElement) FontVariant(fontVariant String) *PolylineElement {
-	e.Attrs["font-variant"] = fontVariant
+// WidthHeight sets the width and height attributes.
+func (e *RectElement) WidthHeight(width, height float64, lengthFunc LengthFunc) *RectElement {
+	e.Attrs["width"] = lengthFunc(width)
+	e.Attrs["height"] = lengthFunc(height)
 	return e
 }
 
-// FontWeight sets the font-weight attribute.
-func (e *PolylineElement) FontWeight(fontWeight String) *PolylineElement {
-	e.Attrs["font-weight"] = fontWeight
+// XY sets the x and y attributes.
+func (e *RectElement) XY(x, y float64, lengthFunc LengthFunc) *RectElement {
+	e.Attrs["x"] = lengthFunc(x)
+	e.Attrs["y"] = lengthFunc(y)
 	return e
 }
 
-// GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
-func (e *PolylineElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *PolylineElement {
-	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
+// XYWidthHeight sets the x, y, width, and height attributes.
+func (e *RectElement) XYWidthHeight(x, y, width, height float64, lengthFunc LengthFunc) *RectElement {
+	e.Attrs["x"] = lengthFunc(x)
+	e.Attrs["y"] = lengthFunc(y)
+	e.Attrs["width"] = lengthFunc(width)
+	e.Attrs["height"] = lengthFunc(height)
 	return e
 }
 
-// GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
-func (e *PolylineElement) GlyphOrientationVertical(glyphOrientationVertical String) *PolylineElement {
-	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
-	return e
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *RectElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
 }
 
-// ImageRendering sets the image-rendering attribute.
-func (e *PolylineElement) ImageRendering(imageRendering String) *PolylineElement {
-	e.Attrs["image-rendering"] = imageRendering
-	return e
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *RectElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
 }
 
-// LetterSpacing sets the letter-spacing attribute.
-func (e *PolylineElement) LetterSpacing(letterSpacing String) *PolylineElement {
-	e.Attrs["letter-spacing"] = letterSpacing
-	return e
+// Attributes implements Node.Attributes.
+func (e *RectElement) Attributes() map[string]AttrValue {
+	return e.Attrs
 }
 
-// LightingColor sets the lighting-color attribute.
-func (e *PolylineElement) LightingColor(lightingColor String) *PolylineElement {
-	e.Attrs["lighting-color"] = lightingColor
-	return e
+// ChildElements implements Node.ChildElements.
+func (e *RectElement) ChildElements() []Element {
+	return e.Children
 }
 
-// MarkerEnd sets the marker-end attribute.
-func (e *PolylineElement) MarkerEnd(markerEnd String) *PolylineElement {
-	e.Attrs["marker-end"] = markerEnd
-	return e
+// SetChildElements implements Container.SetChildElements.
+func (e *RectElement) SetChildElements(children []Element) {
+	e.Children = children
 }
 
-// MarkerMid sets the marker-mid attribute.
-func (e *PolylineElement) MarkerMid(markerMid String) *PolylineElement {
-	e.Attrs["marker-mid"] = markerMid
-	return e
+// TagName implements Node.TagName.
+func (e *RectElement) TagName() string {
+	return "rect"
 }
 
-// MarkerStart sets the marker-start attribute.
-func (e *PolylineElement) MarkerStart(markerStart String) *PolylineElement {
-	e.Attrs["marker-start"] = markerStart
-	return e
+// decode decodes e from d.
+func (e *RectElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *RectElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "x":
+		return parseLength(value)
+	case "y":
+		return parseLength(value)
+	case "width":
+		return parseLength(value)
+	case "height":
+		return parseLength(value)
+	case "rx":
+		return parseLength(value)
+	case "ry":
+		return parseLength(value)
+	default:
+		return String(value), nil
+	}
 }
 
-// Mask sets the mask attribute.
-func (e *PolylineElement) Mask(mask String) *PolylineElement {
-	e.Attrs["mask"] = mask
-	return e
+// A SetElement is a set element.
+type SetElement struct {
+	Attrs    map[string]AttrValue
+	Children []Element
 }
 
-// Opacity sets the opacity attribute.
-func (e *PolylineElement) Opacity(opacity Float64) *PolylineElement {
-	e.Attrs["opacity"] = opacity
+// Set returns a new SetElement.
+func Set(children ...Element) *SetElement {
+	return &SetElement{
+		Attrs:    map[string]AttrValue{},
+		Children: children,
+	}
+}
+
+// AppendChildren appends the given children.
+func (e *SetElement) AppendChildren(children ...Element) *SetElement {
+	e.Children = append(e.Children, children...)
 	return e
 }
 
-// Overflow sets the overflow attribute.
-func (e *PolylineElement) Overflow(overflow String) *PolylineElement {
-	e.Attrs["overflow"] = overflow
+// RemoveAttrs removes the attributes with the given names.
+func (e *SetElement) RemoveAttrs(names ...string) *SetElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
 	return e
 }
 
-// PaintOrder sets the paint-order attribute.
-func (e *PolylineElement) PaintOrder(paintOrder String) *PolylineElement {
-	e.Attrs["paint-order"] = paintOrder
+// ID sets the id attribute.
+func (e *SetElement) ID(id String) *SetElement {
+	e.Attrs["id"] = id
 	return e
 }
 
-// PointerEvents sets the pointer-events attribute.
-func (e *PolylineElement) PointerEvents(pointerEvents String) *PolylineElement {
-	e.Attrs["pointer-events"] = pointerEvents
+// TabIndex sets the tabindex attribute.
+func (e *SetElement) TabIndex(tabIndex Int) *SetElement {
+	e.Attrs["tabindex"] = tabIndex
 	return e
 }
 
-// ShapeRendering sets the shape-rendering attribute.
-func (e *PolylineElement) ShapeRendering(shapeRendering String) *PolylineElement {
-	e.Attrs["shape-rendering"] = shapeRendering
+// Lang sets the lang attribute.
+func (e *SetElement) Lang(lang String) *SetElement {
+	e.Attrs["lang"] = lang
 	return e
 }
 
-// StopColor sets the stop-color attribute.
-func (e *PolylineElement) StopColor(stopColor String) *PolylineElement {
-	e.Attrs["stop-color"] = stopColor
+// Class sets the class attribute.
+func (e *SetElement) Class(class String) *SetElement {
+	e.Attrs["class"] = class
 	return e
 }
 
-// StopOpacity sets the stop-opacity attribute.
-func (e *PolylineElement) StopOpacity(stopOpacity Float64) *PolylineElement {
-	e.Attrs["stop-opacity"] = stopOpacity
+// Style sets the style attribute.
+func (e *SetElement) Style(style String) *SetElement {
+	e.Attrs["style"] = style
 	return e
 }
 
-// Stroke sets the stroke attribute.
-func (e *PolylineElement) Stroke(stroke String) *PolylineElement {
-	e.Attrs["stroke"] = stroke
+// Begin sets the begin attribute.
+func (e *SetElement) Begin(begin String) *SetElement {
+	e.Attrs["begin"] = begin
 	return e
 }
 
-// StrokeDashArray sets the stroke-dasharray attribute.
-func (e *PolylineElement) StrokeDashArray(strokeDashArray String) *PolylineElement {
-	e.Attrs["stroke-dasharray"] = strokeDashArray
+// BeginDuration sets the begin attribute to a Duration.
+func (e *SetElement) BeginDuration(begin Duration) *SetElement {
+	e.Attrs["begin"] = begin
 	return e
 }
 
-// StrokeDashOffset sets the stroke-dashoffset attribute.
-func (e *PolylineElement) StrokeDashOffset(strokeDashOffset Float64) *PolylineElement {
-	e.Attrs["stroke-dashoffset"] = strokeDashOffset
+// Dur sets the dur attribute.
+func (e *SetElement) Dur(dur Duration) *SetElement {
+	e.Attrs["dur"] = dur
 	return e
 }
 
-// StrokeLineCap sets the stroke-linecap attribute.
-func (e *PolylineElement) StrokeLineCap(strokeLineCap String) *PolylineElement {
-	e.Attrs["stroke-linecap"] = strokeLineCap
+// End sets the end attribute.
+func (e *SetElement) End(end String) *SetElement {
+	e.Attrs["end"] = end
 	return e
 }
 
-// StrokeLineJoin sets the stroke-linejoin attribute.
-func (e *PolylineElement) StrokeLineJoin(strokeLineJoin String) *PolylineElement {
-	e.Attrs["stroke-linejoin"] = strokeLineJoin
+// EndDuration sets the end attribute to a Duration.
+func (e *SetElement) EndDuration(end Duration) *SetElement {
+	e.Attrs["end"] = end
 	return e
 }
 
-// StrokeMiterLimit sets the stroke-miterlimit attribute.
-func (e *PolylineElement) StrokeMiterLimit(strokeMiterLimit Float64) *PolylineElement {
-	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
+// Min sets the min attribute.
+func (e *SetElement) Min(_min Duration) *SetElement {
+	e.Attrs["min"] = _min
 	return e
 }
 
-// StrokeOpacity sets the stroke-opacity attribute.
-func (e *PolylineElement) StrokeOpacity(strokeOpacity Float64) *PolylineElement {
-	e.Attrs["stroke-opacity"] = strokeOpacity
+// Max sets the max attribute.
+func (e *SetElement) Max(_max Duration) *SetElement {
+	e.Attrs["max"] = _max
 	return e
 }
 
-// StrokeWidth sets the stroke-width attribute.
-func (e *PolylineElement) StrokeWidth(strokeWidth Length) *PolylineElement {
-	e.Attrs["stroke-width"] = strokeWidth
+// Restart sets the restart attribute.
+func (e *SetElement) Restart(restart String) *SetElement {
+	e.Attrs["restart"] = restart
 	return e
 }
 
-// TextAnchor sets the text-anchor attribute.
-func (e *PolylineElement) TextAnchor(textAnchor String) *PolylineElement {
-	e.Attrs["text-anchor"] = textAnchor
+// RepeatCount sets the repeatCount attribute.
+func (e *SetElement) RepeatCount(repeatCount RepeatCount) *SetElement {
+	e.Attrs["repeatCount"] = repeatCount
 	return e
 }
 
-// TextDecoration sets the text-decoration attribute.
-func (e *PolylineElement) TextDecoration(textDecoration String) *PolylineElement {
-	e.Attrs["text-decoration"] = textDecoration
+// RepeatDur sets the repeatDur attribute.
+func (e *SetElement) RepeatDur(repeatDur Duration) *SetElement {
+	e.Attrs["repeatDur"] = repeatDur
 	return e
 }
 
-// TextOverflow sets the text-overflow attribute.
-func (e *PolylineElement) TextOverflow(textOverflow String) *PolylineElement {
-	e.Attrs["text-overflow"] = textOverflow
+// Fill sets the fill attribute.
+func (e *SetElement) Fill(fill String) *SetElement {
+	e.Attrs["fill"] = fill
 	return e
 }
 
-// TextRendering sets the text-rendering attribute.
-func (e *PolylineElement) TextRendering(textRendering String) *PolylineElement {
-	e.Attrs["text-rendering"] = textRendering
+// AttributeName sets the attributeName attribute.
+func (e *SetElement) AttributeName(attributeName String) *SetElement {
+	e.Attrs["attributeName"] = attributeName
 	return e
 }
 
-// UnicodeBiDi sets the unicode-bidi attribute.
-func (e *PolylineElement) UnicodeBiDi(unicodeBiDi String) *PolylineElement {
-	e.Attrs["unicode-bidi"] = unicodeBiDi
+// Href sets the href attribute.
+func (e *SetElement) Href(href String) *SetElement {
+	e.Attrs["href"] = href
 	return e
 }
 
-// VectorEffect sets the vector-effect attribute.
-func (e *PolylineElement) VectorEffect(vectorEffect String) *PolylineElement {
-	e.Attrs["vector-effect"] = vectorEffect
+// To sets the to attribute.
+func (e *SetElement) To(to String) *SetElement {
+	e.Attrs["to"] = to
 	return e
 }
 
-// Visibility sets the visibility attribute.
-func (e *PolylineElement) Visibility(visibility String) *PolylineElement {
-	e.Attrs["visibility"] = visibility
-	return e
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *SetElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
 }
 
-// WhiteSpace sets the white-space attribute.
-func (e *PolylineElement) WhiteSpace(whiteSpace String) *PolylineElement {
-	e.Attrs["white-space"] = whiteSpace
-	return e
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *SetElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
 }
 
-// WordSpacing sets the word-spacing attribute.
-func (e *PolylineElement) WordSpacing(wordSpacing String) *PolylineElement {
-	e.Attrs["word-spacing"] = wordSpacing
-	return e
+// Attributes implements Node.Attributes.
+func (e *SetElement) Attributes() map[string]AttrValue {
+	return e.Attrs
 }
 
-// WritingMode sets the writing-mode attribute.
-func (e *PolylineElement) WritingMode(writingMode String) *PolylineElement {
-	e.Attrs["writing-mode"] = writingMode
-	return e
+// ChildElements implements Node.ChildElements.
+func (e *SetElement) ChildElements() []Element {
+	return e.Children
 }
 
-// PathLength sets the pathLength attribute.
-func (e *PolylineElement) PathLength(pathLength String) *PolylineElement {
-	e.Attrs["pathLength"] = pathLength
-	return e
+// SetChildElements implements Container.SetChildElements.
+func (e *SetElement) SetChildElements(children []Element) {
+	e.Children = children
 }
 
-// Points sets the points attribute.
-func (e *PolylineElement) Points(points Points) *PolylineElement {
-	e.Attrs["points"] = points
-	return e
+// TagName implements Node.TagName.
+func (e *SetElement) TagName() string {
+	return "set"
 }
 
-// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
-func (e *PolylineElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
-	return encodeElement(encoder, "polyline", e.Attrs, e.Children)
+// decode decodes e from d.
+func (e *SetElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *SetElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "dur":
+		return parseDuration(value)
+	case "min":
+		return parseDuration(value)
+	case "max":
+		return parseDuration(value)
+	case "repeatCount":
+		return parseRepeatCount(value)
+	case "repeatDur":
+		return parseDuration(value)
+	default:
+		return String(value), nil
+	}
 }
 
-// A RectElement is a rect element.
-type RectElement struct {
+// A StopElement is a stop element.
+type StopElement struct {
 	Attrs    map[string]AttrValue
 	Children []Element
 }
 
-// Rect returns a new RectElement.
-func Rect(children ...Element) *RectElement {
-	return &RectElement{
+// Stop returns a new StopElement.
+func Stop(children ...Element) *StopElement {
+	return &StopElement{
 		Attrs:    map[string]AttrValue{},
 		Children: children,
 	}
 }
 
 // AppendChildren appends the given children.
-func (e *RectElement) AppendChildren(children ...Element) *RectElement {
+func (e *StopElement) AppendChildren(children ...Element) *StopElement {
 	e.Children = append(e.Children, children...)
 	return e
 }
 
+// RemoveAttrs removes the attributes with the given names.
+func (e *StopElement) RemoveAttrs(names ...string) *StopElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
 // ID sets the id attribute.
-func (e *RectElement) ID(id String) *RectElement {
+func (e *StopElement) ID(id String) *StopElement {
 	e.Attrs["id"] = id
 	return e
 }
 
 // TabIndex sets the tabindex attribute.
-func (e *RectElement) TabIndex(tabIndex Int) *RectElement {
+func (e *StopElement) TabIndex(tabIndex Int) *StopElement {
 	e.Attrs["tabindex"] = tabIndex
 	return e
 }
 
 // Lang sets the lang attribute.
-func (e *RectElement) Lang(lang String) *RectElement {
+func (e *StopElement) Lang(lang String) *StopElement {
 	e.Attrs["lang"] = lang
 	return e
 }
 
 // Class sets the class attribute.
-func (e *RectElement) Class(class String) *RectElement {
+func (e *StopElement) Class(class String) *StopElement {
 	e.Attrs["class"] = class
 	return e
 }
 
 // Style sets the style attribute.
-func (e *RectElement) Style(style String) *RectElement {
+func (e *StopElement) Style(style String) *StopElement {
 	e.Attrs["style"] = style
 	return e
 }
 
 // AlignmentBaseline sets the alignment-baseline attribute.
-func (e *RectElement) AlignmentBaseline(alignmentBaseline String) *RectElement {
+func (e *StopElement) AlignmentBaseline(alignmentBaseline String) *StopElement {
 	e.Attrs["alignment-baseline"] = alignmentBaseline
 	return e
 }
 
 // BaselineShift sets the baseline-shift attribute.
-func (e *RectElement) BaselineShift(baselineShift String) *RectElement {
+func (e *StopElement) BaselineShift(baselineShift String) *StopElement {
 	e.Attrs["baseline-shift"] = baselineShift
 	return e
 }
 
 // ClipPath sets the clip-path attribute.
-func (e *RectElement) ClipPath(clipPath String) *RectElement {
+func (e *StopElement) ClipPath(clipPath String) *StopElement {
 	e.Attrs["clip-path"] = clipPath
 	return e
 }
 
 // ClipRule sets the clip-rule attribute.
-func (e *RectElement) ClipRule(clipRule String) *RectElement {
+func (e *StopElement) ClipRule(clipRule String) *StopElement {
 	e.Attrs["clip-rule"] = clipRule
 	return e
 }
 
 // Color sets the color attribute.
-func (e *RectElement) Color(color String) *RectElement {
+func (e *StopElement) Color(color Color) *StopElement {
 	e.Attrs["color"] = color
 	return e
 }
 
 // ColorInterpolation sets the color-interpolation attribute.
-func (e *RectElement) ColorInterpolation(colorInterpolation String) *RectElement {
+func (e *StopElement) ColorInterpolation(colorInterpolation String) *StopElement {
 	e.Attrs["color-interpolation"] = colorInterpolation
 	return e
 }
 
 // ColorInterpolationFilters sets the color-interpolation-filters attribute.
-func (e *RectElement) ColorInterpolationFilters(colorInterpolationFilters String) *RectElement {
+func (e *StopElement) ColorInterpolationFilters(colorInterpolationFilters String) *StopElement {
 	e.Attrs["color-interpolation-filters"] = colorInterpolationFilters
 	return e
 }
 
 // ColorRendering sets the color-rendering attribute.
-func (e *RectElement) ColorRendering(colorRendering String) *RectElement {
+func (e *StopElement) ColorRendering(colorRendering String) *StopElement {
 	e.Attrs["color-rendering"] = colorRendering
 	return e
 }
 
 // Cursor sets the cursor attribute.
-func (e *RectElement) Cursor(cursor String) *RectElement {
+func (e *StopElement) Cursor(cursor String) *StopElement {
 	e.Attrs["cursor"] = cursor
 	return e
 }
 
 // Direction sets the direction attribute.
-func (e *RectElement) Direction(direction String) *RectElement {
+func (e *StopElement) Direction(direction String) *StopElement {
 	e.Attrs["direction"] = direction
 	return e
 }
 
 // Display sets the display attribute.
-func (e *RectElement) Display(display String) *RectElement {
+func (e *StopElement) Display(display String) *StopElement {
 	e.Attrs["display"] = display
 	return e
 }
 
 // DominantBaseline sets the dominant-baseline attribute.
-func (e *RectElement) DominantBaseline(dominantBaseline String) *RectElement {
+func (e *StopElement) DominantBaseline(dominantBaseline String) *StopElement {
 	e.Attrs["dominant-baseline"] = dominantBaseline
 	return e
 }
 
 // Fill sets the fill attribute.
-func (e *RectElement) Fill(fill String) *RectElement {
+func (e *StopElement) Fill(fill String) *StopElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillColor sets the fill attribute to a Color.
+func (e *StopElement) FillColor(fill Color) *StopElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *StopElement) FillPaint(fill Paint) *StopElement {
 	e.Attrs["fill"] = fill
 	return e
 }
 
 // FillOpacity sets the fill-opacity attribute.
-func (e *RectElement) FillOpacity(fillOpacity Float64) *RectElement {
+func (e *StopElement) FillOpacity(fillOpacity Float64) *StopElement {
 	e.Attrs["fill-opacity"] = fillOpacity
 	return e
 }
 
 // FillRule sets the fill-rule attribute.
-func (e *RectElement) FillRule(fillRule String) *RectElement {
+func (e *StopElement) FillRule(fillRule String) *StopElement {
 	e.Attrs["fill-rule"] = fillRule
 	return e
 }
 
 // Filter sets the filter attribute.
-func (e *RectElement) Filter(filter String) *RectElement {
+func (e *StopElement) Filter(filter String) *StopElement {
 	e.Attrs["filter"] = filter
 	return e
 }
 
 // FloodColor sets the flood-color attribute.
-func (e *RectElement) FloodColor(floodColor String) *RectElement {
+func (e *StopElement) FloodColor(floodColor Color) *StopElement {
 	e.Attrs["flood-color"] = floodColor
 	return e
 }
 
 // FloodOpacity sets the flood-opacity attribute.
-func (e *RectElement) FloodOpacity(floodOpacity Float64) *RectElement {
+func (e *StopElement) FloodOpacity(floodOpacity Float64) *StopElement {
 	e.Attrs["flood-opacity"] = floodOpacity
 	return e
 }
 
 // FontFamily sets the font-family attribute.
-func (e *RectElement) FontFamily(fontFamily String) *RectElement {
+func (e *StopElement) FontFamily(fontFamily String) *StopElement {
 	e.Attrs["font-family"] = fontFamily
 	return e
 }
 
 // FontSize sets the font-size attribute.
-func (e *RectElement) FontSize(fontSize String) *RectElement {
+func (e *StopElement) FontSize(fontSize String) *StopElement {
 	e.Attrs["font-size"] = fontSize
 	return e
 }
 
 // FontSizeAdjust sets the font-size-adjust attribute.
-func (e *RectElement) FontSizeAdjust(fontSizeAdjust String) *RectElement {
+func (e *StopElement) FontSizeAdjust(fontSizeAdjust String) *StopElement {
 	e.Attrs["font-size-adjust"] = fontSizeAdjust
 	return e
 }
 
 // FontStretch sets the font-stretch attribute.
-func (e *RectElement) FontStretch(fontStretch String) *RectElement {
+func (e *StopElement) FontStretch(fontStretch String) *StopElement {
 	e.Attrs["font-stretch"] = fontStretch
 	return e
 }
 
 // FontStyle sets the font-style attribute.
-func (e *RectElement) FontStyle(fontStyle String) *RectElement {
+func (e *StopElement) FontStyle(fontStyle String) *StopElement {
 	e.Attrs["font-style"] = fontStyle
 	return e
 }
 
 // FontVariant sets the font-variant attribute.
-func (e *RectElement) FontVariant(fontVariant String) *RectElement {
+func (e *StopElement) FontVariant(fontVariant String) *StopElement {
 	e.Attrs["font-variant"] = fontVariant
 	return e
 }
 
 // FontWeight sets the font-weight attribute.
-func (e *RectElement) FontWeight(fontWeight String) *RectElement {
+func (e *StopElement) FontWeight(fontWeight String) *StopElement {
 	e.Attrs["font-weight"] = fontWeight
 	return e
 }
 
 // GlyphOrientationHorizontal sets the glyph-orientation-horizontal attribute.
-func (e *RectElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *RectElement {
+func (e *StopElement) GlyphOrientationHorizontal(glyphOrientationHorizontal String) *StopElement {
 	e.Attrs["glyph-orientation-horizontal"] = glyphOrientationHorizontal
 	return e
 }
 
 // GlyphOrientationVertical sets the glyph-orientation-vertical attribute.
-func (e *RectElement) GlyphOrientationVertical(glyphOrientationVertical String) *RectElement {
+func (e *StopElement) GlyphOrientationVertical(glyphOrientationVertical String) *StopElement {
 	e.Attrs["glyph-orientation-vertical"] = glyphOrientationVertical
 	return e
 }
 
 // ImageRendering sets the image-rendering attribute.
-func (e *RectElement) ImageRendering(imageRendering String) *RectElement {
+func (e *StopElement) ImageRendering(imageRendering String) *StopElement {
 	e.Attrs["image-rendering"] = imageRendering
 	return e
 }
 
 // LetterSpacing sets the letter-spacing attribute.
-func (e *RectElement) LetterSpacing(letterSpacing String) *RectElement {
+func (e *StopElement) LetterSpacing(letterSpacing String) *StopElement {
 	e.Attrs["letter-spacing"] = letterSpacing
 	return e
 }
 
 // LightingColor sets the lighting-color attribute.
-func (e *RectElement) LightingColor(lightingColor String) *RectElement {
+func (e *StopElement) LightingColor(lightingColor Color) *StopElement {
 	e.Attrs["lighting-color"] = lightingColor
 	return e
 }
 
 // MarkerEnd sets the marker-end attribute.
-func (e *RectElement) MarkerEnd(markerEnd String) *RectElement {
+func (e *StopElement) MarkerEnd(markerEnd String) *StopElement {
 	e.Attrs["marker-end"] = markerEnd
 	return e
 }
 
 // MarkerMid sets the marker-mid attribute.
-func (e *RectElement) MarkerMid(markerMid String) *RectElement {
+func (e *StopElement) MarkerMid(markerMid String) *StopElement {
 	e.Attrs["marker-mid"] = markerMid
 	return e
 }
 
 // MarkerStart sets the marker-start attribute.
-func (e *RectElement) MarkerStart(markerStart String) *RectElement {
+func (e *StopElement) MarkerStart(markerStart String) *StopElement {
 	e.Attrs["marker-start"] = markerStart
 	return e
 }
 
 // Mask sets the mask attribute.
-func (e *RectElement) Mask(mask String) *RectElement {
+func (e *StopElement) Mask(mask String) *StopElement {
 	e.Attrs["mask"] = mask
 	return e
 }
 
 // Opacity sets the opacity attribute.
-func (e *RectElement) Opacity(opacity Float64) *RectElement {
+func (e *StopElement) Opacity(opacity Float64) *StopElement {
 	e.Attrs["opacity"] = opacity
 	return e
 }
 
 // Overflow sets the overflow attribute.
-func (e *RectElement) Overflow(overflow String) *RectElement {
+func (e *StopElement) Overflow(overflow String) *StopElement {
 	e.Attrs["overflow"] = overflow
 	return e
 }
 
 // PaintOrder sets the paint-order attribute.
-func (e *RectElement) PaintOrder(paintOrder String) *RectElement {
+func (e *StopElement) PaintOrder(paintOrder String) *StopElement {
 	e.Attrs["paint-order"] = paintOrder
 	return e
 }
 
 // PointerEvents sets the pointer-events attribute.
-func (e *RectElement) PointerEvents(pointerEvents String) *RectElement {
+func (e *StopElement) PointerEvents(pointerEvents String) *StopElement {
 	e.Attrs["pointer-events"] = pointerEvents
 	return e
 }
 
 // ShapeRendering sets the shape-rendering attribute.
-func (e *RectElement) ShapeRendering(shapeRendering String) *RectElement {
+func (e *StopElement) ShapeRendering(shapeRendering String) *StopElement {
 	e.Attrs["shape-rendering"] = shapeRendering
 	return e
 }
 
 // StopColor sets the stop-color attribute.
-func (e *RectElement) StopColor(stopColor String) *RectElement {
+func (e *StopElement) StopColor(stopColor Color) *StopElement {
 	e.Attrs["stop-color"] = stopColor
 	return e
 }
 
 // StopOpacity sets the stop-opacity attribute.
-func (e *RectElement) StopOpacity(stopOpacity Float64) *RectElement {
+func (e *StopElement) StopOpacity(stopOpacity Float64) *StopElement {
 	e.Attrs["stop-opacity"] = stopOpacity
 	return e
 }
 
 // Stroke sets the stroke attribute.
-func (e *RectElement) Stroke(stroke String) *RectElement {
+func (e *StopElement) Stroke(stroke String) *StopElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokeColor sets the stroke attribute to a Color.
+func (e *StopElement) StrokeColor(stroke Color) *StopElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *StopElement) StrokePaint(stroke Paint) *StopElement {
 	e.Attrs["stroke"] = stroke
 	return e
 }
 
 // StrokeDashArray sets the stroke-dasharray attribute.
-func (e *RectElement) StrokeDashArray(strokeDashArray String) *RectElement {
+func (e *StopElement) StrokeDashArray(strokeDashArray String) *StopElement {
 	e.Attrs["stroke-dasharray"] = strokeDashArray
 	return e
 }
 
 // StrokeDashOffset sets the stroke-dashoffset attribute.
-func (e *RectElement) StrokeDashOffset(strokeDashOffset Float64) *RectElement {
+func (e *StopElement) StrokeDashOffset(strokeDashOffset Float64) *StopElement {
 	e.Attrs["stroke-dashoffset"] = strokeDashOffset
 	return e
 }
 
 // StrokeLineCap sets the stroke-linecap attribute.
-func (e *RectElement) StrokeLineCap(strokeLineCap String) *RectElement {
+func (e *StopElement) StrokeLineCap(strokeLineCap String) *StopElement {
 	e.Attrs["stroke-linecap"] = strokeLineCap
 	return e
 }
 
 // StrokeLineJoin sets the stroke-linejoin attribute.
-func (e *RectElement) StrokeLineJoin(strokeLineJoin String) *RectElement {
+func (e *StopElement) StrokeLineJoin(strokeLineJoin String) *StopElement {
 	e.Attrs["stroke-linejoin"] = strokeLineJoin
 	return e
 }
 
 // StrokeMiterLimit sets the stroke-miterlimit attribute.
-func (e *RectElement) StrokeMiterLimit(strokeMiterLimit Float64) *RectElement {
+func (e *StopElement) StrokeMiterLimit(strokeMiterLimit Float64) *StopElement {
 	e.Attrs["stroke-miterlimit"] = strokeMiterLimit
 	return e
 }
 
 // StrokeOpacity sets the stroke-opacity attribute.
-func (e *RectElement) StrokeOpacity(strokeOpacity Float64) *RectElement {
+func (e *StopElement) StrokeOpacity(strokeOpacity Float64) *StopElement {
 	e.Attrs["stroke-opacity"] = strokeOpacity
 	return e
 }
 
 // StrokeWidth sets the stroke-width attribute.
-func (e *RectElement) StrokeWidth(strokeWidth Length) *RectElement {
+func (e *StopElement) StrokeWidth(strokeWidth Length) *StopElement {
 	e.Attrs["stroke-width"] = strokeWidth
 	return e
 }
 
 // TextAnchor sets the text-anchor attribute.
-func (e *RectElement) TextAnchor(textAnchor String) *RectElement {
+func (e *StopElement) TextAnchor(textAnchor String) *StopElement {
 	e.Attrs["text-anchor"] = textAnchor
 	return e
 }
 
 // TextDecoration sets the text-decoration attribute.
-func (e *RectElement) TextDecoration(textDecoration String) *RectElement {
+func (e *StopElement) TextDecoration(textDecoration String) *StopElement {
 	e.Attrs["text-decoration"] = textDecoration
 	return e
 }
 
 // TextOverflow sets the text-overflow attribute.
-func (e *RectElement) TextOverflow(textOverflow String) *RectElement {
+func (e *StopElement) TextOverflow(textOverflow String) *StopElement {
 	e.Attrs["text-overflow"] = textOverflow
 	return e
 }
 
 // TextRendering sets the text-rendering attribute.
-func (e *RectElement) TextRendering(textRendering String) *RectElement {
+func (e *StopElement) TextRendering(textRendering String) *StopElement {
 	e.Attrs["text-rendering"] = textRendering
 	return e
 }
 
+// Transform sets the transform attribute.
+func (e *StopElement) Transform(transform Transform) *StopElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
 // UnicodeBiDi sets the unicode-bidi attribute.
-func (e *RectElement) UnicodeBiDi(unicodeBiDi String) *RectElement {
+func (e *StopElement) UnicodeBiDi(unicodeBiDi String) *StopElement {
 	e.Attrs["unicode-bidi"] = unicodeBiDi
 	return e
 }
 
 // VectorEffect sets the vector-effect attribute.
-func (e *RectElement) VectorEffect(vectorEffect String) *RectElement {
+func (e *StopElement) VectorEffect(vectorEffect String) *StopElement {
 	e.Attrs["vector-effect"] = vectorEffect
 	return e
 }
 
 // Visibility sets the visibility attribute.
-func (e *RectElement) Visibility(visibility String) *RectElement {
+func (e *StopElement) Visibility(visibility String) *StopElement {
 	e.Attrs["visibility"] = visibility
 	return e
 }
 
 // WhiteSpace sets the white-space attribute.
-func (e *RectElement) WhiteSpace(whiteSpace String) *RectElement {
+func (e *StopElement) WhiteSpace(whiteSpace String) *StopElement {
 	e.Attrs["white-space"] = whiteSpace
 	return e
 }
 
 // WordSpacing sets the word-spacing attribute.
-func (e *RectElement) WordSpacing(wordSpacing String) *RectElement {
+func (e *StopElement) WordSpacing(wordSpacing String) *StopElement {
 	e.Attrs["word-spacing"] = wordSpacing
 	return e
 }
 
 // WritingMode sets the writing-mode attribute.
-func (e *RectElement) WritingMode(writingMode String) *RectElement {
+func (e *StopElement) WritingMode(writingMode String) *StopElement {
 	e.Attrs["writing-mode"] = writingMode
 	return e
 }
 
-// PathLength sets the pathLength attribute.
-func (e *RectElement) PathLength(pathLength String) *RectElement {
-	e.Attrs["pathLength"] = pathLength
-	return e
-}
-
-// X sets the x attribute.
-func (e *RectElement) X(x Length) *RectElement {
-	e.Attrs["x"] = x
-	return e
-}
-
-// Y sets the y attribute.
-func (e *RectElement) Y(y Length) *RectElement {
-	e.Attrs["y"] = y
-	return e
-}
-
-// Width sets the width attribute.
-func (e *RectElement) Width(width Length) *RectElement {
-	e.Attrs["width"] = width
-	return e
-}
-
-// Height sets the height attribute.
-func (e *RectElement) Height(height Length) *RectElement {
-	e.Attrs["height"] = height
+// Offset sets the offset attribute.
+func (e *StopElement) Offset(offset Length) *StopElement {
+	e.Attrs["offset"] = offset
 	return e
 }
 
-// RX sets the rx attribute.
-func (e *RectElement) RX(rx Length) *RectElement {
-	e.Attrs["rx"] = rx
-	return e
+// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *StopElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
 }
 
-// RY sets the ry attribute.
-func (e *RectElement) RY(ry Length) *RectElement {
-	e.Attrs["ry"] = ry
-	return e
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *StopElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
 }
 
-// RXRY sets the rx and ry attributes.
-func (e *RectElement) RXRY(rx, ry float64, lengthFunc LengthFunc) *RectElement {
-	e.Attrs["rx"] = lengthFunc(rx)
-	e.Attrs["ry"] = lengthFunc(ry)
-	return e
+// Attributes implements Node.Attributes.
+func (e *StopElement) Attributes() map[string]AttrValue {
+	return e.Attrs
 }
 
-// WidthHeight sets the width and height attributes.
-func (e *RectElement) WidthHeight(width, height float64, lengthFunc LengthFunc) *RectElement {
-	e.Attrs["width"] = lengthFunc(width)
-	e.Attrs["height"] = lengthFunc(height)
-	return e
+// ChildElements implements Node.ChildElements.
+func (e *StopElement) ChildElements() []Element {
+	return e.Children
 }
 
-// XY sets the x and y attributes.
-func (e *RectElement) XY(x, y float64, lengthFunc LengthFunc) *RectElement {
-	e.Attrs["x"] = lengthFunc(x)
-	e.Attrs["y"] = lengthFunc(y)
-	return e
+// SetChildElements implements Container.SetChildElements.
+func (e *StopElement) SetChildElements(children []Element) {
+	e.Children = children
 }
 
-// XYWidthHeight sets the x, y, width, and height attributes.
-func (e *RectElement) XYWidthHeight(x, y, width, height float64, lengthFunc LengthFunc) *RectElement {
-	e.Attrs["x"] = lengthFunc(x)
-	e.Attrs["y"] = lengthFunc(y)
-	e.Attrs["width"] = lengthFunc(width)
-	e.Attrs["height"] = lengthFunc(height)
-	return e
+// TagName implements Node.TagName.
+func (e *StopElement) TagName() string {
+	return "stop"
 }
 
-// MarshallXML implements encoding/xml.Marshaller.MarshalXML.
-func (e *RectElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
-	return encodeElement(encoder, "rect", e.Attrs, e.Children)
+// decode decodes e from d.
+func (e *StopElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *StopElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "offset":
+		return parseLength(value)
+	default:
+		return String(value), nil
+	}
 }
 
 // A StyleElement is a style element.
@@ -6498,6 +22855,14 @@ func (e *StyleElement) AppendChildren(children ...Element) *StyleElement {
 	return e
 }
 
+// RemoveAttrs removes the attributes with the given names.
+func (e *StyleElement) RemoveAttrs(names ...string) *StyleElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
 // Type sets the type attribute.
 func (e *StyleElement) Type(_type String) *StyleElement {
 	e.Attrs["type"] = _type
@@ -6506,7 +22871,50 @@ func (e *StyleElement) Type(_type String) *StyleElement {
 
 // MarshallXML implements encoding/xml.Marshaller.MarshalXML.
 func (e *StyleElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
-	return encodeElement(encoder, "style", e.Attrs, e.Children)
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *StyleElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *StyleElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *StyleElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *StyleElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *StyleElement) TagName() string {
+	return "style"
+}
+
+// decode decodes e from d.
+func (e *StyleElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *StyleElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	default:
+		return String(value), nil
+	}
 }
 
 // A SwitchElement is a switch element.
@@ -6529,6 +22937,14 @@ func (e *SwitchElement) AppendChildren(children ...Element) *SwitchElement {
 	return e
 }
 
+// RemoveAttrs removes the attributes with the given names.
+func (e *SwitchElement) RemoveAttrs(names ...string) *SwitchElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
 // ID sets the id attribute.
 func (e *SwitchElement) ID(id String) *SwitchElement {
 	e.Attrs["id"] = id
@@ -6584,7 +23000,7 @@ func (e *SwitchElement) ClipRule(clipRule String) *SwitchElement {
 }
 
 // Color sets the color attribute.
-func (e *SwitchElement) Color(color String) *SwitchElement {
+func (e *SwitchElement) Color(color Color) *SwitchElement {
 	e.Attrs["color"] = color
 	return e
 }
@@ -6637,6 +23053,18 @@ func (e *SwitchElement) Fill(fill String) *SwitchElement {
 	return e
 }
 
+// FillColor sets the fill attribute to a Color.
+func (e *SwitchElement) FillColor(fill Color) *SwitchElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *SwitchElement) FillPaint(fill Paint) *SwitchElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
 // FillOpacity sets the fill-opacity attribute.
 func (e *SwitchElement) FillOpacity(fillOpacity Float64) *SwitchElement {
 	e.Attrs["fill-opacity"] = fillOpacity
@@ -6656,7 +23084,7 @@ func (e *SwitchElement) Filter(filter String) *SwitchElement {
 }
 
 // FloodColor sets the flood-color attribute.
-func (e *SwitchElement) FloodColor(floodColor String) *SwitchElement {
+func (e *SwitchElement) FloodColor(floodColor Color) *SwitchElement {
 	e.Attrs["flood-color"] = floodColor
 	return e
 }
@@ -6734,7 +23162,7 @@ func (e *SwitchElement) LetterSpacing(letterSpacing String) *SwitchElement {
 }
 
 // LightingColor sets the lighting-color attribute.
-func (e *SwitchElement) LightingColor(lightingColor String) *SwitchElement {
+func (e *SwitchElement) LightingColor(lightingColor Color) *SwitchElement {
 	e.Attrs["lighting-color"] = lightingColor
 	return e
 }
@@ -6794,7 +23222,7 @@ func (e *SwitchElement) ShapeRendering(shapeRendering String) *SwitchElement {
 }
 
 // StopColor sets the stop-color attribute.
-func (e *SwitchElement) StopColor(stopColor String) *SwitchElement {
+func (e *SwitchElement) StopColor(stopColor Color) *SwitchElement {
 	e.Attrs["stop-color"] = stopColor
 	return e
 }
@@ -6811,6 +23239,18 @@ func (e *SwitchElement) Stroke(stroke String) *SwitchElement {
 	return e
 }
 
+// StrokeColor sets the stroke attribute to a Color.
+func (e *SwitchElement) StrokeColor(stroke Color) *SwitchElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *SwitchElement) StrokePaint(stroke Paint) *SwitchElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
 // StrokeDashArray sets the stroke-dasharray attribute.
 func (e *SwitchElement) StrokeDashArray(strokeDashArray String) *SwitchElement {
 	e.Attrs["stroke-dasharray"] = strokeDashArray
@@ -6877,6 +23317,12 @@ func (e *SwitchElement) TextRendering(textRendering String) *SwitchElement {
 	return e
 }
 
+// Transform sets the transform attribute.
+func (e *SwitchElement) Transform(transform Transform) *SwitchElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
 // UnicodeBiDi sets the unicode-bidi attribute.
 func (e *SwitchElement) UnicodeBiDi(unicodeBiDi String) *SwitchElement {
 	e.Attrs["unicode-bidi"] = unicodeBiDi
@@ -6915,7 +23361,78 @@ func (e *SwitchElement) WritingMode(writingMode String) *SwitchElement {
 
 // MarshallXML implements encoding/xml.Marshaller.MarshalXML.
 func (e *SwitchElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
-	return encodeElement(encoder, "switch", e.Attrs, e.Children)
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *SwitchElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *SwitchElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *SwitchElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *SwitchElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *SwitchElement) TagName() string {
+	return "switch"
+}
+
+// decode decodes e from d.
+func (e *SwitchElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *SwitchElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	default:
+		return String(value), nil
+	}
 }
 
 // A SymbolElement is a symbol element.
@@ -6938,6 +23455,14 @@ func (e *SymbolElement) AppendChildren(children ...Element) *SymbolElement {
 	return e
 }
 
+// RemoveAttrs removes the attributes with the given names.
+func (e *SymbolElement) RemoveAttrs(names ...string) *SymbolElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
 // ID sets the id attribute.
 func (e *SymbolElement) ID(id String) *SymbolElement {
 	e.Attrs["id"] = id
@@ -6993,7 +23518,7 @@ func (e *SymbolElement) ClipRule(clipRule String) *SymbolElement {
 }
 
 // Color sets the color attribute.
-func (e *SymbolElement) Color(color String) *SymbolElement {
+func (e *SymbolElement) Color(color Color) *SymbolElement {
 	e.Attrs["color"] = color
 	return e
 }
@@ -7046,6 +23571,18 @@ func (e *SymbolElement) Fill(fill String) *SymbolElement {
 	return e
 }
 
+// FillColor sets the fill attribute to a Color.
+func (e *SymbolElement) FillColor(fill Color) *SymbolElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *SymbolElement) FillPaint(fill Paint) *SymbolElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
 // FillOpacity sets the fill-opacity attribute.
 func (e *SymbolElement) FillOpacity(fillOpacity Float64) *SymbolElement {
 	e.Attrs["fill-opacity"] = fillOpacity
@@ -7065,7 +23602,7 @@ func (e *SymbolElement) Filter(filter String) *SymbolElement {
 }
 
 // FloodColor sets the flood-color attribute.
-func (e *SymbolElement) FloodColor(floodColor String) *SymbolElement {
+func (e *SymbolElement) FloodColor(floodColor Color) *SymbolElement {
 	e.Attrs["flood-color"] = floodColor
 	return e
 }
@@ -7143,7 +23680,7 @@ func (e *SymbolElement) LetterSpacing(letterSpacing String) *SymbolElement {
 }
 
 // LightingColor sets the lighting-color attribute.
-func (e *SymbolElement) LightingColor(lightingColor String) *SymbolElement {
+func (e *SymbolElement) LightingColor(lightingColor Color) *SymbolElement {
 	e.Attrs["lighting-color"] = lightingColor
 	return e
 }
@@ -7203,7 +23740,7 @@ func (e *SymbolElement) ShapeRendering(shapeRendering String) *SymbolElement {
 }
 
 // StopColor sets the stop-color attribute.
-func (e *SymbolElement) StopColor(stopColor String) *SymbolElement {
+func (e *SymbolElement) StopColor(stopColor Color) *SymbolElement {
 	e.Attrs["stop-color"] = stopColor
 	return e
 }
@@ -7220,6 +23757,18 @@ func (e *SymbolElement) Stroke(stroke String) *SymbolElement {
 	return e
 }
 
+// StrokeColor sets the stroke attribute to a Color.
+func (e *SymbolElement) StrokeColor(stroke Color) *SymbolElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *SymbolElement) StrokePaint(stroke Paint) *SymbolElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
 // StrokeDashArray sets the stroke-dasharray attribute.
 func (e *SymbolElement) StrokeDashArray(strokeDashArray String) *SymbolElement {
 	e.Attrs["stroke-dasharray"] = strokeDashArray
@@ -7286,6 +23835,12 @@ func (e *SymbolElement) TextRendering(textRendering String) *SymbolElement {
 	return e
 }
 
+// Transform sets the transform attribute.
+func (e *SymbolElement) Transform(transform Transform) *SymbolElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
 // UnicodeBiDi sets the unicode-bidi attribute.
 func (e *SymbolElement) UnicodeBiDi(unicodeBiDi String) *SymbolElement {
 	e.Attrs["unicode-bidi"] = unicodeBiDi
@@ -7395,7 +23950,88 @@ func (e *SymbolElement) XYWidthHeight(x, y, width, height float64, lengthFunc Le
 
 // MarshallXML implements encoding/xml.Marshaller.MarshalXML.
 func (e *SymbolElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
-	return encodeElement(encoder, "symbol", e.Attrs, e.Children)
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *SymbolElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *SymbolElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *SymbolElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *SymbolElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *SymbolElement) TagName() string {
+	return "symbol"
+}
+
+// decode decodes e from d.
+func (e *SymbolElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *SymbolElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "viewBox":
+		return parseViewBox(value)
+	case "x":
+		return parseLength(value)
+	case "y":
+		return parseLength(value)
+	case "width":
+		return parseLength(value)
+	case "height":
+		return parseLength(value)
+	default:
+		return String(value), nil
+	}
 }
 
 // A TextElement is a text element.
@@ -7418,6 +24054,14 @@ func (e *TextElement) AppendChildren(children ...Element) *TextElement {
 	return e
 }
 
+// RemoveAttrs removes the attributes with the given names.
+func (e *TextElement) RemoveAttrs(names ...string) *TextElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
 // ID sets the id attribute.
 func (e *TextElement) ID(id String) *TextElement {
 	e.Attrs["id"] = id
@@ -7473,7 +24117,7 @@ func (e *TextElement) ClipRule(clipRule String) *TextElement {
 }
 
 // Color sets the color attribute.
-func (e *TextElement) Color(color String) *TextElement {
+func (e *TextElement) Color(color Color) *TextElement {
 	e.Attrs["color"] = color
 	return e
 }
@@ -7526,6 +24170,18 @@ func (e *TextElement) Fill(fill String) *TextElement {
 	return e
 }
 
+// FillColor sets the fill attribute to a Color.
+func (e *TextElement) FillColor(fill Color) *TextElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *TextElement) FillPaint(fill Paint) *TextElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
 // FillOpacity sets the fill-opacity attribute.
 func (e *TextElement) FillOpacity(fillOpacity Float64) *TextElement {
 	e.Attrs["fill-opacity"] = fillOpacity
@@ -7545,7 +24201,7 @@ func (e *TextElement) Filter(filter String) *TextElement {
 }
 
 // FloodColor sets the flood-color attribute.
-func (e *TextElement) FloodColor(floodColor String) *TextElement {
+func (e *TextElement) FloodColor(floodColor Color) *TextElement {
 	e.Attrs["flood-color"] = floodColor
 	return e
 }
@@ -7623,7 +24279,7 @@ func (e *TextElement) LetterSpacing(letterSpacing String) *TextElement {
 }
 
 // LightingColor sets the lighting-color attribute.
-func (e *TextElement) LightingColor(lightingColor String) *TextElement {
+func (e *TextElement) LightingColor(lightingColor Color) *TextElement {
 	e.Attrs["lighting-color"] = lightingColor
 	return e
 }
@@ -7683,7 +24339,7 @@ func (e *TextElement) ShapeRendering(shapeRendering String) *TextElement {
 }
 
 // StopColor sets the stop-color attribute.
-func (e *TextElement) StopColor(stopColor String) *TextElement {
+func (e *TextElement) StopColor(stopColor Color) *TextElement {
 	e.Attrs["stop-color"] = stopColor
 	return e
 }
@@ -7700,6 +24356,18 @@ func (e *TextElement) Stroke(stroke String) *TextElement {
 	return e
 }
 
+// StrokeColor sets the stroke attribute to a Color.
+func (e *TextElement) StrokeColor(stroke Color) *TextElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *TextElement) StrokePaint(stroke Paint) *TextElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
 // StrokeDashArray sets the stroke-dasharray attribute.
 func (e *TextElement) StrokeDashArray(strokeDashArray String) *TextElement {
 	e.Attrs["stroke-dasharray"] = strokeDashArray
@@ -7766,6 +24434,12 @@ func (e *TextElement) TextRendering(textRendering String) *TextElement {
 	return e
 }
 
+// Transform sets the transform attribute.
+func (e *TextElement) Transform(transform Transform) *TextElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
 // UnicodeBiDi sets the unicode-bidi attribute.
 func (e *TextElement) UnicodeBiDi(unicodeBiDi String) *TextElement {
 	e.Attrs["unicode-bidi"] = unicodeBiDi
@@ -7853,7 +24527,78 @@ func (e *TextElement) XY(x, y float64, lengthFunc LengthFunc) *TextElement {
 
 // MarshallXML implements encoding/xml.Marshaller.MarshalXML.
 func (e *TextElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
-	return encodeElement(encoder, "text", e.Attrs, e.Children)
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *TextElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *TextElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *TextElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *TextElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *TextElement) TagName() string {
+	return "text"
+}
+
+// decode decodes e from d.
+func (e *TextElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *TextElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	default:
+		return String(value), nil
+	}
 }
 
 // A TextPathElement is a textPath element.
@@ -7876,6 +24621,14 @@ func (e *TextPathElement) AppendChildren(children ...Element) *TextPathElement {
 	return e
 }
 
+// RemoveAttrs removes the attributes with the given names.
+func (e *TextPathElement) RemoveAttrs(names ...string) *TextPathElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
 // ID sets the id attribute.
 func (e *TextPathElement) ID(id String) *TextPathElement {
 	e.Attrs["id"] = id
@@ -7931,7 +24684,7 @@ func (e *TextPathElement) ClipRule(clipRule String) *TextPathElement {
 }
 
 // Color sets the color attribute.
-func (e *TextPathElement) Color(color String) *TextPathElement {
+func (e *TextPathElement) Color(color Color) *TextPathElement {
 	e.Attrs["color"] = color
 	return e
 }
@@ -7984,6 +24737,18 @@ func (e *TextPathElement) Fill(fill String) *TextPathElement {
 	return e
 }
 
+// FillColor sets the fill attribute to a Color.
+func (e *TextPathElement) FillColor(fill Color) *TextPathElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *TextPathElement) FillPaint(fill Paint) *TextPathElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
 // FillOpacity sets the fill-opacity attribute.
 func (e *TextPathElement) FillOpacity(fillOpacity Float64) *TextPathElement {
 	e.Attrs["fill-opacity"] = fillOpacity
@@ -8003,7 +24768,7 @@ func (e *TextPathElement) Filter(filter String) *TextPathElement {
 }
 
 // FloodColor sets the flood-color attribute.
-func (e *TextPathElement) FloodColor(floodColor String) *TextPathElement {
+func (e *TextPathElement) FloodColor(floodColor Color) *TextPathElement {
 	e.Attrs["flood-color"] = floodColor
 	return e
 }
@@ -8081,7 +24846,7 @@ func (e *TextPathElement) LetterSpacing(letterSpacing String) *TextPathElement {
 }
 
 // LightingColor sets the lighting-color attribute.
-func (e *TextPathElement) LightingColor(lightingColor String) *TextPathElement {
+func (e *TextPathElement) LightingColor(lightingColor Color) *TextPathElement {
 	e.Attrs["lighting-color"] = lightingColor
 	return e
 }
@@ -8141,7 +24906,7 @@ func (e *TextPathElement) ShapeRendering(shapeRendering String) *TextPathElement
 }
 
 // StopColor sets the stop-color attribute.
-func (e *TextPathElement) StopColor(stopColor String) *TextPathElement {
+func (e *TextPathElement) StopColor(stopColor Color) *TextPathElement {
 	e.Attrs["stop-color"] = stopColor
 	return e
 }
@@ -8158,6 +24923,18 @@ func (e *TextPathElement) Stroke(stroke String) *TextPathElement {
 	return e
 }
 
+// StrokeColor sets the stroke attribute to a Color.
+func (e *TextPathElement) StrokeColor(stroke Color) *TextPathElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *TextPathElement) StrokePaint(stroke Paint) *TextPathElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
 // StrokeDashArray sets the stroke-dasharray attribute.
 func (e *TextPathElement) StrokeDashArray(strokeDashArray String) *TextPathElement {
 	e.Attrs["stroke-dasharray"] = strokeDashArray
@@ -8224,6 +25001,12 @@ func (e *TextPathElement) TextRendering(textRendering String) *TextPathElement {
 	return e
 }
 
+// Transform sets the transform attribute.
+func (e *TextPathElement) Transform(transform Transform) *TextPathElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
 // UnicodeBiDi sets the unicode-bidi attribute.
 func (e *TextPathElement) UnicodeBiDi(unicodeBiDi String) *TextPathElement {
 	e.Attrs["unicode-bidi"] = unicodeBiDi
@@ -8310,7 +25093,78 @@ func (e *TextPathElement) Side(side String) *TextPathElement {
 
 // MarshallXML implements encoding/xml.Marshaller.MarshalXML.
 func (e *TextPathElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
-	return encodeElement(encoder, "textPath", e.Attrs, e.Children)
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *TextPathElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *TextPathElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *TextPathElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *TextPathElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *TextPathElement) TagName() string {
+	return "textPath"
+}
+
+// decode decodes e from d.
+func (e *TextPathElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *TextPathElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	default:
+		return String(value), nil
+	}
 }
 
 // A TitleElement is a title element.
@@ -8333,9 +25187,60 @@ func (e *TitleElement) AppendChildren(children ...Element) *TitleElement {
 	return e
 }
 
+// RemoveAttrs removes the attributes with the given names.
+func (e *TitleElement) RemoveAttrs(names ...string) *TitleElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
 // MarshallXML implements encoding/xml.Marshaller.MarshalXML.
 func (e *TitleElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
-	return encodeElement(encoder, "title", e.Attrs, e.Children)
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *TitleElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *TitleElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *TitleElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *TitleElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *TitleElement) TagName() string {
+	return "title"
+}
+
+// decode decodes e from d.
+func (e *TitleElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *TitleElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	default:
+		return String(value), nil
+	}
 }
 
 // A TSpanElement is a tspan element.
@@ -8358,6 +25263,14 @@ func (e *TSpanElement) AppendChildren(children ...Element) *TSpanElement {
 	return e
 }
 
+// RemoveAttrs removes the attributes with the given names.
+func (e *TSpanElement) RemoveAttrs(names ...string) *TSpanElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
 // ID sets the id attribute.
 func (e *TSpanElement) ID(id String) *TSpanElement {
 	e.Attrs["id"] = id
@@ -8413,7 +25326,7 @@ func (e *TSpanElement) ClipRule(clipRule String) *TSpanElement {
 }
 
 // Color sets the color attribute.
-func (e *TSpanElement) Color(color String) *TSpanElement {
+func (e *TSpanElement) Color(color Color) *TSpanElement {
 	e.Attrs["color"] = color
 	return e
 }
@@ -8466,6 +25379,18 @@ func (e *TSpanElement) Fill(fill String) *TSpanElement {
 	return e
 }
 
+// FillColor sets the fill attribute to a Color.
+func (e *TSpanElement) FillColor(fill Color) *TSpanElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *TSpanElement) FillPaint(fill Paint) *TSpanElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
 // FillOpacity sets the fill-opacity attribute.
 func (e *TSpanElement) FillOpacity(fillOpacity Float64) *TSpanElement {
 	e.Attrs["fill-opacity"] = fillOpacity
@@ -8485,7 +25410,7 @@ func (e *TSpanElement) Filter(filter String) *TSpanElement {
 }
 
 // FloodColor sets the flood-color attribute.
-func (e *TSpanElement) FloodColor(floodColor String) *TSpanElement {
+func (e *TSpanElement) FloodColor(floodColor Color) *TSpanElement {
 	e.Attrs["flood-color"] = floodColor
 	return e
 }
@@ -8563,7 +25488,7 @@ func (e *TSpanElement) LetterSpacing(letterSpacing String) *TSpanElement {
 }
 
 // LightingColor sets the lighting-color attribute.
-func (e *TSpanElement) LightingColor(lightingColor String) *TSpanElement {
+func (e *TSpanElement) LightingColor(lightingColor Color) *TSpanElement {
 	e.Attrs["lighting-color"] = lightingColor
 	return e
 }
@@ -8623,7 +25548,7 @@ func (e *TSpanElement) ShapeRendering(shapeRendering String) *TSpanElement {
 }
 
 // StopColor sets the stop-color attribute.
-func (e *TSpanElement) StopColor(stopColor String) *TSpanElement {
+func (e *TSpanElement) StopColor(stopColor Color) *TSpanElement {
 	e.Attrs["stop-color"] = stopColor
 	return e
 }
@@ -8640,6 +25565,18 @@ func (e *TSpanElement) Stroke(stroke String) *TSpanElement {
 	return e
 }
 
+// StrokeColor sets the stroke attribute to a Color.
+func (e *TSpanElement) StrokeColor(stroke Color) *TSpanElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *TSpanElement) StrokePaint(stroke Paint) *TSpanElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
 // StrokeDashArray sets the stroke-dasharray attribute.
 func (e *TSpanElement) StrokeDashArray(strokeDashArray String) *TSpanElement {
 	e.Attrs["stroke-dasharray"] = strokeDashArray
@@ -8706,6 +25643,12 @@ func (e *TSpanElement) TextRendering(textRendering String) *TSpanElement {
 	return e
 }
 
+// Transform sets the transform attribute.
+func (e *TSpanElement) Transform(transform Transform) *TSpanElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
 // UnicodeBiDi sets the unicode-bidi attribute.
 func (e *TSpanElement) UnicodeBiDi(unicodeBiDi String) *TSpanElement {
 	e.Attrs["unicode-bidi"] = unicodeBiDi
@@ -8793,7 +25736,78 @@ func (e *TSpanElement) XY(x, y float64) *TSpanElement {
 
 // MarshallXML implements encoding/xml.Marshaller.MarshalXML.
 func (e *TSpanElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
-	return encodeElement(encoder, "tspan", e.Attrs, e.Children)
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *TSpanElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *TSpanElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *TSpanElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *TSpanElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *TSpanElement) TagName() string {
+	return "tspan"
+}
+
+// decode decodes e from d.
+func (e *TSpanElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *TSpanElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	default:
+		return String(value), nil
+	}
 }
 
 // A UseElement is a use element.
@@ -8816,6 +25830,14 @@ func (e *UseElement) AppendChildren(children ...Element) *UseElement {
 	return e
 }
 
+// RemoveAttrs removes the attributes with the given names.
+func (e *UseElement) RemoveAttrs(names ...string) *UseElement {
+	for _, name := range names {
+		delete(e.Attrs, name)
+	}
+	return e
+}
+
 // ID sets the id attribute.
 func (e *UseElement) ID(id String) *UseElement {
 	e.Attrs["id"] = id
@@ -8871,7 +25893,7 @@ func (e *UseElement) ClipRule(clipRule String) *UseElement {
 }
 
 // Color sets the color attribute.
-func (e *UseElement) Color(color String) *UseElement {
+func (e *UseElement) Color(color Color) *UseElement {
 	e.Attrs["color"] = color
 	return e
 }
@@ -8924,6 +25946,18 @@ func (e *UseElement) Fill(fill String) *UseElement {
 	return e
 }
 
+// FillColor sets the fill attribute to a Color.
+func (e *UseElement) FillColor(fill Color) *UseElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
+// FillPaint sets the fill attribute to a Paint.
+func (e *UseElement) FillPaint(fill Paint) *UseElement {
+	e.Attrs["fill"] = fill
+	return e
+}
+
 // FillOpacity sets the fill-opacity attribute.
 func (e *UseElement) FillOpacity(fillOpacity Float64) *UseElement {
 	e.Attrs["fill-opacity"] = fillOpacity
@@ -8943,7 +25977,7 @@ func (e *UseElement) Filter(filter String) *UseElement {
 }
 
 // FloodColor sets the flood-color attribute.
-func (e *UseElement) FloodColor(floodColor String) *UseElement {
+func (e *UseElement) FloodColor(floodColor Color) *UseElement {
 	e.Attrs["flood-color"] = floodColor
 	return e
 }
@@ -9021,7 +26055,7 @@ func (e *UseElement) LetterSpacing(letterSpacing String) *UseElement {
 }
 
 // LightingColor sets the lighting-color attribute.
-func (e *UseElement) LightingColor(lightingColor String) *UseElement {
+func (e *UseElement) LightingColor(lightingColor Color) *UseElement {
 	e.Attrs["lighting-color"] = lightingColor
 	return e
 }
@@ -9081,7 +26115,7 @@ func (e *UseElement) ShapeRendering(shapeRendering String) *UseElement {
 }
 
 // StopColor sets the stop-color attribute.
-func (e *UseElement) StopColor(stopColor String) *UseElement {
+func (e *UseElement) StopColor(stopColor Color) *UseElement {
 	e.Attrs["stop-color"] = stopColor
 	return e
 }
@@ -9098,6 +26132,18 @@ func (e *UseElement) Stroke(stroke String) *UseElement {
 	return e
 }
 
+// StrokeColor sets the stroke attribute to a Color.
+func (e *UseElement) StrokeColor(stroke Color) *UseElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
+// StrokePaint sets the stroke attribute to a Paint.
+func (e *UseElement) StrokePaint(stroke Paint) *UseElement {
+	e.Attrs["stroke"] = stroke
+	return e
+}
+
 // StrokeDashArray sets the stroke-dasharray attribute.
 func (e *UseElement) StrokeDashArray(strokeDashArray String) *UseElement {
 	e.Attrs["stroke-dasharray"] = strokeDashArray
@@ -9164,6 +26210,12 @@ func (e *UseElement) TextRendering(textRendering String) *UseElement {
 	return e
 }
 
+// Transform sets the transform attribute.
+func (e *UseElement) Transform(transform Transform) *UseElement {
+	e.Attrs["transform"] = transform
+	return e
+}
+
 // UnicodeBiDi sets the unicode-bidi attribute.
 func (e *UseElement) UnicodeBiDi(unicodeBiDi String) *UseElement {
 	e.Attrs["unicode-bidi"] = unicodeBiDi
@@ -9255,5 +26307,3479 @@ func (e *UseElement) XYWidthHeight(x, y, width, height float64, lengthFunc Lengt
 
 // MarshallXML implements encoding/xml.Marshaller.MarshalXML.
 func (e *UseElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
-	return encodeElement(encoder, "use", e.Attrs, e.Children)
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *UseElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *UseElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *UseElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *UseElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *UseElement) TagName() string {
+	return "use"
+}
+
+// decode decodes e from d.
+func (e *UseElement) decode(d *decoder, start xml.StartElement) error {
+	attrs, err := d.decodeElement(start, e.parseAttr, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Attrs = attrs
+	return nil
+}
+
+// parseAttr parses the value of the attribute name.
+func (e *UseElement) parseAttr(name, value string) (AttrValue, error) {
+	switch name {
+	case "tabindex":
+		return parseInt(value)
+	case "color":
+		return ParseColor(value)
+	case "fill-opacity":
+		return parseFloat64(value)
+	case "flood-color":
+		return ParseColor(value)
+	case "flood-opacity":
+		return parseFloat64(value)
+	case "lighting-color":
+		return ParseColor(value)
+	case "opacity":
+		return parseFloat64(value)
+	case "stop-color":
+		return ParseColor(value)
+	case "stop-opacity":
+		return parseFloat64(value)
+	case "stroke-dashoffset":
+		return parseFloat64(value)
+	case "stroke-miterlimit":
+		return parseFloat64(value)
+	case "stroke-opacity":
+		return parseFloat64(value)
+	case "stroke-width":
+		return parseLength(value)
+	case "transform":
+		return parseTransform(value)
+	case "x":
+		return parseLength(value)
+	case "y":
+		return parseLength(value)
+	case "width":
+		return parseLength(value)
+	case "height":
+		return parseLength(value)
+	default:
+		return String(value), nil
+	}
+}
+
+// newElement returns a new, empty element with the given name, or nil if name
+// is not a supported element.
+func newElement(name string) decodable {
+	switch name {
+	case "svg":
+		return &SVGElement{}
+	case "a":
+		return &AElement{}
+	case "animate":
+		return &AnimateElement{}
+	case "animateMotion":
+		return &AnimateMotionElement{}
+	case "animateTransform":
+		return &AnimateTransformElement{}
+	case "circle":
+		return &CircleElement{}
+	case "clipPath":
+		return &ClipPathElement{}
+	case "defs":
+		return &DefsElement{}
+	case "desc":
+		return &DescElement{}
+	case "ellipse":
+		return &EllipseElement{}
+	case "feBlend":
+		return &FeBlendElement{}
+	case "feColorMatrix":
+		return &FeColorMatrixElement{}
+	case "feComponentTransfer":
+		return &FeComponentTransferElement{}
+	case "feComposite":
+		return &FeCompositeElement{}
+	case "feConvolveMatrix":
+		return &FeConvolveMatrixElement{}
+	case "feDiffuseLighting":
+		return &FeDiffuseLightingElement{}
+	case "feDisplacementMap":
+		return &FeDisplacementMapElement{}
+	case "feDistantLight":
+		return &FeDistantLightElement{}
+	case "feDropShadow":
+		return &FeDropShadowElement{}
+	case "feFlood":
+		return &FeFloodElement{}
+	case "feFuncA":
+		return &FeFuncAElement{}
+	case "feFuncB":
+		return &FeFuncBElement{}
+	case "feFuncG":
+		return &FeFuncGElement{}
+	case "feFuncR":
+		return &FeFuncRElement{}
+	case "feGaussianBlur":
+		return &FeGaussianBlurElement{}
+	case "feImage":
+		return &FeImageElement{}
+	case "feMerge":
+		return &FeMergeElement{}
+	case "feMergeNode":
+		return &FeMergeNodeElement{}
+	case "feMorphology":
+		return &FeMorphologyElement{}
+	case "feOffset":
+		return &FeOffsetElement{}
+	case "fePointLight":
+		return &FePointLightElement{}
+	case "feSpecularLighting":
+		return &FeSpecularLightingElement{}
+	case "feSpotLight":
+		return &FeSpotLightElement{}
+	case "feTile":
+		return &FeTileElement{}
+	case "feTurbulence":
+		return &FeTurbulenceElement{}
+	case "filter":
+		return &FilterElement{}
+	case "foreignObject":
+		return &ForeignObjectElement{}
+	case "g":
+		return &GElement{}
+	case "image":
+		return &ImageElement{}
+	case "line":
+		return &LineElement{}
+	case "linearGradient":
+		return &LinearGradientElement{}
+	case "marker":
+		return &MarkerElement{}
+	case "mask":
+		return &MaskElement{}
+	case "metadata":
+		return &MetadataElement{}
+	case "mpath":
+		return &MPathElement{}
+	case "path":
+		return &PathElement{}
+	case "pattern":
+		return &PatternElement{}
+	case "polygon":
+		return &PolygonElement{}
+	case "polyline":
+		return &PolylineElement{}
+	case "radialGradient":
+		return &RadialGradientElement{}
+	case "rect":
+		return &RectElement{}
+	case "set":
+		return &SetElement{}
+	case "stop":
+		return &StopElement{}
+	case "style":
+		return &StyleElement{}
+	case "switch":
+		return &SwitchElement{}
+	case "symbol":
+		return &SymbolElement{}
+	case "text":
+		return &TextElement{}
+	case "textPath":
+		return &TextPathElement{}
+	case "title":
+		return &TitleElement{}
+	case "tspan":
+		return &TSpanElement{}
+	case "use":
+		return &UseElement{}
+	default:
+		return nil
+	}
+}
+
+// specAttrNames maps element names to the names of their attributes in the
+// order in which they are defined: core attributes first, then the element's
+// own attributes, then those of its other attribute groups.
+var specAttrNames = map[string][]string{
+	"svg": {
+		"version",
+		"xmlns",
+		"viewBox",
+		"preserveAspectRatio",
+		"zoomAndPan",
+		"transform",
+		"x",
+		"y",
+		"width",
+		"height",
+	},
+	"a": {
+		"href",
+		"target",
+		"download",
+		"ping",
+		"rel",
+		"hreflang",
+		"type",
+		"referrerpolicy",
+	},
+	"animate": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"attributeName",
+		"href",
+		"begin",
+		"dur",
+		"end",
+		"min",
+		"max",
+		"restart",
+		"repeatCount",
+		"repeatDur",
+		"fill",
+		"calcMode",
+		"values",
+		"keyTimes",
+		"keySplines",
+		"from",
+		"to",
+		"by",
+		"additive",
+		"accumulate",
+	},
+	"animateMotion": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"href",
+		"path",
+		"keyPoints",
+		"rotate",
+		"origin",
+		"begin",
+		"dur",
+		"end",
+		"min",
+		"max",
+		"restart",
+		"repeatCount",
+		"repeatDur",
+		"fill",
+		"calcMode",
+		"values",
+		"keyTimes",
+		"keySplines",
+		"from",
+		"to",
+		"by",
+		"additive",
+		"accumulate",
+	},
+	"animateTransform": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"attributeName",
+		"href",
+		"type",
+		"begin",
+		"dur",
+		"end",
+		"min",
+		"max",
+		"restart",
+		"repeatCount",
+		"repeatDur",
+		"fill",
+		"calcMode",
+		"values",
+		"keyTimes",
+		"keySplines",
+		"from",
+		"to",
+		"by",
+		"additive",
+		"accumulate",
+	},
+	"circle": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"pathLength",
+		"cx",
+		"cy",
+		"r",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+	},
+	"clipPath": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"externalResourcesRequired",
+		"clipPathUnits",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+	},
+	"defs": {},
+	"desc": {},
+	"ellipse": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"pathLength",
+		"cx",
+		"cy",
+		"rx",
+		"ry",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+	},
+	"feBlend": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"in",
+		"in2",
+		"mode",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+		"x",
+		"y",
+		"width",
+		"height",
+		"result",
+	},
+	"feColorMatrix": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"in",
+		"type",
+		"values",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+		"x",
+		"y",
+		"width",
+		"height",
+		"result",
+	},
+	"feComponentTransfer": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"in",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+		"x",
+		"y",
+		"width",
+		"height",
+		"result",
+	},
+	"feComposite": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"in",
+		"in2",
+		"operator",
+		"k1",
+		"k2",
+		"k3",
+		"k4",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+		"x",
+		"y",
+		"width",
+		"height",
+		"result",
+	},
+	"feConvolveMatrix": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"in",
+		"order",
+		"kernelMatrix",
+		"divisor",
+		"bias",
+		"targetX",
+		"targetY",
+		"edgeMode",
+		"kernelUnitLength",
+		"preserveAlpha",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+		"x",
+		"y",
+		"width",
+		"height",
+		"result",
+	},
+	"feDiffuseLighting": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"in",
+		"surfaceScale",
+		"diffuseConstant",
+		"kernelUnitLength",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+		"x",
+		"y",
+		"width",
+		"height",
+		"result",
+	},
+	"feDisplacementMap": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"in",
+		"in2",
+		"scale",
+		"xChannelSelector",
+		"yChannelSelector",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+		"x",
+		"y",
+		"width",
+		"height",
+		"result",
+	},
+	"feDistantLight": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"azimuth",
+		"elevation",
+	},
+	"feDropShadow": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"in",
+		"dx",
+		"dy",
+		"stdDeviation",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+		"x",
+		"y",
+		"width",
+		"height",
+		"result",
+	},
+	"feFlood": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+		"x",
+		"y",
+		"width",
+		"height",
+		"result",
+	},
+	"feFuncA": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"type",
+		"tableValues",
+		"slope",
+		"intercept",
+		"amplitude",
+		"exponent",
+		"offset",
+	},
+	"feFuncB": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"type",
+		"tableValues",
+		"slope",
+		"intercept",
+		"amplitude",
+		"exponent",
+		"offset",
+	},
+	"feFuncG": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"type",
+		"tableValues",
+		"slope",
+		"intercept",
+		"amplitude",
+		"exponent",
+		"offset",
+	},
+	"feFuncR": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"type",
+		"tableValues",
+		"slope",
+		"intercept",
+		"amplitude",
+		"exponent",
+		"offset",
+	},
+	"feGaussianBlur": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"in",
+		"stdDeviation",
+		"edgeMode",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+		"x",
+		"y",
+		"width",
+		"height",
+		"result",
+	},
+	"feImage": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"href",
+		"preserveAspectRatio",
+		"crossorigin",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+		"x",
+		"y",
+		"width",
+		"height",
+		"result",
+	},
+	"feMerge": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+		"x",
+		"y",
+		"width",
+		"height",
+		"result",
+	},
+	"feMergeNode": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"in",
+	},
+	"feMorphology": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"in",
+		"operator",
+		"radius",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+		"x",
+		"y",
+		"width",
+		"height",
+		"result",
+	},
+	"feOffset": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"in",
+		"dx",
+		"dy",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+		"x",
+		"y",
+		"width",
+		"height",
+		"result",
+	},
+	"fePointLight": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"x",
+		"y",
+		"z",
+	},
+	"feSpecularLighting": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"in",
+		"surfaceScale",
+		"specularConstant",
+		"specularExponent",
+		"kernelUnitLength",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+		"x",
+		"y",
+		"width",
+		"height",
+		"result",
+	},
+	"feSpotLight": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"x",
+		"y",
+		"z",
+		"pointsAtX",
+		"pointsAtY",
+		"pointsAtZ",
+		"specularExponent",
+		"limitingConeAngle",
+	},
+	"feTile": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"in",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+		"x",
+		"y",
+		"width",
+		"height",
+		"result",
+	},
+	"feTurbulence": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"baseFrequency",
+		"numOctaves",
+		"seed",
+		"stitchTiles",
+		"type",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+		"x",
+		"y",
+		"width",
+		"height",
+		"result",
+	},
+	"filter": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"filterUnits",
+		"primitiveUnits",
+		"href",
+		"x",
+		"y",
+		"width",
+		"height",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+	},
+	"foreignObject": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"href",
+		"x",
+		"y",
+		"width",
+		"height",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+		"requiredExtensions",
+		"systemLanguage",
+	},
+	"g": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+	},
+	"image": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"preserveAspectRatio",
+		"href",
+		"crossorigin",
+		"x",
+		"y",
+		"width",
+		"height",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+	},
+	"line": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"pathLength",
+		"x1",
+		"y1",
+		"x2",
+		"y2",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+	},
+	"linearGradient": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"x1",
+		"y1",
+		"x2",
+		"y2",
+		"gradientUnits",
+		"gradientTransform",
+		"spreadMethod",
+		"href",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+	},
+	"marker": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"viewBox",
+		"preserveAspectRatio",
+		"refX",
+		"refY",
+		"markerUnits",
+		"markerWidth",
+		"markerHeight",
+		"orient",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+	},
+	"mask": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"maskUnits",
+		"maskContentUnits",
+		"x",
+		"y",
+		"width",
+		"height",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+	},
+	"metadata": {},
+	"mpath": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"href",
+	},
+	"path": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"d",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+	},
+	"pattern": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"viewBox",
+		"preserveAspectRatio",
+		"patternUnits",
+		"patternContentUnits",
+		"patternTransform",
+		"href",
+		"x",
+		"y",
+		"width",
+		"height",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+	},
+	"polygon": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"pathLength",
+		"points",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+	},
+	"polyline": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"pathLength",
+		"points",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+	},
+	"radialGradient": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"cx",
+		"cy",
+		"r",
+		"fx",
+		"fy",
+		"fr",
+		"gradientUnits",
+		"gradientTransform",
+		"spreadMethod",
+		"href",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+	},
+	"rect": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"pathLength",
+		"x",
+		"y",
+		"width",
+		"height",
+		"rx",
+		"ry",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+	},
+	"set": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"attributeName",
+		"href",
+		"to",
+		"begin",
+		"dur",
+		"end",
+		"min",
+		"max",
+		"restart",
+		"repeatCount",
+		"repeatDur",
+		"fill",
+	},
+	"stop": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"offset",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+	},
+	"style": {
+		"type",
+	},
+	"switch": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+	},
+	"symbol": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"preserveAspectRatio",
+		"viewBox",
+		"refX",
+		"refY",
+		"x",
+		"y",
+		"width",
+		"height",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+	},
+	"text": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"lengthAdjust",
+		"x",
+		"y",
+		"dx",
+		"dy",
+		"rotate",
+		"textLength",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+	},
+	"textPath": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"lengthAdjust",
+		"textLength",
+		"path",
+		"href",
+		"startOffset",
+		"method",
+		"spacing",
+		"side",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+	},
+	"title": {},
+	"tspan": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"x",
+		"y",
+		"dx",
+		"dy",
+		"rotate",
+		"textLength",
+		"lengthAdjust",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+	},
+	"use": {
+		"id",
+		"tabindex",
+		"lang",
+		"class",
+		"style",
+		"href",
+		"x",
+		"y",
+		"width",
+		"height",
+		"alignment-baseline",
+		"baseline-shift",
+		"clip-path",
+		"clip-rule",
+		"color",
+		"color-interpolation",
+		"color-interpolation-filters",
+		"color-rendering",
+		"cursor",
+		"direction",
+		"display",
+		"dominant-baseline",
+		"fill",
+		"fill-opacity",
+		"fill-rule",
+		"filter",
+		"flood-color",
+		"flood-opacity",
+		"font-family",
+		"font-size",
+		"font-size-adjust",
+		"font-stretch",
+		"font-style",
+		"font-variant",
+		"font-weight",
+		"glyph-orientation-horizontal",
+		"glyph-orientation-vertical",
+		"image-rendering",
+		"letter-spacing",
+		"lighting-color",
+		"marker-end",
+		"marker-mid",
+		"marker-start",
+		"mask",
+		"opacity",
+		"overflow",
+		"paint-order",
+		"pointer-events",
+		"shape-rendering",
+		"stop-color",
+		"stop-opacity",
+		"stroke",
+		"stroke-dasharray",
+		"stroke-dashoffset",
+		"stroke-linecap",
+		"stroke-linejoin",
+		"stroke-miterlimit",
+		"stroke-opacity",
+		"stroke-width",
+		"text-anchor",
+		"text-decoration",
+		"text-overflow",
+		"text-rendering",
+		"transform",
+		"unicode-bidi",
+		"vector-effect",
+		"visibility",
+		"white-space",
+		"word-spacing",
+		"writing-mode",
+	},
 }
@@ -0,0 +1,355 @@
+package svg
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strings"
+)
+
+// doctype is the SVG 1.1 document type declaration.
+const doctype = `<!DOCTYPE svg PUBLIC "-//W3C//DTD SVG 1.1//EN" "http://www.w3.org/Graphics/SVG/1.1/DTD/svg11.dtd">` + "\n"
+
+// An AttrOrder is an order in which attributes are written.
+type AttrOrder int
+
+// AttrOrders.
+const (
+	// AttrOrderAlphabetical writes attributes in alphabetical order.
+	AttrOrderAlphabetical AttrOrder = iota
+	// AttrOrderSpec writes attributes in the order in which they are defined
+	// for each element: core attributes such as id first, then the element's
+	// own attributes, then presentation and other attributes. Unknown
+	// attributes are written last, in alphabetical order.
+	AttrOrderSpec
+)
+
+// WriteOptions are options for writing SVG. The zero WriteOptions writes the
+// same output as encoding/xml.
+type WriteOptions struct {
+	// XMLDeclaration writes encoding/xml.Header before the first element.
+	XMLDeclaration bool
+	// DOCTYPE writes the SVG 1.1 document type declaration before the first
+	// element.
+	DOCTYPE bool
+	// SelfClosing writes elements without children as self-closing tags, for
+	// example <rect/> instead of <rect></rect>.
+	SelfClosing bool
+	// AttrOrder is the order in which attributes are written.
+	AttrOrder AttrOrder
+	// Fragment omits xmlns attributes, for embedding SVG in HTML.
+	Fragment bool
+	// Prefix and Indent indent each element, like encoding/xml.Encoder.Indent.
+	Prefix string
+	Indent string
+	// Precision is the precision with which numbers in attribute values that
+	// implement NumberFormatter are written.
+	Precision Precision
+
+	// singleQuotes delimits attribute values with single quotes.
+	singleQuotes bool
+}
+
+// An Encoder writes SVG elements to an output stream. Container elements can
+// be opened, have their children written incrementally, and then be closed,
+// so that documents can be written without holding them in memory.
+//
+// Unlike Write, an Encoder does not check that elements referenced by Paint
+// attribute values are in the document.
+type Encoder struct {
+	w           *bufio.Writer
+	options     WriteOptions
+	started     bool
+	depth       int
+	indentedIn  bool
+	putNewline  bool
+	endElements []string
+}
+
+// NewEncoder returns a new Encoder that writes to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{
+		w: bufio.NewWriter(w),
+	}
+}
+
+// Write writes element to w with options. If element is an *SVGElement and
+// options.Fragment is false then Write returns an error if a Paint attribute
+// value references an element that is not in element.
+func Write(w io.Writer, element Element, options WriteOptions) (int64, error) {
+	if svgElement, ok := element.(*SVGElement); ok && !options.Fragment {
+		if err := checkReferences(svgElement); err != nil {
+			return 0, err
+		}
+	}
+	wc := &writeCounter{w: w}
+	encoder := NewEncoder(wc)
+	encoder.SetOptions(options)
+	if err := encoder.Encode(element); err != nil {
+		return int64(wc.bytesWritten), err
+	}
+	if err := encoder.Flush(); err != nil {
+		return int64(wc.bytesWritten), err
+	}
+	return int64(wc.bytesWritten), nil
+}
+
+// Close writes the end tag of the most recently opened element.
+func (e *Encoder) Close() error {
+	if len(e.endElements) == 0 {
+		return errors.New("no open element")
+	}
+	name := e.endElements[len(e.endElements)-1]
+	e.endElements = e.endElements[:len(e.endElements)-1]
+	e.writeEnd(name)
+	return nil
+}
+
+// Encode writes element, including all its children. It returns an error if
+// an attribute value contains a number that is not finite, or if a comment
+// contains "--" or ends with "-", which is not allowed in XML.
+//
+// Elements that are not defined by this package are written with their
+// MarshalXML method, without indentation.
+func (e *Encoder) Encode(element Element) error {
+	e.writeProlog()
+	switch element := element.(type) {
+	case Node:
+		children := element.ChildElements()
+		if err := e.writeStart(element, e.options.SelfClosing && len(children) == 0); err != nil {
+			return err
+		}
+		if e.options.SelfClosing && len(children) == 0 {
+			return nil
+		}
+		for _, child := range children {
+			if err := e.Encode(child); err != nil {
+				return err
+			}
+		}
+		e.writeEnd(element.TagName())
+		return nil
+	case CharData:
+		e.writeCharData(element)
+		return nil
+	case Comment:
+		switch {
+		case bytes.Contains(element, []byte("-->")):
+			return errors.New("comment contains -->")
+		case bytes.Contains(element, []byte("--")):
+			return errors.New("comment contains --")
+		case bytes.HasSuffix(element, []byte("-")):
+			return errors.New("comment ends with -")
+		}
+		_, _ = e.w.WriteString("<!--")
+		_, _ = e.w.Write(element)
+		_, _ = e.w.WriteString("-->")
+		return nil
+	default:
+		xmlEncoder := xml.NewEncoder(e.w)
+		if err := element.MarshalXML(xmlEncoder, xml.StartElement{}); err != nil {
+			return err
+		}
+		return xmlEncoder.Flush()
+	}
+}
+
+// Flush flushes any buffered output to the underlying writer. It should be
+// called after the last element is written.
+func (e *Encoder) Flush() error {
+	return e.w.Flush()
+}
+
+// Indent sets e to indent each element with prefix and indent, like
+// encoding/xml.Encoder.Indent.
+func (e *Encoder) Indent(prefix, indent string) {
+	e.options.Prefix = prefix
+	e.options.Indent = indent
+}
+
+// Open writes the start tag of element and any children that it already has,
+// leaving it open so that further children can be written with Encode or Open.
+// The element must be closed with Close.
+func (e *Encoder) Open(element Element) error {
+	node, ok := element.(Node)
+	if !ok {
+		return fmt.Errorf("%T: cannot open element", element)
+	}
+	e.writeProlog()
+	if err := e.writeStart(node, false); err != nil {
+		return err
+	}
+	for _, child := range node.ChildElements() {
+		if err := e.Encode(child); err != nil {
+			return err
+		}
+	}
+	e.endElements = append(e.endElements, node.TagName())
+	return nil
+}
+
+// SetOptions sets the options with which e writes elements.
+func (e *Encoder) SetOptions(options WriteOptions) {
+	e.options = options
+}
+
+// SetPrecision sets the precision with which e writes numbers in attribute
+// values that implement NumberFormatter. The default is exact.
+func (e *Encoder) SetPrecision(precision Precision) {
+	e.options.Precision = precision
+}
+
+// writeCharData writes escaped character data.
+func (e *Encoder) writeCharData(charData []byte) {
+	for i, line := range bytes.Split(charData, []byte("\n")) {
+		if i > 0 {
+			_ = e.w.WriteByte('\n')
+		}
+		_ = xml.EscapeText(e.w, line)
+	}
+}
+
+// writeEnd writes the end tag of the element called name.
+func (e *Encoder) writeEnd(name string) {
+	e.writeIndent(-1)
+	_, _ = e.w.WriteString("</")
+	_, _ = e.w.WriteString(name)
+	_ = e.w.WriteByte('>')
+}
+
+// writeIndent writes indentation, exactly as encoding/xml.Encoder does.
+func (e *Encoder) writeIndent(depthDelta int) {
+	if e.options.Prefix == "" && e.options.Indent == "" {
+		return
+	}
+	if depthDelta < 0 {
+		e.depth--
+		if e.indentedIn {
+			e.indentedIn = false
+			return
+		}
+		e.indentedIn = false
+	}
+	if e.putNewline {
+		_ = e.w.WriteByte('\n')
+	} else {
+		e.putNewline = true
+	}
+	_, _ = e.w.WriteString(e.options.Prefix)
+	for range e.depth {
+		_, _ = e.w.WriteString(e.options.Indent)
+	}
+	if depthDelta > 0 {
+		e.depth++
+		e.indentedIn = true
+	}
+}
+
+// writeProlog writes the XML declaration and document type declaration, if
+// requested, before the first element.
+func (e *Encoder) writeProlog() {
+	if e.started {
+		return
+	}
+	e.started = true
+	if e.options.XMLDeclaration {
+		_, _ = e.w.WriteString(xml.Header)
+	}
+	if e.options.DOCTYPE {
+		_, _ = e.w.WriteString(doctype)
+	}
+}
+
+// writeStart writes the start tag of element. If selfClosing is true then the
+// tag is self-closing.
+func (e *Encoder) writeStart(element Node, selfClosing bool) error {
+	xmlAttrs, err := formatAttrs(element, e.options)
+	if err != nil {
+		return err
+	}
+	e.writeIndent(1)
+	_ = e.w.WriteByte('<')
+	_, _ = e.w.WriteString(element.TagName())
+	quote := byte('"')
+	if e.options.singleQuotes {
+		quote = '\''
+	}
+	for _, xmlAttr := range xmlAttrs {
+		_ = e.w.WriteByte(' ')
+		_, _ = e.w.WriteString(xmlAttr.Name.Local)
+		_ = e.w.WriteByte('=')
+		_ = e.w.WriteByte(quote)
+		_ = xml.EscapeText(e.w, []byte(xmlAttr.Value))
+		_ = e.w.WriteByte(quote)
+	}
+	if selfClosing {
+		_, _ = e.w.WriteString("/>")
+		e.depth--
+		e.indentedIn = false
+		return nil
+	}
+	_ = e.w.WriteByte('>')
+	return nil
+}
+
+// formatAttrs returns the attributes of element, formatted and ordered
+// according to options.
+func formatAttrs(element Node, options WriteOptions) ([]xml.Attr, error) {
+	attrs := element.Attributes()
+	localNames := make([]string, 0, len(attrs))
+	for localName := range attrs {
+		if options.Fragment && (localName == "xmlns" || strings.HasPrefix(localName, "xmlns:")) {
+			continue
+		}
+		localNames = append(localNames, localName)
+	}
+	slices.Sort(localNames)
+	if options.AttrOrder == AttrOrderSpec {
+		specNames := specAttrNames[element.TagName()]
+		rank := func(localName string) int {
+			if i := slices.Index(specNames, localName); i >= 0 {
+				return i
+			}
+			return len(specNames)
+		}
+		slices.SortStableFunc(localNames, func(a, b string) int {
+			return rank(a) - rank(b)
+		})
+	}
+
+	xmlAttrs := make([]xml.Attr, 0, len(localNames))
+	for _, localName := range localNames {
+		value, err := formatAttrValue(attrs[localName], options.Precision)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s: %w", element.TagName(), localName, err)
+		}
+		xmlAttr := xml.Attr{
+			Name:  xml.Name{Local: localName},
+			Value: value,
+		}
+		xmlAttrs = append(xmlAttrs, xmlAttr)
+	}
+	return xmlAttrs, nil
+}
+
+// formatAttrValue returns the string representation of value, with numbers
+// formatted with precision.
+func formatAttrValue(value AttrValue, precision Precision) (string, error) {
+	numberFormatter, ok := value.(NumberFormatter)
+	if !ok {
+		return value.String(), nil
+	}
+	var err error
+	valueStr := numberFormatter.FormatNumbers(func(f float64) string {
+		if (math.IsNaN(f) || math.IsInf(f, 0)) && err == nil {
+			err = fmt.Errorf("%v: number is not finite", f)
+		}
+		return precision.FormatFloat(f)
+	})
+	return valueStr, err
+}
@@ -0,0 +1,41 @@
+package svg
+
+// DropShadow returns a new FilterElement that draws a copy of its input's
+// alpha channel in color c with opacity opacity, offset by dx, dy and blurred
+// with standard deviation stdDeviation, behind its input. The filter region is
+// enlarged so that the shadow is not clipped.
+func DropShadow(dx, dy, stdDeviation float64, c Color, opacity float64) *FilterElement {
+	flood := FeFlood().FloodColor(c).Result("flood")
+	if opacity != 1 {
+		flood.FloodOpacity(Float64(opacity))
+	}
+	filter := Filter().XYWidthHeight(-50, -50, 200, 200, Percent).AppendChildren(
+		FeGaussianBlur().In("SourceAlpha").StdDeviation(Numbers{stdDeviation}).Result("blur"),
+	)
+	shadowIn := String("blur")
+	if dx != 0 || dy != 0 {
+		filter.AppendChildren(FeOffset().In("blur").DX(Float64(dx)).DY(Float64(dy)).Result("offset"))
+		shadowIn = "offset"
+	}
+	return filter.AppendChildren(
+		flood,
+		FeComposite().In("flood").In2(shadowIn).Operator("in").Result("shadow"),
+		FeMerge(
+			FeMergeNode().In("shadow"),
+			FeMergeNode().In("SourceGraphic"),
+		),
+	)
+}
+
+// Glow returns a new FilterElement that draws a halo in color c with opacity
+// opacity around its input, blurred with standard deviation stdDeviation.
+func Glow(stdDeviation float64, c Color, opacity float64) *FilterElement {
+	return DropShadow(0, 0, stdDeviation, c, opacity)
+}
+
+// Grayscale returns a new FilterElement that removes all color from its input.
+func Grayscale() *FilterElement {
+	return Filter(
+		FeColorMatrix().In("SourceGraphic").Type("saturate").Values(Numbers{0}),
+	)
+}
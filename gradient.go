@@ -0,0 +1,32 @@
+package svg
+
+// A GradientStop is a stop in a gradient.
+type GradientStop struct {
+	Offset  float64  // Offset is the offset of the stop, from 0 to 1.
+	Color   Color    // Color is the color of the stop.
+	Opacity *float64 // Opacity is the opacity of the stop, from 0 to 1, or nil if the stop is opaque.
+}
+
+// LinearGradientFromStops returns a new LinearGradientElement with stops.
+func LinearGradientFromStops(stops ...GradientStop) *LinearGradientElement {
+	return LinearGradient(stopElements(stops)...)
+}
+
+// RadialGradientFromStops returns a new RadialGradientElement with stops.
+func RadialGradientFromStops(stops ...GradientStop) *RadialGradientElement {
+	return RadialGradient(stopElements(stops)...)
+}
+
+// stopElements returns the stop elements for stops. The stop-opacity attribute
+// is omitted for stops without an Opacity.
+func stopElements(stops []GradientStop) []Element {
+	elements := make([]Element, 0, len(stops))
+	for _, stop := range stops {
+		stopElement := Stop().Offset(Number(stop.Offset)).StopColor(stop.Color)
+		if stop.Opacity != nil {
+			stopElement.StopOpacity(Float64(*stop.Opacity))
+		}
+		elements = append(elements, stopElement)
+	}
+	return elements
+}
@@ -0,0 +1,73 @@
+package svg
+
+import (
+	"errors"
+	"fmt"
+	"html/template"
+	"regexp"
+	"strings"
+)
+
+// attrNameRx matches attribute names that are safe to write in HTML.
+var attrNameRx = regexp.MustCompile(`\A[A-Za-z_][-.0-9A-Za-z_]*(?::[A-Za-z_][-.0-9A-Za-z_]*)?\z`)
+
+// FuncMap returns functions for use in html/template templates:
+//
+//	svg        renders an Element as inline markup, as for HTML.
+//	svgDataURI returns an *SVGElement as a data URI, as for DataURI.
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"svg": HTML,
+		"svgDataURI": func(svgElement *SVGElement) (template.URL, error) {
+			dataURI, err := svgElement.DataURI()
+			if err != nil {
+				return "", err
+			}
+			return template.URL(dataURI), nil //nolint:gosec
+		},
+	}
+}
+
+// HTML returns element as markup that can be safely included inline in an
+// HTML document. The markup has no XML declaration or xmlns attributes, and
+// character data and attribute values are escaped so that they cannot break
+// out of the SVG content. It returns an error if element contains an attribute
+// name that is not a valid XML name, or a comment that could end early when
+// parsed as HTML.
+//
+// Attribute values are escaped but not otherwise checked, so untrusted values
+// must not be used for attributes like href or event handlers.
+func HTML(element Element) (template.HTML, error) {
+	if err := checkHTML(element); err != nil {
+		return "", err
+	}
+	var builder strings.Builder
+	if _, err := Write(&builder, element, WriteOptions{
+		SelfClosing: true,
+		Fragment:    true,
+	}); err != nil {
+		return "", err
+	}
+	return template.HTML(builder.String()), nil //nolint:gosec
+}
+
+// checkHTML returns an error if element or any of its descendants cannot be
+// written safely in HTML.
+func checkHTML(element Element) error {
+	for element := range Walk(element) {
+		switch element := element.(type) {
+		case Node:
+			for name := range element.Attributes() {
+				if !attrNameRx.MatchString(name) {
+					return fmt.Errorf("%s: %q: invalid attribute name", element.TagName(), name)
+				}
+			}
+		case Comment:
+			s := string(element)
+			if strings.Contains(s, "--") || strings.HasPrefix(s, ">") || strings.HasPrefix(s, "-") || strings.HasSuffix(s, "-") || strings.Contains(s, "<!-") {
+				return errors.New("comment is not safe in HTML")
+			}
+		}
+	}
+	return nil
+}
@@ -22,11 +22,17 @@ var (
 )
 
 type Attribute struct {
-	Name           string `yaml:"name"`
-	GoName         string `yaml:"goName"`
-	ExportedGoName string `yaml:"exportedGoName"`
-	Type           string `yaml:"type"`
-	Default        string `yaml:"default"`
+	Name           string     `yaml:"name"`
+	GoName         string     `yaml:"goName"`
+	ExportedGoName string     `yaml:"exportedGoName"`
+	Type           string     `yaml:"type"`
+	ParseFunc      string     `yaml:"parseFunc"`
+	Default        string     `yaml:"default"`
+	Overloads      []Overload `yaml:"overloads"`
+}
+
+type Overload struct {
+	Type string `yaml:"type"`
 }
 
 type Element struct {
@@ -75,6 +81,17 @@ func (p *Attribute) generateGoType(defaultType string) {
 	p.Type = defaultType
 }
 
+func (p *Attribute) generateParseFunc() {
+	if p.ParseFunc != "" {
+		return
+	}
+	switch p.Type {
+	case "AttrValue", "String":
+	default:
+		p.ParseFunc = "parse" + p.Type
+	}
+}
+
 func titleize(s string) string {
 	runes := []rune(s)
 	if len(runes) > 0 {
@@ -111,6 +128,7 @@ func run() error {
 			attribute.generateGoName()
 			attribute.generateExportedGoName()
 			attribute.generateGoType("String")
+			attribute.generateParseFunc()
 		}
 	}
 
@@ -130,12 +148,14 @@ func run() error {
 			attribute.generateGoName()
 			attribute.generateExportedGoName()
 			attribute.generateGoType("String")
+			attribute.generateParseFunc()
 		}
 		for j := range templateData.Elements[i].GeometryProperties {
 			geometryProperty := &element.GeometryProperties[j]
 			geometryProperty.generateGoName()
 			geometryProperty.generateExportedGoName()
 			geometryProperty.generateGoType("Length")
+			geometryProperty.generateParseFunc()
 		}
 	}
 
@@ -160,7 +180,32 @@ func run() error {
 			}
 			return defaultValue
 		},
-		"quote":      strconv.Quote,
+		"quote": strconv.Quote,
+		"specAttributes": func(e Element) []Attribute {
+			var specAttributes []Attribute
+			seen := make(map[string]bool)
+			appendAttributes := func(attributes []Attribute) {
+				for _, attribute := range attributes {
+					if !seen[attribute.Name] {
+						specAttributes = append(specAttributes, attribute)
+						seen[attribute.Name] = true
+					}
+				}
+			}
+			for _, attributeGroupName := range e.AttributeGroups {
+				if attributeGroupName == "core" {
+					appendAttributes(templateData.AttributeGroups[attributeGroupName])
+				}
+			}
+			appendAttributes(e.Attributes)
+			appendAttributes(e.GeometryProperties)
+			for _, attributeGroupName := range e.AttributeGroups {
+				if attributeGroupName != "core" {
+					appendAttributes(templateData.AttributeGroups[attributeGroupName])
+				}
+			}
+			return specAttributes
+		},
 		"titleize":   titleize,
 		"untitleize": untitleize,
 	}
@@ -0,0 +1,147 @@
+package optimize
+
+import (
+	"maps"
+	"strconv"
+	"strings"
+
+	"github.com/twpayne/go-svg"
+)
+
+// defaultValues maps presentation attributes to their initial values.
+var defaultValues = map[string][]string{
+	"clip-rule":           {"nonzero"},
+	"display":             {"inline"},
+	"fill":                {"#000", "#000000", "black"},
+	"fill-opacity":        {"1"},
+	"fill-rule":           {"nonzero"},
+	"flood-color":         {"#000", "#000000", "black"},
+	"flood-opacity":       {"1"},
+	"font-stretch":        {"normal"},
+	"font-style":          {"normal"},
+	"font-variant":        {"normal"},
+	"font-weight":         {"normal", "400"},
+	"lighting-color":      {"#fff", "#ffffff", "white"},
+	"opacity":             {"1"},
+	"stop-color":          {"#000", "#000000", "black"},
+	"stop-opacity":        {"1"},
+	"stroke":              {"none"},
+	"stroke-dasharray":    {"none"},
+	"stroke-dashoffset":   {"0"},
+	"stroke-linecap":      {"butt"},
+	"stroke-linejoin":     {"miter"},
+	"stroke-miterlimit":   {"4"},
+	"stroke-opacity":      {"1"},
+	"stroke-width":        {"1"},
+	"text-anchor":         {"start"},
+	"visibility":          {"visible"},
+	"writing-mode":        {"horizontal-tb"},
+	"letter-spacing":      {"normal"},
+	"word-spacing":        {"normal"},
+	"color-interpolation": {"srgb"},
+}
+
+// RemoveDefaultAttrs is a Pass that removes presentation attributes that are
+// set to their initial values, and geometry attributes that are zero when zero
+// is their default.
+//
+// An inherited attribute set to its initial value is kept if it overrides a
+// different value set by an ancestor, if its value might be set by a style
+// sheet, or if the element might be rendered where it is referenced, for
+// example by a use element, and so inherit from a different context.
+type RemoveDefaultAttrs struct{}
+
+// Name implements Pass.Name.
+func (RemoveDefaultAttrs) Name() string {
+	return "removeDefaultAttrs"
+}
+
+// Apply implements Pass.Apply.
+func (RemoveDefaultAttrs) Apply(root *svg.SVGElement) error {
+	removeDefaultAttrs(root, make(map[string]bool), hasStyleSheet(root), false, references(root))
+	return nil
+}
+
+// removeDefaultAttrs removes default attributes from element and its
+// descendants. overridden contains the inherited attributes that an ancestor
+// sets to a value other than their initial value. If styled is true then any
+// inherited attribute may have been set by a style sheet. If reused is true
+// then element is in content that may be rendered elsewhere, where it
+// inherits from the referencing element. ids contains the ids of referenced
+// elements.
+func removeDefaultAttrs(element svg.Element, overridden map[string]bool, styled, reused bool, ids map[string]bool) {
+	node, ok := element.(svg.Node)
+	if !ok {
+		return
+	}
+	attrs := node.Attributes()
+	switch element.(type) {
+	case *svg.ClipPathElement, *svg.DefsElement, *svg.MarkerElement, *svg.PatternElement, *svg.SymbolElement:
+		reused = true
+	}
+	if id, ok := attrs["id"]; ok && ids[id.String()] {
+		reused = true
+	}
+
+	var childOverridden map[string]bool
+	for name, value := range attrs {
+		isDefault := isDefaultValue(element, name, value)
+		if inheritedProperties[name] && overridden[name] == isDefault {
+			if childOverridden == nil {
+				childOverridden = maps.Clone(overridden)
+			}
+			childOverridden[name] = !isDefault
+		}
+		if isDefault && !(inheritedProperties[name] && (styled || reused || overridden[name])) {
+			delete(attrs, name)
+		}
+	}
+	if childOverridden == nil {
+		childOverridden = overridden
+	}
+	if _, ok := attrs["class"]; ok {
+		styled = true
+	}
+	if _, ok := attrs["style"]; ok {
+		styled = true
+	}
+
+	for _, child := range node.ChildElements() {
+		removeDefaultAttrs(child, childOverridden, styled, reused, ids)
+	}
+}
+
+// isDefaultValue returns whether value is the default value of the attribute
+// name on element.
+func isDefaultValue(element svg.Element, name string, value svg.AttrValue) bool {
+	valueStr := strings.ToLower(strings.TrimSpace(value.String()))
+	switch name {
+	case "x", "y":
+		switch element.(type) {
+		case *svg.ForeignObjectElement, *svg.ImageElement, *svg.RectElement, *svg.UseElement:
+			return isZeroLength(valueStr)
+		}
+	case "cx", "cy":
+		switch element.(type) {
+		case *svg.CircleElement, *svg.EllipseElement:
+			return isZeroLength(valueStr)
+		}
+	case "x1", "y1", "x2", "y2":
+		if _, ok := element.(*svg.LineElement); ok {
+			return isZeroLength(valueStr)
+		}
+	}
+	for _, defaultValue := range defaultValues[name] {
+		if valueStr == defaultValue {
+			return true
+		}
+	}
+	return false
+}
+
+// isZeroLength returns whether s is a zero length.
+func isZeroLength(s string) bool {
+	number := strings.TrimRight(s, "%abcdefghijklmnopqrstuvwxyz")
+	value, err := strconv.ParseFloat(number, 64)
+	return err == nil && value == 0
+}
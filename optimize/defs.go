@@ -0,0 +1,120 @@
+package optimize
+
+import (
+	"github.com/twpayne/go-svg"
+)
+
+// RemoveEmptyContainers is a Pass that removes a, defs, g, and switch elements
+// that have no children, unless they have an id or a filter.
+type RemoveEmptyContainers struct{}
+
+// Name implements Pass.Name.
+func (RemoveEmptyContainers) Name() string {
+	return "removeEmptyContainers"
+}
+
+// Apply implements Pass.Apply.
+func (RemoveEmptyContainers) Apply(root *svg.SVGElement) error {
+	removeEmptyContainers(root)
+	return nil
+}
+
+// removeEmptyContainers removes the empty containers in element's descendants.
+func removeEmptyContainers(element svg.Element) {
+	container, ok := element.(svg.Container)
+	if !ok {
+		return
+	}
+	children := container.ChildElements()
+	newChildren := make([]svg.Element, 0, len(children))
+	for _, child := range children {
+		removeEmptyContainers(child)
+		if !isEmptyContainer(child) {
+			newChildren = append(newChildren, child)
+		}
+	}
+	container.SetChildElements(newChildren)
+}
+
+// isEmptyContainer returns whether element is an empty container that can be
+// removed.
+func isEmptyContainer(element svg.Element) bool {
+	switch element.(type) {
+	case *svg.AElement, *svg.DefsElement, *svg.GElement, *svg.SwitchElement:
+	default:
+		return false
+	}
+	container, ok := element.(svg.Container)
+	if !ok || len(container.ChildElements()) != 0 {
+		return false
+	}
+	attrs := container.Attributes()
+	_, hasID := attrs["id"]
+	_, hasFilter := attrs["filter"]
+	return !hasID && !hasFilter
+}
+
+// RemoveUnusedDefs is a Pass that removes children of defs elements that are
+// not referenced, either directly or by other referenced elements. References
+// are found in url() functions in attribute values and style sheets, and in
+// href attributes.
+type RemoveUnusedDefs struct{}
+
+// Name implements Pass.Name.
+func (RemoveUnusedDefs) Name() string {
+	return "removeUnusedDefs"
+}
+
+// Apply implements Pass.Apply.
+func (RemoveUnusedDefs) Apply(root *svg.SVGElement) error {
+	for {
+		if !removeUnusedDefs(root, references(root)) {
+			return nil
+		}
+	}
+}
+
+// removeUnusedDefs removes the children of defs elements in element's
+// descendants that do not contain an element whose id is in ids. It returns
+// whether any elements were removed.
+func removeUnusedDefs(element svg.Element, ids map[string]bool) bool {
+	container, ok := element.(svg.Container)
+	if !ok {
+		return false
+	}
+	children := container.ChildElements()
+	removed := false
+	if _, ok := element.(*svg.DefsElement); ok {
+		newChildren := make([]svg.Element, 0, len(children))
+		for _, child := range children {
+			if isUsed(child, ids) {
+				newChildren = append(newChildren, child)
+			} else {
+				removed = true
+			}
+		}
+		container.SetChildElements(newChildren)
+		children = newChildren
+	}
+	for _, child := range children {
+		if removeUnusedDefs(child, ids) {
+			removed = true
+		}
+	}
+	return removed
+}
+
+// isUsed returns whether element is a style sheet, is not an element, or
+// contains an element whose id is in ids.
+func isUsed(element svg.Element, ids map[string]bool) bool {
+	switch element.(type) {
+	case svg.CharData, svg.Comment, *svg.StyleElement:
+		return true
+	}
+	for node := range svg.OfType[svg.Node](svg.Walk(element)) {
+		if id, ok := node.Attributes()["id"]; ok && ids[id.String()] {
+			return true
+		}
+	}
+	return false
+}
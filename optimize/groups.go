@@ -0,0 +1,143 @@
+package optimize
+
+import (
+	"slices"
+
+	"github.com/twpayne/go-svg"
+)
+
+// CollapseGroups is a Pass that removes redundant g elements. A g element
+// without attributes is replaced by its children. A g element with a single
+// graphics element child and only inherited presentation attributes and a
+// transform is replaced by its child, which receives the g element's
+// attributes.
+//
+// Groups are not collapsed if the document contains a style sheet, as its
+// selectors might depend on the structure of the document.
+type CollapseGroups struct{}
+
+// Name implements Pass.Name.
+func (CollapseGroups) Name() string {
+	return "collapseGroups"
+}
+
+// Apply implements Pass.Apply.
+func (CollapseGroups) Apply(root *svg.SVGElement) error {
+	if hasStyleSheet(root) {
+		return nil
+	}
+	collapseGroups(root)
+	return nil
+}
+
+// collapseGroups collapses the redundant groups in element's descendants.
+// Direct children of switch elements are never collapsed, because a switch
+// element renders only its first direct child whose conditions are met.
+func collapseGroups(element svg.Element) {
+	container, ok := element.(svg.Container)
+	if !ok {
+		return
+	}
+	children := container.ChildElements()
+	_, isSwitch := element.(*svg.SwitchElement)
+	newChildren := make([]svg.Element, 0, len(children))
+	for _, child := range children {
+		collapseGroups(child)
+		g, ok := child.(*svg.GElement)
+		if !ok || isSwitch || slices.ContainsFunc(g.Children, isAnimation) {
+			newChildren = append(newChildren, child)
+			continue
+		}
+		if len(g.Attrs) == 0 {
+			newChildren = append(newChildren, g.Children...)
+			continue
+		}
+		if len(g.Children) == 1 && moveAttrs(g, g.Children[0]) {
+			newChildren = append(newChildren, g.Children[0])
+			continue
+		}
+		newChildren = append(newChildren, child)
+	}
+	container.SetChildElements(newChildren)
+}
+
+// moveAttrs moves g's attributes to child, if possible, and returns whether it
+// did so.
+func moveAttrs(g *svg.GElement, child svg.Element) bool {
+	switch child.(type) {
+	case *svg.CircleElement, *svg.EllipseElement, *svg.GElement, *svg.ImageElement, *svg.LineElement,
+		*svg.PathElement, *svg.PolygonElement, *svg.PolylineElement, *svg.RectElement, *svg.TextElement,
+		*svg.UseElement:
+	default:
+		return false
+	}
+	childNode, ok := child.(svg.Node)
+	if !ok {
+		return false
+	}
+	childAttrs := childNode.Attributes()
+	for name := range g.Attrs {
+		if name != "transform" && !inheritedProperties[name] {
+			return false
+		}
+	}
+
+	var transform svg.AttrValue
+	if gTransform, ok := g.Attrs["transform"]; ok {
+		transform = gTransform
+		if childTransform, ok := childAttrs["transform"]; ok {
+			if transform, ok = composeTransforms(gTransform, childTransform); !ok {
+				return false
+			}
+		}
+	}
+
+	for name, value := range g.Attrs {
+		if _, ok := childAttrs[name]; !ok {
+			childAttrs[name] = value
+		}
+	}
+	switch {
+	case transform == nil:
+	case transform.String() == "":
+		delete(childAttrs, "transform")
+	default:
+		childAttrs["transform"] = transform
+	}
+	return true
+}
+
+// composeTransforms returns the composition of the transform attribute values
+// outer and inner, which applies inner first, and whether they could be
+// composed. Parsed TransformLists are concatenated if that is shorter than
+// their combined matrix.
+func composeTransforms(outer, inner svg.AttrValue) (svg.AttrValue, bool) {
+	outerTransform, outerOK := outer.(svg.Transform)
+	innerTransform, innerOK := inner.(svg.Transform)
+	if outerOK && innerOK {
+		return outerTransform.Mul(innerTransform), true
+	}
+	outerList, outerOK := transformList(outer)
+	innerList, innerOK := transformList(inner)
+	if !outerOK || !innerOK {
+		return nil, false
+	}
+	concatenated := slices.Concat(outerList, innerList)
+	if matrix := concatenated.Matrix(); len(matrix.String()) <= len(concatenated.String()) {
+		return matrix, true
+	}
+	return concatenated, true
+}
+
+// transformList returns value as a TransformList, and whether value is a
+// transform.
+func transformList(value svg.AttrValue) (svg.TransformList, bool) {
+	switch value := value.(type) {
+	case svg.TransformList:
+		return value, true
+	case svg.Transform:
+		return svg.TransformList{{Name: "matrix", Args: value[:]}}, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,91 @@
+// Package optimize reduces the size of SVG documents by transforming their
+// element trees.
+//
+// An optimization is a sequence of passes. Each pass implements Pass and
+// modifies the tree in place without changing how it is rendered.
+package optimize
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/twpayne/go-svg"
+)
+
+// A Pass is an optimization pass.
+type Pass interface {
+	// Name returns the name of the pass.
+	Name() string
+	// Apply applies the pass to the tree rooted at root.
+	Apply(root *svg.SVGElement) error
+}
+
+// A PassResult is the result of a single pass.
+type PassResult struct {
+	Name       string
+	BytesSaved int
+}
+
+// A Report describes the effect of an optimization.
+type Report struct {
+	BytesBefore int
+	BytesAfter  int
+	Passes      []PassResult
+}
+
+// DefaultPasses returns the default passes, in the order in which they are
+// applied.
+func DefaultPasses() []Pass {
+	return []Pass{
+		RemoveDefaultAttrs{},
+		RemoveUnusedDefs{},
+		RemoveEmptyContainers{},
+		CollapseGroups{},
+		MergePaths{},
+		ShortenPathData{},
+	}
+}
+
+// Optimize applies passes to the tree rooted at root, in order, and reports the
+// number of bytes saved by each. If no passes are given then DefaultPasses are
+// used.
+func Optimize(root *svg.SVGElement, passes ...Pass) (*Report, error) {
+	if len(passes) == 0 {
+		passes = DefaultPasses()
+	}
+	bytesBefore, err := size(root)
+	if err != nil {
+		return nil, err
+	}
+	report := &Report{
+		BytesBefore: bytesBefore,
+		BytesAfter:  bytesBefore,
+		Passes:      make([]PassResult, 0, len(passes)),
+	}
+	for _, pass := range passes {
+		if err := pass.Apply(root); err != nil {
+			return report, fmt.Errorf("%s: %w", pass.Name(), err)
+		}
+		bytesAfter, err := size(root)
+		if err != nil {
+			return report, fmt.Errorf("%s: %w", pass.Name(), err)
+		}
+		report.Passes = append(report.Passes, PassResult{
+			Name:       pass.Name(),
+			BytesSaved: report.BytesAfter - bytesAfter,
+		})
+		report.BytesAfter = bytesAfter
+	}
+	return report, nil
+}
+
+// BytesSaved returns the total number of bytes saved.
+func (r *Report) BytesSaved() int {
+	return r.BytesBefore - r.BytesAfter
+}
+
+// size returns the size of the tree rooted at root when written.
+func size(root *svg.SVGElement) (int, error) {
+	n, err := root.WriteTo(io.Discard)
+	return int(n), err
+}
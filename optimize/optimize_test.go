@@ -0,0 +1,223 @@
+package optimize_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-svg"
+	"github.com/twpayne/go-svg/optimize"
+)
+
+func TestPasses(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		pass     optimize.Pass
+		input    string
+		expected string
+	}{
+		{
+			name:     "remove_default_attrs",
+			pass:     optimize.RemoveDefaultAttrs{},
+			input:    `<svg xmlns="http://www.w3.org/2000/svg"><rect x="0" y="1" fill="black" stroke="none" opacity="1" stroke-width="2"></rect></svg>`,
+			expected: `<svg xmlns="http://www.w3.org/2000/svg"><rect stroke-width="2" y="1"></rect></svg>`,
+		},
+		{
+			name:     "remove_default_attrs_inherited",
+			pass:     optimize.RemoveDefaultAttrs{},
+			input:    `<svg xmlns="http://www.w3.org/2000/svg"><g fill="red"><path d="M0,0" fill="black"></path></g><g class="c"><path d="M0,0" fill="black" opacity="1"></path></g></svg>`,
+			expected: `<svg xmlns="http://www.w3.org/2000/svg"><g fill="red"><path d="M0,0" fill="black"></path></g><g class="c"><path d="M0,0" fill="black"></path></g></svg>`,
+		},
+		{
+			name:     "remove_default_attrs_style_sheet",
+			pass:     optimize.RemoveDefaultAttrs{},
+			input:    `<svg xmlns="http://www.w3.org/2000/svg"><style>path { fill: red }</style><path d="M0,0" fill="black" opacity="1"></path></svg>`,
+			expected: `<svg xmlns="http://www.w3.org/2000/svg"><style>path { fill: red }</style><path d="M0,0" fill="black"></path></svg>`,
+		},
+		{
+			name:     "remove_default_attrs_reused",
+			pass:     optimize.RemoveDefaultAttrs{},
+			input:    `<svg xmlns="http://www.w3.org/2000/svg"><defs><path id="p" d="M0,0" fill="black" opacity="1"></path></defs><use href="#p" fill="red"></use></svg>`,
+			expected: `<svg xmlns="http://www.w3.org/2000/svg"><defs><path d="M0,0" fill="black" id="p"></path></defs><use fill="red" href="#p"></use></svg>`,
+		},
+		{
+			name:     "remove_default_attrs_symbol",
+			pass:     optimize.RemoveDefaultAttrs{},
+			input:    `<svg xmlns="http://www.w3.org/2000/svg"><symbol id="s"><g><path d="M0,0" stroke="none"></path></g></symbol><use href="#s" stroke="red"></use></svg>`,
+			expected: `<svg xmlns="http://www.w3.org/2000/svg"><symbol id="s"><g><path d="M0,0" stroke="none"></path></g></symbol><use href="#s" stroke="red"></use></svg>`,
+		},
+		{
+			name:     "remove_default_attrs_referenced",
+			pass:     optimize.RemoveDefaultAttrs{},
+			input:    `<svg xmlns="http://www.w3.org/2000/svg"><g id="g"><path d="M0,0" fill="black"></path></g><path d="M0,0" fill="black"></path><use href="#g" fill="red"></use></svg>`,
+			expected: `<svg xmlns="http://www.w3.org/2000/svg"><g id="g"><path d="M0,0" fill="black"></path></g><path d="M0,0"></path><use fill="red" href="#g"></use></svg>`,
+		},
+		{
+			name:     "remove_default_attrs_geometry",
+			pass:     optimize.RemoveDefaultAttrs{},
+			input:    `<svg xmlns="http://www.w3.org/2000/svg"><circle cx="0" cy="0px" r="0"></circle><text x="0"></text></svg>`,
+			expected: `<svg xmlns="http://www.w3.org/2000/svg"><circle r="0"></circle><text x="0"></text></svg>`,
+		},
+		{
+			name:     "collapse_groups",
+			pass:     optimize.CollapseGroups{},
+			input:    `<svg xmlns="http://www.w3.org/2000/svg"><g><g><rect width="1"></rect><rect width="2"></rect></g></g></svg>`,
+			expected: `<svg xmlns="http://www.w3.org/2000/svg"><rect width="1"></rect><rect width="2"></rect></svg>`,
+		},
+		{
+			name:     "collapse_groups_move_attrs",
+			pass:     optimize.CollapseGroups{},
+			input:    `<svg xmlns="http://www.w3.org/2000/svg"><g fill="red" stroke="blue" transform="translate(1,2)"><rect stroke="green" transform="scale(2)"></rect></g></svg>`,
+			expected: `<svg xmlns="http://www.w3.org/2000/svg"><rect fill="red" stroke="green" transform="matrix(2 0 0 2 1 2)"></rect></svg>`,
+		},
+		{
+			name:     "collapse_groups_concatenate_transforms",
+			pass:     optimize.CollapseGroups{},
+			input:    `<svg xmlns="http://www.w3.org/2000/svg"><g transform="rotate(45)"><rect transform="skewX(30)"></rect></g></svg>`,
+			expected: `<svg xmlns="http://www.w3.org/2000/svg"><rect transform="rotate(45) skewX(30)"></rect></svg>`,
+		},
+		{
+			name:     "collapse_groups_identity_transform",
+			pass:     optimize.CollapseGroups{},
+			input:    `<svg xmlns="http://www.w3.org/2000/svg"><g transform="translate(10,20)"><rect transform="translate(-10,-20)"></rect></g></svg>`,
+			expected: `<svg xmlns="http://www.w3.org/2000/svg"><rect></rect></svg>`,
+		},
+		{
+			name:     "collapse_groups_switch",
+			pass:     optimize.CollapseGroups{},
+			input:    `<svg xmlns="http://www.w3.org/2000/svg"><switch><g><rect systemLanguage="en"></rect><circle></circle></g><g fill="red"><text systemLanguage="fr"></text></g><g><g><rect></rect></g></g></switch></svg>`,
+			expected: `<svg xmlns="http://www.w3.org/2000/svg"><switch><g><rect systemLanguage="en"></rect><circle></circle></g><g fill="red"><text systemLanguage="fr"></text></g><g><rect></rect></g></switch></svg>`,
+		},
+		{
+			name:     "collapse_groups_keep",
+			pass:     optimize.CollapseGroups{},
+			input:    `<svg xmlns="http://www.w3.org/2000/svg"><g id="a"><rect></rect></g><g opacity="0.5"><rect></rect></g><g fill="red"><rect></rect><rect></rect></g></svg>`,
+			expected: `<svg xmlns="http://www.w3.org/2000/svg"><g id="a"><rect></rect></g><g opacity="0.5"><rect></rect></g><g fill="red"><rect></rect><rect></rect></g></svg>`,
+		},
+		{
+			name:     "merge_paths",
+			pass:     optimize.MergePaths{},
+			input:    `<svg xmlns="http://www.w3.org/2000/svg"><path d="M0,0 h1 v1 z" fill="red"></path><path d="m2,2 h1 v1 z" fill="red"></path><path d="M4,4 h1 v1 z" fill="blue"></path></svg>`,
+			expected: `<svg xmlns="http://www.w3.org/2000/svg"><path d="M0,0 h1 v1 z M2,2 H3 V3 Z" fill="red"></path><path d="M4,4 h1 v1 z" fill="blue"></path></svg>`,
+		},
+		{
+			name:     "merge_paths_overlapping",
+			pass:     optimize.MergePaths{},
+			input:    `<svg xmlns="http://www.w3.org/2000/svg"><path d="M0,0 h2 v2 z"></path><path d="M1,1 h2 v2 z"></path></svg>`,
+			expected: `<svg xmlns="http://www.w3.org/2000/svg"><path d="M0,0 h2 v2 z"></path><path d="M1,1 h2 v2 z"></path></svg>`,
+		},
+		{
+			name:     "merge_paths_stroked",
+			pass:     optimize.MergePaths{},
+			input:    `<svg xmlns="http://www.w3.org/2000/svg"><path d="M0,0 L2,2" fill="none" stroke="red"></path><path d="M0,2 L2,0" fill="none" stroke="red"></path></svg>`,
+			expected: `<svg xmlns="http://www.w3.org/2000/svg"><path d="M0,0 L2,2 M0,2 L2,0" fill="none" stroke="red"></path></svg>`,
+		},
+		{
+			name:     "merge_paths_transparent",
+			pass:     optimize.MergePaths{},
+			input:    `<svg xmlns="http://www.w3.org/2000/svg"><g stroke-opacity="0.5"><path d="M0,0 L2,2" fill="none" stroke="red"></path><path d="M0,2 L2,0" fill="none" stroke="red"></path></g></svg>`,
+			expected: `<svg xmlns="http://www.w3.org/2000/svg"><g stroke-opacity="0.5"><path d="M0,0 L2,2" fill="none" stroke="red"></path><path d="M0,2 L2,0" fill="none" stroke="red"></path></g></svg>`,
+		},
+		{
+			name:     "remove_empty_containers",
+			pass:     optimize.RemoveEmptyContainers{},
+			input:    `<svg xmlns="http://www.w3.org/2000/svg"><g><g fill="red"></g><defs></defs></g><g id="a"></g><rect></rect></svg>`,
+			expected: `<svg xmlns="http://www.w3.org/2000/svg"><g id="a"></g><rect></rect></svg>`,
+		},
+		{
+			name:     "remove_unused_defs",
+			pass:     optimize.RemoveUnusedDefs{},
+			input:    `<svg xmlns="http://www.w3.org/2000/svg"><defs><linearGradient id="a" href="#b"></linearGradient><linearGradient id="b"></linearGradient><linearGradient id="c"></linearGradient><linearGradient id="d" href="#e"></linearGradient><linearGradient id="e"></linearGradient><style>.f { fill: url(#c) }</style></defs><rect fill="url(#a)"></rect></svg>`,
+			expected: `<svg xmlns="http://www.w3.org/2000/svg"><defs><linearGradient href="#b" id="a"></linearGradient><linearGradient id="b"></linearGradient><linearGradient id="c"></linearGradient><style>.f { fill: url(#c) }</style></defs><rect fill="url(#a)"></rect></svg>`,
+		},
+		{
+			name:     "shorten_path_data",
+			pass:     optimize.ShortenPathData{},
+			input:    `<svg xmlns="http://www.w3.org/2000/svg"><path d="M 100 100 L 200 100"></path><path d="M1,1 l1,1"></path></svg>`,
+			expected: `<svg xmlns="http://www.w3.org/2000/svg"><path d="M100 100l100 0"></path><path d="M1 1l1 1"></path></svg>`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			root, err := svg.Parse(strings.NewReader(tc.input))
+			assert.NoError(t, err)
+			assert.NoError(t, tc.pass.Apply(root))
+			assert.Equal(t, tc.expected, root.String())
+		})
+	}
+}
+
+func TestOptimize(t *testing.T) {
+	root, err := svg.Parse(strings.NewReader(`<svg xmlns="http://www.w3.org/2000/svg">` +
+		`<defs><linearGradient id="unused"></linearGradient></defs>` +
+		`<g><g fill="red" stroke="none">` +
+		`<path d="M10,10 l-10,0 l0,-10 z"></path>` +
+		`<path d="M 12 12 L 13 12 L 13 13 Z"></path>` +
+		`</g></g>` +
+		`</svg>`))
+	assert.NoError(t, err)
+	before := len(root.String())
+
+	report, err := optimize.Optimize(root)
+	assert.NoError(t, err)
+	assert.Equal(t, `<svg xmlns="http://www.w3.org/2000/svg"><g fill="red"><path d="M10 10 0 10 0 0zm2 2 1 0 0 1Z"></path></g></svg>`, root.String())
+	assert.Equal(t, before, report.BytesBefore)
+	assert.Equal(t, len(root.String()), report.BytesAfter)
+	assert.Equal(t, report.BytesBefore-report.BytesAfter, report.BytesSaved())
+	names := make([]string, 0, len(report.Passes))
+	totalBytesSaved := 0
+	for _, passResult := range report.Passes {
+		names = append(names, passResult.Name)
+		totalBytesSaved += passResult.BytesSaved
+	}
+	assert.Equal(t, []string{
+		"removeDefaultAttrs",
+		"removeUnusedDefs",
+		"removeEmptyContainers",
+		"collapseGroups",
+		"mergePaths",
+		"shortenPathData",
+	}, names)
+	assert.Equal(t, report.BytesSaved(), totalBytesSaved)
+	for _, passResult := range report.Passes {
+		assert.True(t, passResult.BytesSaved > 0, passResult.Name)
+	}
+}
+
+type removeTitles struct{}
+
+func (removeTitles) Name() string { return "removeTitles" }
+
+func (removeTitles) Apply(root *svg.SVGElement) error {
+	children := root.Children[:0]
+	for _, child := range root.Children {
+		if _, ok := child.(*svg.TitleElement); !ok {
+			children = append(children, child)
+		}
+	}
+	root.Children = children
+	return nil
+}
+
+type failingPass struct{}
+
+func (failingPass) Name() string { return "failing" }
+
+func (failingPass) Apply(*svg.SVGElement) error { return errors.New("error") }
+
+func TestOptimizeCustomPass(t *testing.T) {
+	root := svg.New().AppendChildren(
+		svg.Title(svg.CharData("Title")),
+		svg.Rect().Fill("black"),
+	)
+	report, err := optimize.Optimize(root, removeTitles{}, optimize.RemoveDefaultAttrs{})
+	assert.NoError(t, err)
+	assert.Equal(t, []optimize.PassResult{
+		{Name: "removeTitles", BytesSaved: len("<title>Title</title>")},
+		{Name: "removeDefaultAttrs", BytesSaved: len(` fill="black"`)},
+	}, report.Passes)
+
+	_, err = optimize.Optimize(root, failingPass{})
+	assert.EqualError(t, err, "failing: error")
+}
@@ -0,0 +1,167 @@
+package optimize
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/twpayne/go-svg"
+	"github.com/twpayne/go-svg/svgpath"
+)
+
+// unmergeableAttrs are attributes whose effect depends on the individual path.
+var unmergeableAttrs = map[string]bool{
+	"class":        true,
+	"clip-path":    true,
+	"filter":       true,
+	"id":           true,
+	"marker-end":   true,
+	"marker-mid":   true,
+	"marker-start": true,
+	"mask":         true,
+	"pathLength":   true,
+	"style":        true,
+}
+
+// opacityAttrs are attributes that make overlapping paths render differently
+// from a single path.
+var opacityAttrs = []string{
+	"fill-opacity",
+	"opacity",
+	"stroke-opacity",
+}
+
+// MergePaths is a Pass that merges adjacent path elements that have the same
+// attributes into a single path element. Filled paths are only merged if their
+// bounding boxes do not overlap, so that fill rules give the same result.
+//
+// Paths are not merged if the document contains a style sheet.
+type MergePaths struct{}
+
+// Name implements Pass.Name.
+func (MergePaths) Name() string {
+	return "mergePaths"
+}
+
+// Apply implements Pass.Apply.
+func (MergePaths) Apply(root *svg.SVGElement) error {
+	if hasStyleSheet(root) {
+		return nil
+	}
+	mergePaths(root, false)
+	return nil
+}
+
+// mergePaths merges the paths in element's descendants. If transparent is true
+// then an ancestor sets an inherited opacity or might be styled.
+func mergePaths(element svg.Element, transparent bool) {
+	container, ok := element.(svg.Container)
+	if !ok {
+		return
+	}
+	if attrs := container.Attributes(); attrs != nil {
+		transparent = transparent || !isOpaque(attrs)
+	}
+	children := container.ChildElements()
+
+	newChildren := make([]svg.Element, 0, len(children))
+	var prev *svg.PathElement
+	var prevPath *svgpath.Path
+	var prevBBox svgpath.BBox
+	var prevMerged bool
+	for _, child := range children {
+		mergePaths(child, transparent)
+		pathElement, ok := child.(*svg.PathElement)
+		if !ok || transparent || !isMergeable(pathElement) {
+			newChildren = append(newChildren, child)
+			prev = nil
+			continue
+		}
+		path, ok := pathData(pathElement)
+		if !ok {
+			newChildren = append(newChildren, child)
+			prev = nil
+			continue
+		}
+		bbox := path.BBox()
+		if prev != nil && attrsEqualExceptD(prev.Attrs, pathElement.Attrs) &&
+			(!isFilled(prev.Attrs) || !bboxesOverlap(prevBBox, bbox)) {
+			if !prevMerged {
+				prevPath = svgpath.New().AppendSegments(slices.Collect(prevPath.Segments())...)
+				prevMerged = true
+			}
+			prevPath.AppendSegments(slices.Collect(path.Abs().Segments())...)
+			prev.Attrs["d"] = prevPath
+			prevBBox = prevBBox.Union(bbox)
+			continue
+		}
+		newChildren = append(newChildren, child)
+		prev, prevPath, prevBBox, prevMerged = pathElement, path, bbox, false
+	}
+	container.SetChildElements(newChildren)
+}
+
+// attrsEqualExceptD returns whether attrs1 and attrs2 are equal, ignoring the
+// d attribute.
+func attrsEqualExceptD(attrs1, attrs2 map[string]svg.AttrValue) bool {
+	return maps.EqualFunc(withoutD(attrs1), withoutD(attrs2), func(value1, value2 svg.AttrValue) bool {
+		return value1.String() == value2.String()
+	})
+}
+
+// bboxesOverlap returns whether bbox1 and bbox2 overlap.
+func bboxesOverlap(bbox1, bbox2 svgpath.BBox) bool {
+	return bbox1.MinX <= bbox2.MaxX && bbox2.MinX <= bbox1.MaxX &&
+		bbox1.MinY <= bbox2.MaxY && bbox2.MinY <= bbox1.MaxY
+}
+
+// isFilled returns whether a path with attrs might be filled.
+func isFilled(attrs map[string]svg.AttrValue) bool {
+	fill, ok := attrs["fill"]
+	return !ok || fill.String() != "none"
+}
+
+// isMergeable returns whether pathElement can be merged with other paths.
+func isMergeable(pathElement *svg.PathElement) bool {
+	for name, value := range pathElement.Attrs {
+		if unmergeableAttrs[name] || strings.Contains(value.String(), "url(") {
+			return false
+		}
+	}
+	return isOpaque(pathElement.Attrs)
+}
+
+// isOpaque returns whether attrs sets no opacity and cannot be styled.
+func isOpaque(attrs map[string]svg.AttrValue) bool {
+	for _, name := range opacityAttrs {
+		if value, ok := attrs[name]; ok && value.String() != "1" {
+			return false
+		}
+	}
+	_, hasClass := attrs["class"]
+	_, hasStyle := attrs["style"]
+	return !hasClass && !hasStyle
+}
+
+// pathData returns the parsed d attribute of pathElement.
+func pathData(pathElement *svg.PathElement) (*svgpath.Path, bool) {
+	switch d := pathElement.Attrs["d"].(type) {
+	case *svgpath.Path:
+		return d, d != nil
+	case nil:
+		return nil, false
+	default:
+		path, err := svgpath.Parse(d.String())
+		if err != nil {
+			return nil, false
+		}
+		return path, true
+	}
+}
+
+// withoutD returns attrs without the d attribute.
+func withoutD(attrs map[string]svg.AttrValue) map[string]svg.AttrValue {
+	result := maps.Clone(attrs)
+	delete(result, "d")
+	return result
+}
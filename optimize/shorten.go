@@ -0,0 +1,36 @@
+package optimize
+
+import (
+	"slices"
+
+	"github.com/twpayne/go-svg"
+	"github.com/twpayne/go-svg/svgpath"
+)
+
+// ShortenPathData is a Pass that rewrites the d attributes of path elements in
+// compact form, without loss of precision. See svgpath.Path.Compact.
+type ShortenPathData struct{}
+
+// Name implements Pass.Name.
+func (ShortenPathData) Name() string {
+	return "shortenPathData"
+}
+
+// Apply implements Pass.Apply.
+func (ShortenPathData) Apply(root *svg.SVGElement) error {
+	for pathElement := range svg.OfType[*svg.PathElement](svg.Walk(root)) {
+		d, ok := pathElement.Attrs["d"]
+		if !ok {
+			continue
+		}
+		path, ok := pathData(pathElement)
+		if !ok {
+			continue
+		}
+		compact := svgpath.New().AppendSegments(slices.Collect(path.Segments())...).Compact(-1)
+		if len(compact.String()) < len(d.String()) {
+			pathElement.Attrs["d"] = compact
+		}
+	}
+	return nil
+}
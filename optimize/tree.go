@@ -0,0 +1,104 @@
+package optimize
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/twpayne/go-svg"
+)
+
+// inheritedProperties are the presentation attributes whose values are
+// inherited by child elements.
+var inheritedProperties = map[string]bool{
+	"clip-rule":                   true,
+	"color":                       true,
+	"color-interpolation":         true,
+	"color-interpolation-filters": true,
+	"color-rendering":             true,
+	"cursor":                      true,
+	"direction":                   true,
+	"dominant-baseline":           true,
+	"fill":                        true,
+	"fill-opacity":                true,
+	"fill-rule":                   true,
+	"font-family":                 true,
+	"font-size":                   true,
+	"font-size-adjust":            true,
+	"font-stretch":                true,
+	"font-style":                  true,
+	"font-variant":                true,
+	"font-weight":                 true,
+	"image-rendering":             true,
+	"letter-spacing":              true,
+	"marker-end":                  true,
+	"marker-mid":                  true,
+	"marker-start":                true,
+	"paint-order":                 true,
+	"pointer-events":              true,
+	"shape-rendering":             true,
+	"stroke":                      true,
+	"stroke-dasharray":            true,
+	"stroke-dashoffset":           true,
+	"stroke-linecap":              true,
+	"stroke-linejoin":             true,
+	"stroke-miterlimit":           true,
+	"stroke-opacity":              true,
+	"stroke-width":                true,
+	"text-anchor":                 true,
+	"text-rendering":              true,
+	"visibility":                  true,
+	"word-spacing":                true,
+	"writing-mode":                true,
+}
+
+// urlReferenceRx matches references to elements in url() functions.
+var urlReferenceRx = regexp.MustCompile(`url\(\s*['"]?#([^)'"\s]+)`)
+
+// hasStyleSheet returns whether the tree rooted at root contains a style
+// element. Style sheets can select elements by their structure, so passes
+// that change the structure of the tree are not safe.
+func hasStyleSheet(root svg.Element) bool {
+	for element := range svg.Walk(root) {
+		if _, ok := element.(*svg.StyleElement); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// isAnimation returns whether element is an animation element.
+func isAnimation(element svg.Element) bool {
+	switch element.(type) {
+	case *svg.AnimateElement, *svg.AnimateMotionElement, *svg.AnimateTransformElement, *svg.SetElement:
+		return true
+	default:
+		return false
+	}
+}
+
+// references returns the ids of all elements referenced in the tree rooted at
+// root.
+func references(root svg.Element) map[string]bool {
+	ids := make(map[string]bool)
+	for element := range svg.Walk(root) {
+		switch element := element.(type) {
+		case svg.CharData:
+			for _, match := range urlReferenceRx.FindAllSubmatch(element, -1) {
+				ids[string(match[1])] = true
+			}
+		case svg.Node:
+			for name, value := range element.Attributes() {
+				valueStr := value.String()
+				if name == "href" || strings.HasSuffix(name, ":href") {
+					if id, ok := strings.CutPrefix(valueStr, "#"); ok {
+						ids[id] = true
+					}
+				}
+				for _, match := range urlReferenceRx.FindAllStringSubmatch(valueStr, -1) {
+					ids[match[1]] = true
+				}
+			}
+		}
+	}
+	return ids
+}
@@ -0,0 +1,134 @@
+package svg
+
+import (
+	"errors"
+	"fmt"
+)
+
+// A paintKind is a kind of paint.
+type paintKind int
+
+// paintKinds.
+const (
+	paintKindNone paintKind = iota
+	paintKindColor
+	paintKindContextFill
+	paintKindContextStroke
+	paintKindElement
+)
+
+// A Paint is a fill or stroke attribute value. The zero value of Paint is
+// none.
+type Paint struct {
+	kind        paintKind
+	color       Color
+	element     Element
+	fallback    Color
+	hasFallback bool
+}
+
+// Paints.
+var (
+	NoPaint       = Paint{}
+	ContextFill   = Paint{kind: paintKindContextFill}
+	ContextStroke = Paint{kind: paintKindContextStroke}
+)
+
+// ColorPaint returns a Paint with color c.
+func ColorPaint(c Color) Paint {
+	return Paint{
+		kind:  paintKindColor,
+		color: c,
+	}
+}
+
+// ElementPaint returns a Paint that references the paint server element, for
+// example a gradient or a pattern. element must have an id and be part of the
+// same document, otherwise writing the document fails.
+func ElementPaint(element Element) Paint {
+	return Paint{
+		kind:    paintKindElement,
+		element: element,
+	}
+}
+
+// Element returns the element referenced by p, or nil if p does not reference
+// an element.
+func (p Paint) Element() Element {
+	return p.element
+}
+
+// WithFallback returns p with the fallback color fallback, which is used if
+// the element referenced by p cannot be used.
+func (p Paint) WithFallback(fallback Color) Paint {
+	p.fallback = fallback
+	p.hasFallback = true
+	return p
+}
+
+func (p Paint) String() string {
+	switch p.kind {
+	case paintKindColor:
+		return p.color.String()
+	case paintKindContextFill:
+		return "context-fill"
+	case paintKindContextStroke:
+		return "context-stroke"
+	case paintKindElement:
+		s := "url(#" + elementID(p.element) + ")"
+		if p.hasFallback {
+			s += " " + p.fallback.String()
+		}
+		return s
+	default:
+		return "none"
+	}
+}
+
+// checkReferences returns an error if any Paint attribute value in the tree
+// rooted at root references an element without an id or that is not in the
+// tree.
+func checkReferences(root Element) error {
+	elements := make(map[Element]struct{})
+	var paints []Paint
+	var paintAttrNames []string
+	for node := range OfType[Node](Walk(root)) {
+		elements[node] = struct{}{}
+		for name, value := range node.Attributes() {
+			if paint, ok := value.(Paint); ok && paint.kind == paintKindElement {
+				paints = append(paints, paint)
+				paintAttrNames = append(paintAttrNames, name)
+			}
+		}
+	}
+
+	var errs []error
+	for i, paint := range paints {
+		if _, ok := paint.element.(Node); !ok {
+			errs = append(errs, fmt.Errorf("%s: referenced element cannot have an id", paintAttrNames[i]))
+			continue
+		}
+		id := elementID(paint.element)
+		switch _, ok := elements[paint.element]; {
+		case id == "":
+			errs = append(errs, fmt.Errorf("%s: referenced element has no id", paintAttrNames[i]))
+		case !ok:
+			errs = append(errs, fmt.Errorf("%s: %s: referenced element not in document", paintAttrNames[i], paint))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// elementID returns the id of element, or the empty string if element does not
+// have an id.
+func elementID(element Element) string {
+	node, ok := element.(Node)
+	if !ok {
+		return ""
+	}
+	id, ok := node.Attributes()["id"]
+	if !ok {
+		return ""
+	}
+	return id.String()
+}
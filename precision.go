@@ -0,0 +1,60 @@
+package svg
+
+import (
+	"strconv"
+)
+
+// precisionKind is the kind of a Precision.
+type precisionKind int
+
+const (
+	precisionExact precisionKind = iota
+	precisionSignificantDigits
+	precisionDecimalPlaces
+)
+
+// A Precision specifies how numbers in attribute values are rounded when they
+// are written. The zero Precision writes numbers exactly, using the smallest
+// number of digits necessary.
+type Precision struct {
+	kind   precisionKind
+	digits int
+}
+
+// DecimalPlaces returns a Precision that rounds numbers to n decimal places.
+func DecimalPlaces(n int) Precision {
+	return Precision{
+		kind:   precisionDecimalPlaces,
+		digits: n,
+	}
+}
+
+// SignificantDigits returns a Precision that rounds numbers to n significant
+// digits. n must be positive.
+func SignificantDigits(n int) Precision {
+	return Precision{
+		kind:   precisionSignificantDigits,
+		digits: n,
+	}
+}
+
+// FormatFloat formats f rounded to p, without trailing zeros or an exponent.
+// Negative zero, and negative numbers that round to zero, are formatted as
+// "0".
+func (p Precision) FormatFloat(f float64) string {
+	switch p.kind {
+	case precisionSignificantDigits:
+		f, _ = strconv.ParseFloat(strconv.FormatFloat(f, 'g', p.digits, 64), 64)
+	case precisionDecimalPlaces:
+		f, _ = strconv.ParseFloat(strconv.FormatFloat(f, 'f', p.digits, 64), 64)
+	}
+	if f == 0 {
+		f = 0 // Avoid formatting negative zero as "-0".
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// formatFloat formats f exactly.
+func formatFloat(f float64) string {
+	return Precision{}.FormatFloat(f)
+}
@@ -7,12 +7,37 @@
 package svg
 
 import (
+	"bytes"
 	"encoding/xml"
+	"fmt"
 	"io"
-	"sort"
 	"strings"
 )
 
+const (
+	svgNamespace = "http://www.w3.org/2000/svg"
+	xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+)
+
+// wellKnownNamespacePrefixes maps well-known XML namespaces to their
+// conventional prefixes.
+var wellKnownNamespacePrefixes = map[string]string{
+	"http://www.w3.org/1999/xlink":  "xlink",
+	xmlNamespace:                    "xml",
+	"http://www.w3.org/2000/xmlns/": "xmlns",
+}
+
+// textContentElements are the elements whose whitespace character data is
+// significant.
+var textContentElements = map[string]bool{
+	"desc":     true,
+	"style":    true,
+	"text":     true,
+	"textPath": true,
+	"title":    true,
+	"tspan":    true,
+}
+
 // A Comment is a comment.
 type Comment []byte
 
@@ -29,67 +54,320 @@ func (c CharData) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
 	return encoder.EncodeToken(xml.CharData(c))
 }
 
+// A RawElement is an element that is not defined by this package, for example
+// an element in another namespace such as Inkscape's sodipodi:namedview.
+// Parse preserves RawElements so that they can be written unchanged. Name and
+// the attribute names include any namespace prefix.
+type RawElement struct {
+	Name     string
+	Attrs    map[string]AttrValue
+	Children []Element
+}
+
+// MarshalXML implements encoding/xml.Marshaller.MarshalXML.
+func (e *RawElement) MarshalXML(encoder *xml.Encoder, _ xml.StartElement) error {
+	return encodeElement(encoder, e)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.UnmarshalXML.
+func (e *RawElement) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	return newDecoder(decoder).decode(e, start)
+}
+
+// Attributes implements Node.Attributes.
+func (e *RawElement) Attributes() map[string]AttrValue {
+	return e.Attrs
+}
+
+// ChildElements implements Node.ChildElements.
+func (e *RawElement) ChildElements() []Element {
+	return e.Children
+}
+
+// SetChildElements implements Container.SetChildElements.
+func (e *RawElement) SetChildElements(children []Element) {
+	e.Children = children
+}
+
+// TagName implements Node.TagName.
+func (e *RawElement) TagName() string {
+	return e.Name
+}
+
+// decode decodes e from d.
+func (e *RawElement) decode(d *decoder, start xml.StartElement) error {
+	name, err := d.elementName(start.Name)
+	if err != nil {
+		return err
+	}
+	attrs, err := d.decodeElement(start, func(_, value string) (AttrValue, error) {
+		return String(value), nil
+	}, &e.Children)
+	if err != nil {
+		return err
+	}
+	e.Name = name
+	e.Attrs = attrs
+	return nil
+}
+
+// Parse parses an SVG document from r. Attribute values that cannot be parsed
+// as their type are kept as Strings, and elements that are not defined by this
+// package are parsed as RawElements, so that documents created by other tools
+// can be written back without loss.
+func Parse(r io.Reader) (*SVGElement, error) {
+	decoder := xml.NewDecoder(r)
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		if startElement, ok := token.(xml.StartElement); ok {
+			if startElement.Name.Local != "svg" {
+				return nil, fmt.Errorf("%s: expected svg element", startElement.Name.Local)
+			}
+			svgElement := &SVGElement{}
+			if err := newDecoder(decoder).decode(svgElement, startElement); err != nil {
+				return nil, err
+			}
+			return svgElement, nil
+		}
+	}
+}
+
 func (e *SVGElement) String() string {
 	var builder strings.Builder
 	_, _ = e.WriteTo(&builder)
 	return builder.String()
 }
 
-// WriteTo implements io.WriterTo.WriteTo. It writes encoding/xml.Header and
-// then e to w.
+// WriteTo implements io.WriterTo.WriteTo. It writes e to w.
 func (e *SVGElement) WriteTo(w io.Writer) (int64, error) {
-	return e.WriteToIndent(w, "", "")
+	return Write(w, e, WriteOptions{})
 }
 
-// WriteToIndent writes encoding/xml.Header and then e to w, indenting with
-// prefix and indent.
+// WriteToIndent writes e to w, indenting with prefix and indent. It returns an
+// error if a Paint attribute value references an element that is not in e.
 func (e *SVGElement) WriteToIndent(w io.Writer, prefix, indent string) (int64, error) {
-	wc := &writeCounter{w: w}
-	encoder := xml.NewEncoder(wc)
-	encoder.Indent(prefix, indent)
-	if err := encoder.Encode(e); err != nil {
-		return int64(wc.bytesWritten), err
-	}
-	return int64(wc.bytesWritten), nil
+	return Write(w, e, WriteOptions{
+		Prefix: prefix,
+		Indent: indent,
+	})
 }
 
 // encodeElement is a helper function to encode a single element with its
 // attributes and children.
-func encodeElement(encoder *xml.Encoder, name string, attrs map[string]AttrValue, children []Element) error {
-	localNames := make([]string, 0, len(attrs))
-	for localName := range attrs {
-		localNames = append(localNames, localName)
-	}
-	sort.Strings(localNames)
-
-	xmlAttrs := make([]xml.Attr, 0, len(attrs))
-	for _, localName := range localNames {
-		value := attrs[localName].String()
-		if value == "" {
-			continue
-		}
-		xmlAttr := xml.Attr{
-			Name:  xml.Name{Local: localName},
-			Value: value,
-		}
-		xmlAttrs = append(xmlAttrs, xmlAttr)
+func encodeElement(encoder *xml.Encoder, e Node) error {
+	xmlAttrs, err := formatAttrs(e, WriteOptions{})
+	if err != nil {
+		return err
 	}
-
 	startElement := xml.StartElement{
-		Name: xml.Name{Local: name},
+		Name: xml.Name{Local: e.TagName()},
 		Attr: xmlAttrs,
 	}
 	if err := encoder.EncodeToken(startElement); err != nil {
 		return err
 	}
-	for _, child := range children {
+	for _, child := range e.ChildElements() {
 		if err := child.MarshalXML(encoder, xml.StartElement{}); err != nil {
 			return err
 		}
 	}
-	if err := encoder.EncodeToken(startElement.End()); err != nil {
-		return err
+	return encoder.EncodeToken(startElement.End())
+}
+
+// A decodable is an element that can be decoded.
+type decodable interface {
+	Element
+	decode(d *decoder, start xml.StartElement) error
+}
+
+// A decoder decodes elements, tracking the namespace declarations and
+// xml:space attributes in scope.
+type decoder struct {
+	*xml.Decoder
+	scopes []decoderScope
+}
+
+// A decoderScope contains the namespace declarations and xml:space attribute
+// of an element.
+type decoderScope struct {
+	namespaces    map[string]string
+	preserveSpace bool
+}
+
+// newDecoder returns a new decoder that reads tokens from xmlDecoder.
+func newDecoder(xmlDecoder *xml.Decoder) *decoder {
+	return &decoder{
+		Decoder: xmlDecoder,
 	}
+}
 
-	return nil
+// decode decodes element, starting with start, with the namespace
+// declarations and xml:space attribute of start in scope.
+func (d *decoder) decode(element decodable, start xml.StartElement) error {
+	scope := decoderScope{}
+	if len(d.scopes) > 0 {
+		scope.preserveSpace = d.scopes[len(d.scopes)-1].preserveSpace
+	}
+	for _, xmlAttr := range start.Attr {
+		switch {
+		case xmlAttr.Name.Space == "xmlns":
+			if scope.namespaces == nil {
+				scope.namespaces = make(map[string]string)
+			}
+			scope.namespaces[xmlAttr.Name.Local] = xmlAttr.Value
+		case xmlAttr.Name.Space == "" && xmlAttr.Name.Local == "xmlns":
+			if scope.namespaces == nil {
+				scope.namespaces = make(map[string]string)
+			}
+			scope.namespaces[""] = xmlAttr.Value
+		case xmlAttr.Name.Space == xmlNamespace && xmlAttr.Name.Local == "space":
+			scope.preserveSpace = xmlAttr.Value == "preserve"
+		}
+	}
+	d.scopes = append(d.scopes, scope)
+	defer func() {
+		d.scopes = d.scopes[:len(d.scopes)-1]
+	}()
+	return element.decode(d, start)
+}
+
+// decodeElement is a helper function to decode a single element's attributes
+// and children. Attribute values are parsed with parseAttr, or kept as Strings
+// if they cannot be parsed. If children is nil then the element's children
+// are skipped.
+func (d *decoder) decodeElement(start xml.StartElement, parseAttr func(name, value string) (AttrValue, error), children *[]Element) (map[string]AttrValue, error) {
+	attrs := make(map[string]AttrValue, len(start.Attr))
+	for _, xmlAttr := range start.Attr {
+		name, err := d.attrName(xmlAttr.Name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", start.Name.Local, err)
+		}
+		value, err := parseAttr(name, xmlAttr.Value)
+		if err != nil {
+			value = String(xmlAttr.Value)
+		}
+		attrs[name] = value
+	}
+
+	preserveSpace := d.scopes[len(d.scopes)-1].preserveSpace ||
+		(start.Name.Space == "" || start.Name.Space == svgNamespace) && textContentElements[start.Name.Local]
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			if children == nil {
+				if err := d.Skip(); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			child, err := d.decodeChildElement(token)
+			if err != nil {
+				return nil, err
+			}
+			*children = append(*children, child)
+		case xml.EndElement:
+			return attrs, nil
+		case xml.CharData:
+			if children == nil || len(bytes.TrimSpace(token)) == 0 && !preserveSpace {
+				continue
+			}
+			*children = append(*children, CharData(bytes.Clone(token)))
+		case xml.Comment:
+			if children != nil {
+				*children = append(*children, Comment(bytes.Clone(token)))
+			}
+		}
+	}
+}
+
+// decodeChildElement decodes the child element starting with start. Elements
+// that are not defined by this package, including elements in other
+// namespaces, are decoded as RawElements.
+func (d *decoder) decodeChildElement(start xml.StartElement) (Element, error) {
+	var element decodable
+	if start.Name.Space == "" || start.Name.Space == svgNamespace {
+		element = newElement(start.Name.Local)
+	}
+	if element == nil {
+		element = &RawElement{}
+	}
+	if err := d.decode(element, start); err != nil {
+		return nil, err
+	}
+	return element, nil
+}
+
+// attrName returns the attribute name of name, restoring the prefix of its
+// namespace.
+func (d *decoder) attrName(name xml.Name) (string, error) {
+	switch name.Space {
+	case "":
+		return name.Local, nil
+	case "xmlns":
+		return "xmlns:" + name.Local, nil
+	}
+	prefix, err := d.prefix(name.Space, false)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", name.Local, err)
+	}
+	return prefix + ":" + name.Local, nil
+}
+
+// elementName returns the element name of name, restoring the prefix of its
+// namespace.
+func (d *decoder) elementName(name xml.Name) (string, error) {
+	if name.Space == "" {
+		return name.Local, nil
+	}
+	prefix, err := d.prefix(name.Space, true)
+	switch {
+	case err != nil:
+		return "", fmt.Errorf("%s: %w", name.Local, err)
+	case prefix == "":
+		return name.Local, nil
+	default:
+		return prefix + ":" + name.Local, nil
+	}
+}
+
+// prefix returns the prefix of the namespace space in scope. If allowDefault is
+// true then the empty prefix of the default namespace may be returned. If the
+// namespace is not declared then the well-known prefix is returned. If space
+// is not a namespace name then it is an undeclared prefix, which is returned
+// unchanged.
+func (d *decoder) prefix(space string, allowDefault bool) (string, error) {
+	for i := len(d.scopes) - 1; i >= 0; i-- {
+		for prefix, namespace := range d.scopes[i].namespaces {
+			if namespace != space || prefix == "" && !allowDefault {
+				continue
+			}
+			if d.namespace(prefix) == space {
+				return prefix, nil
+			}
+		}
+	}
+	if prefix, ok := wellKnownNamespacePrefixes[space]; ok {
+		return prefix, nil
+	}
+	if !strings.Contains(space, ":") {
+		return space, nil
+	}
+	return "", fmt.Errorf("%s: undeclared namespace", space)
+}
+
+// namespace returns the namespace of prefix in scope.
+func (d *decoder) namespace(prefix string) string {
+	for i := len(d.scopes) - 1; i >= 0; i-- {
+		if namespace, ok := d.scopes[i].namespaces[prefix]; ok {
+			return namespace
+		}
+	}
+	return ""
 }
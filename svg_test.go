@@ -2,20 +2,27 @@ package svg_test
 
 import (
 	"bytes"
-	"cmp"
-	"encoding/xml"
-	"errors"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"html/template"
+	"image/color"
 	"io"
+	"iter"
+	"math"
+	"net/url"
 	"os"
 	"path/filepath"
 	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/alecthomas/assert/v2"
 
 	"github.com/twpayne/go-svg"
 	"github.com/twpayne/go-svg/svgpath"
+	"github.com/twpayne/go-svg/svgtest"
 )
 
 func TestSimple(t *testing.T) {
@@ -303,6 +310,35 @@ func TestSimple(t *testing.T) {
 				).Fill("none").Stroke("black").MarkerStart("url(#m1)").MarkerMid("url(#m2)").MarkerEnd("url(#m3)"),
 			),
 		},
+		{
+			name: "lingrad01", // 14.2.2
+			svg: func() *svg.SVGElement {
+				myGradient := svg.LinearGradientFromStops(
+					svg.GradientStop{Offset: 0.05, Color: svg.RGB(0xff, 0x66, 0)},
+					svg.GradientStop{Offset: 0.95, Color: svg.RGB(0xff, 0xff, 0x66)},
+				).ID("MyGradient")
+				return svg.New().WidthHeight(8, 4, svg.CM).ViewBox(0, 0, 800, 400).AppendChildren(
+					svg.Desc(svg.CharData("Example lingrad01 - fill a rectangle using a linear gradient paint server")),
+					svg.G(
+						svg.Defs(myGradient),
+						svg.Rect().FillPaint(svg.ElementPaint(myGradient)).Stroke("black").StrokeWidth(svg.Number(5)).XYWidthHeight(100, 100, 600, 200, svg.Number),
+					),
+				)
+			}(),
+		},
+		{
+			name: "filterPresets",
+			svg: svg.New().WidthHeight(300, 100, svg.Number).AppendChildren(
+				svg.Defs(
+					svg.DropShadow(2, 3, 1.5, svg.RGB(0, 0, 0), 0.5).ID("shadow"),
+					svg.Glow(4, svg.RGB(0xff, 0xd7, 0), 1).ID("glow"),
+					svg.Grayscale().ID("grayscale"),
+				),
+				svg.Rect().XYWidthHeight(10, 10, 80, 80, svg.Number).Fill("red").Filter("url(#shadow)"),
+				svg.Rect().XYWidthHeight(110, 10, 80, 80, svg.Number).Fill("red").Filter("url(#glow)"),
+				svg.Rect().XYWidthHeight(210, 10, 80, 80, svg.Number).Fill("red").Filter("url(#grayscale)"),
+			),
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			var buffer bytes.Buffer
@@ -310,55 +346,1539 @@ func TestSimple(t *testing.T) {
 			assert.NoError(t, err)
 			actual := buffer.Bytes()
 			filename := filepath.Join("testdata", tc.name+".svg")
-			if os.Getenv("GO_SVG_WRITE_TESTDATA") == "1" {
+			if svgtest.Update() {
 				assert.NoError(t, os.WriteFile(filename, actual, 0o666)) //nolint:gosec
 			} else {
 				expected, err := os.ReadFile(filename)
 				assert.NoError(t, err)
-				assertEquivalentXML(t, expected, actual)
+				svgtest.AssertEquivalent(t, expected, actual, svgtest.WithExactValues())
 				assert.Equal(t, len(expected), int(n))
 			}
 		})
 	}
 }
 
-// assertEquivalentXML asserts that expectedBytes and actualBytes are equivalent
-// XML documents.
-func assertEquivalentXML(t *testing.T, expectedBytes, actualBytes []byte) {
-	t.Helper()
-	expectedTokens, err := normalizedXMLTokens(expectedBytes)
-	assert.NoError(t, err)
-	actualTokens, err := normalizedXMLTokens(actualBytes)
-	assert.NoError(t, err)
-	assert.Equal(t, expectedTokens, actualTokens)
-}
-
-// normalizedXMLTokens returns all the XML tokens in data, with attributes
-// sorted in order and [xml.CharData]s converted to strings.
-func normalizedXMLTokens(data []byte) ([]any, error) {
-	var tokens []any
-	decoder := xml.NewDecoder(bytes.NewReader(data))
-	for {
-		switch token, err := decoder.Token(); {
-		case errors.Is(err, io.EOF):
-			return tokens, nil
-		case err != nil:
-			return nil, err
-		default:
-			token = xml.CopyToken(token)
-			if charData, ok := token.(xml.CharData); ok {
-				token = string(bytes.TrimSpace(charData))
-			} else if startElement, ok := token.(xml.StartElement); ok {
-				slices.SortFunc(startElement.Attr, func(a, b xml.Attr) int {
-					return cmp.Or(
-						cmp.Compare(a.Name.Space, b.Name.Space),
-						cmp.Compare(a.Name.Local, b.Name.Local),
-						cmp.Compare(a.Value, b.Value),
-					)
-				})
-				token = startElement
+func TestParse(t *testing.T) {
+	filenames, err := filepath.Glob("testdata/*.svg")
+	assert.NoError(t, err)
+	for _, filename := range filenames {
+		t.Run(filepath.Base(filename), func(t *testing.T) {
+			expected, err := os.ReadFile(filename)
+			assert.NoError(t, err)
+			svgElement, err := svg.Parse(bytes.NewReader(expected))
+			assert.NoError(t, err)
+			var buffer bytes.Buffer
+			_, err = svgElement.WriteToIndent(&buffer, "", "  ")
+			assert.NoError(t, err)
+			svgtest.AssertEquivalent(t, expected, buffer.Bytes())
+		})
+	}
+}
+
+func TestParseAttrValues(t *testing.T) {
+	svgElement, err := svg.Parse(strings.NewReader(`<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" viewBox="0 0 1200,400" width="12cm">` +
+		`<rect x="1" y="1.5em" width="50%" tabindex="2" opacity="0.5"/>` +
+		`<polygon points="350,75 379,161 469,161"/>` +
+		`<use xlink:href="#MyPath"/>` +
+		`<path d="M0 0L10 10z"/>` +
+		`</svg>`))
+	assert.NoError(t, err)
+	assert.Equal(t, svg.AttrValue(svg.ViewBox{MinX: 0, MinY: 0, Width: 1200, Height: 400}), svgElement.Attrs["viewBox"])
+	assert.Equal(t, svg.AttrValue(svg.CM(12)), svgElement.Attrs["width"])
+	assert.Equal(t, svg.AttrValue(svg.String("http://www.w3.org/1999/xlink")), svgElement.Attrs["xmlns:xlink"])
+	assert.Equal(t, 4, len(svgElement.Children))
+
+	rectElement, ok := svgElement.Children[0].(*svg.RectElement)
+	assert.True(t, ok)
+	assert.Equal(t, map[string]svg.AttrValue{
+		"x":        svg.Number(1),
+		"y":        svg.Ems(1.5),
+		"width":    svg.Percent(50),
+		"tabindex": svg.Int(2),
+		"opacity":  svg.Float64(0.5),
+	}, rectElement.Attrs)
+
+	polygonElement, ok := svgElement.Children[1].(*svg.PolygonElement)
+	assert.True(t, ok)
+	assert.Equal(t, svg.AttrValue(svg.Points{{350, 75}, {379, 161}, {469, 161}}), polygonElement.Attrs["points"])
+
+	useElement, ok := svgElement.Children[2].(*svg.UseElement)
+	assert.True(t, ok)
+	assert.Equal(t, svg.AttrValue(svg.String("#MyPath")), useElement.Attrs["xlink:href"])
+
+	pathElement, ok := svgElement.Children[3].(*svg.PathElement)
+	assert.True(t, ok)
+	path, ok := pathElement.Attrs["d"].(*svgpath.Path)
+	assert.True(t, ok)
+	assert.Equal(t, "M0,0 L10,10 z", path.String())
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		data string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "not_svg",
+			data: `<html></html>`,
+		},
+		{
+			name: "unterminated",
+			data: `<svg><g>`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := svg.Parse(strings.NewReader(tc.data))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseUnparsed(t *testing.T) {
+	svgElement, err := svg.Parse(strings.NewReader(`<svg viewBox="0 0 100">` +
+		`<rect x="1furlong" width="auto" opacity="50%" fill-opacity="inherit" color="inherit"/>` +
+		`<polyline points="0,0 1"/>` +
+		`<stop stop-color="inherit"/>` +
+		`</svg>`))
+	assert.NoError(t, err)
+	assert.Equal(t, svg.AttrValue(svg.String("0 0 100")), svgElement.Attrs["viewBox"])
+	rectElement, ok := svgElement.Children[0].(*svg.RectElement)
+	assert.True(t, ok)
+	assert.Equal(t, map[string]svg.AttrValue{
+		"x":            svg.String("1furlong"),
+		"width":        svg.String("auto"),
+		"opacity":      svg.String("50%"),
+		"fill-opacity": svg.String("inherit"),
+		"color":        svg.String("inherit"),
+	}, rectElement.Attrs)
+	polylineElement, ok := svgElement.Children[1].(*svg.PolylineElement)
+	assert.True(t, ok)
+	assert.Equal(t, svg.AttrValue(svg.String("0,0 1")), polylineElement.Attrs["points"])
+	stopElement, ok := svgElement.Children[2].(*svg.StopElement)
+	assert.True(t, ok)
+	assert.Equal(t, svg.AttrValue(svg.String("inherit")), stopElement.Attrs["stop-color"])
+}
+
+func TestParseShapeChildren(t *testing.T) {
+	data := `<svg xmlns="http://www.w3.org/2000/svg">` +
+		`<path d="M0,0"><title>hi</title><animate attributeName="opacity"></animate></path>` +
+		`<image href="image.png"><desc>An image</desc></image>` +
+		`</svg>`
+	svgElement, err := svg.Parse(strings.NewReader(data))
+	assert.NoError(t, err)
+
+	pathElement, ok := svgElement.Children[0].(*svg.PathElement)
+	assert.True(t, ok)
+	assert.Equal(t, 2, len(pathElement.Children))
+	_, ok = pathElement.Children[0].(*svg.TitleElement)
+	assert.True(t, ok)
+	_, ok = pathElement.Children[1].(*svg.AnimateElement)
+	assert.True(t, ok)
+
+	imageElement, ok := svgElement.Children[1].(*svg.ImageElement)
+	assert.True(t, ok)
+	_, ok = imageElement.Children[0].(*svg.DescElement)
+	assert.True(t, ok)
+
+	var buffer bytes.Buffer
+	_, err = svgElement.WriteTo(&buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, data, buffer.String())
+}
+
+func TestParseRawElements(t *testing.T) {
+	data := `<svg xmlns="http://www.w3.org/2000/svg" xmlns:inkscape="http://www.inkscape.org/namespaces/inkscape" xmlns:sodipodi="http://sodipodi.sourceforge.net/DTD/sodipodi-0.dtd">` +
+		`<sodipodi:namedview id="namedview" inkscape:zoom="0.5"></sodipodi:namedview>` +
+		`<metadata><rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#"><rdf:Description rdf:about="">Drawing</rdf:Description></rdf:RDF></metadata>` +
+		`<blink></blink>` +
+		`<foo:bar></foo:bar>` +
+		`<g id="layer1" inkscape:label="Layer 1"></g>` +
+		`<other xmlns="https://example.com/other"><child></child></other>` +
+		`</svg>`
+	svgElement, err := svg.Parse(strings.NewReader(data))
+	assert.NoError(t, err)
+
+	namedViewElement, ok := svgElement.Children[0].(*svg.RawElement)
+	assert.True(t, ok)
+	assert.Equal(t, "sodipodi:namedview", namedViewElement.TagName())
+	assert.Equal(t, map[string]svg.AttrValue{
+		"id":            svg.String("namedview"),
+		"inkscape:zoom": svg.String("0.5"),
+	}, namedViewElement.Attrs)
+
+	metadataElement, ok := svgElement.Children[1].(*svg.MetadataElement)
+	assert.True(t, ok)
+	rdfElement, ok := metadataElement.Children[0].(*svg.RawElement)
+	assert.True(t, ok)
+	assert.Equal(t, "rdf:RDF", rdfElement.Name)
+
+	blinkElement, ok := svgElement.Children[2].(*svg.RawElement)
+	assert.True(t, ok)
+	assert.Equal(t, "blink", blinkElement.Name)
+
+	gElement, ok := svgElement.Children[4].(*svg.GElement)
+	assert.True(t, ok)
+	assert.Equal(t, svg.AttrValue(svg.String("Layer 1")), gElement.Attrs["inkscape:label"])
+
+	var buffer bytes.Buffer
+	_, err = svgElement.WriteTo(&buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, `<svg xmlns="http://www.w3.org/2000/svg" xmlns:inkscape="http://www.inkscape.org/namespaces/inkscape" xmlns:sodipodi="http://sodipodi.sourceforge.net/DTD/sodipodi-0.dtd">`+
+		`<sodipodi:namedview id="namedview" inkscape:zoom="0.5"></sodipodi:namedview>`+
+		`<metadata><rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#"><rdf:Description rdf:about="">Drawing</rdf:Description></rdf:RDF></metadata>`+
+		`<blink></blink>`+
+		`<foo:bar></foo:bar>`+
+		`<g id="layer1" inkscape:label="Layer 1"></g>`+
+		`<other xmlns="https://example.com/other"><child></child></other>`+
+		`</svg>`, buffer.String())
+}
+
+func TestParseWhitespace(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		data     string
+		expected string
+	}{
+		{
+			name:     "between_elements",
+			data:     "<svg>\n  <g>\n    <rect/>\n  </g>\n</svg>",
+			expected: `<g><rect></rect></g>`,
+		},
+		{
+			name:     "text",
+			data:     `<svg><text><tspan>Hello</tspan> <tspan>world</tspan></text></svg>`,
+			expected: `<text><tspan>Hello</tspan> <tspan>world</tspan></text>`,
+		},
+		{
+			name:     "title",
+			data:     `<svg><title> </title></svg>`,
+			expected: `<title> </title>`,
+		},
+		{
+			name:     "xml_space_preserve",
+			data:     `<svg><g xml:space="preserve"><a> <rect/> </a></g></svg>`,
+			expected: `<g xml:space="preserve"><a> <rect></rect> </a></g>`,
+		},
+		{
+			name:     "xml_space_default",
+			data:     `<svg xml:space="preserve"><g xml:space="default"> <rect/> </g></svg>`,
+			expected: `<g xml:space="default"><rect></rect></g>`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			svgElement, err := svg.Parse(strings.NewReader(tc.data))
+			assert.NoError(t, err)
+			var builder strings.Builder
+			for _, child := range svgElement.Children {
+				_, err := svg.Write(&builder, child, svg.WriteOptions{})
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expected, builder.String())
+		})
+	}
+}
+
+func TestBBox(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		element  interface{ BBox() (svgpath.BBox, error) }
+		expected svgpath.BBox
+	}{
+		{
+			name:     "circle",
+			element:  svg.Circle().CXCYR(10, 20, 5, svg.Number),
+			expected: svgpath.BBox{MinX: 5, MinY: 15, MaxX: 15, MaxY: 25},
+		},
+		{
+			name:     "ellipse",
+			element:  svg.Ellipse().CXCY(10, 20, svg.Px).RXRY(5, 2, svg.Number),
+			expected: svgpath.BBox{MinX: 5, MinY: 18, MaxX: 15, MaxY: 22},
+		},
+		{
+			name:     "ellipse_auto_ry",
+			element:  svg.Ellipse().CX(svg.Number(10)).RX(svg.Number(5)),
+			expected: svgpath.BBox{MinX: 5, MinY: -5, MaxX: 15, MaxY: 5},
+		},
+		{
+			name:     "line",
+			element:  svg.Line().X1Y1X2Y2(100, 300, 300, 100),
+			expected: svgpath.BBox{MinX: 100, MinY: 100, MaxX: 300, MaxY: 300},
+		},
+		{
+			name:     "path",
+			element:  svg.Path().D(svgpath.New().MoveToAbs([]float64{1, 2}).LineToRel([]float64{3, 4})),
+			expected: svgpath.BBox{MinX: 1, MinY: 2, MaxX: 4, MaxY: 6},
+		},
+		{
+			name:     "path_string",
+			element:  svg.Path().D(svg.String("M1,2 l3,4")),
+			expected: svgpath.BBox{MinX: 1, MinY: 2, MaxX: 4, MaxY: 6},
+		},
+		{
+			name:     "polygon",
+			element:  svg.Polygon().Points(svg.Points{{350, 75}, {379, 161}, {469, 161}}),
+			expected: svgpath.BBox{MinX: 350, MinY: 75, MaxX: 469, MaxY: 161},
+		},
+		{
+			name:     "polyline",
+			element:  svg.Polyline().Points(svg.Points{{50, 375}, {150, 325}}),
+			expected: svgpath.BBox{MinX: 50, MinY: 325, MaxX: 150, MaxY: 375},
+		},
+		{
+			name:     "rect",
+			element:  svg.Rect().XYWidthHeight(1, 2, 398, 397, svg.Number),
+			expected: svgpath.BBox{MinX: 1, MinY: 2, MaxX: 399, MaxY: 399},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := tc.element.BBox()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestBBoxErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		element interface{ BBox() (svgpath.BBox, error) }
+	}{
+		{
+			name:    "rect_percent",
+			element: svg.Rect().WidthHeight(100, 100, svg.Percent),
+		},
+		{
+			name:    "circle_cm",
+			element: svg.Circle().R(svg.CM(1)),
+		},
+		{
+			name:    "invalid_path",
+			element: svg.Path().D(svg.String("L1,2")),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.element.BBox()
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestTransform(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		transform      svg.Transform
+		expectedString string
+		x, y           float64
+		expectedX      float64
+		expectedY      float64
+	}{
+		{
+			name:           "identity",
+			transform:      svg.Identity(),
+			expectedString: "",
+			x:              1,
+			y:              2,
+			expectedX:      1,
+			expectedY:      2,
+		},
+		{
+			name:           "translate",
+			transform:      svg.Translate(10, 20),
+			expectedString: "translate(10 20)",
+			x:              1,
+			y:              2,
+			expectedX:      11,
+			expectedY:      22,
+		},
+		{
+			name:           "scale",
+			transform:      svg.Scale(2, 2),
+			expectedString: "scale(2)",
+			x:              1,
+			y:              2,
+			expectedX:      2,
+			expectedY:      4,
+		},
+		{
+			name:           "scale_non_uniform",
+			transform:      svg.Scale(2, -1),
+			expectedString: "scale(2 -1)",
+			x:              1,
+			y:              2,
+			expectedX:      2,
+			expectedY:      -2,
+		},
+		{
+			name:           "rotate",
+			transform:      svg.Rotate(90, 0, 0),
+			expectedString: "matrix(0 1 -1 0 0 0)",
+			x:              1,
+			y:              2,
+			expectedX:      -2,
+			expectedY:      1,
+		},
+		{
+			name:           "rotate_about_point",
+			transform:      svg.Rotate(180, 10, 10),
+			expectedString: "matrix(-1 0 0 -1 20 20)",
+			x:              0,
+			y:              5,
+			expectedX:      20,
+			expectedY:      15,
+		},
+		{
+			name:           "skew_x",
+			transform:      svg.SkewX(45),
+			expectedString: "matrix(1 0 1 1 0 0)",
+			x:              1,
+			y:              2,
+			expectedX:      3,
+			expectedY:      2,
+		},
+		{
+			name:           "skew_y",
+			transform:      svg.SkewY(-45),
+			expectedString: "matrix(1 -1 0 1 0 0)",
+			x:              1,
+			y:              2,
+			expectedX:      1,
+			expectedY:      1,
+		},
+		{
+			name:           "mul",
+			transform:      svg.Translate(10, 20).Mul(svg.Scale(2, 3)),
+			expectedString: "matrix(2 0 0 3 10 20)",
+			x:              1,
+			y:              1,
+			expectedX:      12,
+			expectedY:      23,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedString, tc.transform.String())
+			actualX, actualY := tc.transform.Apply(tc.x, tc.y)
+			assert.Equal(t, tc.expectedX, actualX)
+			assert.Equal(t, tc.expectedY, actualY)
+			inverse, ok := tc.transform.Inverse()
+			assert.True(t, ok)
+			x, y := inverse.Apply(actualX, actualY)
+			assert.True(t, math.Abs(tc.x-x) < 1e-12)
+			assert.True(t, math.Abs(tc.y-y) < 1e-12)
+		})
+	}
+}
+
+func TestTransformInverseSingular(t *testing.T) {
+	_, ok := svg.Scale(0, 1).Inverse()
+	assert.False(t, ok)
+}
+
+func TestTransformAttr(t *testing.T) {
+	var buffer bytes.Buffer
+	_, err := svg.New().AppendChildren(
+		svg.G().Transform(svg.Translate(10, 20).Mul(svg.Rotate(90, 0, 0))),
+		svg.Pattern().PatternTransform(svg.Scale(0.5, 0.5)),
+	).WriteTo(&buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, `<svg version="1.1" xmlns="http://www.w3.org/2000/svg">`+
+		`<g transform="matrix(0 1 -1 0 10 20)"></g>`+
+		`<pattern patternTransform="scale(0.5)"></pattern>`+
+		`</svg>`, buffer.String())
+}
+
+func TestParseTransform(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		value          string
+		expected       svg.Transform
+		expectedString string
+	}{
+		{
+			name:           "translate",
+			value:          "translate(10,20)",
+			expected:       svg.Translate(10, 20),
+			expectedString: "translate(10 20)",
+		},
+		{
+			name:           "translate_x",
+			value:          "translate(10)",
+			expected:       svg.Translate(10, 0),
+			expectedString: "translate(10)",
+		},
+		{
+			name:           "list",
+			value:          " translate(10 20) ,scale( 2 )\trotate(90)",
+			expected:       svg.Matrix(0, 2, -2, 0, 10, 20),
+			expectedString: "translate(10 20) scale(2) rotate(90)",
+		},
+		{
+			name:           "rotate",
+			value:          "rotate(45)",
+			expected:       svg.Rotate(45, 0, 0),
+			expectedString: "rotate(45)",
+		},
+		{
+			name:           "rotate_about_point",
+			value:          "rotate(180,10,10)",
+			expected:       svg.Rotate(180, 10, 10),
+			expectedString: "rotate(180 10 10)",
+		},
+		{
+			name:           "skew",
+			value:          "skewX(45) skewY(45)",
+			expected:       svg.Matrix(2, 1, 1, 1, 0, 0),
+			expectedString: "skewX(45) skewY(45)",
+		},
+		{
+			name:           "matrix",
+			value:          "matrix(1 2 3 4 5 6)",
+			expected:       svg.Matrix(1, 2, 3, 4, 5, 6),
+			expectedString: "matrix(1 2 3 4 5 6)",
+		},
+		{
+			name:     "empty",
+			expected: svg.Identity(),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			svgElement, err := svg.Parse(strings.NewReader(`<svg><g transform="` + tc.value + `"/></svg>`))
+			assert.NoError(t, err)
+			gElement, ok := svgElement.Children[0].(*svg.GElement)
+			assert.True(t, ok)
+			transformList, ok := gElement.Attrs["transform"].(svg.TransformList)
+			assert.True(t, ok)
+			assert.Equal(t, tc.expected, transformList.Matrix())
+			assert.Equal(t, tc.expectedString, transformList.String())
+		})
+	}
+}
+
+func TestTransformList(t *testing.T) {
+	transformList := svg.TransformList{
+		{Name: "translate", Args: []float64{10, 20}},
+		{Name: "scale", Args: []float64{2}},
+	}
+	x, y := transformList.Apply(1, 2)
+	assert.Equal(t, 12.0, x)
+	assert.Equal(t, 24.0, y)
+	assert.Equal(t, svg.Matrix(2, 0, 0, 2, 10, 20).Mul(svg.Translate(1, 0)), transformList.Mul(svg.Translate(1, 0)))
+	inverse, ok := transformList.Inverse()
+	assert.True(t, ok)
+	assert.Equal(t, "matrix(0.5 0 0 0.5 -5 -10)", inverse.String())
+	assert.Equal(t, "translate(10 20) scale(2)", transformList.String())
+	assert.Equal(t, "translate(10 20) scale(2)", transformList.FormatNumbers(svg.DecimalPlaces(1).FormatFloat))
+	assert.Equal(t, svg.Identity(), svg.TransformFunction{Name: "spin", Args: []float64{45}}.Matrix())
+
+	svgElement, err := svg.Parse(strings.NewReader(`<svg><g transform="rotate(45)"/></svg>`))
+	assert.NoError(t, err)
+	var buffer bytes.Buffer
+	_, err = svgElement.WriteTo(&buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, `<svg><g transform="rotate(45)"></g></svg>`, buffer.String())
+}
+
+func TestParseTransformUnparsed(t *testing.T) {
+	for _, value := range []string{
+		"translate",
+		"translate(10",
+		"translate(a)",
+		"rotate(1,2)",
+		"matrix(1 2 3)",
+		"spin(45)",
+	} {
+		t.Run(value, func(t *testing.T) {
+			svgElement, err := svg.Parse(strings.NewReader(`<svg><g transform="` + value + `"/></svg>`))
+			assert.NoError(t, err)
+			gElement, ok := svgElement.Children[0].(*svg.GElement)
+			assert.True(t, ok)
+			assert.Equal(t, svg.AttrValue(svg.String(value)), gElement.Attrs["transform"])
+		})
+	}
+}
+
+func TestColor(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		color    svg.Color
+		expected string
+	}{
+		{
+			name:     "name",
+			color:    svg.RGB(0xff, 0, 0),
+			expected: "red",
+		},
+		{
+			name:     "short_hex",
+			color:    svg.RGB(0, 0, 0xff),
+			expected: "#00f",
+		},
+		{
+			name:     "hex",
+			color:    svg.RGB(0x12, 0x34, 0x56),
+			expected: "#123456",
+		},
+		{
+			name:     "shortest_name",
+			color:    svg.RGB(0x80, 0x80, 0x80),
+			expected: "gray",
+		},
+		{
+			name:     "short_hex_alpha",
+			color:    svg.RGBA(0xff, 0, 0, 0x88),
+			expected: "#f008",
+		},
+		{
+			name:     "hex_alpha",
+			color:    svg.RGBA(0xff, 0, 0, 0x80),
+			expected: "#ff000080",
+		},
+		{
+			name:     "transparent",
+			color:    svg.Color{},
+			expected: "#0000",
+		},
+		{
+			name:     "current_color",
+			color:    svg.CurrentColor,
+			expected: "currentColor",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.color.String())
+			actual, err := svg.ParseColor(tc.expected)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.color, actual)
+		})
+	}
+}
+
+func TestColorImageColor(t *testing.T) {
+	var _ color.Color = svg.Color{}
+	assert.Equal(t, svg.RGBA(0xff, 0, 0, 0x80), svg.NewColor(color.RGBA{R: 0x80, A: 0x80}))
+	assert.Equal(t, svg.RGB(0x12, 0x34, 0x56), svg.NewColor(color.NRGBA{R: 0x12, G: 0x34, B: 0x56, A: 0xff}))
+	assert.Equal(t, svg.CurrentColor, svg.NewColor(svg.CurrentColor))
+	assert.Equal(t, color.Color(color.RGBA{R: 0x80, A: 0x80}), color.RGBAModel.Convert(svg.RGBA(0xff, 0, 0, 0x80)))
+	assert.True(t, svg.CurrentColor.IsCurrentColor())
+	assert.False(t, svg.RGB(0, 0, 0).IsCurrentColor())
+}
+
+func TestParseColor(t *testing.T) {
+	for _, tc := range []struct {
+		s        string
+		expected svg.Color
+	}{
+		{s: "red", expected: svg.RGB(0xff, 0, 0)},
+		{s: " RebeccaPurple ", expected: svg.RGB(0x66, 0x33, 0x99)},
+		{s: "transparent", expected: svg.RGBA(0, 0, 0, 0)},
+		{s: "currentcolor", expected: svg.CurrentColor},
+		{s: "#F00", expected: svg.RGB(0xff, 0, 0)},
+		{s: "#f008", expected: svg.RGBA(0xff, 0, 0, 0x88)},
+		{s: "#123456", expected: svg.RGB(0x12, 0x34, 0x56)},
+		{s: "#12345678", expected: svg.RGBA(0x12, 0x34, 0x56, 0x78)},
+		{s: "rgb(255, 0, 0)", expected: svg.RGB(0xff, 0, 0)},
+		{s: "rgb(100%,50%,0%)", expected: svg.RGB(0xff, 0x80, 0)},
+		{s: "rgba(255, 0, 0, 0.5)", expected: svg.RGBA(0xff, 0, 0, 0x80)},
+		{s: "rgb(255 0 0 / 50%)", expected: svg.RGBA(0xff, 0, 0, 0x80)},
+		{s: "rgb(300 -10 0)", expected: svg.RGB(0xff, 0, 0)},
+		{s: "hsl(120, 100%, 50%)", expected: svg.RGB(0, 0xff, 0)},
+		{s: "hsl(240deg 100% 50% / 0.5)", expected: svg.RGBA(0, 0, 0xff, 0x80)},
+		{s: "hsla(0.5turn, 100%, 25%, 1)", expected: svg.RGB(0, 0x80, 0x80)},
+		{s: "hsl(-120 100 50)", expected: svg.RGB(0, 0, 0xff)},
+	} {
+		t.Run(tc.s, func(t *testing.T) {
+			actual, err := svg.ParseColor(tc.s)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestParseColorErrors(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"rde",
+		"#12",
+		"#12345",
+		"#ggg",
+		"rgb(1, 2)",
+		"rgb(1 2 3 4)",
+		"rgb(1, 2, x)",
+		"rgb(1 2 3 / x)",
+		"hsl(1foo 2% 3%)",
+		"cmyk(1, 2, 3)",
+		"rgb(1, 2, 3",
+	} {
+		t.Run(s, func(t *testing.T) {
+			_, err := svg.ParseColor(s)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestColorAttrs(t *testing.T) {
+	svgElement, err := svg.Parse(strings.NewReader(`<svg><g color="#ff0000" fill="currentColor"/><rect flood-color="rgb(0 0 255)"/></svg>`))
+	assert.NoError(t, err)
+	gElement, ok := svgElement.Children[0].(*svg.GElement)
+	assert.True(t, ok)
+	assert.Equal(t, svg.AttrValue(svg.RGB(0xff, 0, 0)), gElement.Attrs["color"])
+	rectElement, ok := svgElement.Children[1].(*svg.RectElement)
+	assert.True(t, ok)
+	assert.Equal(t, svg.AttrValue(svg.RGB(0, 0, 0xff)), rectElement.Attrs["flood-color"])
+
+	var buffer bytes.Buffer
+	_, err = svg.New().AppendChildren(
+		svg.Rect().FillColor(svg.RGB(0xff, 0, 0)).StrokeColor(svg.CurrentColor).Color(svg.RGB(0, 0x80, 0)),
+	).WriteTo(&buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, `<svg version="1.1" xmlns="http://www.w3.org/2000/svg">`+
+		`<rect color="green" fill="red" stroke="currentColor"></rect>`+
+		`</svg>`, buffer.String())
+
+	svgElement, err = svg.Parse(strings.NewReader(`<svg><g color="rde"/></svg>`))
+	assert.NoError(t, err)
+	gElement, ok = svgElement.Children[0].(*svg.GElement)
+	assert.True(t, ok)
+	assert.Equal(t, svg.AttrValue(svg.String("rde")), gElement.Attrs["color"])
+}
+
+func TestPaint(t *testing.T) {
+	pattern := svg.Pattern().ID("pattern")
+	for _, tc := range []struct {
+		name     string
+		paint    svg.Paint
+		expected string
+	}{
+		{
+			name:     "none",
+			paint:    svg.NoPaint,
+			expected: "none",
+		},
+		{
+			name:     "color",
+			paint:    svg.ColorPaint(svg.RGB(0xff, 0, 0)),
+			expected: "red",
+		},
+		{
+			name:     "context_fill",
+			paint:    svg.ContextFill,
+			expected: "context-fill",
+		},
+		{
+			name:     "context_stroke",
+			paint:    svg.ContextStroke,
+			expected: "context-stroke",
+		},
+		{
+			name:     "element",
+			paint:    svg.ElementPaint(pattern),
+			expected: "url(#pattern)",
+		},
+		{
+			name:     "element_with_fallback",
+			paint:    svg.ElementPaint(pattern).WithFallback(svg.RGB(0xff, 0, 0)),
+			expected: "url(#pattern) red",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.paint.String())
+		})
+	}
+}
+
+func TestPaintReferences(t *testing.T) {
+	pattern := svg.Pattern().ID("pattern")
+	var buffer bytes.Buffer
+	_, err := svg.New().AppendChildren(
+		svg.Defs(pattern),
+		svg.Rect().FillPaint(svg.ElementPaint(pattern).WithFallback(svg.RGB(0, 0, 0xff))).StrokePaint(svg.ContextStroke),
+	).WriteTo(&buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, `<svg version="1.1" xmlns="http://www.w3.org/2000/svg">`+
+		`<defs><pattern id="pattern"></pattern></defs>`+
+		`<rect fill="url(#pattern) #00f" stroke="context-stroke"></rect>`+
+		`</svg>`, buffer.String())
+	assert.Equal(t, svg.Element(pattern), svg.ElementPaint(pattern).Element())
+	assert.Zero(t, svg.ContextFill.Element())
+}
+
+func TestPaintReferenceErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		svg      *svg.SVGElement
+		expected string
+	}{
+		{
+			name: "missing_element",
+			svg: svg.New().AppendChildren(
+				svg.Rect().FillPaint(svg.ElementPaint(svg.Pattern().ID("pattern"))),
+			),
+			expected: "fill: url(#pattern): referenced element not in document",
+		},
+		{
+			name: "missing_id",
+			svg: func() *svg.SVGElement {
+				pattern := svg.Pattern()
+				return svg.New().AppendChildren(
+					svg.Defs(pattern),
+					svg.Rect().StrokePaint(svg.ElementPaint(pattern)),
+				)
+			}(),
+			expected: "stroke: referenced element has no id",
+		},
+		{
+			name: "char_data",
+			svg: svg.New().AppendChildren(
+				svg.Rect().FillPaint(svg.ElementPaint(svg.CharData("pattern"))),
+			),
+			expected: "fill: referenced element cannot have an id",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var buffer bytes.Buffer
+			_, err := tc.svg.WriteTo(&buffer)
+			assert.EqualError(t, err, tc.expected)
+			assert.Zero(t, buffer.Len())
+		})
+	}
+}
+
+func TestRadialGradientFromStops(t *testing.T) {
+	half, transparent := 0.5, 0.0
+	var buffer bytes.Buffer
+	_, err := svg.New().AppendChildren(
+		svg.RadialGradientFromStops(
+			svg.GradientStop{Offset: 0, Color: svg.RGB(0xff, 0, 0)},
+			svg.GradientStop{Offset: 0.5, Color: svg.RGB(0, 0, 0xff), Opacity: &half},
+			svg.GradientStop{Offset: 0.75, Color: svg.RGBA(0, 0, 0xff, 0x80)},
+			svg.GradientStop{Offset: 1, Color: svg.CurrentColor, Opacity: &transparent},
+		).ID("g").CX(svg.Percent(50)).FX(svg.Percent(25)).FR(svg.Percent(5)).SpreadMethod("reflect").GradientTransform(svg.Rotate(90, 0, 0)),
+	).WriteTo(&buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, `<svg version="1.1" xmlns="http://www.w3.org/2000/svg">`+
+		`<radialGradient cx="50%" fr="5%" fx="25%" gradientTransform="matrix(0 1 -1 0 0 0)" id="g" spreadMethod="reflect">`+
+		`<stop offset="0" stop-color="red"></stop>`+
+		`<stop offset="0.5" stop-color="#00f" stop-opacity="0.5"></stop>`+
+		`<stop offset="0.75" stop-color="#0000ff80"></stop>`+
+		`<stop offset="1" stop-color="currentColor" stop-opacity="0"></stop>`+
+		`</radialGradient>`+
+		`</svg>`, buffer.String())
+}
+
+func TestParseFilter(t *testing.T) {
+	svgElement, err := svg.Parse(strings.NewReader(`<svg>` +
+		`<filter id="f" x="0" y="0" width="100%" height="100%">` +
+		`<feTurbulence baseFrequency="0.05, 0.1" numOctaves="2" result="turbulence"/>` +
+		`<feDisplacementMap in="SourceGraphic" in2="turbulence" scale="20"/>` +
+		`</filter>` +
+		`</svg>`))
+	assert.NoError(t, err)
+	filterElement, ok := svgElement.Children[0].(*svg.FilterElement)
+	assert.True(t, ok)
+	assert.Equal(t, svg.AttrValue(svg.Percent(100)), filterElement.Attrs["width"])
+	feTurbulenceElement, ok := filterElement.Children[0].(*svg.FeTurbulenceElement)
+	assert.True(t, ok)
+	assert.Equal(t, map[string]svg.AttrValue{
+		"baseFrequency": svg.Numbers{0.05, 0.1},
+		"numOctaves":    svg.Int(2),
+		"result":        svg.String("turbulence"),
+	}, feTurbulenceElement.Attrs)
+	feDisplacementMapElement, ok := filterElement.Children[1].(*svg.FeDisplacementMapElement)
+	assert.True(t, ok)
+	assert.Equal(t, map[string]svg.AttrValue{
+		"in":    svg.String("SourceGraphic"),
+		"in2":   svg.String("turbulence"),
+		"scale": svg.Float64(20),
+	}, feDisplacementMapElement.Attrs)
+	assert.Equal(t, "0.05 0.1", feTurbulenceElement.Attrs["baseFrequency"].String())
+}
+
+func TestDuration(t *testing.T) {
+	for _, tc := range []struct {
+		duration svg.Duration
+		expected string
+	}{
+		{duration: 0, expected: "0s"},
+		{duration: svg.Duration(100 * time.Millisecond), expected: "0.1s"},
+		{duration: svg.Duration(1500 * time.Millisecond), expected: "1.5s"},
+		{duration: svg.Duration(time.Millisecond), expected: "1ms"},
+		{duration: svg.Duration(time.Microsecond), expected: "0.000001s"},
+		{duration: svg.Duration(2 * time.Second), expected: "2s"},
+		{duration: svg.Duration(90 * time.Second), expected: "90s"},
+		{duration: svg.Duration(10 * time.Minute), expected: "600s"},
+		{duration: svg.Duration(12 * time.Hour), expected: "12h"},
+		{duration: svg.Duration(-2 * time.Second), expected: "-2s"},
+		{duration: svg.Duration(-1500 * time.Millisecond), expected: "-1.5s"},
+		{duration: svg.IndefiniteDuration, expected: "indefinite"},
+	} {
+		t.Run(tc.expected, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.duration.String())
+		})
+	}
+}
+
+func TestParseAnimation(t *testing.T) {
+	svgElement, err := svg.Parse(strings.NewReader(`<svg>` +
+		`<animate attributeName="x" begin="click" dur="02:30:03" end="50:00.10" repeatCount="indefinite" repeatDur="2.5h" keyTimes="0; 0.25;1" keySplines="0.5 0 0.5 1;0,0,1,1" calcMode="spline" values="0;10;0"/>` +
+		`<animateMotion dur="100ms" repeatCount="2.5" path="M0,0 L10,10"><mpath href="#p"/></animateMotion>` +
+		`<set attributeName="visibility" to="hidden" begin="1s" min="0" max="10s"/>` +
+		`</svg>`))
+	assert.NoError(t, err)
+
+	animateElement, ok := svgElement.Children[0].(*svg.AnimateElement)
+	assert.True(t, ok)
+	assert.Equal(t, map[string]svg.AttrValue{
+		"attributeName": svg.String("x"),
+		"begin":         svg.String("click"),
+		"dur":           svg.Duration(2*time.Hour + 30*time.Minute + 3*time.Second),
+		"end":           svg.String("50:00.10"),
+		"repeatCount":   svg.IndefiniteRepeatCount,
+		"repeatDur":     svg.Duration(150 * time.Minute),
+		"keyTimes":      svg.KeyTimes{0, 0.25, 1},
+		"keySplines":    svg.KeySplines{{0.5, 0, 0.5, 1}, {0, 0, 1, 1}},
+		"calcMode":      svg.String("spline"),
+		"values":        svg.String("0;10;0"),
+	}, animateElement.Attrs)
+	assert.Equal(t, "0.5 0 0.5 1;0 0 1 1", animateElement.Attrs["keySplines"].String())
+	assert.Equal(t, "0;0.25;1", animateElement.Attrs["keyTimes"].String())
+	assert.Equal(t, "9003s", animateElement.Attrs["dur"].String())
+
+	animateMotionElement, ok := svgElement.Children[1].(*svg.AnimateMotionElement)
+	assert.True(t, ok)
+	assert.Equal(t, svg.AttrValue(svg.Duration(100*time.Millisecond)), animateMotionElement.Attrs["dur"])
+	assert.Equal(t, svg.AttrValue(svg.RepeatCount(2.5)), animateMotionElement.Attrs["repeatCount"])
+	path, ok := animateMotionElement.Attrs["path"].(*svgpath.Path)
+	assert.True(t, ok)
+	assert.Equal(t, "M0,0 L10,10", path.String())
+	_, ok = animateMotionElement.Children[0].(*svg.MPathElement)
+	assert.True(t, ok)
+
+	setElement, ok := svgElement.Children[2].(*svg.SetElement)
+	assert.True(t, ok)
+	assert.Equal(t, svg.AttrValue(svg.Duration(0)), setElement.Attrs["min"])
+	assert.Equal(t, svg.AttrValue(svg.Duration(10*time.Second)), setElement.Attrs["max"])
+}
+
+func TestParseAnimationUnparsed(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		value string
+	}{
+		{name: "dur", value: "1furlong"},
+		{name: "dur", value: "-1s"},
+		{name: "dur", value: "1:2:3:4"},
+		{name: "dur", value: "1:60"},
+		{name: "dur", value: "media"},
+		{name: "repeatCount", value: "often"},
+		{name: "repeatCount", value: "-1"},
+		{name: "keyTimes", value: "0;x"},
+		{name: "keySplines", value: "0 0 1"},
+	} {
+		t.Run(tc.name+"="+tc.value, func(t *testing.T) {
+			svgElement, err := svg.Parse(strings.NewReader(`<svg><animate ` + tc.name + `="` + tc.value + `"/></svg>`))
+			assert.NoError(t, err)
+			animateElement, ok := svgElement.Children[0].(*svg.AnimateElement)
+			assert.True(t, ok)
+			assert.Equal(t, svg.AttrValue(svg.String(tc.value)), animateElement.Attrs[tc.name])
+		})
+	}
+}
+
+func TestAnimateMotion(t *testing.T) {
+	var buffer bytes.Buffer
+	_, err := svg.New().AppendChildren(
+		svg.Circle().R(svg.Number(5)).AppendChildren(
+			svg.AnimateMotion().
+				Path(svgpath.New().MoveToAbs([]float64{0, 0}).LineToAbs([]float64{100, 0})).
+				BeginDuration(svg.Duration(time.Second)).
+				Dur(svg.Duration(3 * time.Second)).
+				RepeatCount(svg.IndefiniteRepeatCount).
+				Fill("freeze"),
+		),
+	).WriteTo(&buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, `<svg version="1.1" xmlns="http://www.w3.org/2000/svg">`+
+		`<circle r="5">`+
+		`<animateMotion begin="1s" dur="3s" fill="freeze" path="M0,0 L100,0" repeatCount="indefinite"></animateMotion>`+
+		`</circle>`+
+		`</svg>`, buffer.String())
+}
+
+func TestPathAnimation(t *testing.T) {
+	var buffer bytes.Buffer
+	_, err := svg.New().AppendChildren(
+		svg.Path().D(svgpath.New().MoveToAbs([]float64{0, 0}).LineToAbs([]float64{100, 0})).AppendChildren(
+			svg.Animate().AttributeName("opacity").Values("0;1").
+				BeginDuration(svg.Duration(-2*time.Second)).
+				Dur(svg.Duration(3*time.Second)),
+			svg.AnimateMotion().
+				Path(svgpath.New().MoveToAbs([]float64{0, 0}).LineToAbs([]float64{0, 100})).
+				EndDuration(svg.IndefiniteDuration),
+		),
+	).WriteTo(&buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, `<svg version="1.1" xmlns="http://www.w3.org/2000/svg">`+
+		`<path d="M0,0 L100,0">`+
+		`<animate attributeName="opacity" begin="-2s" dur="3s" values="0;1"></animate>`+
+		`<animateMotion end="indefinite" path="M0,0 L0,100"></animateMotion>`+
+		`</path>`+
+		`</svg>`, buffer.String())
+}
+
+func TestZeroValues(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		value    svg.AttrValue
+		expected string
+	}{
+		{name: "angle", value: svg.Angle{}, expected: "0"},
+		{name: "angle_deg", value: svg.Deg(0), expected: "0deg"},
+		{name: "bool", value: svg.Bool(false), expected: "false"},
+		{name: "duration", value: svg.Duration(0), expected: "0s"},
+		{name: "float64", value: svg.Float64(0), expected: "0"},
+		{name: "int", value: svg.Int(0), expected: "0"},
+		{name: "length", value: svg.Length{}, expected: "0"},
+		{name: "length_number", value: svg.Number(0), expected: "0"},
+		{name: "length_percent", value: svg.Percent(0), expected: "0%"},
+		{name: "numbers", value: svg.Numbers{0}, expected: "0"},
+		{name: "repeat_count", value: svg.RepeatCount(0), expected: "0"},
+		{name: "key_times", value: svg.KeyTimes{0}, expected: "0"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.value.String())
+		})
+	}
+}
+
+func TestZeroValueAttrs(t *testing.T) {
+	rect := svg.Rect().
+		X(svg.Number(0)).
+		Y(svg.Px(0)).
+		Opacity(0).
+		FillOpacity(0).
+		StrokeDashOffset(0).
+		TabIndex(0).
+		Class("")
+	var buffer bytes.Buffer
+	_, err := svg.New().AppendChildren(
+		rect,
+		svg.Set().Dur(0).RepeatCount(0),
+		svg.Stop().StopOpacity(0),
+	).WriteTo(&buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, `<svg version="1.1" xmlns="http://www.w3.org/2000/svg">`+
+		`<rect class="" fill-opacity="0" opacity="0" stroke-dashoffset="0" tabindex="0" x="0" y="0px"></rect>`+
+		`<set dur="0s" repeatCount="0"></set>`+
+		`<stop stop-opacity="0"></stop>`+
+		`</svg>`, buffer.String())
+
+	rect.RemoveAttrs("opacity", "tabindex", "class", "not-set")
+	buffer.Reset()
+	_, err = svg.New().AppendChildren(rect).WriteTo(&buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, `<svg version="1.1" xmlns="http://www.w3.org/2000/svg">`+
+		`<rect fill-opacity="0" stroke-dashoffset="0" x="0" y="0px"></rect>`+
+		`</svg>`, buffer.String())
+}
+
+func TestParseZeroValues(t *testing.T) {
+	svgElement, err := svg.Parse(strings.NewReader(`<svg><rect x="0" opacity="0" tabindex="0" class=""/></svg>`))
+	assert.NoError(t, err)
+	rectElement, ok := svgElement.Children[0].(*svg.RectElement)
+	assert.True(t, ok)
+	assert.Equal(t, map[string]svg.AttrValue{
+		"x":        svg.Number(0),
+		"opacity":  svg.Float64(0),
+		"tabindex": svg.Int(0),
+		"class":    svg.String(""),
+	}, rectElement.Attrs)
+	var buffer bytes.Buffer
+	_, err = svgElement.WriteTo(&buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, `<svg><rect class="" opacity="0" tabindex="0" x="0"></rect></svg>`, buffer.String())
+}
+
+func TestEncoder(t *testing.T) {
+	const n = 1000
+	points := make([]svg.Element, 0, n)
+	for i := range n {
+		points = append(points, svg.Circle().CXCYR(float64(i), float64(i%10), 1, svg.Number))
+	}
+	expected := svg.New().WidthHeight(n, 10, svg.Number).AppendChildren(
+		svg.Title(svg.CharData("Scatter plot")),
+		svg.G(points...).Fill("blue"),
+	)
+	var expectedBuffer bytes.Buffer
+	_, err := expected.WriteToIndent(&expectedBuffer, "", "  ")
+	assert.NoError(t, err)
+
+	var actualBuffer bytes.Buffer
+	encoder := svg.NewEncoder(&actualBuffer)
+	encoder.Indent("", "  ")
+	assert.NoError(t, encoder.Open(svg.New().WidthHeight(n, 10, svg.Number).AppendChildren(
+		svg.Title(svg.CharData("Scatter plot")),
+	)))
+	assert.NoError(t, encoder.Open(svg.G().Fill("blue")))
+	for i := range n {
+		assert.NoError(t, encoder.Encode(svg.Circle().CXCYR(float64(i), float64(i%10), 1, svg.Number)))
+	}
+	assert.NoError(t, encoder.Close())
+	assert.NoError(t, encoder.Close())
+	assert.NoError(t, encoder.Flush())
+	assert.Equal(t, expectedBuffer.String(), actualBuffer.String())
+}
+
+func TestEncoderErrors(t *testing.T) {
+	encoder := svg.NewEncoder(io.Discard)
+	assert.EqualError(t, encoder.Close(), "no open element")
+	assert.EqualError(t, encoder.Open(svg.CharData("text")), "svg.CharData: cannot open element")
+	assert.EqualError(t, encoder.Encode(svg.Comment("a-->b")), "comment contains -->")
+	assert.EqualError(t, encoder.Encode(svg.Comment("a--b")), "comment contains --")
+	assert.EqualError(t, encoder.Encode(svg.Comment("a-")), "comment ends with -")
+	assert.NoError(t, encoder.Encode(svg.Comment("-a-b")))
+}
+
+func TestPrecision(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		precision svg.Precision
+		f         float64
+		expected  string
+	}{
+		{name: "exact", f: 0.30000000000000004, expected: "0.30000000000000004"},
+		{name: "exact_negative_zero", f: math.Copysign(0, -1), expected: "0"},
+		{name: "decimal_places", precision: svg.DecimalPlaces(2), f: 0.1 + 0.2, expected: "0.3"},
+		{name: "decimal_places_round", precision: svg.DecimalPlaces(2), f: 1.23456, expected: "1.23"},
+		{name: "decimal_places_zero", precision: svg.DecimalPlaces(0), f: 1234.5678, expected: "1235"},
+		{name: "decimal_places_negative_zero", precision: svg.DecimalPlaces(2), f: -0.001, expected: "0"},
+		{name: "significant_digits", precision: svg.SignificantDigits(3), f: 1.23456, expected: "1.23"},
+		{name: "significant_digits_large", precision: svg.SignificantDigits(3), f: 1234567, expected: "1230000"},
+		{name: "significant_digits_small", precision: svg.SignificantDigits(2), f: 0.00012345, expected: "0.00012"},
+		{name: "significant_digits_negative_zero", precision: svg.SignificantDigits(2), f: math.Copysign(0, -1), expected: "0"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.precision.FormatFloat(tc.f))
+		})
+	}
+}
+
+func TestEncoderPrecision(t *testing.T) {
+	svgElement := svg.New().
+		ViewBox(0, 0, 1.0/3, 2.0/3).
+		AppendChildren(
+			svg.Circle().CXCYR(0.1+0.2, -0.0001, 1.23456, svg.Number).Transform(svg.Rotate(30, 0, 0)),
+			svg.Path().D(svgpath.New().MoveToAbs([]float64{1.0 / 3, 2.0 / 3}).LineToRel([]float64{0.1 + 0.2, -0.001})),
+			svg.Path().D(svgpath.New().MoveToAbs([]float64{1.0 / 3, 2.0 / 3}).LineToRel([]float64{0.1 + 0.2, -0.001}).Compact(-1)),
+			svg.Polyline().Points(svg.Points{{1.0 / 3, 2.0 / 3}}),
+			svg.Animate().KeyTimes(svg.KeyTimes{0, 1.0 / 3, 1}),
+		)
+	var builder strings.Builder
+	encoder := svg.NewEncoder(&builder)
+	encoder.SetPrecision(svg.DecimalPlaces(2))
+	assert.NoError(t, encoder.Encode(svgElement))
+	assert.NoError(t, encoder.Flush())
+	assert.Equal(t, ``+
+		`<svg version="1.1" viewBox="0 0 0.33 0.67" xmlns="http://www.w3.org/2000/svg">`+
+		`<circle cx="0.3" cy="0" r="1.23" transform="matrix(0.87 0.5 -0.5 0.87 0 0)"></circle>`+
+		`<path d="M0.33,0.67 l0.3,0"></path>`+
+		`<path d="M.33.67l.3 0"></path>`+
+		`<polyline points="0.33,0.67"></polyline>`+
+		`<animate keyTimes="0;0.33;1"></animate>`+
+		`</svg>`,
+		builder.String())
+}
+
+func TestEncoderNotFinite(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		element       svg.Element
+		expectedError string
+	}{
+		{
+			name:          "length",
+			element:       svg.Circle().R(svg.Number(math.NaN())),
+			expectedError: "circle: r: NaN: number is not finite",
+		},
+		{
+			name:          "path",
+			element:       svg.Path().D(svgpath.New().MoveToAbs([]float64{0, math.Inf(1)})),
+			expectedError: "path: d: +Inf: number is not finite",
+		},
+		{
+			name:          "transform",
+			element:       svg.G().Transform(svg.Scale(math.Inf(-1), 1)),
+			expectedError: "g: transform: -Inf: number is not finite",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			encoder := svg.NewEncoder(io.Discard)
+			encoder.SetPrecision(svg.SignificantDigits(3))
+			assert.EqualError(t, encoder.Encode(tc.element), tc.expectedError)
+			_, err := svg.New().AppendChildren(tc.element).WriteTo(io.Discard)
+			assert.EqualError(t, err, tc.expectedError)
+		})
+	}
+}
+
+func TestWriteOptions(t *testing.T) {
+	newSVG := func() *svg.SVGElement {
+		return svg.New().WidthHeight(10, 10, svg.Number).AppendChildren(
+			svg.G(
+				svg.Rect().Fill("red").XYWidthHeight(1, 2, 3, 4, svg.Number).ID("r"),
+				svg.Title(svg.CharData("rectangle")),
+			).Stroke("blue"),
+		)
+	}
+	for _, tc := range []struct {
+		name     string
+		element  svg.Element
+		options  svg.WriteOptions
+		expected string
+	}{
+		{
+			name:    "default",
+			element: newSVG(),
+			expected: `<svg height="10" version="1.1" width="10" xmlns="http://www.w3.org/2000/svg">` +
+				`<g stroke="blue"><rect fill="red" height="4" id="r" width="3" x="1" y="2"></rect><title>rectangle</title></g>` +
+				`</svg>`,
+		},
+		{
+			name:    "xml_declaration_and_doctype",
+			element: svg.New(),
+			options: svg.WriteOptions{
+				XMLDeclaration: true,
+				DOCTYPE:        true,
+			},
+			expected: `<?xml version="1.0" encoding="UTF-8"?>` + "\n" +
+				`<!DOCTYPE svg PUBLIC "-//W3C//DTD SVG 1.1//EN" "http://www.w3.org/Graphics/SVG/1.1/DTD/svg11.dtd">` + "\n" +
+				`<svg version="1.1" xmlns="http://www.w3.org/2000/svg"></svg>`,
+		},
+		{
+			name:    "self_closing",
+			element: newSVG(),
+			options: svg.WriteOptions{
+				SelfClosing: true,
+				Indent:      "  ",
+			},
+			expected: `<svg height="10" version="1.1" width="10" xmlns="http://www.w3.org/2000/svg">` + "\n" +
+				`  <g stroke="blue">` + "\n" +
+				`    <rect fill="red" height="4" id="r" width="3" x="1" y="2"/>` + "\n" +
+				`    <title>rectangle</title>` + "\n" +
+				`  </g>` + "\n" +
+				`</svg>`,
+		},
+		{
+			name:    "attr_order_spec",
+			element: newSVG(),
+			options: svg.WriteOptions{
+				AttrOrder: svg.AttrOrderSpec,
+			},
+			expected: `<svg version="1.1" xmlns="http://www.w3.org/2000/svg" width="10" height="10">` +
+				`<g stroke="blue"><rect id="r" x="1" y="2" width="3" height="4" fill="red"></rect><title>rectangle</title></g>` +
+				`</svg>`,
+		},
+		{
+			name: "fragment",
+			element: func() *svg.SVGElement {
+				svgElement := newSVG()
+				svgElement.Attrs["xmlns:xlink"] = svg.String("http://www.w3.org/1999/xlink")
+				return svgElement
+			}(),
+			options: svg.WriteOptions{
+				Fragment:    true,
+				SelfClosing: true,
+			},
+			expected: `<svg height="10" version="1.1" width="10">` +
+				`<g stroke="blue"><rect fill="red" height="4" id="r" width="3" x="1" y="2"/><title>rectangle</title></g>` +
+				`</svg>`,
+		},
+		{
+			name:    "fragment_element",
+			element: svg.Circle().CXCYR(1.0/3, 2.0/3, 1, svg.Number),
+			options: svg.WriteOptions{
+				Fragment:    true,
+				SelfClosing: true,
+				Precision:   svg.DecimalPlaces(3),
+			},
+			expected: `<circle cx="0.333" cy="0.667" r="1"/>`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var builder strings.Builder
+			n, err := svg.Write(&builder, tc.element, tc.options)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, builder.String())
+			assert.Equal(t, int64(builder.Len()), n)
+		})
+	}
+}
+
+func TestWriteFragmentReferences(t *testing.T) {
+	svgElement := svg.New().AppendChildren(
+		svg.Rect().FillPaint(svg.ElementPaint(svg.LinearGradient().ID("gradient"))),
+	)
+	_, err := svg.Write(io.Discard, svgElement, svg.WriteOptions{})
+	assert.Error(t, err)
+	_, err = svg.Write(io.Discard, svgElement, svg.WriteOptions{Fragment: true})
+	assert.NoError(t, err)
+}
+
+func TestSVGZ(t *testing.T) {
+	expected, err := os.ReadFile(filepath.Join("testdata", "triangle01.svg"))
+	assert.NoError(t, err)
+	svgElement, err := svg.Parse(bytes.NewReader(expected))
+	assert.NoError(t, err)
+
+	var buffer bytes.Buffer
+	compressed, uncompressed, err := svgElement.WriteSVGZ(&buffer, "", "  ")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(buffer.Len()), compressed)
+	assert.Equal(t, int64(len(expected)), uncompressed)
+	assert.True(t, compressed < uncompressed)
+
+	actual, err := svg.ParseSVGZ(&buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, svgElement, actual)
+}
+
+func TestParseSVGZErrors(t *testing.T) {
+	_, err := svg.ParseSVGZ(strings.NewReader(`<svg xmlns="http://www.w3.org/2000/svg"></svg>`))
+	assert.IsError(t, err, gzip.ErrHeader)
+
+	var buffer bytes.Buffer
+	_, _, err = svg.New().WriteSVGZ(&buffer, "", "")
+	assert.NoError(t, err)
+	corrupted := buffer.Bytes()
+	corrupted[len(corrupted)-5] ^= 0xff // Corrupt the checksum.
+	_, err = svg.ParseSVGZ(bytes.NewReader(corrupted))
+	assert.IsError(t, err, gzip.ErrChecksum)
+}
+
+func TestDataURI(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		svg      *svg.SVGElement
+		expected string
+	}{
+		{
+			name: "percent",
+			svg: svg.New().WidthHeight(10, 10, svg.Number).AppendChildren(
+				svg.Comment(` "comment" `),
+				svg.Rect().WidthHeight(10, 10, svg.Number).Fill("#f00"),
+				svg.Text(svg.CharData("100% \"quoted\"\n")),
+			),
+			expected: `data:image/svg+xml,` +
+				`<svg height='10' version='1.1' width='10' xmlns='http://www.w3.org/2000/svg'>` +
+				`<!-- %22comment%22 -->` +
+				`<rect fill='%23f00' height='10' width='10'></rect>` +
+				`<text>100%25 &%2334;quoted&%2334;%0A</text>` +
+				`</svg>`,
+		},
+		{
+			name: "base64",
+			svg: svg.New().AppendChildren(
+				svg.Text(svg.CharData(strings.Repeat("日本語", 10))),
+			),
+			expected: "data:image/svg+xml;base64,PHN2ZyB2ZXJzaW9uPScxLjEnIHhtbG5zPSdodHRwOi8vd3d3LnczLm9yZy8yMDAwL3N2Zyc+PHRleHQ+5pel5pys6Kqe5pel5pys6Kqe5pel5pys6Kqe5pel5pys6Kqe5pel5pys6Kqe5pel5pys6Kqe5pel5pys6Kqe5pel5pys6Kqe5pel5pys6Kqe5pel5pys6KqePC90ZXh0Pjwvc3ZnPg==",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := tc.svg.DataURI()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestWriteDataURI(t *testing.T) {
+	svgElement := svg.New().AppendChildren(
+		svg.Rect().Fill("#f00"),
+		svg.Text(svg.CharData("日本語")),
+	)
+
+	var builder strings.Builder
+	n, err := svgElement.WriteDataURI(&builder, svg.DataURIEncodingPercent)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(builder.Len()), n)
+	percentEncoded, ok := strings.CutPrefix(builder.String(), "data:image/svg+xml,")
+	assert.True(t, ok)
+	assert.False(t, strings.ContainsAny(percentEncoded, "\"#"))
+	decoded, err := url.PathUnescape(percentEncoded)
+	assert.NoError(t, err)
+	actual, err := svg.Parse(strings.NewReader(decoded))
+	assert.NoError(t, err)
+	assert.Equal(t, svgElement.String(), actual.String())
+
+	builder.Reset()
+	n, err = svgElement.WriteDataURI(&builder, svg.DataURIEncodingBase64)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(builder.Len()), n)
+	base64Encoded, ok := strings.CutPrefix(builder.String(), "data:image/svg+xml;base64,")
+	assert.True(t, ok)
+	decodedBytes, err := base64.StdEncoding.DecodeString(base64Encoded)
+	assert.NoError(t, err)
+	actual, err = svg.Parse(bytes.NewReader(decodedBytes))
+	assert.NoError(t, err)
+	assert.Equal(t, svgElement.String(), actual.String())
+}
+
+func TestHTML(t *testing.T) {
+	untrusted := `</svg><script>alert("x")</script>`
+	svgElement := svg.New().WidthHeight(10, 10, svg.Number).AppendChildren(
+		svg.Title(svg.CharData(untrusted)),
+		svg.Text(svg.CharData(untrusted)).ID(svg.String(untrusted)),
+		svg.Comment(" comment "),
+	)
+	actual, err := svg.HTML(svgElement)
+	assert.NoError(t, err)
+	assert.Equal(t, template.HTML(``+
+		`<svg height="10" version="1.1" width="10">`+
+		`<title>&lt;/svg&gt;&lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt;</title>`+
+		`<text id="&lt;/svg&gt;&lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt;">&lt;/svg&gt;&lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt;</text>`+
+		`<!-- comment -->`+
+		`</svg>`), actual)
+
+	circle, err := svg.HTML(svg.Circle().R(svg.Number(1)))
+	assert.NoError(t, err)
+	assert.Equal(t, template.HTML(`<circle r="1"/>`), circle)
+}
+
+func TestHTMLErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		element       svg.Element
+		expectedError string
+	}{
+		{
+			name: "attribute_name",
+			element: svg.G(svg.Rect()).Fill("red").AppendChildren(func() svg.Element {
+				rect := svg.Rect()
+				rect.Attrs[`x onload="alert(1)"`] = svg.String("0")
+				return rect
+			}()),
+			expectedError: `rect: "x onload=\"alert(1)\"": invalid attribute name`,
+		},
+		{
+			name:          "comment_end",
+			element:       svg.G(svg.Comment("--!><script>alert(1)</script>")),
+			expectedError: "comment is not safe in HTML",
+		},
+		{
+			name:          "comment_start",
+			element:       svg.G(svg.Comment("><script>alert(1)</script>")),
+			expectedError: "comment is not safe in HTML",
+		},
+		{
+			name:          "not_finite",
+			element:       svg.Circle().R(svg.Number(math.NaN())),
+			expectedError: "circle: r: NaN: number is not finite",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := svg.HTML(tc.element)
+			assert.EqualError(t, err, tc.expectedError)
+		})
+	}
+}
+
+func TestFuncMap(t *testing.T) {
+	tmpl, err := template.New("").Funcs(svg.FuncMap()).Parse(`<div>{{ svg . }}</div><img src="{{ svgDataURI . }}">`)
+	assert.NoError(t, err)
+	var builder strings.Builder
+	assert.NoError(t, tmpl.Execute(&builder, svg.New().AppendChildren(
+		svg.Title(svg.CharData("<b>")),
+	)))
+	assert.Equal(t, ``+
+		`<div><svg version="1.1"><title>&lt;b&gt;</title></svg></div>`+
+		`<img src="data:image/svg&#43;xml,%3csvg%20version=%271.1%27%20xmlns=%27http://www.w3.org/2000/svg%27%3e%3ctitle%3e&amp;lt;b&amp;gt;%3c/title%3e%3c/svg%3e">`,
+		builder.String())
+}
+
+func TestNode(t *testing.T) {
+	path := svg.Path().ID("path")
+	g := svg.G(path)
+
+	var node svg.Node = path
+	assert.Equal(t, "path", node.TagName())
+	assert.Equal(t, map[string]svg.AttrValue{"id": svg.String("path")}, node.Attributes())
+	assert.Zero(t, node.ChildElements())
+
+	var container svg.Container = g
+	assert.Equal(t, "g", container.TagName())
+	assert.Equal(t, []svg.Element{path}, container.ChildElements())
+	container.SetChildElements(nil)
+	assert.Zero(t, g.Children)
+
+	_, ok := svg.Element(svg.CharData("text")).(svg.Node)
+	assert.False(t, ok)
+}
+
+func TestWalk(t *testing.T) {
+	title := svg.Title(svg.CharData("Title"))
+	rect1 := svg.Rect().ID("rect1")
+	rect2 := svg.Rect().ID("rect2")
+	circle := svg.Circle()
+	g := svg.G(rect2, circle)
+	root := svg.New().AppendChildren(title, rect1, g)
+	orphan := svg.Rect()
+
+	tagNames := func(seq iter.Seq[svg.Element]) []string {
+		var tagNames []string
+		for element := range seq {
+			if node, ok := element.(svg.Node); ok {
+				tagNames = append(tagNames, node.TagName())
+			} else {
+				tagNames = append(tagNames, fmt.Sprintf("%T", element))
+			}
+		}
+		return tagNames
+	}
+
+	assert.Equal(t, []string{"svg", "title", "svg.CharData", "rect", "g", "rect", "circle"}, tagNames(svg.Walk(root)))
+	assert.Equal(t, []string{"title", "svg.CharData", "rect", "g", "rect", "circle"}, tagNames(svg.Descendants(root)))
+	assert.Equal(t, []string{"rect", "circle"}, tagNames(svg.Descendants(g)))
+	assert.Zero(t, tagNames(svg.Descendants(svg.CharData("text"))))
+	assert.Equal(t, []string{"svg.CharData"}, tagNames(svg.Walk(svg.CharData("text"))))
+
+	assert.Equal(t, []string{"g", "svg"}, tagNames(svg.Ancestors(root, circle)))
+	assert.Equal(t, []string{"svg"}, tagNames(svg.Ancestors(root, rect1)))
+	assert.Zero(t, tagNames(svg.Ancestors(root, root)))
+	assert.Zero(t, tagNames(svg.Ancestors(root, orphan)))
+
+	assert.Equal(t, []*svg.RectElement{rect1, rect2}, slices.Collect(svg.OfType[*svg.RectElement](svg.Walk(root))))
+
+	for element := range svg.Walk(root) {
+		if element == svg.Element(g) {
+			break
+		}
+		if node, ok := element.(svg.Node); ok {
+			node.Attributes()["class"] = svg.String("visited")
+		}
+	}
+	assert.Equal(t, svg.AttrValue(svg.String("visited")), rect1.Attrs["class"])
+	_, ok := rect2.Attrs["class"]
+	assert.False(t, ok)
+}
+
+func TestWalkRecolor(t *testing.T) {
+	root := svg.New().AppendChildren(
+		svg.Rect().Fill("red"),
+		svg.G(
+			svg.Circle().Fill("red").Stroke("red"),
+		).Fill("blue"),
+	)
+	for node := range svg.OfType[svg.Node](svg.Walk(root)) {
+		attrs := node.Attributes()
+		for _, name := range []string{"fill", "stroke"} {
+			if value, ok := attrs[name]; ok && value.String() == "red" {
+				attrs[name] = svg.String("green")
 			}
-			tokens = append(tokens, token)
 		}
 	}
+	var builder strings.Builder
+	_, err := root.WriteTo(&builder)
+	assert.NoError(t, err)
+	assert.Equal(t, `<svg version="1.1" xmlns="http://www.w3.org/2000/svg"><rect fill="green"></rect><g fill="blue"><circle fill="green" stroke="green"></circle></g></svg>`, builder.String())
 }
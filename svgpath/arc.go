@@ -0,0 +1,105 @@
+package svgpath
+
+import "math"
+
+// An ellipticalArc is an elliptical arc in center parameterization, see
+// https://www.w3.org/TR/SVG2/implnote.html#ArcConversionEndpointToCenter.
+type ellipticalArc struct {
+	cx, cy         float64
+	rx, ry         float64
+	cosPhi, sinPhi float64
+	theta1         float64
+	deltaTheta     float64
+}
+
+// newEllipticalArc returns the center parameterization of the arc from x1, y1
+// to x2, y2 with radii rx and ry, x-axis rotation phi degrees, and flags
+// largeArc and sweep. It returns false if the arc is omitted because its
+// endpoints are identical or is a straight line because either radius is zero.
+func newEllipticalArc(x1, y1, rx, ry, phi float64, largeArc, sweep bool, x2, y2 float64) (ellipticalArc, bool) {
+	if x1 == x2 && y1 == y2 {
+		return ellipticalArc{}, false
+	}
+	rx, ry = math.Abs(rx), math.Abs(ry)
+	if rx == 0 || ry == 0 {
+		return ellipticalArc{}, false
+	}
+
+	sinPhi, cosPhi := math.Sincos(phi * math.Pi / 180)
+	dx2, dy2 := (x1-x2)/2, (y1-y2)/2
+	x1p := cosPhi*dx2 + sinPhi*dy2
+	y1p := -sinPhi*dx2 + cosPhi*dy2
+
+	if lambda := x1p*x1p/(rx*rx) + y1p*y1p/(ry*ry); lambda > 1 {
+		sqrtLambda := math.Sqrt(lambda)
+		rx *= sqrtLambda
+		ry *= sqrtLambda
+	}
+
+	numerator := rx*rx*ry*ry - rx*rx*y1p*y1p - ry*ry*x1p*x1p
+	denominator := rx*rx*y1p*y1p + ry*ry*x1p*x1p
+	coef := math.Sqrt(max(0, numerator/denominator))
+	if largeArc == sweep {
+		coef = -coef
+	}
+	cxp := coef * rx * y1p / ry
+	cyp := -coef * ry * x1p / rx
+
+	ux, uy := (x1p-cxp)/rx, (y1p-cyp)/ry
+	vx, vy := (-x1p-cxp)/rx, (-y1p-cyp)/ry
+	theta1 := vectorAngle(1, 0, ux, uy)
+	deltaTheta := vectorAngle(ux, uy, vx, vy)
+	switch {
+	case !sweep && deltaTheta > 0:
+		deltaTheta -= 2 * math.Pi
+	case sweep && deltaTheta < 0:
+		deltaTheta += 2 * math.Pi
+	}
+
+	return ellipticalArc{
+		cx:         cosPhi*cxp - sinPhi*cyp + (x1+x2)/2,
+		cy:         sinPhi*cxp + cosPhi*cyp + (y1+y2)/2,
+		rx:         rx,
+		ry:         ry,
+		cosPhi:     cosPhi,
+		sinPhi:     sinPhi,
+		theta1:     theta1,
+		deltaTheta: deltaTheta,
+	}, true
+}
+
+// cubics calls yield with the control points of a sequence of cubic Bézier
+// curves that approximate a, each spanning at most a quarter turn.
+func (a *ellipticalArc) cubics(yield func(x1, y1, x2, y2, x, y float64)) {
+	// Allow a small tolerance so that exact quarter turns are not split.
+	n := max(1, int(math.Ceil(math.Abs(a.deltaTheta)/(math.Pi/2)-1e-9)))
+	delta := a.deltaTheta / float64(n)
+	k := 4 / 3.0 * math.Tan(delta/4)
+	theta := a.theta1
+	x0, y0 := a.point(theta)
+	for range n {
+		dx0, dy0 := a.derivative(theta)
+		theta += delta
+		x, y := a.point(theta)
+		dx, dy := a.derivative(theta)
+		yield(x0+k*dx0, y0+k*dy0, x-k*dx, y-k*dy, x, y)
+		x0, y0 = x, y
+	}
+}
+
+// derivative returns the derivative of a with respect to theta.
+func (a *ellipticalArc) derivative(theta float64) (float64, float64) {
+	sin, cos := math.Sincos(theta)
+	return -a.rx*sin*a.cosPhi - a.ry*cos*a.sinPhi, -a.rx*sin*a.sinPhi + a.ry*cos*a.cosPhi
+}
+
+// point returns the point on a's ellipse at angle theta.
+func (a *ellipticalArc) point(theta float64) (float64, float64) {
+	sin, cos := math.Sincos(theta)
+	return a.cx + a.rx*cos*a.cosPhi - a.ry*sin*a.sinPhi, a.cy + a.rx*cos*a.sinPhi + a.ry*sin*a.cosPhi
+}
+
+// vectorAngle returns the signed angle between the vectors u and v.
+func vectorAngle(ux, uy, vx, vy float64) float64 {
+	return math.Atan2(ux*vy-uy*vx, ux*vx+uy*vy)
+}
@@ -0,0 +1,187 @@
+package svgpath
+
+import "math"
+
+// negligible is the relative magnitude below which polynomial coefficients are
+// treated as zero.
+const negligible = 1e-12
+
+// A BBox is an axis-aligned bounding box.
+type BBox struct {
+	MinX float64
+	MinY float64
+	MaxX float64
+	MaxY float64
+}
+
+// EmptyBBox returns an empty BBox.
+func EmptyBBox() BBox {
+	return BBox{
+		MinX: math.Inf(1),
+		MinY: math.Inf(1),
+		MaxX: math.Inf(-1),
+		MaxY: math.Inf(-1),
+	}
+}
+
+// Empty returns whether b is empty.
+func (b BBox) Empty() bool {
+	return b.MinX > b.MaxX || b.MinY > b.MaxY
+}
+
+// Extend returns b extended to include the point x, y.
+func (b BBox) Extend(x, y float64) BBox {
+	return BBox{
+		MinX: min(b.MinX, x),
+		MinY: min(b.MinY, y),
+		MaxX: max(b.MaxX, x),
+		MaxY: max(b.MaxY, y),
+	}
+}
+
+// Height returns the height of b.
+func (b BBox) Height() float64 {
+	if b.Empty() {
+		return 0
+	}
+	return b.MaxY - b.MinY
+}
+
+// Union returns the smallest BBox that contains both b and other.
+func (b BBox) Union(other BBox) BBox {
+	return BBox{
+		MinX: min(b.MinX, other.MinX),
+		MinY: min(b.MinY, other.MinY),
+		MaxX: max(b.MaxX, other.MaxX),
+		MaxY: max(b.MaxY, other.MaxY),
+	}
+}
+
+// Width returns the width of b.
+func (b BBox) Width() float64 {
+	if b.Empty() {
+		return 0
+	}
+	return b.MaxX - b.MinX
+}
+
+// BBox returns the tight bounding box of p's geometry, excluding any stroke.
+// The extrema of curves and arcs are calculated exactly, rather than using
+// their control points.
+func (p *Path) BBox() BBox {
+	bbox := EmptyBBox()
+	var moveToX, moveToY float64
+	pendingMoveTo := false
+	for piece := range p.pieces() {
+		if piece.command == CommandMoveTo {
+			moveToX, moveToY = piece.args[0], piece.args[1]
+			pendingMoveTo = true
+			continue
+		}
+		// A moveto only contributes to the bounding box if it is followed by
+		// another command.
+		if pendingMoveTo {
+			bbox = bbox.Extend(moveToX, moveToY)
+			pendingMoveTo = false
+		}
+		switch piece.command {
+		case CommandArcTo:
+			bbox = bbox.extendArc(piece)
+		case CommandClosePath, CommandLineTo:
+			bbox = bbox.Extend(piece.args[0], piece.args[1])
+		case CommandCurveTo:
+			bbox = bbox.extendCubic(piece.x0, piece.y0, piece.args[0], piece.args[1], piece.args[2], piece.args[3], piece.args[4], piece.args[5])
+		}
+	}
+	return bbox
+}
+
+// extendArc returns b extended to include the arc piece.
+func (b BBox) extendArc(piece piece) BBox {
+	x, y := piece.args[5], piece.args[6]
+	b = b.Extend(x, y)
+	arc, ok := newEllipticalArc(piece.x0, piece.y0, piece.args[0], piece.args[1], piece.args[2], piece.args[3] != 0, piece.args[4] != 0, x, y)
+	if !ok {
+		return b
+	}
+	// Find the angles at which the derivatives of x and y with respect to theta
+	// are zero.
+	thetaX := math.Atan2(-arc.ry*arc.sinPhi, arc.rx*arc.cosPhi)
+	thetaY := math.Atan2(arc.ry*arc.cosPhi, arc.rx*arc.sinPhi)
+	for _, theta := range []float64{thetaX, thetaX + math.Pi, thetaY, thetaY + math.Pi} {
+		if arc.contains(theta) {
+			b = b.Extend(arc.point(theta))
+		}
+	}
+	return b
+}
+
+// extendCubic returns b extended to include the cubic Bézier curve with
+// control points x0, y0, x1, y1, x2, y2, and x3, y3.
+func (b BBox) extendCubic(x0, y0, x1, y1, x2, y2, x3, y3 float64) BBox {
+	b = b.Extend(x3, y3)
+	for _, t := range cubicExtrema(x0, x1, x2, x3) {
+		b = b.Extend(cubic(x0, x1, x2, x3, t), cubic(y0, y1, y2, y3, t))
+	}
+	for _, t := range cubicExtrema(y0, y1, y2, y3) {
+		b = b.Extend(cubic(x0, x1, x2, x3, t), cubic(y0, y1, y2, y3, t))
+	}
+	return b
+}
+
+// contains returns whether the angle theta is swept by a.
+func (a *ellipticalArc) contains(theta float64) bool {
+	d := math.Mod(theta-a.theta1, 2*math.Pi)
+	if a.deltaTheta >= 0 {
+		if d < 0 {
+			d += 2 * math.Pi
+		}
+		return d <= a.deltaTheta
+	}
+	if d > 0 {
+		d -= 2 * math.Pi
+	}
+	return d >= a.deltaTheta
+}
+
+// cubic returns the value of the one-dimensional cubic Bézier curve with
+// control points p0, p1, p2, and p3 at t.
+func cubic(p0, p1, p2, p3, t float64) float64 {
+	mt := 1 - t
+	return mt*mt*mt*p0 + 3*mt*mt*t*p1 + 3*mt*t*t*p2 + t*t*t*p3
+}
+
+// cubicExtrema returns the values of t in (0, 1) at which the derivative of
+// the one-dimensional cubic Bézier curve with control points p0, p1, p2, and
+// p3 is zero.
+func cubicExtrema(p0, p1, p2, p3 float64) []float64 {
+	// The derivative, divided by three, is at^2 + bt + c. Quadratic Bézier
+	// curves raised to cubics have an a that is zero up to rounding error, so
+	// a is treated as zero if it is negligible compared to the control points.
+	a := -p0 + 3*p1 - 3*p2 + p3
+	b := 2 * (p0 - 2*p1 + p2)
+	c := p1 - p0
+	scale := max(math.Abs(p0), math.Abs(p1), math.Abs(p2), math.Abs(p3))
+	var roots []float64
+	switch discriminant := b*b - 4*a*c; {
+	case math.Abs(a) <= negligible*scale:
+		if b != 0 {
+			roots = append(roots, -c/b)
+		}
+	case discriminant >= 0:
+		// Avoid cancellation by computing the root with the larger magnitude
+		// first and the other from the product of the roots, c/a.
+		q := -(b + math.Copysign(math.Sqrt(discriminant), b)) / 2
+		roots = append(roots, q/a)
+		if q != 0 {
+			roots = append(roots, c/q)
+		}
+	}
+	extrema := roots[:0]
+	for _, t := range roots {
+		if 0 < t && t < 1 {
+			extrema = append(extrema, t)
+		}
+	}
+	return extrema
+}
@@ -0,0 +1,216 @@
+package svgpath
+
+import (
+	"strconv"
+	"strings"
+)
+
+// A compactEncoder writes segments in compact form. It tracks the current
+// point as a reader of its output would compute it, so that rounding errors do
+// not accumulate along relative segments.
+type compactEncoder struct {
+	b              []byte
+	formatFloat    func(float64) string
+	c              cursor
+	x, y           float64 // current point as read from the output
+	startX, startY float64 // start of the current subpath as read from the output
+	implicit       byte    // command letter that can be omitted, or zero
+	last           string  // last number written, or empty after a letter
+	lastFlag       bool    // whether the last number written was a flag
+}
+
+// Compact sets p to be written in compact form by String and returns p. In
+// compact form each segment is written in whichever of absolute or relative
+// coordinates is shorter, repeated command letters are omitted, leading zeros
+// are dropped, and separators are omitted where possible. Values are rounded
+// to precision decimal places. A negative precision uses the smallest number
+// of digits necessary to represent each value exactly.
+func (p *Path) Compact(precision int) *Path {
+	p.compact = true
+	p.precision = precision
+	return p
+}
+
+// appendCompact appends the compact form of p to b, formatting values with
+// formatFloat.
+func (p *Path) appendCompact(b []byte, formatFloat func(float64) string) []byte {
+	e := &compactEncoder{
+		b:           b,
+		formatFloat: formatFloat,
+	}
+	for _, segment := range p.segments {
+		e.encodeSegment(segment)
+	}
+	return e.b
+}
+
+// encodeSegment writes segment, choosing the shorter of its absolute and
+// relative forms.
+func (e *compactEncoder) encodeSegment(segment Segment) {
+	exactX, exactY := e.c.x, e.c.y
+	args := e.c.absArgs(segment)
+	e.c.advance(segment.Command, args)
+
+	if segment.Command == CommandClosePath {
+		e.appendLetter(segment.Letter())
+		e.x, e.y = e.startX, e.startY
+		return
+	}
+
+	absSegment := Segment{Command: segment.Command}
+	relSegment := Segment{Command: segment.Command, Relative: true}
+	absNumbers := e.numbers(segment.Command, args, 0, 0)
+	var relNumbers []string
+	if segment.Relative && e.x == exactX && e.y == exactY {
+		// Use the original arguments to avoid introducing rounding errors.
+		var relArgs [7]float64
+		copy(relArgs[:], segment.Args)
+		relNumbers = e.numbers(segment.Command, relArgs, 0, 0)
+	} else {
+		relNumbers = e.numbers(segment.Command, args, e.x, e.y)
+	}
+	absLen := len(e.clone().appendSegment(absSegment.Letter(), segment.Command, absNumbers).b)
+	relLen := len(e.clone().appendSegment(relSegment.Letter(), segment.Command, relNumbers).b)
+
+	selected, numbers := absSegment, absNumbers
+	if relLen < absLen || relLen == absLen && segment.Relative {
+		selected, numbers = relSegment, relNumbers
+	}
+	e.appendSegment(selected.Letter(), segment.Command, numbers)
+
+	// Update the current point as a reader would compute it.
+	var x0, y0 float64
+	if selected.Relative {
+		x0, y0 = e.x, e.y
+	}
+	switch segment.Command {
+	case CommandHLineTo:
+		e.x = x0 + parseNumber(numbers[0])
+	case CommandVLineTo:
+		e.y = y0 + parseNumber(numbers[0])
+	default:
+		e.x = x0 + parseNumber(numbers[len(numbers)-2])
+		e.y = y0 + parseNumber(numbers[len(numbers)-1])
+	}
+	if segment.Command == CommandMoveTo {
+		e.startX, e.startY = e.x, e.y
+	}
+}
+
+// numbers returns the formatted arguments of a segment with command and
+// absolute arguments args, with coordinates relative to x0, y0.
+func (e *compactEncoder) numbers(command Command, args [7]float64, x0, y0 float64) []string {
+	numbers := make([]string, 0, command.Arity())
+	switch command {
+	case CommandArcTo:
+		for _, arg := range args[:3] {
+			numbers = append(numbers, e.formatNumber(arg))
+		}
+		numbers = append(numbers, string(appendFlag(nil, args[3])), string(appendFlag(nil, args[4])))
+		numbers = append(numbers, e.formatNumber(args[5]-x0), e.formatNumber(args[6]-y0))
+	case CommandHLineTo:
+		numbers = append(numbers, e.formatNumber(args[0]-x0))
+	case CommandVLineTo:
+		numbers = append(numbers, e.formatNumber(args[0]-y0))
+	default:
+		for i := 0; i+1 < command.Arity(); i += 2 {
+			numbers = append(numbers, e.formatNumber(args[i]-x0), e.formatNumber(args[i+1]-y0))
+		}
+	}
+	return numbers
+}
+
+// appendSegment writes a segment with letter and numbers.
+func (e *compactEncoder) appendSegment(letter byte, command Command, numbers []string) *compactEncoder {
+	if letter != e.implicit {
+		e.appendLetter(letter)
+	}
+	for i, number := range numbers {
+		isFlag := command == CommandArcTo && (i == 3 || i == 4)
+		e.appendNumber(number, isFlag)
+	}
+	switch letter {
+	case 'M':
+		e.implicit = 'L'
+	case 'm':
+		e.implicit = 'l'
+	default:
+		e.implicit = letter
+	}
+	return e
+}
+
+// appendLetter writes a command letter.
+func (e *compactEncoder) appendLetter(letter byte) {
+	e.b = append(e.b, letter)
+	e.implicit = 0
+	e.last = ""
+	e.lastFlag = false
+}
+
+// appendNumber writes number, preceded by a separator if needed.
+func (e *compactEncoder) appendNumber(number string, isFlag bool) {
+	if e.last != "" && (isFlag || e.lastFlag || !canFollow(e.last, number)) {
+		e.b = append(e.b, ' ')
+	}
+	e.b = append(e.b, number...)
+	e.last = number
+	e.lastFlag = isFlag
+}
+
+// clone returns a copy of e that writes to a new buffer.
+func (e *compactEncoder) clone() *compactEncoder {
+	clone := *e
+	clone.b = nil
+	return &clone
+}
+
+// formatNumber formats f with leading zeros removed.
+func (e *compactEncoder) formatNumber(f float64) string {
+	s := e.formatFloat(f)
+	switch {
+	case strings.HasPrefix(s, "0."):
+		s = s[1:]
+	case strings.HasPrefix(s, "-0."):
+		s = "-" + s[2:]
+	}
+	if s == "-0" || s == "-" || s == "-." {
+		s = "0"
+	}
+	return s
+}
+
+// canFollow returns whether number can be written directly after last without
+// a separator.
+func canFollow(last, number string) bool {
+	switch {
+	case strings.HasPrefix(number, "-"):
+		return true
+	case strings.HasPrefix(number, "."):
+		return strings.Contains(last, ".") && !strings.ContainsAny(last, "Ee")
+	default:
+		return false
+	}
+}
+
+// decimalFormatter returns a function that formats values rounded to precision
+// decimal places, without trailing zeros. A negative precision uses the
+// smallest number of digits necessary to represent each value exactly.
+func decimalFormatter(precision int) func(float64) string {
+	return func(f float64) string {
+		s := strconv.FormatFloat(f, 'f', precision, 64)
+		if strings.Contains(s, ".") {
+			s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+		}
+		if s == "-0" {
+			s = "0"
+		}
+		return s
+	}
+}
+
+// parseNumber parses a number written by a compactEncoder.
+func parseNumber(s string) float64 {
+	f, _ := strconv.ParseFloat(s, 64)
+	return f
+}
@@ -0,0 +1,242 @@
+package svgpath
+
+import "math"
+
+// DefaultTolerance is the default tolerance used when measuring paths.
+const DefaultTolerance = 1e-6
+
+// A curve is a piece of a path that has a length, parameterized by t in [0, 1].
+type curve struct {
+	command Command // CommandLineTo, CommandCurveTo, or CommandArcTo
+	x0, y0  float64
+	args    [7]float64
+	arc     ellipticalArc
+	length  float64
+}
+
+// Tolerance sets the tolerance used when measuring the length of p and
+// returns p. A tolerance of zero uses DefaultTolerance.
+func (p *Path) Tolerance(tolerance float64) *Path {
+	p.tolerance = tolerance
+	return p
+}
+
+// Length returns the length of p.
+func (p *Path) Length() float64 {
+	length := 0.0
+	for _, curve := range p.curves() {
+		length += curve.length
+	}
+	return length
+}
+
+// PointAtLength returns the point at distance d along p. d is clamped to the
+// range [0, p.Length()].
+func (p *Path) PointAtLength(d float64) (float64, float64) {
+	curve, t, ok := p.curveAtLength(d)
+	if !ok {
+		return p.start()
+	}
+	return curve.point(t)
+}
+
+// TangentAtLength returns the unit tangent vector of p at distance d along p.
+// d is clamped to the range [0, p.Length()]. It returns 0, 0 if p has no
+// length.
+func (p *Path) TangentAtLength(d float64) (float64, float64) {
+	curve, t, ok := p.curveAtLength(d)
+	if !ok {
+		return 0, 0
+	}
+	dx, dy := curve.derivative(t)
+	if math.Hypot(dx, dy) < 1e-9*curve.length {
+		// The derivative vanishes, for example where a control point coincides
+		// with an endpoint, so use the direction to a nearby point instead.
+		const epsilon = 1e-6
+		if t < 0.5 {
+			x0, y0 := curve.point(t)
+			x1, y1 := curve.point(t + epsilon)
+			dx, dy = x1-x0, y1-y0
+		} else {
+			x0, y0 := curve.point(t - epsilon)
+			x1, y1 := curve.point(t)
+			dx, dy = x1-x0, y1-y0
+		}
+	}
+	length := math.Hypot(dx, dy)
+	if length == 0 {
+		return 0, 0
+	}
+	return dx / length, dy / length
+}
+
+// curveAtLength returns the curve and the parameter t at distance d along p.
+// It returns false if p has no length.
+func (p *Path) curveAtLength(d float64) (*curve, float64, bool) {
+	curves := p.curves()
+	if len(curves) == 0 {
+		return nil, 0, false
+	}
+	d = max(d, 0)
+	for i := range curves {
+		curve := &curves[i]
+		if d <= curve.length || i == len(curves)-1 {
+			return curve, curve.paramAtLength(min(d, curve.length), p.getTolerance()), true
+		}
+		d -= curve.length
+	}
+	return nil, 0, false
+}
+
+// curves returns the curves in p with non-zero length.
+func (p *Path) curves() []curve {
+	var curves []curve
+	tolerance := p.getTolerance()
+	for piece := range p.pieces() {
+		c := curve{
+			command: piece.command,
+			x0:      piece.x0,
+			y0:      piece.y0,
+			args:    piece.args,
+		}
+		switch piece.command {
+		case CommandArcTo:
+			x, y := piece.args[5], piece.args[6]
+			arc, ok := newEllipticalArc(piece.x0, piece.y0, piece.args[0], piece.args[1], piece.args[2], piece.args[3] != 0, piece.args[4] != 0, x, y)
+			if ok {
+				c.arc = arc
+			} else {
+				c.command = CommandLineTo
+				c.args[0], c.args[1] = x, y
+			}
+		case CommandClosePath:
+			c.command = CommandLineTo
+		case CommandCurveTo, CommandLineTo:
+		default:
+			continue
+		}
+		c.length = c.lengthAt(1, tolerance)
+		if c.length > 0 {
+			curves = append(curves, c)
+		}
+	}
+	return curves
+}
+
+// getTolerance returns p's tolerance.
+func (p *Path) getTolerance() float64 {
+	if p == nil || p.tolerance == 0 {
+		return DefaultTolerance
+	}
+	return p.tolerance
+}
+
+// start returns the first point of p.
+func (p *Path) start() (float64, float64) {
+	if p == nil || len(p.segments) == 0 || len(p.segments[0].Args) < 2 {
+		return 0, 0
+	}
+	return p.segments[0].Args[0], p.segments[0].Args[1]
+}
+
+// derivative returns the derivative of c with respect to t.
+func (c *curve) derivative(t float64) (float64, float64) {
+	switch c.command {
+	case CommandArcTo:
+		dx, dy := c.arc.derivative(c.arc.theta1 + t*c.arc.deltaTheta)
+		return dx * c.arc.deltaTheta, dy * c.arc.deltaTheta
+	case CommandCurveTo:
+		return cubicDerivative(c.x0, c.args[0], c.args[2], c.args[4], t), cubicDerivative(c.y0, c.args[1], c.args[3], c.args[5], t)
+	default:
+		return c.args[0] - c.x0, c.args[1] - c.y0
+	}
+}
+
+// lengthAt returns the length of c from 0 to t.
+func (c *curve) lengthAt(t, tolerance float64) float64 {
+	if c.command == CommandLineTo {
+		return t * math.Hypot(c.args[0]-c.x0, c.args[1]-c.y0)
+	}
+	return integrate(c.speed, 0, t, tolerance)
+}
+
+// paramAtLength returns the parameter t at which the length of c is d.
+func (c *curve) paramAtLength(d, tolerance float64) float64 {
+	if c.command == CommandLineTo {
+		return d / c.length
+	}
+	// Use Newton's method, falling back to bisection if it leaves the bracket.
+	lo, hi := 0.0, 1.0
+	t := d / c.length
+	for range 64 {
+		diff := c.lengthAt(t, tolerance) - d
+		if math.Abs(diff) <= tolerance {
+			break
+		}
+		if diff > 0 {
+			hi = t
+		} else {
+			lo = t
+		}
+		if speed := c.speed(t); speed > 0 {
+			t -= diff / speed
+		}
+		if t <= lo || t >= hi {
+			t = (lo + hi) / 2
+		}
+	}
+	return t
+}
+
+// point returns the point on c at t.
+func (c *curve) point(t float64) (float64, float64) {
+	switch c.command {
+	case CommandArcTo:
+		return c.arc.point(c.arc.theta1 + t*c.arc.deltaTheta)
+	case CommandCurveTo:
+		return cubic(c.x0, c.args[0], c.args[2], c.args[4], t), cubic(c.y0, c.args[1], c.args[3], c.args[5], t)
+	default:
+		return c.x0 + t*(c.args[0]-c.x0), c.y0 + t*(c.args[1]-c.y0)
+	}
+}
+
+// speed returns the magnitude of the derivative of c at t.
+func (c *curve) speed(t float64) float64 {
+	return math.Hypot(c.derivative(t))
+}
+
+// cubicDerivative returns the derivative of the one-dimensional cubic Bézier
+// curve with control points p0, p1, p2, and p3 at t.
+func cubicDerivative(p0, p1, p2, p3, t float64) float64 {
+	mt := 1 - t
+	return 3*mt*mt*(p1-p0) + 6*mt*t*(p2-p1) + 3*t*t*(p3-p2)
+}
+
+// integrate returns the integral of f from a to b, calculated with adaptive
+// Simpson's rule. The interval is first split into several subintervals so
+// that symmetric functions are not mistaken for well-approximated ones.
+func integrate(f func(float64) float64, a, b, tolerance float64) float64 {
+	const n = 8
+	h := (b - a) / n
+	integral := 0.0
+	for i := range n {
+		a, b := a+float64(i)*h, a+float64(i+1)*h
+		fa, fm, fb := f(a), f((a+b)/2), f(b)
+		whole := (b - a) / 6 * (fa + 4*fm + fb)
+		integral += adaptiveSimpson(f, a, b, fa, fm, fb, whole, tolerance/n, 16)
+	}
+	return integral
+}
+
+func adaptiveSimpson(f func(float64) float64, a, b, fa, fm, fb, whole, tolerance float64, depth int) float64 {
+	m := (a + b) / 2
+	lm, rm := (a+m)/2, (m+b)/2
+	flm, frm := f(lm), f(rm)
+	left := (m - a) / 6 * (fa + 4*flm + fm)
+	right := (b - m) / 6 * (fm + 4*frm + fb)
+	if delta := left + right - whole; depth <= 0 || math.Abs(delta) <= 15*tolerance {
+		return left + right + delta/15
+	}
+	return adaptiveSimpson(f, a, m, fa, flm, fm, left, tolerance/2, depth-1) +
+		adaptiveSimpson(f, m, b, fm, frm, fb, right, tolerance/2, depth-1)
+}
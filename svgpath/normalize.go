@@ -0,0 +1,190 @@
+package svgpath
+
+import "iter"
+
+// A cursor tracks the state needed to interpret a sequence of segments.
+type cursor struct {
+	x, y           float64 // current point
+	startX, startY float64 // start of the current subpath
+	ctrlX, ctrlY   float64 // last control point, for S and T
+	lastCommand    Command
+}
+
+// A piece is a segment in absolute coordinates using only the commands M, L,
+// C, A, and Z, together with the point at which it starts. The args of a Z
+// piece are the start of its subpath.
+type piece struct {
+	command Command
+	x0, y0  float64
+	args    [7]float64
+}
+
+// Abs returns a new Path equivalent to p with all segments in absolute
+// coordinates.
+func (p *Path) Abs() *Path {
+	abs := New()
+	if p == nil {
+		return abs
+	}
+	var c cursor
+	for _, segment := range p.segments {
+		args := c.absArgs(segment)
+		abs.appendSegment(segment.Command, false, args[:segment.Command.Arity()]...)
+		c.advance(segment.Command, args)
+	}
+	return abs
+}
+
+// Normalize returns a new Path equivalent to p in canonical form, using only
+// absolute M, L, C, and Z commands. H and V commands are converted to L
+// commands, S, Q, and T commands are converted to C commands, and A commands
+// are approximated by C commands.
+func (p *Path) Normalize() *Path {
+	normalized := New()
+	for piece := range p.pieces() {
+		switch piece.command {
+		case CommandArcTo:
+			x, y := piece.args[5], piece.args[6]
+			if piece.x0 == x && piece.y0 == y {
+				continue
+			}
+			arc, ok := newEllipticalArc(piece.x0, piece.y0, piece.args[0], piece.args[1], piece.args[2], piece.args[3] != 0, piece.args[4] != 0, x, y)
+			if !ok {
+				normalized.appendSegment(CommandLineTo, false, x, y)
+				continue
+			}
+			start := len(normalized.segments)
+			arc.cubics(func(x1, y1, x2, y2, x, y float64) {
+				normalized.appendSegment(CommandCurveTo, false, x1, y1, x2, y2, x, y)
+			})
+			// Use the exact endpoint to avoid accumulating rounding errors.
+			if len(normalized.segments) > start {
+				last := normalized.segments[len(normalized.segments)-1]
+				last.Args[4], last.Args[5] = x, y
+			}
+		case CommandClosePath:
+			normalized.appendSegment(CommandClosePath, false)
+		default:
+			normalized.appendSegment(piece.command, false, piece.args[:piece.command.Arity()]...)
+		}
+	}
+	return normalized
+}
+
+// pieces returns an iterator over p's segments converted to pieces.
+func (p *Path) pieces() iter.Seq[piece] {
+	return func(yield func(piece) bool) {
+		if p == nil {
+			return
+		}
+		var c cursor
+		for _, segment := range p.segments {
+			args := c.absArgs(segment)
+			piece := piece{
+				command: segment.Command,
+				x0:      c.x,
+				y0:      c.y,
+				args:    args,
+			}
+			switch segment.Command {
+			case CommandHLineTo:
+				piece.command = CommandLineTo
+				piece.args[1] = c.y
+			case CommandVLineTo:
+				piece.command = CommandLineTo
+				piece.args[0], piece.args[1] = c.x, args[0]
+			case CommandSCurveTo:
+				x1, y1 := c.reflectedCtrl(CommandCurveTo, CommandSCurveTo)
+				piece.command = CommandCurveTo
+				piece.args = [7]float64{x1, y1, args[0], args[1], args[2], args[3]}
+			case CommandQCurveTo:
+				piece.command = CommandCurveTo
+				piece.args = elevate(c.x, c.y, args[0], args[1], args[2], args[3])
+			case CommandTCurveTo:
+				x1, y1 := c.reflectedCtrl(CommandQCurveTo, CommandTCurveTo)
+				piece.command = CommandCurveTo
+				piece.args = elevate(c.x, c.y, x1, y1, args[0], args[1])
+			case CommandClosePath:
+				piece.args[0], piece.args[1] = c.startX, c.startY
+			}
+			c.advance(segment.Command, args)
+			if !yield(piece) {
+				return
+			}
+		}
+	}
+}
+
+// absArgs returns the arguments of s in absolute coordinates.
+func (c *cursor) absArgs(s Segment) [7]float64 {
+	var args [7]float64
+	copy(args[:], s.Args)
+	if !s.Relative {
+		return args
+	}
+	switch s.Command {
+	case CommandArcTo:
+		args[5] += c.x
+		args[6] += c.y
+	case CommandClosePath:
+	case CommandHLineTo:
+		args[0] += c.x
+	case CommandVLineTo:
+		args[0] += c.y
+	default:
+		for i := 0; i+1 < s.Command.Arity(); i += 2 {
+			args[i] += c.x
+			args[i+1] += c.y
+		}
+	}
+	return args
+}
+
+// advance advances c past a segment with command and absolute arguments args.
+func (c *cursor) advance(command Command, args [7]float64) {
+	switch command {
+	case CommandArcTo:
+		c.x, c.y = args[5], args[6]
+	case CommandClosePath:
+		c.x, c.y = c.startX, c.startY
+	case CommandCurveTo:
+		c.ctrlX, c.ctrlY = args[2], args[3]
+		c.x, c.y = args[4], args[5]
+	case CommandHLineTo:
+		c.x = args[0]
+	case CommandLineTo:
+		c.x, c.y = args[0], args[1]
+	case CommandMoveTo:
+		c.x, c.y = args[0], args[1]
+		c.startX, c.startY = c.x, c.y
+	case CommandQCurveTo, CommandSCurveTo:
+		c.ctrlX, c.ctrlY = args[0], args[1]
+		c.x, c.y = args[2], args[3]
+	case CommandTCurveTo:
+		c.ctrlX, c.ctrlY = c.reflectedCtrl(CommandQCurveTo, CommandTCurveTo)
+		c.x, c.y = args[0], args[1]
+	case CommandVLineTo:
+		c.y = args[0]
+	}
+	c.lastCommand = command
+}
+
+// reflectedCtrl returns the reflection of the last control point about the
+// current point if the last command was command1 or command2, or the current
+// point otherwise.
+func (c *cursor) reflectedCtrl(command1, command2 Command) (float64, float64) {
+	if c.lastCommand != command1 && c.lastCommand != command2 {
+		return c.x, c.y
+	}
+	return 2*c.x - c.ctrlX, 2*c.y - c.ctrlY
+}
+
+// elevate returns the control points of the cubic Bézier curve equivalent to
+// the quadratic Bézier curve from x0, y0 with control point x1, y1 to x, y.
+func elevate(x0, y0, x1, y1, x, y float64) [7]float64 {
+	return [7]float64{
+		x0 + 2*(x1-x0)/3, y0 + 2*(y1-y0)/3,
+		x + 2*(x1-x)/3, y + 2*(y1-y)/3,
+		x, y,
+	}
+}
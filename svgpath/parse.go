@@ -0,0 +1,192 @@
+package svgpath
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// A SyntaxError is a syntax error in path data.
+type SyntaxError struct {
+	Offset int
+	Msg    string
+}
+
+func (e *SyntaxError) Error() string {
+	return "offset " + strconv.Itoa(e.Offset) + ": " + e.Msg
+}
+
+// A parser parses path data.
+type parser struct {
+	s   string
+	pos int
+}
+
+// Parse parses the path data s, as described in
+// https://www.w3.org/TR/SVG2/paths.html#PathDataBNF.
+func Parse(s string) (*Path, error) {
+	p := &parser{s: s}
+	path := New()
+	p.skipWSP()
+	for p.pos < len(p.s) {
+		offset := p.pos
+		letter := p.s[p.pos]
+		command, relative := Command(letter), false
+		if 'a' <= letter && letter <= 'z' {
+			command, relative = Command(letter-'a'+'A'), true
+		}
+		arity, ok := commandArity[command]
+		if !ok {
+			return nil, p.errorf(offset, "%q: invalid command", letter)
+		}
+		if len(path.segments) == 0 && command != CommandMoveTo {
+			return nil, p.errorf(offset, "%q: expected moveto", letter)
+		}
+		p.pos++
+		p.skipWSP()
+
+		if arity == 0 {
+			path.appendSegment(command, relative)
+			continue
+		}
+		for {
+			args, err := p.args(command, arity)
+			if err != nil {
+				return nil, err
+			}
+			path.appendSegment(command, relative, args...)
+			if command == CommandMoveTo {
+				command = CommandLineTo
+			}
+			afterArgs := p.pos
+			p.skipCommaWSP()
+			if !p.atNumber() {
+				p.pos = afterArgs
+				p.skipWSP()
+				break
+			}
+		}
+	}
+	return path, nil
+}
+
+// args parses the arity arguments of a single command.
+func (p *parser) args(command Command, arity int) ([]float64, error) {
+	args := make([]float64, 0, arity)
+	for i := range arity {
+		if i > 0 {
+			p.skipCommaWSP()
+		}
+		var arg float64
+		var err error
+		if command == CommandArcTo && (i == 3 || i == 4) {
+			arg, err = p.flag()
+		} else {
+			arg, err = p.number()
+		}
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+	return args, nil
+}
+
+// atNumber returns whether p is at the start of a number.
+func (p *parser) atNumber() bool {
+	if p.pos >= len(p.s) {
+		return false
+	}
+	switch c := p.s[p.pos]; {
+	case '0' <= c && c <= '9':
+		return true
+	case c == '+' || c == '-' || c == '.':
+		return true
+	default:
+		return false
+	}
+}
+
+func (p *parser) errorf(offset int, format string, args ...any) *SyntaxError {
+	return &SyntaxError{
+		Offset: offset,
+		Msg:    fmt.Sprintf(format, args...),
+	}
+}
+
+// flag parses an arc flag.
+func (p *parser) flag() (float64, error) {
+	if p.pos < len(p.s) {
+		switch p.s[p.pos] {
+		case '0':
+			p.pos++
+			return 0, nil
+		case '1':
+			p.pos++
+			return 1, nil
+		}
+	}
+	return 0, p.errorf(p.pos, "expected flag")
+}
+
+// number parses a number.
+func (p *parser) number() (float64, error) {
+	start := p.pos
+	if p.pos < len(p.s) && (p.s[p.pos] == '+' || p.s[p.pos] == '-') {
+		p.pos++
+	}
+	digits := p.skipDigits()
+	if p.pos < len(p.s) && p.s[p.pos] == '.' {
+		p.pos++
+		digits += p.skipDigits()
+	}
+	if digits == 0 {
+		p.pos = start
+		return 0, p.errorf(start, "expected number")
+	}
+	if p.pos < len(p.s) && (p.s[p.pos] == 'e' || p.s[p.pos] == 'E') {
+		exponentStart := p.pos
+		p.pos++
+		if p.pos < len(p.s) && (p.s[p.pos] == '+' || p.s[p.pos] == '-') {
+			p.pos++
+		}
+		if p.skipDigits() == 0 {
+			p.pos = exponentStart
+		}
+	}
+	f, err := strconv.ParseFloat(p.s[start:p.pos], 64)
+	if err != nil {
+		return 0, p.errorf(start, "%s: invalid number", p.s[start:p.pos])
+	}
+	return f, nil
+}
+
+// skipCommaWSP skips optional whitespace, at most one comma, and optional
+// whitespace.
+func (p *parser) skipCommaWSP() {
+	p.skipWSP()
+	if p.pos < len(p.s) && p.s[p.pos] == ',' {
+		p.pos++
+		p.skipWSP()
+	}
+}
+
+// skipDigits skips decimal digits and returns the number of digits skipped.
+func (p *parser) skipDigits() int {
+	start := p.pos
+	for p.pos < len(p.s) && '0' <= p.s[p.pos] && p.s[p.pos] <= '9' {
+		p.pos++
+	}
+	return p.pos - start
+}
+
+// skipWSP skips whitespace.
+func (p *parser) skipWSP() {
+	for p.pos < len(p.s) {
+		switch p.s[p.pos] {
+		case ' ', '\t', '\n', '\f', '\r':
+			p.pos++
+		default:
+			return
+		}
+	}
+}
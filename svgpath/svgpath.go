@@ -1,29 +1,123 @@
 package svgpath
 
 import (
+	"fmt"
+	"iter"
 	"strconv"
-	"strings"
 )
 
+// A Command is a path command.
+type Command byte
+
+// Commands.
 const (
-	commandClosePath           = "z"
-	commandCurveToAbs          = "C"
-	commandCurveToRel          = "c"
-	commandHorizontalLineToAbs = "H"
-	commandHorizontalLineToRel = "h"
-	commandLineToAbs           = "L"
-	commandLineToRel           = "l"
-	commandMoveToAbs           = "M"
-	commandMoveToRel           = "m"
-	commandSmoothCurveToAbs    = "S"
-	commandSmoothCurveToRel    = "s"
-	commandVerticalLineToAbs   = "V"
-	commandVerticalLineToRel   = "v"
+	CommandArcTo     Command = 'A'
+	CommandClosePath Command = 'Z'
+	CommandCurveTo   Command = 'C'
+	CommandHLineTo   Command = 'H'
+	CommandLineTo    Command = 'L'
+	CommandMoveTo    Command = 'M'
+	CommandQCurveTo  Command = 'Q'
+	CommandSCurveTo  Command = 'S'
+	CommandTCurveTo  Command = 'T'
+	CommandVLineTo   Command = 'V'
 )
 
+// commandArity is the number of arguments of each command.
+var commandArity = map[Command]int{
+	CommandArcTo:     7,
+	CommandClosePath: 0,
+	CommandCurveTo:   6,
+	CommandHLineTo:   1,
+	CommandLineTo:    2,
+	CommandMoveTo:    2,
+	CommandQCurveTo:  4,
+	CommandSCurveTo:  4,
+	CommandTCurveTo:  2,
+	CommandVLineTo:   1,
+}
+
+// Arity returns the number of arguments of c, or -1 if c is not a valid
+// command.
+func (c Command) Arity() int {
+	arity, ok := commandArity[c]
+	if !ok {
+		return -1
+	}
+	return arity
+}
+
+func (c Command) String() string {
+	return string(rune(c))
+}
+
+// A Segment is a single path segment.
+type Segment struct {
+	Command  Command
+	Relative bool
+	Args     []float64
+}
+
+// Letter returns the command letter of s, which is lower case if s is
+// relative.
+func (s Segment) Letter() byte {
+	if s.Relative {
+		return byte(s.Command) + 'a' - 'A'
+	}
+	return byte(s.Command)
+}
+
+// Valid returns whether s has a valid command and the number of arguments that
+// its command requires.
+func (s Segment) Valid() bool {
+	return len(s.Args) == s.Command.Arity()
+}
+
+func (s Segment) String() string {
+	return string(s.appendText(nil, formatFloat))
+}
+
+// appendText appends the text representation of s to b, formatting numbers
+// with formatFloat. If s has the wrong number of arguments for its command
+// then its arguments are written as pairs.
+func (s Segment) appendText(b []byte, formatFloat func(float64) string) []byte {
+	b = append(b, s.Letter())
+	switch {
+	case s.Command == CommandArcTo && len(s.Args) == 7:
+		b = append(b, formatFloat(s.Args[0])...)
+		b = append(b, ',')
+		b = append(b, formatFloat(s.Args[1])...)
+		b = append(b, ' ')
+		b = append(b, formatFloat(s.Args[2])...)
+		b = append(b, ' ')
+		b = appendFlag(b, s.Args[3])
+		b = append(b, ',')
+		b = appendFlag(b, s.Args[4])
+		b = append(b, ' ')
+		b = append(b, formatFloat(s.Args[5])...)
+		b = append(b, ',')
+		b = append(b, formatFloat(s.Args[6])...)
+	default:
+		for i, arg := range s.Args {
+			switch {
+			case i%2 == 1:
+				b = append(b, ',')
+			case i > 0:
+				b = append(b, ' ')
+			}
+			b = append(b, formatFloat(arg)...)
+		}
+	}
+	return b
+}
+
 // A Path is an SVG path.
 type Path struct {
-	commands []string
+	segments  []Segment
+	args      []float64
+	tolerance float64
+	compact   bool
+	precision int
 }
 
 // New returns a new Path.
@@ -31,117 +125,232 @@ func New() *Path {
 	return &Path{}
 }
 
-func (p *Path) String() string {
+// FormatNumbers returns the same as String, except that numbers are formatted
+// with formatFloat. Numbers in compact form are formatted with formatFloat
+// instead of being rounded to the precision passed to Compact.
+func (p *Path) FormatNumbers(formatFloat func(float64) string) string {
 	if p == nil {
 		return ""
 	}
-	return strings.Join(p.commands, " ")
+	if p.compact {
+		return string(p.appendCompact(nil, formatFloat))
+	}
+	var b []byte
+	for i, segment := range p.segments {
+		if i > 0 {
+			b = append(b, ' ')
+		}
+		b = segment.appendText(b, formatFloat)
+	}
+	return string(b)
 }
 
-// CurveToAbs appends an absolute curveto command to p.
-func (p *Path) CurveToAbs(coords ...[]float64) *Path {
-	command := commandCurveToAbs + formatCoords(coords)
-	p.commands = append(p.commands, command)
+func (p *Path) String() string {
+	if p != nil && p.compact {
+		return p.FormatNumbers(decimalFormatter(p.precision))
+	}
+	return p.FormatNumbers(formatFloat)
+}
+
+// AppendSegments appends segments to p. It panics if any segment is not Valid,
+// in which case p is not modified.
+func (p *Path) AppendSegments(segments ...Segment) *Path {
+	for _, segment := range segments {
+		if !segment.Valid() {
+			panic(fmt.Sprintf("svgpath: %s: invalid segment", segment))
+		}
+	}
+	for _, segment := range segments {
+		p.appendSegment(segment.Command, segment.Relative, segment.Args...)
+	}
 	return p
 }
 
-// CurveToRel appends a relative curveto command to p.
-func (p *Path) CurveToRel(coords ...[]float64) *Path {
-	command := commandCurveToRel + formatCoords(coords)
-	p.commands = append(p.commands, command)
+// Segments returns an iterator over p's segments. The Args of each segment
+// share storage with p, so modifying them modifies p.
+func (p *Path) Segments() iter.Seq[Segment] {
+	return func(yield func(Segment) bool) {
+		if p == nil {
+			return
+		}
+		for _, segment := range p.segments {
+			if !yield(segment) {
+				return
+			}
+		}
+	}
+}
+
+// ArcToAbs appends an absolute elliptical arc command to p. The arc has radii
+// rx and ry, its x-axis is rotated by xAxisRotation degrees, largeArc and sweep
+// select which of the four possible arcs is drawn, and it ends at x, y.
+func (p *Path) ArcToAbs(rx, ry, xAxisRotation float64, largeArc, sweep bool, x, y float64) *Path {
+	p.appendSegment(CommandArcTo, false, rx, ry, xAxisRotation, flagValue(largeArc), flagValue(sweep), x, y)
 	return p
 }
 
+// ArcToRel appends a relative elliptical arc command to p. The arguments are
+// as for ArcToAbs except that x and y are relative to the current point.
+func (p *Path) ArcToRel(rx, ry, xAxisRotation float64, largeArc, sweep bool, x, y float64) *Path {
+	p.appendSegment(CommandArcTo, true, rx, ry, xAxisRotation, flagValue(largeArc), flagValue(sweep), x, y)
+	return p
+}
+
+// CurveToAbs appends absolute curveto commands to p, one for each three
+// coordinates. It panics if the number of coordinates is not a multiple of
+// three.
+func (p *Path) CurveToAbs(coords ...[]float64) *Path {
+	return p.appendCoords(CommandCurveTo, false, coords)
+}
+
+// CurveToRel appends relative curveto commands to p, one for each three
+// coordinates. It panics if the number of coordinates is not a multiple of
+// three.
+func (p *Path) CurveToRel(coords ...[]float64) *Path {
+	return p.appendCoords(CommandCurveTo, true, coords)
+}
+
 // ClosePath appends a closepath command to p.
 func (p *Path) ClosePath() *Path {
-	command := commandClosePath
-	p.commands = append(p.commands, command)
+	p.appendSegment(CommandClosePath, true)
 	return p
 }
 
 // HLineToAbs appends an absolute horizontal lineto command to p.
 func (p *Path) HLineToAbs(x float64) *Path {
-	command := commandHorizontalLineToAbs + formatFloat(x)
-	p.commands = append(p.commands, command)
+	p.appendSegment(CommandHLineTo, false, x)
 	return p
 }
 
 // HLineToRel appends a relative horizontal lineto command to p.
 func (p *Path) HLineToRel(x float64) *Path {
-	command := commandHorizontalLineToRel + formatFloat(x)
-	p.commands = append(p.commands, command)
+	p.appendSegment(CommandHLineTo, true, x)
 	return p
 }
 
 // LineToAbs appends an absolute lineto command to p.
 func (p *Path) LineToAbs(coords ...[]float64) *Path {
-	command := commandLineToAbs + formatCoords(coords)
-	p.commands = append(p.commands, command)
-	return p
+	return p.appendCoords(CommandLineTo, false, coords)
 }
 
 // LineToRel appends a relative lineto command to p.
 func (p *Path) LineToRel(coords ...[]float64) *Path {
-	command := commandLineToRel + formatCoords(coords)
-	p.commands = append(p.commands, command)
-	return p
+	return p.appendCoords(CommandLineTo, true, coords)
 }
 
 // MoveToAbs appends an absolute moveto command to p.
 func (p *Path) MoveToAbs(coords ...[]float64) *Path {
-	command := commandMoveToAbs + formatCoords(coords)
-	p.commands = append(p.commands, command)
-	return p
+	return p.appendCoords(CommandMoveTo, false, coords)
 }
 
 // MoveToRel appends a relative moveto command to p.
 func (p *Path) MoveToRel(coords ...[]float64) *Path {
-	command := commandMoveToRel + formatCoords(coords)
-	p.commands = append(p.commands, command)
-	return p
+	return p.appendCoords(CommandMoveTo, true, coords)
+}
+
+// QCurveToAbs appends absolute quadratic Bézier curveto commands to p, one for
+// each two coordinates. It panics if the number of coordinates is odd.
+func (p *Path) QCurveToAbs(coords ...[]float64) *Path {
+	return p.appendCoords(CommandQCurveTo, false, coords)
+}
+
+// QCurveToRel appends relative quadratic Bézier curveto commands to p, one for
+// each two coordinates. It panics if the number of coordinates is odd.
+func (p *Path) QCurveToRel(coords ...[]float64) *Path {
+	return p.appendCoords(CommandQCurveTo, true, coords)
 }
 
-// SCurveToAbs appends an absolute shorthand/smooth curveto command to p.
+// SCurveToAbs appends absolute shorthand/smooth curveto commands to p, one for
+// each two coordinates. It panics if the number of coordinates is odd.
 func (p *Path) SCurveToAbs(coords ...[]float64) *Path {
-	command := commandSmoothCurveToAbs + formatCoords(coords)
-	p.commands = append(p.commands, command)
-	return p
+	return p.appendCoords(CommandSCurveTo, false, coords)
 }
 
-// SCurveToRel appends a relative shorthand/smooth curveto command to p.
+// SCurveToRel appends relative shorthand/smooth curveto commands to p, one for
+// each two coordinates. It panics if the number of coordinates is odd.
 func (p *Path) SCurveToRel(coords ...[]float64) *Path {
-	command := commandSmoothCurveToRel + formatCoords(coords)
-	p.commands = append(p.commands, command)
-	return p
+	return p.appendCoords(CommandSCurveTo, true, coords)
+}
+
+// TCurveToAbs appends an absolute shorthand/smooth quadratic Bézier curveto
+// command to p.
+func (p *Path) TCurveToAbs(coords ...[]float64) *Path {
+	return p.appendCoords(CommandTCurveTo, false, coords)
+}
+
+// TCurveToRel appends a relative shorthand/smooth quadratic Bézier curveto
+// command to p.
+func (p *Path) TCurveToRel(coords ...[]float64) *Path {
+	return p.appendCoords(CommandTCurveTo, true, coords)
 }
 
 // VLineToAbs appends an absolute vertical lineto command to p.
 func (p *Path) VLineToAbs(x float64) *Path {
-	command := commandVerticalLineToAbs + formatFloat(x)
-	p.commands = append(p.commands, command)
+	p.appendSegment(CommandVLineTo, false, x)
 	return p
 }
 
 // VLineToRel appends a relative vertical lineto command to p.
 func (p *Path) VLineToRel(x float64) *Path {
-	command := commandVerticalLineToRel + formatFloat(x)
-	p.commands = append(p.commands, command)
+	p.appendSegment(CommandVLineTo, true, x)
 	return p
 }
 
-func formatCoord(c []float64) string {
-	return formatFloat(c[0]) + "," + formatFloat(c[1])
+// appendCoords appends segments with command to p, taking the arguments from
+// coords. Additional coordinates after a moveto command are treated as
+// implicit lineto commands. It panics if coords do not make up a whole number
+// of commands, in which case p is not modified.
+func (p *Path) appendCoords(command Command, relative bool, coords [][]float64) *Path {
+	arity := command.Arity()
+	if 2*len(coords)%arity != 0 {
+		panic(fmt.Sprintf("svgpath: %s: %d coordinates do not make up a whole number of commands", command, len(coords)))
+	}
+	var args [6]float64
+	n := 0
+	for _, coord := range coords {
+		args[n], args[n+1] = coord[0], coord[1]
+		n += 2
+		if n == arity {
+			p.appendSegment(command, relative, args[:n]...)
+			if command == CommandMoveTo {
+				command = CommandLineTo
+				arity = command.Arity()
+			}
+			n = 0
+		}
+	}
+	return p
 }
 
-func formatCoords(coords [][]float64) string {
-	coordStrs := make([]string, 0, len(coords))
-	for _, coord := range coords {
-		coordStr := formatCoord(coord)
-		coordStrs = append(coordStrs, coordStr)
+// appendSegment appends a single segment to p.
+func (p *Path) appendSegment(command Command, relative bool, args ...float64) {
+	start := len(p.args)
+	p.args = append(p.args, args...)
+	p.segments = append(p.segments, Segment{
+		Command:  command,
+		Relative: relative,
+		Args:     p.args[start:len(p.args):len(p.args)],
+	})
+}
+
+func appendFlag(b []byte, flag float64) []byte {
+	if flag != 0 {
+		return append(b, '1')
 	}
-	return strings.Join(coordStrs, " ")
+	return append(b, '0')
 }
 
+// formatFloat formats f exactly.
 func formatFloat(f float64) string {
+	if f == 0 {
+		f = 0 // Avoid formatting negative zero as "-0".
+	}
 	return strconv.FormatFloat(f, 'f', -1, 64)
 }
+
+func flagValue(flag bool) float64 {
+	if flag {
+		return 1
+	}
+	return 0
+}
@@ -1,6 +1,12 @@
 package svgpath_test
 
 import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"strconv"
 	"testing"
 
 	"github.com/alecthomas/assert/v2"
@@ -25,9 +31,794 @@ func TestString(t *testing.T) {
 				ClosePath(),
 			expected: "M200,300 L400,50 z",
 		},
+		{
+			name: "arcs",
+			path: svgpath.New().
+				MoveToAbs([]float64{300, 200}).
+				HLineToRel(-150).
+				ArcToRel(150, 150, 0, true, false, 150, -150).
+				ClosePath().
+				MoveToAbs([]float64{275, 175}).
+				ArcToAbs(150, 150, 0, false, true, 125, 25),
+			expected: "M300,200 h-150 a150,150 0 1,0 150,-150 z M275,175 A150,150 0 0,1 125,25",
+		},
+		{
+			name: "quadratic",
+			path: svgpath.New().
+				MoveToAbs([]float64{200, 300}).
+				QCurveToAbs([][]float64{{400, 50}, {600, 300}}...).
+				TCurveToAbs([]float64{1000, 300}).
+				QCurveToRel([][]float64{{10, 20}, {30, 40}}...).
+				TCurveToRel([]float64{50, 60}),
+			expected: "M200,300 Q400,50 600,300 T1000,300 q10,20 30,40 t50,60",
+		},
+		{
+			name: "implicit_lineto",
+			path: svgpath.New().
+				MoveToRel([][]float64{{1, 2}, {3, 4}, {5, 6}}...).
+				LineToAbs([][]float64{{7, 8}, {9, 10}}...),
+			expected: "m1,2 l3,4 l5,6 L7,8 L9,10",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			assert.Equal(t, tc.expected, tc.path.String())
 		})
 	}
 }
+
+func TestCompact(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		s         string
+		precision int
+		expected  string
+	}{
+		{
+			name:      "empty",
+			precision: -1,
+		},
+		{
+			name:      "triangle",
+			s:         "M100,100 L300,100 L200,300 z",
+			precision: -1,
+			expected:  "M100 100l200 0L200 300z",
+		},
+		{
+			name:      "leading_zeros",
+			s:         "M0.5,0.5 L-0.25,-0.75 L10.125,3",
+			precision: -1,
+			expected:  "M.5.5-.25-.75 10.125 3",
+		},
+		{
+			name:      "implicit_commands",
+			s:         "m1,2 l3,4 l5,6 L7,8 L9,10 M20,20 M30,30",
+			precision: -1,
+			expected:  "m1 2 3 4 5 6L7 8l2 2M20 20M30 30",
+		},
+		{
+			name:      "arc",
+			s:         "M300,200 h-150 a150,150 0 1,0 150,-150 z",
+			precision: -1,
+			expected:  "M300 200H150A150 150 0 1 0 300 50z",
+		},
+		{
+			name:      "curves",
+			s:         "M0,0 C1,2 3,4 5,6 C7,8 9,10 11,12 S20,20 30,30",
+			precision: -1,
+			expected:  "M0 0C1 2 3 4 5 6c2 2 4 4 6 6s9 8 19 18",
+		},
+		{
+			name:      "relative_exact",
+			s:         "M1.123456,2.98765 l0.00001,0.33333 l0.33333,0.33333",
+			precision: -1,
+			expected:  "M1.123456 2.98765l.00001.33333.33333.33333",
+		},
+		{
+			name:      "precision",
+			s:         "M1.123456,2.98765 l0.00001,0.33333 l0.33333,0.33333",
+			precision: 2,
+			expected:  "M1.12 2.99l0 .33.34.33",
+		},
+		{
+			name:      "precision_zero",
+			s:         "M0.4,-0.4 L10.6,3",
+			precision: 0,
+			expected:  "M0 0 11 3",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			path, err := svgpath.Parse(tc.s)
+			assert.NoError(t, err)
+			actual := path.Compact(tc.precision).String()
+			assert.Equal(t, tc.expected, actual)
+
+			// Check that the compact form describes the same path, to within
+			// the precision.
+			tolerance := 1e-9
+			if tc.precision >= 0 {
+				tolerance = 0.5*math.Pow10(-tc.precision) + 1e-9
+			}
+			actualPath, err := svgpath.Parse(actual)
+			assert.NoError(t, err)
+			expectedSegments := slices.Collect(path.Abs().Segments())
+			actualSegments := slices.Collect(actualPath.Abs().Segments())
+			assert.Equal(t, len(expectedSegments), len(actualSegments))
+			for i, expectedSegment := range expectedSegments {
+				assert.Equal(t, expectedSegment.Command, actualSegments[i].Command)
+				for j, expectedArg := range expectedSegment.Args {
+					assert.True(t, math.Abs(expectedArg-actualSegments[i].Args[j]) <= tolerance)
+				}
+			}
+		})
+	}
+}
+
+func TestFormatNumbers(t *testing.T) {
+	path := svgpath.New().
+		MoveToAbs([]float64{1.0 / 3, 2.0 / 3}).
+		ArcToRel(1.0/3, 1.0/3, 0, false, true, -1.0/3, 0)
+	formatFloat := func(f float64) string {
+		return strconv.FormatFloat(f, 'f', 2, 64)
+	}
+	assert.Equal(t, "M0.33,0.67 a0.33,0.33 0.00 0,1 -0.33,0.00", path.FormatNumbers(formatFloat))
+	assert.Equal(t, "M.33.67A.33.33.00 0 1 .00.67", path.Compact(-1).FormatNumbers(formatFloat))
+}
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		s        string
+		expected string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "whitespace",
+			s:    " \t\n",
+		},
+		{
+			name:     "simple",
+			s:        "M200,300 L400,50 z",
+			expected: "M200,300 L400,50 z",
+		},
+		{
+			name:     "implicit_commands",
+			s:        "M100 200 300 400 L1 2 3 4 Z",
+			expected: "M100,200 L300,400 L1,2 L3,4 Z",
+		},
+		{
+			name:     "missing_separators",
+			s:        "M.5.5-1-2l-.5-.5h10v-10",
+			expected: "M0.5,0.5 L-1,-2 l-0.5,-0.5 h10 v-10",
+		},
+		{
+			name:     "exponents",
+			s:        "M1e2,1E-1 L+1.5e+1 2e0",
+			expected: "M100,0.1 L15,2",
+		},
+		{
+			name:     "curves",
+			s:        "M100,200 C100,100 250,100 250,200 S400,300 400,200 Q1,2 3,4 T5,6 7,8",
+			expected: "M100,200 C100,100 250,100 250,200 S400,300 400,200 Q1,2 3,4 T5,6 T7,8",
+		},
+		{
+			name:     "arcs",
+			s:        "M600,350 l50,-25a25,25 -30 0,1 50,-25 a25 50 -30 1150 -25",
+			expected: "M600,350 l50,-25 a25,25 -30 0,1 50,-25 a25,50 -30 1,1 50,-25",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			path, err := svgpath.Parse(tc.s)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, path.String())
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		s              string
+		expectedOffset int
+	}{
+		{
+			name:           "no_moveto",
+			s:              "L1,2",
+			expectedOffset: 0,
+		},
+		{
+			name:           "invalid_command",
+			s:              "M1,2 X3,4",
+			expectedOffset: 5,
+		},
+		{
+			name:           "missing_argument",
+			s:              "M1,2 L3",
+			expectedOffset: 7,
+		},
+		{
+			name:           "invalid_number",
+			s:              "M1,2 L3,.",
+			expectedOffset: 8,
+		},
+		{
+			name:           "invalid_flag",
+			s:              "M0,0 A1,1 0 2,0 1,1",
+			expectedOffset: 12,
+		},
+		{
+			name:           "number_after_closepath",
+			s:              "M0,0 L1,1 z 2,2",
+			expectedOffset: 12,
+		},
+		{
+			name:           "trailing_comma",
+			s:              "M0,0, L1,1",
+			expectedOffset: 4,
+		},
+		{
+			name:           "double_comma",
+			s:              "M0,,0",
+			expectedOffset: 3,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := svgpath.Parse(tc.s)
+			var syntaxError *svgpath.SyntaxError
+			assert.True(t, errors.As(err, &syntaxError))
+			assert.Equal(t, tc.expectedOffset, syntaxError.Offset)
+		})
+	}
+}
+
+func TestSegments(t *testing.T) {
+	path := svgpath.New().
+		MoveToAbs([]float64{10, 20}).
+		HLineToRel(5).
+		ArcToAbs(1, 2, 30, true, false, 3, 4).
+		ClosePath()
+	assert.Equal(t, []svgpath.Segment{
+		{Command: svgpath.CommandMoveTo, Args: []float64{10, 20}},
+		{Command: svgpath.CommandHLineTo, Relative: true, Args: []float64{5}},
+		{Command: svgpath.CommandArcTo, Args: []float64{1, 2, 30, 1, 0, 3, 4}},
+		{Command: svgpath.CommandClosePath, Relative: true, Args: []float64{}},
+	}, slices.Collect(path.Segments()))
+
+	for segment := range path.Segments() {
+		if segment.Command == svgpath.CommandHLineTo {
+			segment.Args[0] = 15
+		}
+	}
+	assert.Equal(t, "M10,20 h15 A1,2 30 1,0 3,4 z", path.String())
+
+	copied := svgpath.New().AppendSegments(slices.Collect(path.Segments())...)
+	assert.Equal(t, path.String(), copied.String())
+}
+
+func TestAppendSegmentsInvalid(t *testing.T) {
+	for _, segment := range []svgpath.Segment{
+		{Command: svgpath.CommandArcTo, Args: []float64{1, 1}},
+		{Command: svgpath.CommandLineTo, Args: []float64{1, 2, 3}},
+		{Command: svgpath.CommandClosePath, Args: []float64{1}},
+		{Command: svgpath.Command('X')},
+	} {
+		t.Run(segment.String(), func(t *testing.T) {
+			assert.False(t, segment.Valid())
+			path := svgpath.New().MoveToAbs([]float64{0, 0})
+			assert.Panics(t, func() {
+				path.AppendSegments(svgpath.Segment{Command: svgpath.CommandLineTo, Args: []float64{1, 2}}, segment)
+			})
+			assert.Equal(t, "M0,0", path.String())
+		})
+	}
+	assert.Equal(t, "A1,1", svgpath.Segment{Command: svgpath.CommandArcTo, Args: []float64{1, 1}}.String())
+	assert.Equal(t, "L1,2 3", svgpath.Segment{Command: svgpath.CommandLineTo, Args: []float64{1, 2, 3}}.String())
+}
+
+func TestIncompleteCoords(t *testing.T) {
+	assert.Panics(t, func() {
+		svgpath.New().CurveToAbs([]float64{1, 2}, []float64{3, 4})
+	})
+	assert.Panics(t, func() {
+		svgpath.New().CurveToAbs([][]float64{{1, 2}, {3, 4}, {5, 6}, {7, 8}}...)
+	})
+	assert.Panics(t, func() {
+		svgpath.New().QCurveToRel([][]float64{{1, 2}, {3, 4}, {5, 6}}...)
+	})
+	path := svgpath.New().MoveToAbs([]float64{0, 0})
+	assert.Panics(t, func() {
+		path.SCurveToAbs([]float64{1, 2})
+	})
+	assert.Equal(t, "M0,0", path.String())
+	assert.Equal(t, "C1,2 3,4 5,6", svgpath.New().CurveToAbs([][]float64{{1, 2}, {3, 4}, {5, 6}}...).String())
+	assert.Equal(t, "M1,2 L3,4 L5,6", svgpath.New().MoveToAbs([][]float64{{1, 2}, {3, 4}, {5, 6}}...).String())
+}
+
+func TestAbs(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		s        string
+		expected string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:     "marker",
+			s:        "M10,10 h10 v10 z m20,0 h10 v10 z",
+			expected: "M10,10 H20 V20 Z M30,10 H40 V20 Z",
+		},
+		{
+			name:     "curves",
+			s:        "M1,2 c1,1 2,2 3,3 s1,1 2,2 q1,1 2,2 t2,2",
+			expected: "M1,2 C2,3 3,4 4,5 S5,6 6,7 Q7,8 8,9 T10,11",
+		},
+		{
+			name:     "arc",
+			s:        "M1,2 a10,20 30 1,0 5,5",
+			expected: "M1,2 A10,20 30 1,0 6,7",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			path, err := svgpath.Parse(tc.s)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, path.Abs().String())
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		s        string
+		expected string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:     "lines",
+			s:        "M10,10 h10 v10 z m20,0 l10,0 L40,20 Z",
+			expected: "M10,10 L20,10 L20,20 Z M30,10 L40,10 L40,20 Z",
+		},
+		{
+			name:     "smooth_cubic",
+			s:        "M0,0 C0,3 3,6 6,6 S12,3 12,0 s3,3 6,3",
+			expected: "M0,0 C0,3 3,6 6,6 C9,6 12,3 12,0 C12,-3 15,3 18,3",
+		},
+		{
+			name:     "quadratic",
+			s:        "M0,0 Q3,6 6,0 T12,0",
+			expected: "M0,0 C2,4 4,4 6,0 C8,-4 10,-4 12,0",
+		},
+		{
+			name:     "smooth_quadratic_without_previous",
+			s:        "M0,0 T6,3",
+			expected: "M0,0 C0,0 2,1 6,3",
+		},
+		{
+			name:     "degenerate_arcs",
+			s:        "M0,0 A0,10 0 0,1 10,10 A10,10 0 0,1 10,10",
+			expected: "M0,0 L10,10",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			path, err := svgpath.Parse(tc.s)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, path.Normalize().String())
+		})
+	}
+}
+
+func TestNormalizeArc(t *testing.T) {
+	for _, tc := range []struct {
+		name             string
+		s                string
+		cx, cy, r        float64
+		expectedSegments int
+	}{
+		{
+			name:             "quarter",
+			s:                "M0,0 A10,10 0 0,1 10,10",
+			cx:               0,
+			cy:               10,
+			r:                10,
+			expectedSegments: 2,
+		},
+		{
+			name:             "three_quarters",
+			s:                "M0,0 a10,10 0 1,1 10,10",
+			cx:               10,
+			cy:               0,
+			r:                10,
+			expectedSegments: 4,
+		},
+		{
+			name:             "radii_too_small",
+			s:                "M0,0 A5,5 0 0,0 20,0",
+			cx:               10,
+			cy:               0,
+			r:                10,
+			expectedSegments: 3,
+		},
+		{
+			name:             "rotated",
+			s:                "M0,0 A10,10 45 0,0 0,20",
+			cx:               0,
+			cy:               10,
+			r:                10,
+			expectedSegments: 3,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			path, err := svgpath.Parse(tc.s)
+			assert.NoError(t, err)
+			segments := slices.Collect(path.Normalize().Segments())
+			assert.Equal(t, tc.expectedSegments, len(segments))
+			x0, y0 := segments[0].Args[0], segments[0].Args[1]
+			for _, segment := range segments[1:] {
+				assert.Equal(t, svgpath.CommandCurveTo, segment.Command)
+				assert.False(t, segment.Relative)
+				args := segment.Args
+				// Check that the endpoint and the midpoint of each cubic Bézier
+				// curve lie on the circle.
+				x, y := args[4], args[5]
+				midX := (x0 + 3*args[0] + 3*args[2] + x) / 8
+				midY := (y0 + 3*args[1] + 3*args[3] + y) / 8
+				assert.True(t, math.Abs(math.Hypot(x-tc.cx, y-tc.cy)-tc.r) < 1e-6*tc.r)
+				assert.True(t, math.Abs(math.Hypot(midX-tc.cx, midY-tc.cy)-tc.r) < 1e-3*tc.r)
+				x0, y0 = x, y
+			}
+		})
+	}
+}
+
+func TestBBox(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		s        string
+		expected svgpath.BBox
+	}{
+		{
+			name:     "lines",
+			s:        "M10,10 L20,30 h-15 z",
+			expected: svgpath.BBox{MinX: 5, MinY: 10, MaxX: 20, MaxY: 30},
+		},
+		{
+			name:     "trailing_moveto",
+			s:        "M0,0 L1,1 M100,100",
+			expected: svgpath.BBox{MinX: 0, MinY: 0, MaxX: 1, MaxY: 1},
+		},
+		{
+			name:     "cubic",
+			s:        "M0,0 C0,10 10,10 10,0",
+			expected: svgpath.BBox{MinX: 0, MinY: 0, MaxX: 10, MaxY: 7.5},
+		},
+		{
+			name:     "cubic_x_extrema",
+			s:        "M0,0 C-10,0 20,10 10,10",
+			expected: svgpath.BBox{MinX: -2.0711, MinY: 0, MaxX: 12.0711, MaxY: 10},
+		},
+		{
+			name:     "quadratic",
+			s:        "M0,0 Q5,10 10,0 T20,0",
+			expected: svgpath.BBox{MinX: 0, MinY: -5, MaxX: 20, MaxY: 5},
+		},
+		{
+			name:     "semicircle",
+			s:        "M0,0 A10,10 0 0,1 20,0",
+			expected: svgpath.BBox{MinX: 0, MinY: -10, MaxX: 20, MaxY: 0},
+		},
+		{
+			name:     "large_arc",
+			s:        "M0,0 a10,10 0 1,1 10,10",
+			expected: svgpath.BBox{MinX: 0, MinY: -10, MaxX: 20, MaxY: 10},
+		},
+		{
+			name:     "rotated_ellipse",
+			s:        "M0,-20 A20,10 90 0,1 0,20",
+			expected: svgpath.BBox{MinX: 0, MinY: -20, MaxX: 10, MaxY: 20},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			path, err := svgpath.Parse(tc.s)
+			assert.NoError(t, err)
+			actual := path.BBox()
+			assert.True(t, math.Abs(tc.expected.MinX-actual.MinX) < 1e-4)
+			assert.True(t, math.Abs(tc.expected.MinY-actual.MinY) < 1e-4)
+			assert.True(t, math.Abs(tc.expected.MaxX-actual.MaxX) < 1e-4)
+			assert.True(t, math.Abs(tc.expected.MaxY-actual.MaxY) < 1e-4)
+		})
+	}
+}
+
+func TestBBoxQuadratic(t *testing.T) {
+	paths := []string{
+		"M-96.71,125.7 Q61.17,-16.53 43.71,-18.65",
+		"M0,0 Q100,1 50,2",
+		"M1e3,1e3 Q1e3,-1e3 1001,1002 T1003,1e3",
+		"M0,0 Q5,10 10,0 T20,0 t10,-5",
+	}
+	random := rand.New(rand.NewPCG(1, 2)) //nolint:gosec
+	for range 32 {
+		coord := func() float64 {
+			return math.Round(2000*random.Float64()-1000) / 10
+		}
+		paths = append(paths, fmt.Sprintf("M%g,%g Q%g,%g %g,%g T%g,%g", coord(), coord(), coord(), coord(), coord(), coord(), coord(), coord()))
+	}
+	for _, s := range paths {
+		t.Run(s, func(t *testing.T) {
+			path, err := svgpath.Parse(s)
+			assert.NoError(t, err)
+			actual := path.BBox()
+			// Sample the path and check that the samples are inside the
+			// bounding box and that the bounding box is no further from the
+			// samples than the distance between them.
+			expected := svgpath.EmptyBBox()
+			length := path.Length()
+			const n = 1024
+			for i := range n + 1 {
+				x, y := path.PointAtLength(length * float64(i) / n)
+				assert.True(t, actual.MinX-1e-9 <= x && x <= actual.MaxX+1e-9)
+				assert.True(t, actual.MinY-1e-9 <= y && y <= actual.MaxY+1e-9)
+				expected = expected.Extend(x, y)
+			}
+			tolerance := length / n
+			assert.True(t, expected.MinX-actual.MinX < tolerance)
+			assert.True(t, expected.MinY-actual.MinY < tolerance)
+			assert.True(t, actual.MaxX-expected.MaxX < tolerance)
+			assert.True(t, actual.MaxY-expected.MaxY < tolerance)
+		})
+	}
+}
+
+func TestBBoxEmpty(t *testing.T) {
+	assert.True(t, svgpath.New().BBox().Empty())
+	assert.True(t, svgpath.New().MoveToAbs([]float64{1, 2}).BBox().Empty())
+	bbox := svgpath.EmptyBBox().Extend(1, 2)
+	assert.False(t, bbox.Empty())
+	assert.Equal(t, 0.0, bbox.Width())
+	assert.Equal(t, svgpath.BBox{MinX: 1, MinY: 2, MaxX: 3, MaxY: 5}, bbox.Union(svgpath.BBox{MinX: 3, MinY: 4, MaxX: 3, MaxY: 5}))
+}
+
+func TestLength(t *testing.T) {
+	type sample struct {
+		d                                  float64
+		expectedX, expectedY               float64
+		expectedTangentX, expectedTangentY float64
+	}
+	for _, tc := range []struct {
+		name           string
+		s              string
+		expectedLength float64
+		samples        []sample
+	}{
+		{
+			name: "empty",
+			samples: []sample{
+				{d: 0},
+			},
+		},
+		{
+			name: "moveto_only",
+			s:    "M1,2",
+			samples: []sample{
+				{d: 1, expectedX: 1, expectedY: 2},
+			},
+		},
+		{
+			name:           "line",
+			s:              "M0,0 L3,4",
+			expectedLength: 5,
+			samples: []sample{
+				{d: -1, expectedX: 0, expectedY: 0, expectedTangentX: 0.6, expectedTangentY: 0.8},
+				{d: 2.5, expectedX: 1.5, expectedY: 2, expectedTangentX: 0.6, expectedTangentY: 0.8},
+				{d: 6, expectedX: 3, expectedY: 4, expectedTangentX: 0.6, expectedTangentY: 0.8},
+			},
+		},
+		{
+			name:           "closed_square",
+			s:              "M0,0 h10 v10 h-10 z M100,100",
+			expectedLength: 40,
+			samples: []sample{
+				{d: 15, expectedX: 10, expectedY: 5, expectedTangentX: 0, expectedTangentY: 1},
+				{d: 35, expectedX: 0, expectedY: 5, expectedTangentX: 0, expectedTangentY: -1},
+			},
+		},
+		{
+			name:           "subpaths",
+			s:              "M0,0 h10 M20,0 h10",
+			expectedLength: 20,
+			samples: []sample{
+				{d: 15, expectedX: 25, expectedY: 0, expectedTangentX: 1, expectedTangentY: 0},
+			},
+		},
+		{
+			name:           "cubic",
+			s:              "M0,0 C0,0 0,0 3,0",
+			expectedLength: 3,
+			samples: []sample{
+				{d: 0, expectedX: 0, expectedY: 0, expectedTangentX: 1, expectedTangentY: 0},
+				{d: 1, expectedX: 1, expectedY: 0, expectedTangentX: 1, expectedTangentY: 0},
+			},
+		},
+		{
+			name:           "quadratic",
+			s:              "M0,0 Q5,0 10,0",
+			expectedLength: 10,
+			samples: []sample{
+				{d: 7, expectedX: 7, expectedY: 0, expectedTangentX: 1, expectedTangentY: 0},
+			},
+		},
+		{
+			name:           "circle",
+			s:              "M0,0 A10,10 0 0,1 20,0 A10,10 0 0,1 0,0",
+			expectedLength: 20 * math.Pi,
+			samples: []sample{
+				{d: 0, expectedX: 0, expectedY: 0, expectedTangentX: 0, expectedTangentY: -1},
+				{d: 5 * math.Pi, expectedX: 10, expectedY: -10, expectedTangentX: 1, expectedTangentY: 0},
+				{d: 15 * math.Pi, expectedX: 10, expectedY: 10, expectedTangentX: -1, expectedTangentY: 0},
+			},
+		},
+		{
+			name:           "ellipse",
+			s:              "M-20,0 A20,10 0 0,0 20,0 A20,10 0 0,0 -20,0",
+			expectedLength: 96.88448220547776,
+			samples: []sample{
+				{d: 0, expectedX: -20, expectedY: 0, expectedTangentX: 0, expectedTangentY: 1},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			path, err := svgpath.Parse(tc.s)
+			assert.NoError(t, err)
+			assert.True(t, math.Abs(tc.expectedLength-path.Length()) < 1e-6)
+			for _, sample := range tc.samples {
+				x, y := path.PointAtLength(sample.d)
+				assert.True(t, math.Abs(sample.expectedX-x) < 1e-6)
+				assert.True(t, math.Abs(sample.expectedY-y) < 1e-6)
+				tangentX, tangentY := path.TangentAtLength(sample.d)
+				assert.True(t, math.Abs(sample.expectedTangentX-tangentX) < 1e-6)
+				assert.True(t, math.Abs(sample.expectedTangentY-tangentY) < 1e-6)
+			}
+		})
+	}
+}
+
+func TestLengthTolerance(t *testing.T) {
+	path := svgpath.New().
+		MoveToAbs([]float64{0, 0}).
+		CurveToAbs([][]float64{{0, 100}, {100, 100}, {100, 0}}...)
+	exact := path.Length()
+	for _, tolerance := range []float64{1e-1, 1e-3, 1e-9} {
+		assert.True(t, math.Abs(exact-path.Tolerance(tolerance).Length()) < max(tolerance, 1e-6))
+	}
+}
+
+func TestTransform(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		s        string
+		m        [6]float64
+		expected string
+	}{
+		{
+			name: "empty",
+			m:    [6]float64{1, 0, 0, 1, 10, 20},
+		},
+		{
+			name:     "translate",
+			s:        "M10,10 h10 v10 z m20,0 H40 V20 Z",
+			m:        [6]float64{1, 0, 0, 1, 10, 20},
+			expected: "M20,30 h10 v10 z m20,0 H50 V40 Z",
+		},
+		{
+			name:     "leading_relative_moveto",
+			s:        "m10 10 l5 0 m1,1 2,2",
+			m:        [6]float64{1, 0, 0, 1, 100, 0},
+			expected: "m110,10 l5,0 m1,1 l2,2",
+		},
+		{
+			name:     "leading_relative_moveto_scale",
+			s:        "m10 10 l5 0",
+			m:        [6]float64{2, 0, 0, 2, 1, 1},
+			expected: "m21,21 l10,0",
+		},
+		{
+			name:     "scale",
+			s:        "M1,2 C2,3 3,4 4,5 s1,1 2,2 Q7,8 8,9 t2,2",
+			m:        [6]float64{-1, 0, 0, 2, 0, 0},
+			expected: "M-1,4 C-2,6 -3,8 -4,10 s-1,2 -2,4 Q-7,16 -8,18 t-2,4",
+		},
+		{
+			name:     "rotate_hv",
+			s:        "M0,0 H10 V10 h-10 v-10",
+			m:        [6]float64{0, 1, -1, 0, 0, 0},
+			expected: "M0,0 L0,10 L-10,10 l0,-10 l10,0",
+		},
+		{
+			name:     "arc_identity",
+			s:        "M0,0 A10,20 30 1,0 5,5",
+			m:        [6]float64{1, 0, 0, 1, 0, 0},
+			expected: "M0,0 A10,20 30 1,0 5,5",
+		},
+		{
+			name:     "arc_rotate",
+			s:        "M0,0 a10,20 0 1,0 5,5",
+			m:        [6]float64{0, 1, -1, 0, 0, 0},
+			expected: "M0,0 a10,20 90 1,0 -5,5",
+		},
+		{
+			name:     "arc_scale",
+			s:        "M0,0 A10,10 0 0,1 20,0",
+			m:        [6]float64{2, 0, 0, 1, 0, 0},
+			expected: "M0,0 A20,10 0 0,1 40,0",
+		},
+		{
+			name:     "arc_reflect",
+			s:        "M0,0 A10,10 0 0,1 20,0",
+			m:        [6]float64{1, 0, 0, -1, 0, 0},
+			expected: "M0,0 A10,10 0 0,0 20,0",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			path, err := svgpath.Parse(tc.s)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, path.Transform(tc.m).String())
+		})
+	}
+}
+
+func TestTransformArcGeometry(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		s    string
+		m    [6]float64
+	}{
+		{
+			name: "non_uniform_scale",
+			s:    "M10,0 A10,20 30 1,1 0,10",
+			m:    [6]float64{3, 0, 0, 0.5, 7, -2},
+		},
+		{
+			name: "skew",
+			s:    "M10,0 A10,20 30 0,0 0,10",
+			m:    [6]float64{1, 0, 1, 1, 0, 0},
+		},
+		{
+			name: "swap_axes",
+			s:    "M10,0 A10,20 30 1,1 0,10",
+			m:    [6]float64{0, 1, 1, 0, 0, 0},
+		},
+		{
+			name: "rotate_and_scale",
+			s:    "M10,0 A10,20 30 0,1 0,10",
+			m:    [6]float64{1, 2, -2, 1, 0, 0},
+		},
+		{
+			name: "reflect_and_rotate",
+			s:    "M0,0 a15,5 -60 1,0 10,10",
+			m:    [6]float64{0, 2, 1, 0, 5, 5},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			path, err := svgpath.Parse(tc.s)
+			assert.NoError(t, err)
+			// Sample the original path, transform the samples, and check that
+			// they agree with the transformed path.
+			expected := svgpath.EmptyBBox()
+			length := path.Length()
+			const n = 4096
+			for i := range n + 1 {
+				x, y := path.PointAtLength(length * float64(i) / n)
+				expected = expected.Extend(tc.m[0]*x+tc.m[2]*y+tc.m[4], tc.m[1]*x+tc.m[3]*y+tc.m[5])
+			}
+			actual := path.Transform(tc.m).BBox()
+			assert.True(t, math.Abs(expected.MinX-actual.MinX) < 1e-3)
+			assert.True(t, math.Abs(expected.MinY-actual.MinY) < 1e-3)
+			assert.True(t, math.Abs(expected.MaxX-actual.MaxX) < 1e-3)
+			assert.True(t, math.Abs(expected.MaxY-actual.MaxY) < 1e-3)
+		})
+	}
+}
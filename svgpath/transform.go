@@ -0,0 +1,127 @@
+package svgpath
+
+import "math"
+
+// Transform returns a new Path equivalent to p transformed by the affine
+// transformation matrix m. The elements of m are a, b, c, d, e, and f in the
+// order used by the SVG matrix transform function, so the point x, y is
+// transformed to a*x + c*y + e, b*x + d*y + f.
+//
+// Segments keep their commands and remain absolute or relative, except that H
+// and V commands become L commands if m rotates or skews. The radii and x-axis
+// rotation of arcs are recalculated, and their sweep flag is flipped if m is a
+// reflection. A leading relative moveto is relative to the origin, so it is
+// transformed as a point rather than as a vector.
+func (p *Path) Transform(m [6]float64) *Path {
+	transformed := New()
+	if p == nil {
+		return transformed
+	}
+	axisAligned := m[1] == 0 && m[2] == 0
+	var c cursor
+	for i, segment := range p.segments {
+		absArgs := c.absArgs(segment)
+		args := make([]float64, len(segment.Args))
+		copy(args, segment.Args)
+		command := segment.Command
+		switch segment.Command {
+		case CommandArcTo:
+			args[0], args[1], args[2] = transformEllipse(m, args[0], args[1], args[2])
+			if m[0]*m[3]-m[1]*m[2] < 0 {
+				args[4] = flagValue(args[4] == 0)
+			}
+			args[5], args[6] = transformPoint(m, segment.Relative, args[5], args[6])
+		case CommandClosePath:
+		case CommandHLineTo:
+			switch {
+			case !axisAligned:
+				command = CommandLineTo
+				if segment.Relative {
+					args = []float64{m[0] * args[0], m[1] * args[0]}
+				} else {
+					x, y := transformPoint(m, false, absArgs[0], c.y)
+					args = []float64{x, y}
+				}
+			case segment.Relative:
+				args[0] = m[0] * args[0]
+			default:
+				args[0] = m[0]*args[0] + m[4]
+			}
+		case CommandVLineTo:
+			switch {
+			case !axisAligned:
+				command = CommandLineTo
+				if segment.Relative {
+					args = []float64{m[2] * args[0], m[3] * args[0]}
+				} else {
+					x, y := transformPoint(m, false, c.x, absArgs[0])
+					args = []float64{x, y}
+				}
+			case segment.Relative:
+				args[0] = m[3] * args[0]
+			default:
+				args[0] = m[3]*args[0] + m[5]
+			}
+		case CommandMoveTo:
+			args[0], args[1] = transformPoint(m, segment.Relative && i > 0, args[0], args[1])
+		default:
+			for j := 0; j+1 < len(args); j += 2 {
+				args[j], args[j+1] = transformPoint(m, segment.Relative, args[j], args[j+1])
+			}
+		}
+		transformed.appendSegment(command, segment.Relative, args...)
+		c.advance(segment.Command, absArgs)
+	}
+	return transformed
+}
+
+// transformEllipse returns the radii and x-axis rotation in degrees of the
+// ellipse with radii rx and ry and x-axis rotation phi degrees transformed by
+// m. Translations do not affect the shape of an ellipse so only the linear
+// part of m is used.
+func transformEllipse(m [6]float64, rx, ry, phi float64) (float64, float64, float64) {
+	// Rotations, uniform scales, and reflections preserve the shape of the
+	// ellipse, so handle them directly to avoid introducing rounding errors.
+	switch {
+	case m[0] == m[3] && m[1] == -m[2]:
+		scale := math.Hypot(m[0], m[1])
+		return scale * rx, scale * ry, phi + math.Atan2(m[1], m[0])*180/math.Pi
+	case m[0] == -m[3] && m[1] == m[2]:
+		scale := math.Hypot(m[0], m[1])
+		return scale * rx, scale * ry, math.Atan2(m[1], m[0])*180/math.Pi - phi
+	}
+
+	// Find the singular value decomposition of the matrix that maps the unit
+	// circle to the transformed ellipse. The singular values are the new radii
+	// and the angle of the left singular vectors is the new rotation.
+	sinPhi, cosPhi := math.Sincos(phi * math.Pi / 180)
+	a11 := rx * (m[0]*cosPhi + m[2]*sinPhi)
+	a21 := rx * (m[1]*cosPhi + m[3]*sinPhi)
+	a12 := ry * (-m[0]*sinPhi + m[2]*cosPhi)
+	a22 := ry * (-m[1]*sinPhi + m[3]*cosPhi)
+	e, f := (a11+a22)/2, (a11-a22)/2
+	g, h := (a21+a12)/2, (a21-a12)/2
+	q, r := math.Hypot(e, h), math.Hypot(f, g)
+	rx, ry = q+r, math.Abs(q-r)
+	theta := (math.Atan2(g, f) + math.Atan2(h, e)) / 2 * 180 / math.Pi
+	// The same ellipse is described by swapping the radii and rotating by 90
+	// degrees. Choose the description whose x-axis is closest to the
+	// transformed x-axis of the original ellipse, so that, for example, the
+	// identity transform does not change the arc.
+	alpha := math.Atan2(a21, a11) * 180 / math.Pi
+	k := math.Round((alpha - theta) / 90)
+	theta += 90 * k
+	if math.Mod(k, 2) != 0 {
+		rx, ry = ry, rx
+	}
+	return rx, ry, theta
+}
+
+// transformPoint returns the point x, y transformed by m. If relative is true
+// then x, y is a vector and only the linear part of m is used.
+func transformPoint(m [6]float64, relative bool, x, y float64) (float64, float64) {
+	if relative {
+		return m[0]*x + m[2]*y, m[1]*x + m[3]*y
+	}
+	return m[0]*x + m[2]*y + m[4], m[1]*x + m[3]*y + m[5]
+}
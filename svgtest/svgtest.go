@@ -0,0 +1,418 @@
+// Package svgtest provides utilities for testing code that generates SVG.
+//
+// Golden files are updated, instead of compared, when tests are run with the
+// -svgtest.update flag or with the GO_SVG_WRITE_TESTDATA environment variable
+// set to 1.
+package svgtest
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/twpayne/go-svg"
+)
+
+// DefaultTolerance is the default tolerance when comparing numbers.
+const DefaultTolerance = 1e-9
+
+// updateEnvVar is the environment variable that updates golden files when set
+// to 1.
+const updateEnvVar = "GO_SVG_WRITE_TESTDATA"
+
+var update = flag.Bool("svgtest.update", false, "update golden files")
+
+// separators are the characters that separate numbers in attribute values.
+const separators = " \t\n\r,"
+
+// pathDataAttrs are the attributes whose values are path data.
+var pathDataAttrs = map[string]bool{
+	"d":    true,
+	"path": true,
+}
+
+var (
+	// commaRx matches a comma and its surrounding whitespace.
+	commaRx = regexp.MustCompile(`\s*,\s*`)
+
+	// numberRx matches numbers in attribute values.
+	numberRx = regexp.MustCompile(`[-+]?(?:\d+\.?\d*|\.\d+)(?:[eE][-+]?\d+)?`)
+)
+
+// An Option sets an option for comparing documents.
+type Option func(*comparer)
+
+// A comparer compares documents.
+type comparer struct {
+	tolerance   float64
+	exactValues bool
+	diffs       []string
+}
+
+// Names of comment and text nodes, as in XPath.
+const (
+	commentName = "comment()"
+	textName    = "text()"
+)
+
+// A node is an element, comment, or text node in a parsed document. The text
+// of comment and text nodes has leading and trailing whitespace removed.
+type node struct {
+	name     string
+	attrs    map[string]string
+	text     string
+	children []*node
+}
+
+// WithTolerance sets the absolute tolerance when comparing numbers. The
+// default is DefaultTolerance.
+func WithTolerance(tolerance float64) Option {
+	return func(c *comparer) {
+		c.tolerance = tolerance
+	}
+}
+
+// WithExactValues requires attribute values to be identical, instead of
+// ignoring the formatting of numbers and separators.
+func WithExactValues() Option {
+	return func(c *comparer) {
+		c.exactValues = true
+	}
+}
+
+// AssertEquivalent asserts that expected and actual are equivalent SVG
+// documents, as for Diff.
+func AssertEquivalent(tb testing.TB, expected, actual []byte, options ...Option) {
+	tb.Helper()
+	diff, err := Diff(expected, actual, options...)
+	if err != nil {
+		tb.Fatalf("svgtest: %v", err)
+		return
+	}
+	if diff != "" {
+		tb.Errorf("svgtest: documents are not equivalent:\n%s", diff)
+	}
+}
+
+// AssertGolden asserts that actual is equivalent to the contents of filename,
+// as for AssertEquivalent. If golden files are being updated then it writes
+// actual to filename instead.
+func AssertGolden(tb testing.TB, filename string, actual []byte, options ...Option) {
+	tb.Helper()
+	if Update() {
+		if err := os.MkdirAll(filepath.Dir(filename), 0o777); err != nil {
+			tb.Fatalf("svgtest: %v", err)
+			return
+		}
+		if err := os.WriteFile(filename, actual, 0o666); err != nil { //nolint:gosec
+			tb.Fatalf("svgtest: %v", err)
+		}
+		return
+	}
+	expected, err := os.ReadFile(filename)
+	if err != nil {
+		tb.Fatalf("svgtest: %v", err)
+		return
+	}
+	AssertEquivalent(tb, expected, actual, options...)
+}
+
+// AssertGoldenSVG asserts that svgElement, written with two space
+// indentation, is equivalent to the contents of filename, as for AssertGolden.
+func AssertGoldenSVG(tb testing.TB, filename string, svgElement *svg.SVGElement, options ...Option) {
+	tb.Helper()
+	var buffer bytes.Buffer
+	if _, err := svgElement.WriteToIndent(&buffer, "", "  "); err != nil {
+		tb.Fatalf("svgtest: %v", err)
+		return
+	}
+	AssertGolden(tb, filename, buffer.Bytes(), options...)
+}
+
+// Diff returns a description of the differences between the SVG documents
+// expected and actual, or an empty string if they are equivalent. Attribute
+// order, whitespace between elements and around text and comments, and the
+// formatting of numbers in attribute values and the separators next to them
+// are ignored. Other runs of whitespace in attribute values are equivalent to
+// a single space. Numbers are equal if they differ by at most the tolerance.
+// Digits that are part of names, for example in id="layer1", are compared
+// exactly.
+func Diff(expected, actual []byte, options ...Option) (string, error) {
+	c := &comparer{
+		tolerance: DefaultTolerance,
+	}
+	for _, option := range options {
+		option(c)
+	}
+	expectedRoot, err := parse(expected)
+	if err != nil {
+		return "", fmt.Errorf("expected: %w", err)
+	}
+	actualRoot, err := parse(actual)
+	if err != nil {
+		return "", fmt.Errorf("actual: %w", err)
+	}
+	if expectedRoot.name != actualRoot.name {
+		return fmt.Sprintf("/: expected %s, actual %s", expectedRoot, actualRoot), nil
+	}
+	c.compare("/"+expectedRoot.name, expectedRoot, actualRoot)
+	return strings.Join(c.diffs, "\n"), nil
+}
+
+// Update returns whether golden files are being updated.
+func Update() bool {
+	return *update || os.Getenv(updateEnvVar) == "1"
+}
+
+// compare compares the elements expected and actual at path.
+func (c *comparer) compare(path string, expected, actual *node) {
+	names := make([]string, 0, len(expected.attrs)+len(actual.attrs))
+	for name := range expected.attrs {
+		names = append(names, name)
+	}
+	for name := range actual.attrs {
+		if _, ok := expected.attrs[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		expectedValue, expectedOK := expected.attrs[name]
+		actualValue, actualOK := actual.attrs[name]
+		switch {
+		case !actualOK:
+			c.difff("%s: missing attribute %s=%q", path, name, expectedValue)
+		case !expectedOK:
+			c.difff("%s: unexpected attribute %s=%q", path, name, actualValue)
+		case !c.equalValues(name, expectedValue, actualValue):
+			c.difff("%s: attribute %s: expected %q, actual %q", path, name, expectedValue, actualValue)
+		}
+	}
+
+	if expected.text != actual.text {
+		c.difff("%s: text: expected %q, actual %q", path, expected.text, actual.text)
+	}
+
+	counts := make(map[string]int)
+	for i := range max(len(expected.children), len(actual.children)) {
+		switch {
+		case i >= len(actual.children):
+			c.difff("%s: missing child %d: %s", path, i+1, expected.children[i])
+		case i >= len(expected.children):
+			c.difff("%s: unexpected child %d: %s", path, i+1, actual.children[i])
+		case expected.children[i].name != actual.children[i].name:
+			c.difff("%s: child %d: expected %s, actual %s", path, i+1, expected.children[i], actual.children[i])
+		default:
+			name := expected.children[i].name
+			counts[name]++
+			childPath := path + "/" + name + "[" + strconv.Itoa(counts[name]) + "]"
+			c.compare(childPath, expected.children[i], actual.children[i])
+		}
+	}
+}
+
+// difff records a difference.
+func (c *comparer) difff(format string, args ...any) {
+	c.diffs = append(c.diffs, fmt.Sprintf(format, args...))
+}
+
+// equalValues returns whether the values expected and actual of the attribute
+// name are equivalent.
+func (c *comparer) equalValues(name, expected, actual string) bool {
+	if expected == actual {
+		return true
+	}
+	if c.exactValues {
+		return false
+	}
+	pathData := pathDataAttrs[name]
+	expectedNumbers, expectedRest := splitNumbers(expected, pathData)
+	actualNumbers, actualRest := splitNumbers(actual, pathData)
+	if !slices.Equal(expectedRest, actualRest) || len(expectedNumbers) != len(actualNumbers) {
+		return false
+	}
+	for i, expectedNumber := range expectedNumbers {
+		if math.Abs(expectedNumber-actualNumbers[i]) > c.tolerance {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns a short description of n.
+func (n *node) String() string {
+	switch n.name {
+	case commentName:
+		return fmt.Sprintf("<!--%s-->", n.text)
+	case textName:
+		return strconv.Quote(n.text)
+	}
+	return "<" + n.name + ">"
+}
+
+// attrName returns the name of an attribute, with a conventional prefix for
+// well-known namespaces.
+func attrName(name xml.Name) string {
+	switch name.Space {
+	case "":
+		return name.Local
+	case "xmlns":
+		return "xmlns:" + name.Local
+	case "http://www.w3.org/1999/xlink":
+		return "xlink:" + name.Local
+	case "http://www.w3.org/XML/1998/namespace":
+		return "xml:" + name.Local
+	default:
+		return name.Space + ":" + name.Local
+	}
+}
+
+// normalizeSeparators returns s, a part of an attribute value, with its
+// separators normalized. Whitespace and commas are removed from the start of s
+// if afterNumber is true and from its end if beforeNumber is true. Otherwise,
+// leading and trailing whitespace is removed. Remaining runs of whitespace are
+// replaced by a single space and whitespace around commas is removed.
+func normalizeSeparators(s string, afterNumber, beforeNumber bool) string {
+	if afterNumber {
+		s = strings.TrimLeft(s, separators)
+	}
+	if beforeNumber {
+		s = strings.TrimRight(s, separators)
+	}
+	s = strings.Join(strings.Fields(s), " ")
+	return commaRx.ReplaceAllString(s, ",")
+}
+
+// parse parses data into a tree of nodes.
+func parse(data []byte) (*node, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var root *node
+	var stack []*node
+	for {
+		token, err := decoder.Token()
+		switch {
+		case errors.Is(err, io.EOF):
+			if root == nil {
+				return nil, errors.New("no root element")
+			}
+			return root, nil
+		case err != nil:
+			return nil, err
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			n := &node{
+				name:  token.Name.Local,
+				attrs: make(map[string]string, len(token.Attr)),
+			}
+			for _, attr := range token.Attr {
+				n.attrs[attrName(attr.Name)] = attr.Value
+			}
+			if len(stack) == 0 {
+				if root != nil {
+					return nil, errors.New("multiple root elements")
+				}
+				root = n
+			} else {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, n)
+			}
+			stack = append(stack, n)
+		case xml.EndElement:
+			n := stack[len(stack)-1]
+			n.children = slices.DeleteFunc(n.children, func(child *node) bool {
+				if child.name != textName {
+					return false
+				}
+				child.text = strings.TrimSpace(child.text)
+				return child.text == ""
+			})
+			stack = stack[:len(stack)-1]
+		case xml.Comment:
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, &node{
+					name: commentName,
+					text: strings.TrimSpace(string(token)),
+				})
+			}
+		case xml.CharData:
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				if len(parent.children) > 0 && parent.children[len(parent.children)-1].name == textName {
+					parent.children[len(parent.children)-1].text += string(token)
+				} else {
+					parent.children = append(parent.children, &node{
+						name: textName,
+						text: string(token),
+					})
+				}
+			}
+		}
+	}
+}
+
+// isNumber returns whether the match of numberRx in s from start to end is a
+// number, rather than part of a name such as "layer1". Numbers must start at
+// the start of s or after a separator, opening parenthesis, semicolon, or
+// colon, and end at the end of s or before a separator, closing parenthesis,
+// semicolon, unit, or percent sign. If pathData is true then numbers may also
+// be adjacent to command letters and to other numbers.
+func isNumber(s string, start, end int, pathData bool) bool {
+	if start > 0 {
+		switch prev := s[start-1]; {
+		case strings.IndexByte(separators+"(;:", prev) != -1:
+		case pathData && (isLetter(prev) || '0' <= prev && prev <= '9' || prev == '.'):
+		default:
+			return false
+		}
+	}
+	if end < len(s) {
+		switch next := s[end]; {
+		case strings.IndexByte(separators+");%", next) != -1:
+		case isLetter(next):
+		case pathData && (next == '.' || next == '-' || next == '+'):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// isLetter returns whether c is an ASCII letter.
+func isLetter(c byte) bool {
+	return 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z'
+}
+
+// splitNumbers splits s into its numbers and the remaining text between them,
+// ignoring whitespace and commas next to numbers. pathData is as for isNumber.
+func splitNumbers(s string, pathData bool) ([]float64, []string) {
+	var numbers []float64
+	var rest []string
+	last := 0
+	for _, match := range numberRx.FindAllStringIndex(s, -1) {
+		if !isNumber(s, match[0], match[1], pathData) {
+			continue
+		}
+		number, err := strconv.ParseFloat(s[match[0]:match[1]], 64)
+		if err != nil {
+			continue
+		}
+		numbers = append(numbers, number)
+		rest = append(rest, normalizeSeparators(s[last:match[0]], len(numbers) > 1, true))
+		last = match[1]
+	}
+	rest = append(rest, normalizeSeparators(s[last:], len(numbers) > 0, false))
+	return numbers, rest
+}
@@ -0,0 +1,254 @@
+package svgtest_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-svg"
+	"github.com/twpayne/go-svg/svgtest"
+)
+
+// A recordingTB is a testing.TB that records failures instead of reporting
+// them.
+type recordingTB struct {
+	testing.TB
+	errors []string
+	fatals []string
+}
+
+func (tb *recordingTB) Errorf(format string, args ...any) {
+	tb.errors = append(tb.errors, fmt.Sprintf(format, args...))
+}
+
+func (tb *recordingTB) Fatalf(format string, args ...any) {
+	tb.fatals = append(tb.fatals, fmt.Sprintf(format, args...))
+}
+
+func (tb *recordingTB) Helper() {}
+
+func TestDiff(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		expected string
+		actual   string
+		options  []svgtest.Option
+		diff     string
+	}{
+		{
+			name:     "identical",
+			expected: `<svg xmlns="http://www.w3.org/2000/svg"><rect x="1"></rect></svg>`,
+			actual:   `<svg xmlns="http://www.w3.org/2000/svg"><rect x="1"></rect></svg>`,
+		},
+		{
+			name:     "attr_order",
+			expected: `<svg><rect x="1" y="2"/></svg>`,
+			actual:   `<svg><rect y="2" x="1"/></svg>`,
+		},
+		{
+			name:     "whitespace",
+			expected: "<svg>\n  <title> Title </title>\n  <rect/>\n</svg>",
+			actual:   `<svg><title>Title</title><rect></rect></svg>`,
+		},
+		{
+			name:     "number_formatting",
+			expected: `<svg viewBox="0,0,100,100"><path d="M0.5,1 L10,10"/></svg>`,
+			actual:   `<svg viewBox="0 0 1e2 100"><path d="M.5 1L10 10"/></svg>`,
+		},
+		{
+			name:     "whitespace_in_values",
+			expected: `<svg><text font-family="Arial, sans-serif" class="a  b"/></svg>`,
+			actual:   `<svg><text font-family="Arial,sans-serif" class=" a b "/></svg>`,
+		},
+		{
+			name:     "separators_in_words",
+			expected: `<svg><rect class="foo bar"/><path d="M0,0 L 1 1"/></svg>`,
+			actual:   `<svg><rect class="foobar"/><path d="M0,0L1 1"/></svg>`,
+			diff:     `/svg/rect[1]: attribute class: expected "foo bar", actual "foobar"`,
+		},
+		{
+			name:     "number_within_tolerance",
+			expected: `<svg><rect x="0.3"/></svg>`,
+			actual:   `<svg><rect x="0.30000000000000004"/></svg>`,
+		},
+		{
+			name:     "number_outside_tolerance",
+			expected: `<svg><rect x="0.3"/></svg>`,
+			actual:   `<svg><rect x="0.301"/></svg>`,
+			diff:     `/svg/rect[1]: attribute x: expected "0.3", actual "0.301"`,
+		},
+		{
+			name:     "with_tolerance",
+			expected: `<svg><rect x="0.3"/></svg>`,
+			actual:   `<svg><rect x="0.301"/></svg>`,
+			options:  []svgtest.Option{svgtest.WithTolerance(0.01)},
+		},
+		{
+			name:     "with_exact_values",
+			expected: `<svg viewBox="0 0 100 100"><rect x="1"/></svg>`,
+			actual:   `<svg viewBox="0,0,100,100"><rect x="1.0"/></svg>`,
+			options:  []svgtest.Option{svgtest.WithExactValues()},
+			diff: strings.Join([]string{
+				`/svg: attribute viewBox: expected "0 0 100 100", actual "0,0,100,100"`,
+				`/svg/rect[1]: attribute x: expected "1", actual "1.0"`,
+			}, "\n"),
+		},
+		{
+			name:     "digits_in_names",
+			expected: `<svg><g id="layer1" class="c2"><use href="#a1"/></g></svg>`,
+			actual:   `<svg><g id="layer01" class="c2.0"><use href="#a1e0"/></g></svg>`,
+			diff: strings.Join([]string{
+				`/svg/g[1]: attribute class: expected "c2", actual "c2.0"`,
+				`/svg/g[1]: attribute id: expected "layer1", actual "layer01"`,
+				`/svg/g[1]/use[1]: attribute href: expected "#a1", actual "#a1e0"`,
+			}, "\n"),
+		},
+		{
+			name:     "numbers_in_values",
+			expected: `<svg><rect transform="rotate(45)" width="50%" x="1em"/><animate keyTimes="0;0.5;1" begin="0:30"/></svg>`,
+			actual:   `<svg><rect transform="rotate(45.0)" width="50.0%" x="1.0em"/><animate keyTimes="0;.5;1.0" begin="0:30.0"/></svg>`,
+		},
+		{
+			name:     "path_data",
+			expected: `<svg><path d="M0.5,0.5 L-1,1e1z"/><animateMotion path="M0,0 C1,1 2,2 3,3"/></svg>`,
+			actual:   `<svg><path d="M.5.5L-1 10z"/><animateMotion path="M0 0C1 1 2 2 3 3"/></svg>`,
+		},
+		{
+			name:     "units",
+			expected: `<svg><rect x="1cm"/></svg>`,
+			actual:   `<svg><rect x="1mm"/></svg>`,
+			diff:     `/svg/rect[1]: attribute x: expected "1cm", actual "1mm"`,
+		},
+		{
+			name:     "attrs",
+			expected: `<svg><g><rect/><rect fill="red" stroke="blue"/></g></svg>`,
+			actual:   `<svg><g><rect/><rect fill="green" opacity="0.5"/></g></svg>`,
+			diff: strings.Join([]string{
+				`/svg/g[1]/rect[2]: attribute fill: expected "red", actual "green"`,
+				`/svg/g[1]/rect[2]: unexpected attribute opacity="0.5"`,
+				`/svg/g[1]/rect[2]: missing attribute stroke="blue"`,
+			}, "\n"),
+		},
+		{
+			name:     "xlink",
+			expected: `<svg xmlns:xlink="http://www.w3.org/1999/xlink"><use xlink:href="#a"/></svg>`,
+			actual:   `<svg xmlns:xlink="http://www.w3.org/1999/xlink"><use xlink:href="#b"/></svg>`,
+			diff:     `/svg/use[1]: attribute xlink:href: expected "#a", actual "#b"`,
+		},
+		{
+			name:     "text",
+			expected: `<svg><text>Hello</text></svg>`,
+			actual:   `<svg><text>World</text></svg>`,
+			diff:     `/svg/text[1]/text()[1]: text: expected "Hello", actual "World"`,
+		},
+		{
+			name:     "text_order",
+			expected: `<svg><text>a<tspan>b</tspan>c</text></svg>`,
+			actual:   `<svg><text>ac<tspan>b</tspan></text></svg>`,
+			diff: strings.Join([]string{
+				`/svg/text[1]/text()[1]: text: expected "a", actual "ac"`,
+				`/svg/text[1]: missing child 3: "c"`,
+			}, "\n"),
+		},
+		{
+			name:     "text_cdata",
+			expected: `<svg><style>rect { fill: red; }</style></svg>`,
+			actual:   "<svg><style>\n<![CDATA[rect { fill: ]]>red; }\n</style></svg>",
+		},
+		{
+			name:     "comment",
+			expected: `<svg><!-- a --><rect/></svg>`,
+			actual:   `<svg><!-- b --><rect/></svg>`,
+			diff:     `/svg/comment()[1]: text: expected "a", actual "b"`,
+		},
+		{
+			name:     "children",
+			expected: `<svg><rect/><circle/><!-- comment --></svg>`,
+			actual:   `<svg><rect/><ellipse/></svg>`,
+			diff: strings.Join([]string{
+				`/svg: child 2: expected <circle>, actual <ellipse>`,
+				`/svg: missing child 3: <!--comment-->`,
+			}, "\n"),
+		},
+		{
+			name:     "unexpected_child",
+			expected: `<svg></svg>`,
+			actual:   `<svg><rect/></svg>`,
+			diff:     `/svg: unexpected child 1: <rect>`,
+		},
+		{
+			name:     "root",
+			expected: `<svg></svg>`,
+			actual:   `<g></g>`,
+			diff:     `/: expected <svg>, actual <g>`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			diff, err := svgtest.Diff([]byte(tc.expected), []byte(tc.actual), tc.options...)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.diff, diff)
+		})
+	}
+}
+
+func TestDiffErrors(t *testing.T) {
+	_, err := svgtest.Diff([]byte(`<svg>`), []byte(`<svg/>`))
+	assert.Error(t, err)
+	_, err = svgtest.Diff([]byte(`<svg/>`), []byte(``))
+	assert.EqualError(t, err, "actual: no root element")
+	_, err = svgtest.Diff([]byte(`<svg/>`), []byte(`<svg/><svg/>`))
+	assert.EqualError(t, err, "actual: multiple root elements")
+}
+
+func TestAssertEquivalent(t *testing.T) {
+	tb := &recordingTB{TB: t}
+	svgtest.AssertEquivalent(tb, []byte(`<svg><rect x="1" y="2"/></svg>`), []byte(`<svg><rect y="2.0" x="1"/></svg>`))
+	assert.Zero(t, tb.errors)
+	assert.Zero(t, tb.fatals)
+
+	svgtest.AssertEquivalent(tb, []byte(`<svg><rect x="1"/></svg>`), []byte(`<svg><rect x="2"/></svg>`))
+	assert.Equal(t, []string{
+		"svgtest: documents are not equivalent:\n" +
+			`/svg/rect[1]: attribute x: expected "1", actual "2"`,
+	}, tb.errors)
+	assert.Zero(t, tb.fatals)
+}
+
+func TestAssertGolden(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "testdata", "golden.svg")
+	svgElement := svg.New().AppendChildren(
+		svg.Rect().XYWidthHeight(1, 2, 3, 4, svg.Number),
+	)
+
+	tb := &recordingTB{TB: t}
+	svgtest.AssertGoldenSVG(tb, filename, svgElement)
+	assert.Zero(t, tb.errors)
+	assert.Equal(t, 1, len(tb.fatals))
+
+	t.Setenv("GO_SVG_WRITE_TESTDATA", "1")
+	assert.True(t, svgtest.Update())
+	svgtest.AssertGoldenSVG(tb, filename, svgElement)
+	data, err := os.ReadFile(filename)
+	assert.NoError(t, err)
+	assert.Equal(t, strings.Join([]string{
+		`<svg version="1.1" xmlns="http://www.w3.org/2000/svg">`,
+		`  <rect height="4" width="3" x="1" y="2"></rect>`,
+		`</svg>`,
+	}, "\n"), string(data))
+
+	t.Setenv("GO_SVG_WRITE_TESTDATA", "")
+	tb = &recordingTB{TB: t}
+	svgtest.AssertGolden(tb, filename, []byte(`<svg xmlns="http://www.w3.org/2000/svg" version="1.1"><rect x="1" y="2" width="3" height="4"/></svg>`))
+	assert.Zero(t, tb.errors)
+	assert.Zero(t, tb.fatals)
+
+	svgtest.AssertGolden(tb, filename, []byte(`<svg xmlns="http://www.w3.org/2000/svg" version="1.1"><rect x="1" y="2" width="3" height="5"/></svg>`))
+	assert.Equal(t, []string{
+		"svgtest: documents are not equivalent:\n" +
+			`/svg/rect[1]: attribute height: expected "4", actual "5"`,
+	}, tb.errors)
+}
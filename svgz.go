@@ -0,0 +1,43 @@
+package svg
+
+import (
+	"compress/gzip"
+	"io"
+)
+
+// ParseSVGZ parses a gzip-compressed SVG document from r.
+func ParseSVGZ(r io.Reader) (*SVGElement, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gzipReader.Close()
+	svgElement, err := Parse(gzipReader)
+	if err != nil {
+		return nil, err
+	}
+	// Read the rest of the stream so that its checksum is verified.
+	if _, err := io.Copy(io.Discard, gzipReader); err != nil {
+		return nil, err
+	}
+	return svgElement, nil
+}
+
+// WriteSVGZ writes e to w compressed with gzip, as for WriteToIndent. It
+// returns the number of compressed bytes written to w and the number of
+// uncompressed bytes.
+func (e *SVGElement) WriteSVGZ(w io.Writer, prefix, indent string) (int64, int64, error) {
+	wc := &writeCounter{w: w}
+	gzipWriter, err := gzip.NewWriterLevel(wc, gzip.BestCompression)
+	if err != nil {
+		return 0, 0, err
+	}
+	uncompressed, err := e.WriteToIndent(gzipWriter, prefix, indent)
+	if err != nil {
+		return int64(wc.bytesWritten), uncompressed, err
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return int64(wc.bytesWritten), uncompressed, err
+	}
+	return int64(wc.bytesWritten), uncompressed, nil
+}
@@ -0,0 +1,286 @@
+package svg
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// A Transform is an affine transformation matrix attribute value. The elements
+// are a, b, c, d, e, and f in the order used by the SVG matrix transform
+// function, so the point x, y is transformed to a*x + c*y + e, b*x + d*y + f.
+type Transform [6]float64
+
+// A TransformFunction is a single transform function, for example rotate(45)
+// or translate(10 20).
+type TransformFunction struct {
+	Name string
+	Args []float64
+}
+
+// A TransformList is a list of transform functions attribute value. Parsed
+// transform attributes are TransformLists, so that they are written in the
+// same form as they were read. The combined matrix is only computed when it is
+// needed.
+type TransformList []TransformFunction
+
+// Identity returns the identity Transform.
+func Identity() Transform {
+	return Transform{1, 0, 0, 1, 0, 0}
+}
+
+// Matrix returns the Transform with elements a, b, c, d, e, and f.
+func Matrix(a, b, c, d, e, f float64) Transform {
+	return Transform{a, b, c, d, e, f}
+}
+
+// Rotate returns a Transform that rotates by angle degrees about the point cx,
+// cy.
+func Rotate(angle, cx, cy float64) Transform {
+	sin, cos := sincosDeg(angle)
+	rotate := Transform{cos, sin, -sin, cos, 0, 0}
+	if cx == 0 && cy == 0 {
+		return rotate
+	}
+	return Translate(cx, cy).Mul(rotate).Mul(Translate(-cx, -cy))
+}
+
+// Scale returns a Transform that scales by sx horizontally and sy vertically.
+func Scale(sx, sy float64) Transform {
+	return Transform{sx, 0, 0, sy, 0, 0}
+}
+
+// SkewX returns a Transform that skews along the x axis by angle degrees.
+func SkewX(angle float64) Transform {
+	return Transform{1, 0, tanDeg(angle), 1, 0, 0}
+}
+
+// SkewY returns a Transform that skews along the y axis by angle degrees.
+func SkewY(angle float64) Transform {
+	return Transform{1, tanDeg(angle), 0, 1, 0, 0}
+}
+
+// Translate returns a Transform that translates by tx, ty.
+func Translate(tx, ty float64) Transform {
+	return Transform{1, 0, 0, 1, tx, ty}
+}
+
+// Apply returns the point x, y transformed by t.
+func (t Transform) Apply(x, y float64) (float64, float64) {
+	return t[0]*x + t[2]*y + t[4], t[1]*x + t[3]*y + t[5]
+}
+
+// Inverse returns the inverse of t. It returns false if t is not invertible.
+func (t Transform) Inverse() (Transform, bool) {
+	det := t[0]*t[3] - t[1]*t[2]
+	if det == 0 || math.IsNaN(det) || math.IsInf(det, 0) {
+		return Transform{}, false
+	}
+	return Transform{
+		t[3] / det,
+		-t[1] / det,
+		-t[2] / det,
+		t[0] / det,
+		(t[2]*t[5] - t[3]*t[4]) / det,
+		(t[1]*t[4] - t[0]*t[5]) / det,
+	}, true
+}
+
+// Mul returns the composition of t and other, which applies other first and
+// then t. This matches the order of a transform attribute, so
+// Translate(10, 20).Mul(Rotate(45, 0, 0)) is equivalent to "translate(10 20)
+// rotate(45)".
+func (t Transform) Mul(other Transform) Transform {
+	return Transform{
+		t[0]*other[0] + t[2]*other[1],
+		t[1]*other[0] + t[3]*other[1],
+		t[0]*other[2] + t[2]*other[3],
+		t[1]*other[2] + t[3]*other[3],
+		t[0]*other[4] + t[2]*other[5] + t[4],
+		t[1]*other[4] + t[3]*other[5] + t[5],
+	}
+}
+
+// FormatNumbers implements NumberFormatter.FormatNumbers. Translations and
+// scalings are written with the shorter translate and scale functions, after
+// rounding. The identity Transform is written as the empty string.
+func (t Transform) FormatNumbers(formatFloat func(float64) string) string {
+	var strs [6]string
+	for i, arg := range t {
+		strs[i] = formatFloat(arg)
+	}
+	switch {
+	case strs == [6]string{"1", "0", "0", "1", "0", "0"}:
+		return ""
+	case strs[0] == "1" && strs[1] == "0" && strs[2] == "0" && strs[3] == "1":
+		if strs[5] == "0" {
+			return "translate(" + strs[4] + ")"
+		}
+		return "translate(" + strs[4] + " " + strs[5] + ")"
+	case strs[1] == "0" && strs[2] == "0" && strs[4] == "0" && strs[5] == "0":
+		if strs[0] == strs[3] {
+			return "scale(" + strs[0] + ")"
+		}
+		return "scale(" + strs[0] + " " + strs[3] + ")"
+	default:
+		return "matrix(" + strings.Join(strs[:], " ") + ")"
+	}
+}
+
+func (t Transform) String() string {
+	return t.FormatNumbers(formatFloat)
+}
+
+// Matrix returns the Transform of f. It returns the identity Transform if f is
+// not a valid transform function.
+func (f TransformFunction) Matrix() Transform {
+	transform, ok := f.matrix()
+	if !ok {
+		return Identity()
+	}
+	return transform
+}
+
+// FormatNumbers implements NumberFormatter.FormatNumbers.
+func (f TransformFunction) FormatNumbers(formatFloat func(float64) string) string {
+	argStrs := make([]string, 0, len(f.Args))
+	for _, arg := range f.Args {
+		argStrs = append(argStrs, formatFloat(arg))
+	}
+	return f.Name + "(" + strings.Join(argStrs, " ") + ")"
+}
+
+func (f TransformFunction) String() string {
+	return f.FormatNumbers(formatFloat)
+}
+
+// matrix returns the Transform of f and whether f is a valid transform
+// function.
+func (f TransformFunction) matrix() (Transform, bool) {
+	args := f.Args
+	switch {
+	case f.Name == "matrix" && len(args) == 6:
+		return Matrix(args[0], args[1], args[2], args[3], args[4], args[5]), true
+	case f.Name == "translate" && len(args) == 1:
+		return Translate(args[0], 0), true
+	case f.Name == "translate" && len(args) == 2:
+		return Translate(args[0], args[1]), true
+	case f.Name == "scale" && len(args) == 1:
+		return Scale(args[0], args[0]), true
+	case f.Name == "scale" && len(args) == 2:
+		return Scale(args[0], args[1]), true
+	case f.Name == "rotate" && len(args) == 1:
+		return Rotate(args[0], 0, 0), true
+	case f.Name == "rotate" && len(args) == 3:
+		return Rotate(args[0], args[1], args[2]), true
+	case f.Name == "skewX" && len(args) == 1:
+		return SkewX(args[0]), true
+	case f.Name == "skewY" && len(args) == 1:
+		return SkewY(args[0]), true
+	default:
+		return Transform{}, false
+	}
+}
+
+// Apply returns the point x, y transformed by tl.
+func (tl TransformList) Apply(x, y float64) (float64, float64) {
+	return tl.Matrix().Apply(x, y)
+}
+
+// Inverse returns the inverse of tl. It returns false if tl is not invertible.
+func (tl TransformList) Inverse() (Transform, bool) {
+	return tl.Matrix().Inverse()
+}
+
+// Matrix returns the composition of the transform functions in tl.
+func (tl TransformList) Matrix() Transform {
+	transform := Identity()
+	for _, function := range tl {
+		transform = transform.Mul(function.Matrix())
+	}
+	return transform
+}
+
+// Mul returns the composition of tl and other, which applies other first and
+// then tl.
+func (tl TransformList) Mul(other Transform) Transform {
+	return tl.Matrix().Mul(other)
+}
+
+// FormatNumbers implements NumberFormatter.FormatNumbers.
+func (tl TransformList) FormatNumbers(formatFloat func(float64) string) string {
+	functionStrs := make([]string, 0, len(tl))
+	for _, function := range tl {
+		functionStrs = append(functionStrs, function.FormatNumbers(formatFloat))
+	}
+	return strings.Join(functionStrs, " ")
+}
+
+func (tl TransformList) String() string {
+	return tl.FormatNumbers(formatFloat)
+}
+
+// parseTransform parses a list of transform functions, for example
+// "translate(10,20) rotate(45)".
+func parseTransform(s string) (TransformList, error) {
+	transformList := TransformList{}
+	isSeparator := func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t' || r == '\n' || r == '\r'
+	}
+	for rest := strings.TrimFunc(s, isSeparator); rest != ""; rest = strings.TrimLeftFunc(rest, isSeparator) {
+		name, argsAndRest, ok := strings.Cut(rest, "(")
+		if !ok {
+			return nil, fmt.Errorf("%q: expected (", s)
+		}
+		argsStr, remainder, ok := strings.Cut(argsAndRest, ")")
+		if !ok {
+			return nil, fmt.Errorf("%q: expected )", s)
+		}
+		args, err := parseNumbers(argsStr)
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", s, err)
+		}
+		function := TransformFunction{
+			Name: strings.TrimSpace(name),
+			Args: args,
+		}
+		if _, ok := function.matrix(); !ok {
+			return nil, fmt.Errorf("%q: invalid transform function %s with %d arguments", s, function.Name, len(args))
+		}
+		transformList = append(transformList, function)
+		rest = remainder
+	}
+	return transformList, nil
+}
+
+// sincosDeg returns the sine and cosine of angle degrees, exactly for
+// multiples of 90 degrees.
+func sincosDeg(angle float64) (float64, float64) {
+	switch math.Mod(angle, 360) {
+	case 0:
+		return 0, 1
+	case 90, -270:
+		return 1, 0
+	case 180, -180:
+		return 0, -1
+	case 270, -90:
+		return -1, 0
+	default:
+		return math.Sincos(angle * math.Pi / 180)
+	}
+}
+
+// tanDeg returns the tangent of angle degrees, exactly for multiples of 45
+// degrees.
+func tanDeg(angle float64) float64 {
+	switch math.Mod(angle, 180) {
+	case 0:
+		return 0
+	case 45, -135:
+		return 1
+	case 135, -45:
+		return -1
+	default:
+		return math.Tan(angle * math.Pi / 180)
+	}
+}
@@ -1,15 +1,28 @@
 package svg
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
+
+	"github.com/twpayne/go-svg/svgpath"
 )
 
-// An AttrValue is an attribute value.
+// An AttrValue is an attribute value. An attribute is set if and only if it is
+// present in its element's Attrs, and every set attribute is written, even if
+// its value is zero or empty.
 type AttrValue interface {
 	String() string
 }
 
+// A NumberFormatter is an AttrValue that contains numbers. FormatNumbers
+// returns the same as String, except that numbers are formatted with
+// formatFloat.
+type NumberFormatter interface {
+	AttrValue
+	FormatNumbers(formatFloat func(float64) string) string
+}
+
 // An AngleUnit is an angle unit.
 type AngleUnit int
 
@@ -66,43 +79,57 @@ func Rad(rad float64) Angle {
 	}
 }
 
+// FormatNumbers implements NumberFormatter.FormatNumbers.
+func (a Angle) FormatNumbers(formatFloat func(float64) string) string {
+	return formatFloat(a.Value) + a.Unit.String()
+}
+
 func (a Angle) String() string {
-	if a.Unit == AngleUnitUnknown {
-		return ""
-	}
-	return strconv.FormatFloat(a.Value, 'f', -1, 64) + a.Unit.String()
+	return a.FormatNumbers(formatFloat)
 }
 
 // A Bool is a boolean attribute value.
 type Bool bool
 
 func (b Bool) String() string {
-	if !b {
-		return ""
-	}
 	return strconv.FormatBool(bool(b))
 }
 
 // A Float64 is a floating point attribute value.
 type Float64 float64
 
+// FormatNumbers implements NumberFormatter.FormatNumbers.
+func (f Float64) FormatNumbers(formatFloat func(float64) string) string {
+	return formatFloat(float64(f))
+}
+
 func (f Float64) String() string {
-	if f == 0 {
-		return ""
+	return f.FormatNumbers(formatFloat)
+}
+
+func parseFloat64(s string) (Float64, error) {
+	f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		return 0, err
 	}
-	return strconv.FormatFloat(float64(f), 'f', -1, 64)
+	return Float64(f), nil
 }
 
 // An Int is an integer attribute value.
 type Int int
 
 func (i Int) String() string {
-	if i == 0 {
-		return ""
-	}
 	return strconv.Itoa(int(i))
 }
 
+func parseInt(s string) (Int, error) {
+	i, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil {
+		return 0, err
+	}
+	return Int(i), nil
+}
+
 // A LengthUnit is a length unit.
 type LengthUnit int
 
@@ -124,7 +151,7 @@ const (
 var lengthUnitString = map[LengthUnit]string{
 	LengthUnitNumber:  "",
 	LengthUnitPercent: "%",
-	LengthUnitEms:     "ems",
+	LengthUnitEms:     "em",
 	LengthUnitExs:     "ex",
 	LengthUnitPx:      "px",
 	LengthUnitCM:      "cm",
@@ -227,24 +254,90 @@ func Px(px float64) Length {
 	}
 }
 
+// FormatNumbers implements NumberFormatter.FormatNumbers.
+func (l Length) FormatNumbers(formatFloat func(float64) string) string {
+	return formatFloat(l.Value) + l.Unit.String()
+}
+
 func (l Length) String() string {
-	if l.Unit == LengthUnitUnknown {
-		return ""
+	return l.FormatNumbers(formatFloat)
+}
+
+// parseLength parses a length, for example "4cm" or "50%".
+func parseLength(s string) (Length, error) {
+	s = strings.TrimSpace(s)
+	unit := LengthUnitNumber
+	for lengthUnit, suffix := range lengthUnitString {
+		if suffix != "" && strings.HasSuffix(s, suffix) {
+			unit = lengthUnit
+			s = strings.TrimSuffix(s, suffix)
+			break
+		}
+	}
+	value, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return Length{}, err
 	}
-	return strconv.FormatFloat(l.Value, 'f', -1, 64) + l.Unit.String()
+	return Length{
+		Value: value,
+		Unit:  unit,
+	}, nil
 }
 
+// parsePath parses path data.
+func parsePath(s string) (*svgpath.Path, error) {
+	return svgpath.Parse(s)
+}
+
+// Numbers is a list of numbers attribute value.
+type Numbers []float64
+
+// FormatNumbers implements NumberFormatter.FormatNumbers.
+func (ns Numbers) FormatNumbers(formatFloat func(float64) string) string {
+	numberStrs := make([]string, 0, len(ns))
+	for _, number := range ns {
+		numberStrs = append(numberStrs, formatFloat(number))
+	}
+	return strings.Join(numberStrs, " ")
+}
+
+func (ns Numbers) String() string {
+	return ns.FormatNumbers(formatFloat)
+}
+
+// Points is a list of points attribute value.
 type Points [][]float64
 
-func (ps Points) String() string {
+// parsePoints parses a list of points, for example "0,0 10,0 10,10".
+func parsePoints(s string) (Points, error) {
+	numbers, err := parseNumbers(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(numbers)%2 != 0 {
+		return nil, fmt.Errorf("%q: odd number of coordinates", s)
+	}
+	points := make(Points, 0, len(numbers)/2)
+	for i := 0; i < len(numbers); i += 2 {
+		points = append(points, numbers[i:i+2])
+	}
+	return points, nil
+}
+
+// FormatNumbers implements NumberFormatter.FormatNumbers.
+func (ps Points) FormatNumbers(formatFloat func(float64) string) string {
 	pointStrs := make([]string, 0, len(ps))
 	for _, point := range ps {
-		pointStr := strconv.FormatFloat(point[0], 'f', -1, 64) + "," + strconv.FormatFloat(point[1], 'f', -1, 64)
+		pointStr := formatFloat(point[0]) + "," + formatFloat(point[1])
 		pointStrs = append(pointStrs, pointStr)
 	}
 	return strings.Join(pointStrs, " ")
 }
 
+func (ps Points) String() string {
+	return ps.FormatNumbers(formatFloat)
+}
+
 // A String is a string attribute value.
 type String string
 
@@ -252,6 +345,7 @@ func (s String) String() string {
 	return string(s)
 }
 
+// A ViewBox is a viewBox attribute value.
 type ViewBox struct {
 	MinX   float64
 	MinY   float64
@@ -259,9 +353,48 @@ type ViewBox struct {
 	Height float64
 }
 
+// FormatNumbers implements NumberFormatter.FormatNumbers.
+func (vb ViewBox) FormatNumbers(formatFloat func(float64) string) string {
+	return formatFloat(vb.MinX) + " " +
+		formatFloat(vb.MinY) + " " +
+		formatFloat(vb.Width) + " " +
+		formatFloat(vb.Height)
+}
+
 func (vb ViewBox) String() string {
-	return strconv.FormatFloat(vb.MinX, 'f', -1, 64) + " " +
-		strconv.FormatFloat(vb.MinY, 'f', -1, 64) + " " +
-		strconv.FormatFloat(vb.Width, 'f', -1, 64) + " " +
-		strconv.FormatFloat(vb.Height, 'f', -1, 64)
+	return vb.FormatNumbers(formatFloat)
+}
+
+// parseViewBox parses a viewBox, for example "0 0 400 400".
+func parseViewBox(s string) (ViewBox, error) {
+	numbers, err := parseNumbers(s)
+	if err != nil {
+		return ViewBox{}, err
+	}
+	if len(numbers) != 4 {
+		return ViewBox{}, fmt.Errorf("%q: expected four numbers", s)
+	}
+	return ViewBox{
+		MinX:   numbers[0],
+		MinY:   numbers[1],
+		Width:  numbers[2],
+		Height: numbers[3],
+	}, nil
+}
+
+// parseNumbers parses a list of numbers separated by commas and/or
+// whitespace.
+func parseNumbers(s string) (Numbers, error) {
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t' || r == '\n' || r == '\r'
+	})
+	numbers := make(Numbers, 0, len(fields))
+	for _, field := range fields {
+		number, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return nil, err
+		}
+		numbers = append(numbers, number)
+	}
+	return numbers, nil
 }
@@ -0,0 +1,95 @@
+package svg
+
+import "iter"
+
+// Ancestors returns an iterator over the ancestors of element in the tree
+// rooted at root, starting with element's parent and ending with root. It
+// yields nothing if element is root or is not in the tree.
+func Ancestors(root Element, element Node) iter.Seq[Element] {
+	return func(yield func(Element) bool) {
+		ancestors, ok := findAncestors(root, element, nil)
+		if !ok {
+			return
+		}
+		for i := len(ancestors) - 1; i >= 0; i-- {
+			if !yield(ancestors[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Descendants returns an iterator over the descendants of root, excluding
+// root, in document order. See Walk.
+func Descendants(root Element) iter.Seq[Element] {
+	return func(yield func(Element) bool) {
+		node, ok := root.(Node)
+		if !ok {
+			return
+		}
+		for _, child := range node.ChildElements() {
+			if !walk(child, yield) {
+				return
+			}
+		}
+	}
+}
+
+// OfType returns an iterator over the elements in seq of type T, for example
+//
+//	for rect := range svg.OfType[*svg.RectElement](svg.Walk(root)) {
+//		...
+//	}
+func OfType[T Element](seq iter.Seq[Element]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for element := range seq {
+			if t, ok := element.(T); ok && !yield(t) {
+				return
+			}
+		}
+	}
+}
+
+// Walk returns an iterator over root and all its descendants in document
+// order, parents before children. An element's children are read after the
+// element is yielded, so the loop body may change them.
+func Walk(root Element) iter.Seq[Element] {
+	return func(yield func(Element) bool) {
+		walk(root, yield)
+	}
+}
+
+// findAncestors returns the ancestors of element in the tree rooted at root,
+// starting with root, appended to path, and whether element was found.
+func findAncestors(root Element, element Node, path []Element) ([]Element, bool) {
+	node, ok := root.(Node)
+	if !ok {
+		return nil, false
+	}
+	if node == element {
+		return path, true
+	}
+	path = append(path, root)
+	for _, child := range node.ChildElements() {
+		if ancestors, ok := findAncestors(child, element, path); ok {
+			return ancestors, true
+		}
+	}
+	return nil, false
+}
+
+// walk calls yield for element and its descendants, parents before children,
+// and returns false if yield returns false.
+func walk(element Element, yield func(Element) bool) bool {
+	if !yield(element) {
+		return false
+	}
+	if node, ok := element.(Node); ok {
+		for _, child := range node.ChildElements() {
+			if !walk(child, yield) {
+				return false
+			}
+		}
+	}
+	return true
+}